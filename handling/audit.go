@@ -0,0 +1,122 @@
+package handling
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// AuditEntry is a record of a mutation in the audit trail. Before and After
+// are snapshots of the affected entity. Target IDs are plain values rather
+// than relations, so entries outlive the entities they describe.
+type AuditEntry struct {
+	ID        string          `json:"id"`
+	Time      string          `json:"time"`
+	Actor     string          `json:"actor"`
+	Method    string          `json:"method"`
+	RequestID string          `json:"requestId"`
+	BookID    string          `json:"bookId,omitempty"`
+	ChapterID string          `json:"chapterId,omitempty"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditFilter narrows down the audit trail. Zero values match everything.
+type AuditFilter struct {
+	BookID string
+	Actor  string
+	Since  time.Time
+}
+
+func (s *service) AuditLog(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	where := prisma.AuditEntryWhereInput{}
+	if filter.BookID != "" {
+		where.BookID = &filter.BookID
+	}
+	if filter.Actor != "" {
+		where.Actor = &filter.Actor
+	}
+	if !filter.Since.IsZero() {
+		where.CreatedAtGte = prisma.Str(filter.Since.UTC().Format(time.RFC3339))
+	}
+
+	orderBy := prisma.AuditEntryOrderByInputCreatedAtAsc
+	records, err := client.AuditEntries(&prisma.AuditEntriesParams{
+		Where:   &where,
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]AuditEntry, 0, len(records))
+	for _, r := range records {
+		entries = append(entries, toAuditEntry(r))
+	}
+	return entries, nil
+}
+
+// recordAudit appends an entry for a mutation made on behalf of the actor
+// carried by ctx. The audit trail is append-only: entries are never updated
+// or deleted.
+func recordAudit(ctx context.Context, method, bookID, chapterID string, before, after interface{}) error {
+	input := prisma.AuditEntryCreateInput{
+		Actor:     Actor(ctx),
+		Method:    method,
+		RequestID: RequestID(ctx),
+	}
+	if bookID != "" {
+		input.BookID = &bookID
+	}
+	if chapterID != "" {
+		input.ChapterID = &chapterID
+	}
+
+	var err error
+	if input.Before, err = snapshot(before); err != nil {
+		return err
+	}
+	if input.After, err = snapshot(after); err != nil {
+		return err
+	}
+
+	_, err = client.CreateAuditEntry(input).Exec(ctx)
+	return err
+}
+
+func snapshot(v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return prisma.Str(string(b)), nil
+}
+
+func toAuditEntry(r prisma.AuditEntry) AuditEntry {
+	e := AuditEntry{
+		ID:        r.ID,
+		Time:      r.CreatedAt,
+		Actor:     r.Actor,
+		Method:    r.Method,
+		RequestID: r.RequestID,
+	}
+	if r.BookID != nil {
+		e.BookID = *r.BookID
+	}
+	if r.ChapterID != nil {
+		e.ChapterID = *r.ChapterID
+	}
+	if r.Before != nil {
+		e.Before = json.RawMessage(*r.Before)
+	}
+	if r.After != nil {
+		e.After = json.RawMessage(*r.After)
+	}
+	return e
+}
@@ -0,0 +1,546 @@
+package handling
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+type auditingService struct {
+	logger log.Logger
+	Service
+}
+
+// NewAuditingService returns a new instance of a Service which records
+// every successful mutation in the audit trail.
+func NewAuditingService(logger log.Logger, s Service) Service {
+	return &auditingService{
+		logger:  logger,
+		Service: s,
+	}
+}
+
+// bookSnapshot is the state of a book together with its chapters, which
+// are deleted along with it.
+type bookSnapshot struct {
+	prisma.Book
+	Chapters []prisma.Chapter `json:"chapters"`
+}
+
+func (s *auditingService) AddBook(ctx context.Context, name string, description string, chapters []NewChapter) (prisma.Book, []prisma.Chapter, error) {
+	book, created, err := s.Service.AddBook(ctx, name, description, chapters)
+	if err != nil {
+		return book, created, err
+	}
+	s.record(ctx, "add_book", book.ID, "", nil, bookSnapshot{Book: book, Chapters: created})
+	return book, created, nil
+}
+
+func (s *auditingService) DeleteBook(ctx context.Context, id string, version int32) (prisma.Book, error) {
+	before, err := s.Service.GetBook(ctx, id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	// Capture the chapters which go to the trash along with the book.
+	chapters, err := s.Service.Chapters(ctx, id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	book, err := s.Service.DeleteBook(ctx, id, version)
+	if err != nil {
+		return book, err
+	}
+	s.record(ctx, "delete_book", book.ID, "", bookSnapshot{Book: before, Chapters: chapterList(chapters)}, book)
+	return book, nil
+}
+
+// mergeSnapshot is the state of the two books of a merge.
+type mergeSnapshot struct {
+	Source bookSnapshot `json:"source"`
+	Target bookSnapshot `json:"target"`
+}
+
+func (s *auditingService) MergeBooks(ctx context.Context, sourceID string, targetID string, strategy MergeStrategy, archive bool, version int32) (prisma.Book, error) {
+	source, err := s.snapshotBook(ctx, sourceID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	target, err := s.snapshotBook(ctx, targetID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	book, err := s.Service.MergeBooks(ctx, sourceID, targetID, strategy, archive, version)
+	if err != nil {
+		return book, err
+	}
+
+	after, err := s.snapshotBook(ctx, book.ID)
+	if err != nil {
+		after = bookSnapshot{Book: book}
+	}
+	s.record(ctx, "merge_books", book.ID, "", mergeSnapshot{Source: source, Target: target}, after)
+	return book, nil
+}
+
+func (s *auditingService) DuplicateBook(ctx context.Context, id string, name string) (prisma.Book, []prisma.Chapter, error) {
+	book, chapters, err := s.Service.DuplicateBook(ctx, id, name)
+	if err != nil {
+		return book, chapters, err
+	}
+	s.record(ctx, "duplicate_book", book.ID, "", nil, bookSnapshot{Book: book, Chapters: chapters})
+	return book, chapters, nil
+}
+
+func (s *auditingService) AddChapter(ctx context.Context, name string, description string, bookID string) (prisma.Chapter, error) {
+	chapter, err := s.Service.AddChapter(ctx, name, description, bookID)
+	if err != nil {
+		return chapter, err
+	}
+	s.record(ctx, "add_chapter", bookID, chapter.ID, nil, chapter)
+	return chapter, nil
+}
+
+func (s *auditingService) DeleteChapter(ctx context.Context, id string, version int32) (prisma.Chapter, error) {
+	before, err := s.Service.GetChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := s.Service.DeleteChapter(ctx, id, version)
+	if err != nil {
+		return chapter, err
+	}
+	s.record(ctx, "delete_chapter", book.ID, chapter.ID, before, chapter)
+	return chapter, nil
+}
+
+func (s *auditingService) RestoreBook(ctx context.Context, id string) (prisma.Book, error) {
+	book, err := s.Service.RestoreBook(ctx, id)
+	if err != nil {
+		return book, err
+	}
+	s.record(ctx, "restore_book", book.ID, "", nil, book)
+	return book, nil
+}
+
+func (s *auditingService) RestoreChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := s.Service.RestoreChapter(ctx, id)
+	if err != nil {
+		return chapter, err
+	}
+	s.record(ctx, "restore_chapter", book.ID, chapter.ID, nil, chapter)
+	return chapter, nil
+}
+
+func (s *auditingService) MoveChapter(ctx context.Context, id string, bookID string, position int32, version int32) (prisma.Chapter, error) {
+	before, err := s.Service.GetChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := s.Service.MoveChapter(ctx, id, bookID, position, version)
+	if err != nil {
+		return chapter, err
+	}
+	if chapter.Revision != before.Revision {
+		s.record(ctx, "move_chapter", bookID, chapter.ID, before, chapter)
+	}
+	return chapter, nil
+}
+
+func (s *auditingService) CopyChapter(ctx context.Context, id string, bookID string, position int32) (prisma.Chapter, error) {
+	chapter, err := s.Service.CopyChapter(ctx, id, bookID, position)
+	if err != nil {
+		return chapter, err
+	}
+	s.record(ctx, "copy_chapter", bookID, chapter.ID, nil, chapter)
+	return chapter, nil
+}
+
+func (s *auditingService) UpdateBook(ctx context.Context, id string, name string, description string, version int32) (prisma.Book, error) {
+	return s.recordBookUpdate(ctx, "update_book", id, func() (prisma.Book, error) {
+		return s.Service.UpdateBook(ctx, id, name, description, version)
+	})
+}
+
+func (s *auditingService) UpdateBookMetadata(ctx context.Context, id string, m Metadata, version int32) (prisma.Book, error) {
+	return s.recordBookUpdate(ctx, "update_book_metadata", id, func() (prisma.Book, error) {
+		return s.Service.UpdateBookMetadata(ctx, id, m, version)
+	})
+}
+
+func (s *auditingService) RevertBook(ctx context.Context, id string, number int32, version int32) (prisma.Book, error) {
+	return s.recordBookUpdate(ctx, "revert_book", id, func() (prisma.Book, error) {
+		return s.Service.RevertBook(ctx, id, number, version)
+	})
+}
+
+func (s *auditingService) UpdateChapter(ctx context.Context, id string, name string, description string, version int32) (prisma.Chapter, error) {
+	return s.recordChapterUpdate(ctx, "update_chapter", id, func() (prisma.Chapter, error) {
+		return s.Service.UpdateChapter(ctx, id, name, description, version)
+	})
+}
+
+func (s *auditingService) RevertChapter(ctx context.Context, id string, number int32, version int32) (prisma.Chapter, error) {
+	return s.recordChapterUpdate(ctx, "revert_chapter", id, func() (prisma.Chapter, error) {
+		return s.Service.RevertChapter(ctx, id, number, version)
+	})
+}
+
+func (s *auditingService) TagBook(ctx context.Context, id string, tag string) ([]string, error) {
+	return s.recordTags(ctx, "tag_book", id, "", s.Service.BookTags, func() ([]string, error) {
+		return s.Service.TagBook(ctx, id, tag)
+	})
+}
+
+func (s *auditingService) UntagBook(ctx context.Context, id string, tag string) ([]string, error) {
+	return s.recordTags(ctx, "untag_book", id, "", s.Service.BookTags, func() ([]string, error) {
+		return s.Service.UntagBook(ctx, id, tag)
+	})
+}
+
+func (s *auditingService) TagChapter(ctx context.Context, id string, tag string) ([]string, error) {
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.recordTags(ctx, "tag_chapter", book.ID, id, s.Service.ChapterTags, func() ([]string, error) {
+		return s.Service.TagChapter(ctx, id, tag)
+	})
+}
+
+func (s *auditingService) UntagChapter(ctx context.Context, id string, tag string) ([]string, error) {
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.recordTags(ctx, "untag_chapter", book.ID, id, s.Service.ChapterTags, func() ([]string, error) {
+		return s.Service.UntagChapter(ctx, id, tag)
+	})
+}
+
+func (s *auditingService) AddNote(ctx context.Context, chapterID string, n NoteInput) (prisma.Note, error) {
+	book, err := s.Service.ChapterBook(ctx, chapterID)
+	if err != nil {
+		return prisma.Note{}, err
+	}
+
+	note, err := s.Service.AddNote(ctx, chapterID, n)
+	if err != nil {
+		return note, err
+	}
+	s.record(ctx, "add_note", book.ID, chapterID, nil, note)
+	return note, nil
+}
+
+func (s *auditingService) UpdateNote(ctx context.Context, id string, n NoteInput) (prisma.Note, error) {
+	before, err := s.Service.GetNote(ctx, id)
+	if err != nil {
+		return prisma.Note{}, err
+	}
+
+	chapter, book, err := s.noteParents(ctx, id)
+	if err != nil {
+		return prisma.Note{}, err
+	}
+
+	note, err := s.Service.UpdateNote(ctx, id, n)
+	if err != nil {
+		return note, err
+	}
+	s.record(ctx, "update_note", book.ID, chapter.ID, before, note)
+	return note, nil
+}
+
+func (s *auditingService) DeleteNote(ctx context.Context, id string) (prisma.Note, error) {
+	chapter, book, err := s.noteParents(ctx, id)
+	if err != nil {
+		return prisma.Note{}, err
+	}
+
+	note, err := s.Service.DeleteNote(ctx, id)
+	if err != nil {
+		return note, err
+	}
+	s.record(ctx, "delete_note", book.ID, chapter.ID, note, nil)
+	return note, nil
+}
+
+// noteParents returns the chapter and the book of the note with the given
+// id.
+func (s *auditingService) noteParents(ctx context.Context, id string) (prisma.Chapter, prisma.Book, error) {
+	chapter, err := s.Service.NoteChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, prisma.Book{}, err
+	}
+
+	book, err := s.Service.ChapterBook(ctx, chapter.ID)
+	if err != nil {
+		return prisma.Chapter{}, prisma.Book{}, err
+	}
+
+	return chapter, book, nil
+}
+
+func (s *auditingService) AddCard(ctx context.Context, chapterID string, c CardInput) (prisma.Card, error) {
+	book, err := s.Service.ChapterBook(ctx, chapterID)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	card, err := s.Service.AddCard(ctx, chapterID, c)
+	if err != nil {
+		return card, err
+	}
+	s.record(ctx, "add_card", book.ID, chapterID, nil, card)
+	return card, nil
+}
+
+func (s *auditingService) DeleteCard(ctx context.Context, id string) (prisma.Card, error) {
+	chapter, err := s.Service.CardChapter(ctx, id)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	book, err := s.Service.ChapterBook(ctx, chapter.ID)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	card, err := s.Service.DeleteCard(ctx, id)
+	if err != nil {
+		return card, err
+	}
+	s.record(ctx, "delete_card", book.ID, chapter.ID, card, nil)
+	return card, nil
+}
+
+func (s *auditingService) GradeCard(ctx context.Context, id string, grade int32) (prisma.Card, error) {
+	before, err := s.Service.GetCard(ctx, id)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	chapter, err := s.Service.CardChapter(ctx, id)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	book, err := s.Service.ChapterBook(ctx, chapter.ID)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	card, err := s.Service.GradeCard(ctx, id, grade)
+	if err != nil {
+		return card, err
+	}
+	s.record(ctx, "grade_card", book.ID, chapter.ID, before, card)
+	return card, nil
+}
+
+func (s *auditingService) SetChapterStatus(ctx context.Context, id string, status prisma.ReadingStatus) (ReadingChapter, error) {
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return ReadingChapter{}, err
+	}
+
+	chapters, err := s.Service.Chapters(ctx, book.ID)
+	if err != nil {
+		return ReadingChapter{}, err
+	}
+
+	var before interface{}
+	for _, c := range chapters {
+		if c.ID == id {
+			before = c
+		}
+	}
+
+	chapter, err := s.Service.SetChapterStatus(ctx, id, status)
+	if err != nil {
+		return chapter, err
+	}
+	s.record(ctx, "set_chapter_status", book.ID, chapter.ID, before, chapter)
+	return chapter, nil
+}
+
+// StartSession records the session started, and the one it stopped if
+// another was open.
+func (s *auditingService) StartSession(ctx context.Context, bookID string, chapterID string) (Session, error) {
+	var before interface{}
+	open, err := s.Service.CurrentSession(ctx)
+	switch err {
+	case nil:
+		before = open
+	case ErrNotFound:
+	default:
+		return Session{}, err
+	}
+
+	session, err := s.Service.StartSession(ctx, bookID, chapterID)
+	if err != nil {
+		return session, err
+	}
+	if before == nil || open.ID != session.ID {
+		s.record(ctx, "start_session", bookID, chapterID, before, session)
+	}
+	return session, nil
+}
+
+func (s *auditingService) StopSession(ctx context.Context, pages int32) (Session, error) {
+	before, err := s.Service.CurrentSession(ctx)
+	if err != nil {
+		return Session{}, err
+	}
+
+	session, err := s.Service.StopSession(ctx, pages)
+	if err != nil {
+		return session, err
+	}
+	s.record(ctx, "stop_session", session.BookID, session.ChapterID, before, session)
+	return session, nil
+}
+
+func (s *auditingService) CreateCollection(ctx context.Context, name string, description string) (prisma.Collection, error) {
+	collection, err := s.Service.CreateCollection(ctx, name, description)
+	if err != nil {
+		return collection, err
+	}
+	s.record(ctx, "create_collection", "", "", nil, collection)
+	return collection, nil
+}
+
+func (s *auditingService) DeleteCollection(ctx context.Context, id string) (prisma.Collection, error) {
+	collection, err := s.Service.DeleteCollection(ctx, id)
+	if err != nil {
+		return collection, err
+	}
+	s.record(ctx, "delete_collection", "", "", collection, nil)
+	return collection, nil
+}
+
+// collectionMembership is the state of a book in a collection.
+type collectionMembership struct {
+	Collection string `json:"collection"`
+}
+
+func (s *auditingService) AddToCollection(ctx context.Context, id string, bookID string) error {
+	if err := s.Service.AddToCollection(ctx, id, bookID); err != nil {
+		return err
+	}
+	s.record(ctx, "add_to_collection", bookID, "", nil, collectionMembership{Collection: id})
+	return nil
+}
+
+func (s *auditingService) RemoveFromCollection(ctx context.Context, id string, bookID string) error {
+	if err := s.Service.RemoveFromCollection(ctx, id, bookID); err != nil {
+		return err
+	}
+	s.record(ctx, "remove_from_collection", bookID, "", collectionMembership{Collection: id}, nil)
+	return nil
+}
+
+// recordTags runs update and records it if it changed the tags of the
+// book or chapter, which tags returns.
+func (s *auditingService) recordTags(ctx context.Context, method, bookID, chapterID string, tags func(context.Context, string) ([]string, error), update func() ([]string, error)) ([]string, error) {
+	id := bookID
+	if chapterID != "" {
+		id = chapterID
+	}
+
+	before, err := tags(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := update()
+	if err != nil {
+		return after, err
+	}
+	if len(after) != len(before) {
+		s.record(ctx, method, bookID, chapterID, before, after)
+	}
+	return after, nil
+}
+
+func (s *auditingService) recordBookUpdate(ctx context.Context, method, id string, update func() (prisma.Book, error)) (prisma.Book, error) {
+	before, err := s.Service.GetBook(ctx, id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	book, err := update()
+	if err != nil {
+		return book, err
+	}
+	if book.Revision != before.Revision {
+		s.record(ctx, method, book.ID, "", before, book)
+	}
+	return book, nil
+}
+
+func (s *auditingService) recordChapterUpdate(ctx context.Context, method, id string, update func() (prisma.Chapter, error)) (prisma.Chapter, error) {
+	before, err := s.Service.GetChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := update()
+	if err != nil {
+		return chapter, err
+	}
+	if chapter.Revision != before.Revision {
+		s.record(ctx, method, book.ID, chapter.ID, before, chapter)
+	}
+	return chapter, nil
+}
+
+// snapshotBook returns the book with the given id together with its chapters.
+func (s *auditingService) snapshotBook(ctx context.Context, id string) (bookSnapshot, error) {
+	book, err := s.Service.GetBook(ctx, id)
+	if err != nil {
+		return bookSnapshot{}, err
+	}
+
+	chapters, err := s.Service.Chapters(ctx, id)
+	if err != nil {
+		return bookSnapshot{}, err
+	}
+
+	return bookSnapshot{Book: book, Chapters: chapterList(chapters)}, nil
+}
+
+// record writes an audit entry. The mutation has already taken place, so a
+// failure is logged rather than returned.
+func (s *auditingService) record(ctx context.Context, method, bookID, chapterID string, before, after interface{}) {
+	if err := recordAudit(ctx, method, bookID, chapterID, before, after); err != nil {
+		s.logger.Log(
+			"method", method,
+			"book_id", bookID,
+			"chapter_id", chapterID,
+			"request_id", RequestID(ctx),
+			"err", err,
+		)
+	}
+}
@@ -0,0 +1,156 @@
+package handling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// auditedService answers the reads the auditing service makes around the
+// mutations it records.
+type auditedService struct {
+	Service
+	open *Session
+}
+
+func (s *auditedService) GetCard(_ context.Context, id string) (prisma.Card, error) {
+	return prisma.Card{ID: id}, nil
+}
+
+func (s *auditedService) CardChapter(context.Context, string) (prisma.Chapter, error) {
+	return prisma.Chapter{ID: "c1"}, nil
+}
+
+func (s *auditedService) ChapterBook(context.Context, string) (prisma.Book, error) {
+	return prisma.Book{ID: "b1"}, nil
+}
+
+func (s *auditedService) Chapters(context.Context, string) ([]ReadingChapter, error) {
+	return []ReadingChapter{{Chapter: prisma.Chapter{ID: "c1"}, Status: prisma.ReadingStatusUnread}}, nil
+}
+
+func (s *auditedService) CurrentSession(context.Context) (Session, error) {
+	if s.open == nil {
+		return Session{}, ErrNotFound
+	}
+	return *s.open, nil
+}
+
+func (s *auditedService) GradeCard(_ context.Context, id string, grade int32) (prisma.Card, error) {
+	return prisma.Card{ID: id, ReviewCount: 1}, nil
+}
+
+func (s *auditedService) SetChapterStatus(_ context.Context, id string, status prisma.ReadingStatus) (ReadingChapter, error) {
+	return ReadingChapter{Chapter: prisma.Chapter{ID: id}, Status: status}, nil
+}
+
+func (s *auditedService) StartSession(_ context.Context, bookID string, chapterID string) (Session, error) {
+	if s.open != nil && s.open.BookID == bookID {
+		return *s.open, nil
+	}
+	return Session{ReadingSession: prisma.ReadingSession{ID: "s2"}, BookID: bookID, ChapterID: chapterID}, nil
+}
+
+func (s *auditedService) StopSession(context.Context, int32) (Session, error) {
+	return Session{ReadingSession: prisma.ReadingSession{ID: "s1"}, BookID: "b1", ChapterID: "c1"}, nil
+}
+
+func (s *auditedService) CreateCollection(_ context.Context, name string, description string) (prisma.Collection, error) {
+	return prisma.Collection{ID: "l1", Name: name}, nil
+}
+
+func (s *auditedService) DeleteCollection(_ context.Context, id string) (prisma.Collection, error) {
+	return prisma.Collection{ID: id}, nil
+}
+
+func TestAuditingRecords(t *testing.T) {
+	open := &Session{ReadingSession: prisma.ReadingSession{ID: "s1"}, BookID: "b1"}
+
+	tests := []struct {
+		name      string
+		open      *Session
+		call      func(s Service) error
+		method    string
+		bookID    string
+		chapterID string
+		before    bool
+		after     bool
+	}{
+		{"grade card", nil, func(s Service) error {
+			_, err := s.GradeCard(context.Background(), "k1", 4)
+			return err
+		}, "grade_card", "b1", "c1", true, true},
+		{"set chapter status", nil, func(s Service) error {
+			_, err := s.SetChapterStatus(context.Background(), "c1", prisma.ReadingStatusFinished)
+			return err
+		}, "set_chapter_status", "b1", "c1", true, true},
+		{"start session", nil, func(s Service) error {
+			_, err := s.StartSession(context.Background(), "b1", "c1")
+			return err
+		}, "start_session", "b1", "c1", false, true},
+		{"start session stopping another", open, func(s Service) error {
+			_, err := s.StartSession(context.Background(), "b2", "")
+			return err
+		}, "start_session", "b2", "", true, true},
+		{"start session already open", open, func(s Service) error {
+			_, err := s.StartSession(context.Background(), "b1", "")
+			return err
+		}, "", "", "", false, false},
+		{"stop session", open, func(s Service) error {
+			_, err := s.StopSession(context.Background(), 12)
+			return err
+		}, "stop_session", "b1", "c1", true, true},
+		{"create collection", nil, func(s Service) error {
+			_, err := s.CreateCollection(context.Background(), "Classics", "")
+			return err
+		}, "create_collection", "", "", false, true},
+		{"delete collection", nil, func(s Service) error {
+			_, err := s.DeleteCollection(context.Background(), "l1")
+			return err
+		}, "delete_collection", "", "", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, restore := usePrisma(t, map[string]prismaOp{
+				"createAuditEntry": returns(map[string]interface{}{"id": "a1"}),
+			})
+			defer restore()
+
+			s := NewAuditingService(log.NewNopLogger(), &auditedService{open: tt.open})
+			if err := tt.call(s); err != nil {
+				t.Fatal(err)
+			}
+
+			entries := f.called("createAuditEntry")
+			if tt.method == "" {
+				if len(entries) != 0 {
+					t.Errorf("got %d audit entries, want none", len(entries))
+				}
+				return
+			}
+			if len(entries) != 1 {
+				t.Fatalf("got %d audit entries, want 1", len(entries))
+			}
+
+			data := entries[0].Vars["data"]
+			if got := lookup(data, "method"); got != tt.method {
+				t.Errorf("method = %v, want %s", got, tt.method)
+			}
+			if got, _ := lookup(data, "bookId").(string); got != tt.bookID {
+				t.Errorf("bookId = %q, want %q", got, tt.bookID)
+			}
+			if got, _ := lookup(data, "chapterId").(string); got != tt.chapterID {
+				t.Errorf("chapterId = %q, want %q", got, tt.chapterID)
+			}
+			if got := lookup(data, "before") != nil; got != tt.before {
+				t.Errorf("before recorded: %v, want %v", got, tt.before)
+			}
+			if got := lookup(data, "after") != nil; got != tt.after {
+				t.Errorf("after recorded: %v, want %v", got, tt.after)
+			}
+		})
+	}
+}
@@ -0,0 +1,365 @@
+package handling
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// BulkService is the interface that provides operations on many books or
+// chapters at once.
+//
+// A bulk operation applies the single item operation of a Service to every
+// selected item, so each item gets the same revisions, events and audit
+// entries as if it had been changed on its own. Items are changed one by
+// one and a failing item does not stop the rest; the result reports the
+// outcome of every item.
+type BulkService interface {
+	DeleteBooks(ctx context.Context, sel Selection, dryRun bool) (BulkResult, error)
+	UpdateBooks(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (BulkResult, error)
+	DeleteChapters(ctx context.Context, sel Selection, dryRun bool) (BulkResult, error)
+	UpdateChapters(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (BulkResult, error)
+	MoveChapters(ctx context.Context, sel Selection, bookID string, dryRun bool) (BulkResult, error)
+	ImportMarkdown(ctx context.Context, doc string, dryRun bool) (ImportResult, error)
+}
+
+// Selection picks the items of a bulk operation, either by ID or by
+// filter. Exactly one of the two has to be given.
+type Selection struct {
+	IDs    []string    `json:"ids,omitempty"`
+	Filter *BulkFilter `json:"filter,omitempty"`
+}
+
+// BulkFilter selects the items matching all of its fields. Items in the
+// trash never match. At least one field has to be set, so that a filter
+// cannot select everything by accident.
+type BulkFilter struct {
+	// BookID narrows a chapter filter to a single book.
+	BookID              string     `json:"bookId,omitempty"`
+	NameContains        string     `json:"nameContains,omitempty"`
+	DescriptionContains string     `json:"descriptionContains,omitempty"`
+	CreatedBefore       *time.Time `json:"createdBefore,omitempty"`
+	CreatedAfter        *time.Time `json:"createdAfter,omitempty"`
+}
+
+func (f BulkFilter) empty() bool {
+	return f.BookID == "" && f.NameContains == "" && f.DescriptionContains == "" &&
+		f.CreatedBefore == nil && f.CreatedAfter == nil
+}
+
+// BulkUpdate holds the fields a bulk update sets. Fields left nil keep
+// their value.
+type BulkUpdate struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// BulkResult reports the outcome of a bulk operation. In a dry run nothing
+// is changed and the items are the ones which would be.
+type BulkResult struct {
+	DryRun    bool       `json:"dryRun"`
+	Matched   int        `json:"matched"`
+	Succeeded int        `json:"succeeded"`
+	Failed    int        `json:"failed"`
+	Items     []BulkItem `json:"items"`
+}
+
+// BulkItem is the outcome of a bulk operation for a single item.
+type BulkItem struct {
+	ID    string `json:"id"`
+	Error string `json:"error,omitempty"`
+}
+
+type bulkService struct {
+	s Service
+}
+
+// NewBulkService returns a new instance of a BulkService which changes
+// items through s.
+func NewBulkService(s Service) BulkService {
+	return &bulkService{s: s}
+}
+
+func (b *bulkService) DeleteBooks(ctx context.Context, sel Selection, dryRun bool) (BulkResult, error) {
+	ids, err := b.selectBooks(ctx, sel)
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	return b.apply(ids, dryRun, b.bookExists(ctx), func(id string) error {
+		_, err := b.s.DeleteBook(ctx, id, AnyVersion)
+		return err
+	}), nil
+}
+
+func (b *bulkService) UpdateBooks(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (BulkResult, error) {
+	if !set.valid() {
+		return BulkResult{}, ErrInvalidArgument
+	}
+
+	ids, err := b.selectBooks(ctx, sel)
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	// Book names are unique, so all books but the first would fail.
+	if set.Name != nil && len(ids) > 1 {
+		return BulkResult{}, ErrInvalidArgument
+	}
+
+	return b.apply(ids, dryRun, b.bookExists(ctx), func(id string) error {
+		book, err := b.s.GetBook(ctx, id)
+		if err != nil {
+			return err
+		}
+		name, description := set.apply(book.Name, book.Description)
+		_, err = b.s.UpdateBook(ctx, id, name, description, AnyVersion)
+		return err
+	}), nil
+}
+
+func (b *bulkService) DeleteChapters(ctx context.Context, sel Selection, dryRun bool) (BulkResult, error) {
+	ids, err := b.selectChapters(ctx, sel)
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	return b.apply(ids, dryRun, b.chapterExists(ctx), func(id string) error {
+		_, err := b.s.DeleteChapter(ctx, id, AnyVersion)
+		return err
+	}), nil
+}
+
+func (b *bulkService) UpdateChapters(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (BulkResult, error) {
+	if !set.valid() {
+		return BulkResult{}, ErrInvalidArgument
+	}
+
+	ids, err := b.selectChapters(ctx, sel)
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	return b.apply(ids, dryRun, b.chapterExists(ctx), func(id string) error {
+		chapter, err := b.s.GetChapter(ctx, id)
+		if err != nil {
+			return err
+		}
+		name, description := set.apply(chapter.Name, chapter.Description)
+		_, err = b.s.UpdateChapter(ctx, id, name, description, AnyVersion)
+		return err
+	}), nil
+}
+
+// MoveChapters moves the selected chapters to the end of the book with the
+// id bookID, in the order they are selected in.
+func (b *bulkService) MoveChapters(ctx context.Context, sel Selection, bookID string, dryRun bool) (BulkResult, error) {
+	if bookID == "" {
+		return BulkResult{}, ErrInvalidArgument
+	}
+
+	ids, err := b.selectChapters(ctx, sel)
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	// Without the book no chapter can be moved.
+	if _, err := b.s.GetBook(ctx, bookID); err != nil {
+		return BulkResult{}, err
+	}
+
+	return b.apply(ids, dryRun, b.chapterExists(ctx), func(id string) error {
+		_, err := b.s.MoveChapter(ctx, id, bookID, LastPosition, AnyVersion)
+		return err
+	}), nil
+}
+
+// apply runs op for every id, or only check in a dry run, and collects
+// the outcomes.
+func (b *bulkService) apply(ids []string, dryRun bool, check, op func(id string) error) BulkResult {
+	result := BulkResult{
+		DryRun:  dryRun,
+		Matched: len(ids),
+		Items:   make([]BulkItem, 0, len(ids)),
+	}
+
+	for _, id := range ids {
+		item := BulkItem{ID: id}
+
+		var err error
+		if dryRun {
+			err = check(id)
+		} else {
+			err = op(id)
+		}
+
+		if err != nil {
+			item.Error = err.Error()
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return result
+}
+
+// bookExists returns a check whether a book can be changed.
+func (b *bulkService) bookExists(ctx context.Context) func(id string) error {
+	return func(id string) error {
+		_, err := b.s.GetBook(ctx, id)
+		return err
+	}
+}
+
+// chapterExists returns a check whether a chapter can be changed.
+func (b *bulkService) chapterExists(ctx context.Context) func(id string) error {
+	return func(id string) error {
+		_, err := b.s.GetChapter(ctx, id)
+		return err
+	}
+}
+
+func (b *bulkService) selectBooks(ctx context.Context, sel Selection) ([]string, error) {
+	if err := sel.validate(); err != nil {
+		return nil, err
+	}
+	if sel.Filter == nil {
+		return uniqueIDs(sel.IDs), nil
+	}
+
+	// Books have no book to narrow them down to.
+	if sel.Filter.BookID != "" {
+		return nil, ErrInvalidArgument
+	}
+
+	where := prisma.BookWhereInput{
+		Deleted: prisma.Bool(false),
+	}
+	if sel.Filter.NameContains != "" {
+		where.NameContains = &sel.Filter.NameContains
+	}
+	if sel.Filter.DescriptionContains != "" {
+		where.DescriptionContains = &sel.Filter.DescriptionContains
+	}
+	if sel.Filter.CreatedBefore != nil {
+		where.CreatedAtLt = prisma.Str(formatTime(*sel.Filter.CreatedBefore))
+	}
+	if sel.Filter.CreatedAfter != nil {
+		where.CreatedAtGt = prisma.Str(formatTime(*sel.Filter.CreatedAfter))
+	}
+
+	orderBy := prisma.BookOrderByInputCreatedAtAsc
+	books, err := client.Books(&prisma.BooksParams{
+		Where:   &where,
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(books))
+	for i, book := range books {
+		ids[i] = book.ID
+	}
+	return ids, nil
+}
+
+func (b *bulkService) selectChapters(ctx context.Context, sel Selection) ([]string, error) {
+	if err := sel.validate(); err != nil {
+		return nil, err
+	}
+	if sel.Filter == nil {
+		return uniqueIDs(sel.IDs), nil
+	}
+
+	book := prisma.BookWhereInput{
+		Deleted: prisma.Bool(false),
+	}
+	if sel.Filter.BookID != "" {
+		book.ID = &sel.Filter.BookID
+	}
+
+	where := prisma.ChapterWhereInput{
+		Deleted: prisma.Bool(false),
+		Book:    &book,
+	}
+	if sel.Filter.NameContains != "" {
+		where.NameContains = &sel.Filter.NameContains
+	}
+	if sel.Filter.DescriptionContains != "" {
+		where.DescriptionContains = &sel.Filter.DescriptionContains
+	}
+	if sel.Filter.CreatedBefore != nil {
+		where.CreatedAtLt = prisma.Str(formatTime(*sel.Filter.CreatedBefore))
+	}
+	if sel.Filter.CreatedAfter != nil {
+		where.CreatedAtGt = prisma.Str(formatTime(*sel.Filter.CreatedAfter))
+	}
+
+	orderBy := prisma.ChapterOrderByInputCreatedAtAsc
+	chapters, err := client.Chapters(&prisma.ChaptersParams{
+		Where:   &where,
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(chapters))
+	for i, chapter := range chapters {
+		ids[i] = chapter.ID
+	}
+	return ids, nil
+}
+
+func (sel Selection) validate() error {
+	switch {
+	case len(sel.IDs) > 0 && sel.Filter != nil:
+		return ErrInvalidArgument
+	case sel.Filter != nil && sel.Filter.empty():
+		return ErrInvalidArgument
+	case len(sel.IDs) == 0 && sel.Filter == nil:
+		return ErrInvalidArgument
+	}
+	for _, id := range sel.IDs {
+		if id == "" {
+			return ErrInvalidArgument
+		}
+	}
+	return nil
+}
+
+func (set BulkUpdate) valid() bool {
+	if set.Name == nil && set.Description == nil {
+		return false
+	}
+	return (set.Name == nil || *set.Name != "") && (set.Description == nil || *set.Description != "")
+}
+
+func (set BulkUpdate) apply(name, description string) (string, string) {
+	if set.Name != nil {
+		name = *set.Name
+	}
+	if set.Description != nil {
+		description = *set.Description
+	}
+	return name, description
+}
+
+// uniqueIDs returns ids without repetitions, in the order they first occur.
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,453 @@
+package handling
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Cards are flashcards for revisiting what was read. A card belongs to a
+// chapter and may be made from one of its notes. Cards are scheduled with
+// the SM-2 algorithm: every review is graded from 0 (forgotten) to 5
+// (perfect recall), and the grade decides when the card is due again.
+// Cards of chapters in the trash are left out of reviews.
+
+// Grades of a review.
+const (
+	MinGrade = 0
+	MaxGrade = 5
+
+	// passingGrade is the lowest grade of a card which was recalled.
+	passingGrade = 3
+)
+
+const (
+	// initialEase is the ease of a new card and minEase the lowest one.
+	initialEase = 2.5
+	minEase     = 1.3
+
+	// matureInterval is the interval in days from which a card counts as
+	// learned.
+	matureInterval = 21
+
+	// defaultDueLimit and maxDueLimit bound the number of cards due
+	// returned at once.
+	defaultDueLimit = 20
+	maxDueLimit     = 100
+)
+
+// CardInput holds the fields of a new card. If NoteID is set, the card is
+// made from that note, otherwise from the chapter itself. Front and Back
+// override what would be taken from the note or the chapter.
+type CardInput struct {
+	NoteID string `json:"noteId"`
+	Front  string `json:"front"`
+	Back   string `json:"back"`
+}
+
+// ReviewStats sums up the cards of a book and how their reviews went.
+type ReviewStats struct {
+	Cards        int     `json:"cards"`
+	New          int     `json:"new"`
+	Due          int     `json:"due"`
+	Mature       int     `json:"mature"`
+	Reviews      int     `json:"reviews"`
+	ReviewsToday int     `json:"reviewsToday"`
+	Retention    float64 `json:"retention"`
+	AverageEase  float64 `json:"averageEase"`
+}
+
+func (s *service) AddCard(ctx context.Context, chapterID string, c CardInput) (prisma.Card, error) {
+	if chapterID == "" {
+		return prisma.Card{}, ErrInvalidArgument
+	}
+
+	chapter, err := activeChapter(ctx, chapterID)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	front, back := chapter.Name, chapter.Description
+
+	var note *prisma.NoteCreateOneWithoutCardsInput
+	if c.NoteID != "" {
+		n, err := activeNote(ctx, c.NoteID)
+		if err != nil {
+			return prisma.Card{}, err
+		}
+
+		parent, err := s.NoteChapter(ctx, n.ID)
+		if err != nil {
+			return prisma.Card{}, err
+		}
+		if parent.ID != chapterID {
+			return prisma.Card{}, ErrInvalidArgument
+		}
+
+		front, back = noteCard(*n, *chapter)
+		note = &prisma.NoteCreateOneWithoutCardsInput{
+			Connect: &prisma.NoteWhereUniqueInput{
+				ID: &n.ID,
+			},
+		}
+	}
+
+	if f := strings.TrimSpace(c.Front); f != "" {
+		front = f
+	}
+	if b := strings.TrimSpace(c.Back); b != "" {
+		back = b
+	}
+
+	front, back = strings.TrimSpace(front), strings.TrimSpace(back)
+	if front == "" || back == "" {
+		return prisma.Card{}, ErrInvalidArgument
+	}
+
+	card, err := client.CreateCard(prisma.CardCreateInput{
+		Front: front,
+		Back:  back,
+		Due:   formatTime(time.Now()),
+		Chapter: prisma.ChapterCreateOneWithoutCardsInput{
+			Connect: &prisma.ChapterWhereUniqueInput{
+				ID: &chapterID,
+			},
+		},
+		Note: note,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	return *card, nil
+}
+
+func (s *service) GetCard(ctx context.Context, id string) (prisma.Card, error) {
+	if id == "" {
+		return prisma.Card{}, ErrInvalidArgument
+	}
+
+	card, err := activeCard(ctx, id)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	return *card, nil
+}
+
+func (s *service) DeleteCard(ctx context.Context, id string) (prisma.Card, error) {
+	if id == "" {
+		return prisma.Card{}, ErrInvalidArgument
+	}
+
+	if _, err := activeCard(ctx, id); err != nil {
+		return prisma.Card{}, err
+	}
+
+	card, err := client.DeleteCard(prisma.CardWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	return *card, nil
+}
+
+func (s *service) Cards(ctx context.Context, chapterID string) ([]prisma.Card, error) {
+	if chapterID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeChapter(ctx, chapterID); err != nil {
+		return nil, err
+	}
+
+	orderBy := prisma.CardOrderByInputCreatedAtAsc
+	cards, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &chapterID,
+	}).Cards(&prisma.CardsParamsExec{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+func (s *service) CardChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	if id == "" {
+		return prisma.Chapter{}, ErrInvalidArgument
+	}
+
+	chapter, err := client.Card(prisma.CardWhereUniqueInput{
+		ID: &id,
+	}).Chapter().Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	return *chapter, nil
+}
+
+// DueCards returns the cards due for review, those overdue the longest
+// first. Without a book id, the cards of every book are returned. A limit
+// of zero is the default limit.
+func (s *service) DueCards(ctx context.Context, bookID string, limit int32) ([]prisma.Card, error) {
+	switch {
+	case limit < 0:
+		return nil, ErrInvalidArgument
+	case limit == 0:
+		limit = defaultDueLimit
+	case limit > maxDueLimit:
+		limit = maxDueLimit
+	}
+
+	where := activeCards(bookID)
+	where.DueLte = prisma.Str(formatTime(time.Now()))
+
+	orderBy := prisma.CardOrderByInputDueAsc
+	cards, err := client.Cards(&prisma.CardsParams{
+		Where:   where,
+		OrderBy: &orderBy,
+		First:   &limit,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+// GradeCard records a review of the card with the given id and schedules
+// its next one. Every review is numbered, so a card graded twice at the
+// same time is only scheduled once and the other grade fails with
+// ErrVersionMismatch.
+func (s *service) GradeCard(ctx context.Context, id string, grade int32) (prisma.Card, error) {
+	if id == "" || grade < MinGrade || grade > MaxGrade {
+		return prisma.Card{}, ErrInvalidArgument
+	}
+
+	card, err := activeCard(ctx, id)
+	if err != nil {
+		return prisma.Card{}, err
+	}
+
+	now := time.Now()
+	next := schedule(*card, grade, now)
+	reviewedAt := formatTime(now)
+
+	result, err := client.UpdateCard(prisma.CardUpdateParams{
+		Where: prisma.CardWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.CardUpdateInput{
+			Ease:           &next.Ease,
+			Interval:       &next.Interval,
+			Repetitions:    &next.Repetitions,
+			Lapses:         &next.Lapses,
+			ReviewCount:    &next.ReviewCount,
+			Due:            &next.Due,
+			LastReviewedAt: &reviewedAt,
+			Reviews: &prisma.ReviewUpdateManyWithoutCardInput{
+				Create: []prisma.ReviewCreateWithoutCardInput{{
+					Key:      revisionKey(id, next.ReviewCount),
+					Grade:    grade,
+					Ease:     next.Ease,
+					Interval: next.Interval,
+					Due:      next.Due,
+				}},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		if current, cerr := client.Card(prisma.CardWhereUniqueInput{ID: &id}).Exec(ctx); cerr == nil && current.ReviewCount != card.ReviewCount {
+			return prisma.Card{}, ErrVersionMismatch
+		}
+		return prisma.Card{}, err
+	}
+
+	return *result, nil
+}
+
+func (s *service) ReviewStats(ctx context.Context, bookID string) (ReviewStats, error) {
+	if bookID == "" {
+		return ReviewStats{}, ErrInvalidArgument
+	}
+
+	if _, err := activeBook(ctx, bookID); err != nil {
+		return ReviewStats{}, err
+	}
+
+	cards, err := client.Cards(&prisma.CardsParams{
+		Where: activeCards(bookID),
+	}).Exec(ctx)
+
+	if err != nil {
+		return ReviewStats{}, err
+	}
+
+	reviews, err := client.Reviews(&prisma.ReviewsParams{
+		Where: &prisma.ReviewWhereInput{
+			Card: activeCards(bookID),
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return ReviewStats{}, err
+	}
+
+	now := time.Now()
+	return reviewStats(cards, reviews, formatTime(now), startOfDay(now)), nil
+}
+
+// reviewStats sums up cards and reviews. Timestamps are compared in their
+// stored form, which sorts in time order.
+func reviewStats(cards []prisma.Card, reviews []prisma.Review, now, today string) ReviewStats {
+	stats := ReviewStats{
+		Cards:   len(cards),
+		Reviews: len(reviews),
+	}
+
+	var ease float64
+	for _, c := range cards {
+		ease += c.Ease
+		switch {
+		case c.ReviewCount == 0:
+			stats.New++
+		case c.Interval >= matureInterval:
+			stats.Mature++
+		}
+		if c.Due <= now {
+			stats.Due++
+		}
+	}
+	if len(cards) > 0 {
+		stats.AverageEase = round2(ease / float64(len(cards)))
+	}
+
+	var passed int
+	for _, r := range reviews {
+		if r.Grade >= passingGrade {
+			passed++
+		}
+		if r.CreatedAt >= today {
+			stats.ReviewsToday++
+		}
+	}
+	if len(reviews) > 0 {
+		stats.Retention = round2(float64(passed) / float64(len(reviews)))
+	}
+
+	return stats
+}
+
+// schedule returns card as it is after a review with the given grade, by
+// SM-2. A card recalled is due again after one day, then after six days,
+// and after that the interval is multiplied by the ease. A card forgotten
+// starts over with an interval of one day. The ease changes with every
+// grade, lower grades lowering it, but never below minEase.
+func schedule(card prisma.Card, grade int32, now time.Time) prisma.Card {
+	if card.Ease == 0 {
+		card.Ease = initialEase
+	}
+
+	if grade >= passingGrade {
+		switch card.Repetitions {
+		case 0:
+			card.Interval = 1
+		case 1:
+			card.Interval = 6
+		default:
+			card.Interval = int32(math.Round(float64(card.Interval) * card.Ease))
+		}
+		card.Repetitions++
+	} else {
+		card.Interval = 1
+		card.Repetitions = 0
+		card.Lapses++
+	}
+
+	q := float64(MaxGrade - grade)
+	card.Ease = math.Max(minEase, round2(card.Ease+0.1-q*(0.08+q*0.02)))
+
+	card.ReviewCount++
+	card.Due = formatTime(now.AddDate(0, 0, int(card.Interval)))
+	return card
+}
+
+// noteCard returns the front and back of a card made from note. The quote
+// is asked for and the own note is the answer. A note without a quote is
+// asked for by itself and answered with where it is from.
+func noteCard(note prisma.Note, chapter prisma.Chapter) (front, back string) {
+	source := chapter.Name
+	if note.Location != "" {
+		source += ", " + note.Location
+	}
+
+	switch {
+	case note.Quote == "":
+		return note.Text, source
+	case note.Text == "":
+		return source, note.Quote
+	}
+	return note.Quote, note.Text
+}
+
+// activeCard returns the card with the given id unless its chapter is in
+// the trash.
+func activeCard(ctx context.Context, id string) (*prisma.Card, error) {
+	where := activeCards("")
+	where.ID = &id
+
+	cards, err := client.Cards(&prisma.CardsParams{
+		Where: where,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cards) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return &cards[0], nil
+}
+
+// activeCards matches the cards of chapters not in the trash, of the book
+// with the given id or of every book.
+func activeCards(bookID string) *prisma.CardWhereInput {
+	book := &prisma.BookWhereInput{
+		Deleted: prisma.Bool(false),
+	}
+	if bookID != "" {
+		book.ID = &bookID
+	}
+
+	return &prisma.CardWhereInput{
+		Chapter: &prisma.ChapterWhereInput{
+			Deleted: prisma.Bool(false),
+			Book:    book,
+		},
+	}
+}
+
+func startOfDay(t time.Time) string {
+	y, m, d := t.UTC().Date()
+	return formatTime(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,65 @@
+package handling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+func TestSchedule(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		card  prisma.Card
+		grade int32
+		want  prisma.Card
+	}{
+		{
+			name:  "new card recalled",
+			card:  prisma.Card{},
+			grade: 5,
+			want:  prisma.Card{Ease: 2.6, Interval: 1, Repetitions: 1, ReviewCount: 1, Due: "2026-03-02T12:00:00Z"},
+		},
+		{
+			name:  "second repetition",
+			card:  prisma.Card{Ease: 2.5, Interval: 1, Repetitions: 1, ReviewCount: 1},
+			grade: 4,
+			want:  prisma.Card{Ease: 2.5, Interval: 6, Repetitions: 2, ReviewCount: 2, Due: "2026-03-07T12:00:00Z"},
+		},
+		{
+			name:  "interval multiplied by the ease",
+			card:  prisma.Card{Ease: 2.5, Interval: 6, Repetitions: 2, ReviewCount: 2},
+			grade: 3,
+			want:  prisma.Card{Ease: 2.36, Interval: 15, Repetitions: 3, ReviewCount: 3, Due: "2026-03-16T12:00:00Z"},
+		},
+		{
+			name:  "interval rounded",
+			card:  prisma.Card{Ease: 2.5, Interval: 15, Repetitions: 3, ReviewCount: 3},
+			grade: 5,
+			want:  prisma.Card{Ease: 2.6, Interval: 38, Repetitions: 4, ReviewCount: 4, Due: "2026-04-08T12:00:00Z"},
+		},
+		{
+			name:  "forgotten",
+			card:  prisma.Card{Ease: 2.36, Interval: 15, Repetitions: 3, ReviewCount: 3},
+			grade: 2,
+			want:  prisma.Card{Ease: 2.04, Interval: 1, Repetitions: 0, Lapses: 1, ReviewCount: 4, Due: "2026-03-02T12:00:00Z"},
+		},
+		{
+			name:  "ease kept at the minimum",
+			card:  prisma.Card{Ease: 1.3, Interval: 1, Lapses: 2, ReviewCount: 5},
+			grade: 0,
+			want:  prisma.Card{Ease: 1.3, Interval: 1, Repetitions: 0, Lapses: 3, ReviewCount: 6, Due: "2026-03-02T12:00:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := schedule(tt.card, tt.grade, now)
+			if got != tt.want {
+				t.Errorf("schedule(%+v, %d) = %+v, want %+v", tt.card, tt.grade, got, tt.want)
+			}
+		})
+	}
+}
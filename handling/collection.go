@@ -0,0 +1,132 @@
+package handling
+
+import (
+	"context"
+	"strings"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Collections group books. A book can be in any number of collections, and
+// deleting a collection leaves its books alone.
+
+func (s *service) CreateCollection(ctx context.Context, name string, description string) (prisma.Collection, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return prisma.Collection{}, ErrInvalidArgument
+	}
+
+	collection, err := client.CreateCollection(prisma.CollectionCreateInput{
+		Name:        name,
+		Description: &description,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Collection{}, err
+	}
+
+	return *collection, nil
+}
+
+func (s *service) Collections(ctx context.Context) ([]prisma.Collection, error) {
+	orderBy := prisma.CollectionOrderByInputNameAsc
+	collections, err := client.Collections(&prisma.CollectionsParams{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+func (s *service) DeleteCollection(ctx context.Context, id string) (prisma.Collection, error) {
+	if id == "" {
+		return prisma.Collection{}, ErrInvalidArgument
+	}
+
+	collection, err := client.DeleteCollection(prisma.CollectionWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Collection{}, err
+	}
+
+	return *collection, nil
+}
+
+func (s *service) AddToCollection(ctx context.Context, id string, bookID string) error {
+	if id == "" || bookID == "" {
+		return ErrInvalidArgument
+	}
+
+	if _, err := activeBook(ctx, bookID); err != nil {
+		return err
+	}
+
+	_, err := client.UpdateCollection(prisma.CollectionUpdateParams{
+		Where: prisma.CollectionWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.CollectionUpdateInput{
+			Books: &prisma.BookUpdateManyWithoutCollectionsInput{
+				Connect: []prisma.BookWhereUniqueInput{{ID: &bookID}},
+			},
+		},
+	}).Exec(ctx)
+
+	return err
+}
+
+func (s *service) RemoveFromCollection(ctx context.Context, id string, bookID string) error {
+	if id == "" || bookID == "" {
+		return ErrInvalidArgument
+	}
+
+	// Disconnecting a book which is not in the collection is an error, so
+	// removal is made idempotent by checking first.
+	books, err := client.Collection(prisma.CollectionWhereUniqueInput{
+		ID: &id,
+	}).Books(&prisma.BooksParamsExec{
+		Where: &prisma.BookWhereInput{
+			ID: &bookID,
+		},
+	}).Exec(ctx)
+
+	if err != nil || len(books) == 0 {
+		return err
+	}
+
+	_, err = client.UpdateCollection(prisma.CollectionUpdateParams{
+		Where: prisma.CollectionWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.CollectionUpdateInput{
+			Books: &prisma.BookUpdateManyWithoutCollectionsInput{
+				Disconnect: []prisma.BookWhereUniqueInput{{ID: &bookID}},
+			},
+		},
+	}).Exec(ctx)
+
+	return err
+}
+
+// bookCollections returns the IDs of the collections holding the book with
+// the given id.
+func bookCollections(ctx context.Context, id string) ([]string, error) {
+	collections, err := client.Book(prisma.BookWhereUniqueInput{
+		ID: &id,
+	}).Collections(nil).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(collections))
+	for i, c := range collections {
+		ids[i] = c.ID
+	}
+	return ids, nil
+}
@@ -0,0 +1,78 @@
+package handling
+
+import (
+	"context"
+	"net/http"
+)
+
+// Headers identifying the caller and the request.
+const (
+	HeaderActor     = "X-User-ID"
+	HeaderRequestID = "X-Request-ID"
+)
+
+// AnonymousActor is the actor of requests which do not identify a user.
+const AnonymousActor = "anonymous"
+
+type contextKey int
+
+const (
+	actorContextKey contextKey = iota
+	requestIDContextKey
+	requestMethodContextKey
+	ifNoneMatchContextKey
+)
+
+// WithActor returns a copy of ctx carrying the acting user.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey, actor)
+}
+
+// Actor returns the acting user carried by ctx, or AnonymousActor.
+func Actor(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey).(string); ok && actor != "" {
+		return actor
+	}
+	return AnonymousActor
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, id)
+}
+
+// RequestID returns the request ID carried by ctx, if any.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey).(string)
+	return id
+}
+
+// populateRequestContext moves the actor and the request ID from the
+// request headers into the context. A request ID is generated if the
+// client did not send one. The method and If-None-Match header are kept
+// for encoding conditional responses.
+func populateRequestContext(ctx context.Context, r *http.Request) context.Context {
+	id := r.Header.Get(HeaderRequestID)
+	if id == "" {
+		id = newID()
+	}
+	ctx = WithRequestID(ctx, id)
+	ctx = context.WithValue(ctx, requestMethodContextKey, r.Method)
+	ctx = context.WithValue(ctx, ifNoneMatchContextKey, r.Header.Get("If-None-Match"))
+	return WithActor(ctx, r.Header.Get(HeaderActor))
+}
+
+// setRequestIDHeader echoes the request ID on the response.
+func setRequestIDHeader(ctx context.Context, w http.ResponseWriter) context.Context {
+	if id := RequestID(ctx); id != "" {
+		w.Header().Set(HeaderRequestID, id)
+	}
+	return ctx
+}
+
+// varyByActor marks the response as depending on the acting user, so that
+// caches do not serve it to another one.
+func varyByActor(ctx context.Context, w http.ResponseWriter) context.Context {
+	w.Header().Add("Vary", HeaderActor)
+	return ctx
+}
@@ -2,6 +2,7 @@ package handling
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/maxp36/rembook/handling/generated/prisma"
@@ -10,20 +11,62 @@ import (
 type addBookRequest struct {
 	Name        string
 	Description string
+	Chapters    []NewChapter
 }
 
 type addBookResponse struct {
-	Book prisma.Book `json:"book,omitempty"`
-	Err  error       `json:"err,omitempty"`
+	Book     prisma.Book      `json:"book,omitempty"`
+	Chapters []prisma.Chapter `json:"chapters,omitempty"`
+	Err      error            `json:"err,omitempty"`
 }
 
 func (r addBookResponse) error() error { return r.Err }
 
+func (r addBookResponse) version() (int32, string) { return r.Book.Revision, r.Book.UpdatedAt }
+
 func makeAddBookEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(addBookRequest)
-		book, err := s.AddBook(ctx, req.Name, req.Description)
-		return addBookResponse{Book: book, Err: err}, nil
+		book, chapters, err := s.AddBook(ctx, req.Name, req.Description, req.Chapters)
+		return addBookResponse{Book: book, Chapters: chapters, Err: err}, nil
+	}
+}
+
+type mergeBooksRequest struct {
+	SourceID string
+	TargetID string
+	Strategy MergeStrategy
+	Archive  bool
+	Version  ifMatch
+}
+
+type mergeBooksResponse struct {
+	Book prisma.Book `json:"book,omitempty"`
+	Err  error       `json:"err,omitempty"`
+}
+
+func (r mergeBooksResponse) error() error { return r.Err }
+
+func (r mergeBooksResponse) version() (int32, string) { return r.Book.Revision, r.Book.UpdatedAt }
+
+func makeMergeBooksEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(mergeBooksRequest)
+		book, err := s.MergeBooks(ctx, req.SourceID, req.TargetID, req.Strategy, req.Archive, req.Version.resolve(bookVersion(ctx, s, req.TargetID)))
+		return mergeBooksResponse{Book: book, Err: err}, nil
+	}
+}
+
+type duplicateBookRequest struct {
+	ID   string
+	Name string
+}
+
+func makeDuplicateBookEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(duplicateBookRequest)
+		book, chapters, err := s.DuplicateBook(ctx, req.ID, req.Name)
+		return addBookResponse{Book: book, Chapters: chapters, Err: err}, nil
 	}
 }
 
@@ -38,6 +81,8 @@ type getBookResponse struct {
 
 func (r getBookResponse) error() error { return r.Err }
 
+func (r getBookResponse) version() (int32, string) { return r.Book.Revision, r.Book.UpdatedAt }
+
 func makeGetBookEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(getBookRequest)
@@ -47,7 +92,8 @@ func makeGetBookEndpoint(s Service) endpoint.Endpoint {
 }
 
 type deleteBookRequest struct {
-	ID string `json:"id"`
+	ID      string  `json:"id"`
+	Version ifMatch `json:"version"`
 }
 
 type deleteBookResponse struct {
@@ -60,12 +106,14 @@ func (r deleteBookResponse) error() error { return r.Err }
 func makeDeleteBookEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(deleteBookRequest)
-		book, err := s.DeleteBook(ctx, req.ID)
+		book, err := s.DeleteBook(ctx, req.ID, req.Version.resolve(bookVersion(ctx, s, req.ID)))
 		return deleteBookResponse{Book: book, Err: err}, nil
 	}
 }
 
-type listBooksRequest struct{}
+type listBooksRequest struct {
+	Filter BookFilter
+}
 
 type listBooksResponse struct {
 	Books []prisma.Book `json:"books,omitempty"`
@@ -76,12 +124,125 @@ func (r listBooksResponse) error() error { return r.Err }
 
 func makeListBooksEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		_ = request.(listBooksRequest)
-		books, err := s.Books(ctx)
+		req := request.(listBooksRequest)
+		books, err := s.Books(ctx, req.Filter)
 		return listBooksResponse{Books: books, Err: err}, nil
 	}
 }
 
+type getBookMetadataResponse struct {
+	Metadata Metadata `json:"metadata"`
+	Err      error    `json:"err,omitempty"`
+}
+
+func (r getBookMetadataResponse) error() error { return r.Err }
+
+func makeGetBookMetadataEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getBookRequest)
+		m, err := s.BookMetadata(ctx, req.ID)
+		return getBookMetadataResponse{Metadata: m, Err: err}, nil
+	}
+}
+
+type updateBookMetadataRequest struct {
+	ID       string
+	Metadata Metadata
+	Version  ifMatch
+}
+
+type updateBookMetadataResponse struct {
+	Book prisma.Book `json:"book,omitempty"`
+	Err  error       `json:"err,omitempty"`
+}
+
+func (r updateBookMetadataResponse) error() error { return r.Err }
+
+func (r updateBookMetadataResponse) version() (int32, string) {
+	return r.Book.Revision, r.Book.UpdatedAt
+}
+
+func makeUpdateBookMetadataEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateBookMetadataRequest)
+		book, err := s.UpdateBookMetadata(ctx, req.ID, req.Metadata, req.Version.resolve(bookVersion(ctx, s, req.ID)))
+		return updateBookMetadataResponse{Book: book, Err: err}, nil
+	}
+}
+
+type getBookByISBNRequest struct {
+	ISBN string
+}
+
+func makeGetBookByISBNEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getBookByISBNRequest)
+		book, err := s.BookByISBN(ctx, req.ISBN)
+		return getBookResponse{Book: book, Err: err}, nil
+	}
+}
+
+type lookupBookRequest struct {
+	Name  string
+	Limit int32
+}
+
+type lookupBookResponse struct {
+	Matches []BookMatch `json:"matches"`
+	Err     error       `json:"err,omitempty"`
+}
+
+func (r lookupBookResponse) error() error { return r.Err }
+
+func makeLookupBookEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(lookupBookRequest)
+		matches, err := s.LookupBook(ctx, req.Name, req.Limit)
+		if matches == nil {
+			matches = []BookMatch{}
+		}
+		return lookupBookResponse{Matches: matches, Err: err}, nil
+	}
+}
+
+type exportBookRequest struct {
+	ID     string
+	Format exportFormat
+}
+
+type exportBookResponse struct {
+	Export BookExport
+	Format exportFormat
+	Err    error
+}
+
+func (r exportBookResponse) error() error { return r.Err }
+
+func makeExportBookEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(exportBookRequest)
+		export, err := s.ExportBook(ctx, req.ID)
+		return exportBookResponse{Export: export, Format: req.Format, Err: err}, nil
+	}
+}
+
+type listAuthorsRequest struct{}
+
+type listAuthorsResponse struct {
+	Authors []prisma.Author `json:"authors,omitempty"`
+	Err     error           `json:"err,omitempty"`
+}
+
+func (r listAuthorsResponse) error() error { return r.Err }
+
+func makeListAuthorsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listAuthorsRequest)
+		authors, err := s.Authors(ctx)
+		return listAuthorsResponse{Authors: authors, Err: err}, nil
+	}
+}
+
 type addChapterRequest struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
@@ -95,6 +256,8 @@ type addChapterResponse struct {
 
 func (r addChapterResponse) error() error { return r.Err }
 
+func (r addChapterResponse) version() (int32, string) { return r.Chapter.Revision, r.Chapter.UpdatedAt }
+
 func makeAddChapterEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(addChapterRequest)
@@ -114,6 +277,8 @@ type getChapterResponse struct {
 
 func (r getChapterResponse) error() error { return r.Err }
 
+func (r getChapterResponse) version() (int32, string) { return r.Chapter.Revision, r.Chapter.UpdatedAt }
+
 func makeGetChapterEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(getChapterRequest)
@@ -123,7 +288,8 @@ func makeGetChapterEndpoint(s Service) endpoint.Endpoint {
 }
 
 type deleteChapterRequest struct {
-	ID string `json:"id"`
+	ID      string  `json:"id"`
+	Version ifMatch `json:"version"`
 }
 
 type deleteChapterResponse struct {
@@ -136,7 +302,7 @@ func (r deleteChapterResponse) error() error { return r.Err }
 func makeDeleteChapterEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(deleteChapterRequest)
-		chapter, err := s.DeleteChapter(ctx, req.ID)
+		chapter, err := s.DeleteChapter(ctx, req.ID, req.Version.resolve(chapterVersion(ctx, s, req.ID)))
 		return deleteChapterResponse{Chapter: chapter, Err: err}, nil
 	}
 }
@@ -146,7 +312,7 @@ type listChaptersRequest struct {
 }
 
 type listChaptersResponse struct {
-	Chapters []prisma.Chapter `json:"chapters,omitempty"`
+	Chapters []ReadingChapter `json:"chapters,omitempty"`
 	Err      error            `json:"err,omitempty"`
 }
 
@@ -159,3 +325,860 @@ func makeListChaptersEndpoint(s Service) endpoint.Endpoint {
 		return listChaptersResponse{Chapters: chapters, Err: err}, nil
 	}
 }
+
+type auditLogRequest struct {
+	Filter AuditFilter
+}
+
+type auditLogResponse struct {
+	Entries []AuditEntry `json:"entries,omitempty"`
+	Err     error        `json:"err,omitempty"`
+}
+
+func (r auditLogResponse) error() error { return r.Err }
+
+func makeAuditLogEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(auditLogRequest)
+		entries, err := s.AuditLog(ctx, req.Filter)
+		return auditLogResponse{Entries: entries, Err: err}, nil
+	}
+}
+
+type trashRequest struct{}
+
+type trashResponse struct {
+	Trash Trash `json:"trash"`
+	Err   error `json:"err,omitempty"`
+}
+
+func (r trashResponse) error() error { return r.Err }
+
+func makeTrashEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(trashRequest)
+		trash, err := s.Trash(ctx)
+		return trashResponse{Trash: trash, Err: err}, nil
+	}
+}
+
+type restoreBookRequest struct {
+	ID string `json:"id"`
+}
+
+type restoreBookResponse struct {
+	Book prisma.Book `json:"book,omitempty"`
+	Err  error       `json:"err,omitempty"`
+}
+
+func (r restoreBookResponse) error() error { return r.Err }
+
+func (r restoreBookResponse) version() (int32, string) { return r.Book.Revision, r.Book.UpdatedAt }
+
+func makeRestoreBookEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(restoreBookRequest)
+		book, err := s.RestoreBook(ctx, req.ID)
+		return restoreBookResponse{Book: book, Err: err}, nil
+	}
+}
+
+type restoreChapterRequest struct {
+	ID string `json:"id"`
+}
+
+type restoreChapterResponse struct {
+	Chapter prisma.Chapter `json:"chapter,omitempty"`
+	Err     error          `json:"err,omitempty"`
+}
+
+func (r restoreChapterResponse) error() error { return r.Err }
+
+func (r restoreChapterResponse) version() (int32, string) {
+	return r.Chapter.Revision, r.Chapter.UpdatedAt
+}
+
+func makeRestoreChapterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(restoreChapterRequest)
+		chapter, err := s.RestoreChapter(ctx, req.ID)
+		return restoreChapterResponse{Chapter: chapter, Err: err}, nil
+	}
+}
+
+type updateBookRequest struct {
+	ID          string
+	Name        string
+	Description string
+	Version     ifMatch
+}
+
+type updateBookResponse struct {
+	Book prisma.Book `json:"book,omitempty"`
+	Err  error       `json:"err,omitempty"`
+}
+
+func (r updateBookResponse) error() error { return r.Err }
+
+func (r updateBookResponse) version() (int32, string) { return r.Book.Revision, r.Book.UpdatedAt }
+
+func makeUpdateBookEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateBookRequest)
+		book, err := s.UpdateBook(ctx, req.ID, req.Name, req.Description, req.Version.resolve(bookVersion(ctx, s, req.ID)))
+		return updateBookResponse{Book: book, Err: err}, nil
+	}
+}
+
+type updateChapterRequest struct {
+	ID          string
+	Name        string
+	Description string
+	Version     ifMatch
+}
+
+type updateChapterResponse struct {
+	Chapter prisma.Chapter `json:"chapter,omitempty"`
+	Err     error          `json:"err,omitempty"`
+}
+
+func (r updateChapterResponse) error() error { return r.Err }
+
+func (r updateChapterResponse) version() (int32, string) {
+	return r.Chapter.Revision, r.Chapter.UpdatedAt
+}
+
+func makeUpdateChapterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateChapterRequest)
+		chapter, err := s.UpdateChapter(ctx, req.ID, req.Name, req.Description, req.Version.resolve(chapterVersion(ctx, s, req.ID)))
+		return updateChapterResponse{Chapter: chapter, Err: err}, nil
+	}
+}
+
+type listRevisionsRequest struct {
+	ID string
+}
+
+type listRevisionsResponse struct {
+	Revisions []prisma.Revision `json:"revisions,omitempty"`
+	Err       error             `json:"err,omitempty"`
+}
+
+func (r listRevisionsResponse) error() error { return r.Err }
+
+func makeListBookRevisionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listRevisionsRequest)
+		revisions, err := s.BookRevisions(ctx, req.ID)
+		return listRevisionsResponse{Revisions: revisions, Err: err}, nil
+	}
+}
+
+func makeListChapterRevisionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listRevisionsRequest)
+		revisions, err := s.ChapterRevisions(ctx, req.ID)
+		return listRevisionsResponse{Revisions: revisions, Err: err}, nil
+	}
+}
+
+type diffRevisionsRequest struct {
+	ID   string
+	From int32
+	To   int32
+}
+
+type diffRevisionsResponse struct {
+	Diff RevisionDiff `json:"diff"`
+	Err  error        `json:"err,omitempty"`
+}
+
+func (r diffRevisionsResponse) error() error { return r.Err }
+
+func makeDiffBookRevisionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(diffRevisionsRequest)
+		diff, err := s.DiffBookRevisions(ctx, req.ID, req.From, req.To)
+		return diffRevisionsResponse{Diff: diff, Err: err}, nil
+	}
+}
+
+func makeDiffChapterRevisionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(diffRevisionsRequest)
+		diff, err := s.DiffChapterRevisions(ctx, req.ID, req.From, req.To)
+		return diffRevisionsResponse{Diff: diff, Err: err}, nil
+	}
+}
+
+type bookAtRequest struct {
+	ID string
+	At time.Time
+}
+
+type bookAtResponse struct {
+	Book HistoricalBook `json:"book"`
+	Err  error          `json:"err,omitempty"`
+}
+
+func (r bookAtResponse) error() error { return r.Err }
+
+func makeBookAtEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(bookAtRequest)
+		book, err := s.BookAt(ctx, req.ID, req.At)
+		return bookAtResponse{Book: book, Err: err}, nil
+	}
+}
+
+type revertRequest struct {
+	ID      string
+	Number  int32
+	Version ifMatch
+}
+
+type revertBookResponse struct {
+	Book prisma.Book `json:"book,omitempty"`
+	Err  error       `json:"err,omitempty"`
+}
+
+func (r revertBookResponse) error() error { return r.Err }
+
+func (r revertBookResponse) version() (int32, string) { return r.Book.Revision, r.Book.UpdatedAt }
+
+func makeRevertBookEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(revertRequest)
+		book, err := s.RevertBook(ctx, req.ID, req.Number, req.Version.resolve(bookVersion(ctx, s, req.ID)))
+		return revertBookResponse{Book: book, Err: err}, nil
+	}
+}
+
+type revertChapterResponse struct {
+	Chapter prisma.Chapter `json:"chapter,omitempty"`
+	Err     error          `json:"err,omitempty"`
+}
+
+func (r revertChapterResponse) error() error { return r.Err }
+
+func (r revertChapterResponse) version() (int32, string) {
+	return r.Chapter.Revision, r.Chapter.UpdatedAt
+}
+
+func makeRevertChapterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(revertRequest)
+		chapter, err := s.RevertChapter(ctx, req.ID, req.Number, req.Version.resolve(chapterVersion(ctx, s, req.ID)))
+		return revertChapterResponse{Chapter: chapter, Err: err}, nil
+	}
+}
+
+type moveChapterRequest struct {
+	ID       string
+	BookID   string
+	Position int32
+	Version  ifMatch
+}
+
+type moveChapterResponse struct {
+	Chapter prisma.Chapter `json:"chapter,omitempty"`
+	Err     error          `json:"err,omitempty"`
+}
+
+func (r moveChapterResponse) error() error { return r.Err }
+
+func (r moveChapterResponse) version() (int32, string) {
+	return r.Chapter.Revision, r.Chapter.UpdatedAt
+}
+
+func makeMoveChapterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(moveChapterRequest)
+		chapter, err := s.MoveChapter(ctx, req.ID, req.BookID, req.Position, req.Version.resolve(chapterVersion(ctx, s, req.ID)))
+		return moveChapterResponse{Chapter: chapter, Err: err}, nil
+	}
+}
+
+type copyChapterResponse struct {
+	Chapter prisma.Chapter `json:"chapter,omitempty"`
+	Err     error          `json:"err,omitempty"`
+}
+
+func (r copyChapterResponse) error() error { return r.Err }
+
+func (r copyChapterResponse) version() (int32, string) {
+	return r.Chapter.Revision, r.Chapter.UpdatedAt
+}
+
+func makeCopyChapterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(moveChapterRequest)
+		chapter, err := s.CopyChapter(ctx, req.ID, req.BookID, req.Position)
+		return copyChapterResponse{Chapter: chapter, Err: err}, nil
+	}
+}
+
+type bulkRequest struct {
+	Selection Selection
+	Set       BulkUpdate
+	BookID    string
+	DryRun    bool
+}
+
+type bulkResponse struct {
+	Result BulkResult `json:"result"`
+	Err    error      `json:"err,omitempty"`
+}
+
+func (r bulkResponse) error() error { return r.Err }
+
+func makeDeleteBooksEndpoint(s BulkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(bulkRequest)
+		result, err := s.DeleteBooks(ctx, req.Selection, req.DryRun)
+		return bulkResponse{Result: result, Err: err}, nil
+	}
+}
+
+func makeUpdateBooksEndpoint(s BulkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(bulkRequest)
+		result, err := s.UpdateBooks(ctx, req.Selection, req.Set, req.DryRun)
+		return bulkResponse{Result: result, Err: err}, nil
+	}
+}
+
+func makeDeleteChaptersEndpoint(s BulkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(bulkRequest)
+		result, err := s.DeleteChapters(ctx, req.Selection, req.DryRun)
+		return bulkResponse{Result: result, Err: err}, nil
+	}
+}
+
+func makeUpdateChaptersEndpoint(s BulkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(bulkRequest)
+		result, err := s.UpdateChapters(ctx, req.Selection, req.Set, req.DryRun)
+		return bulkResponse{Result: result, Err: err}, nil
+	}
+}
+
+func makeMoveChaptersEndpoint(s BulkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(bulkRequest)
+		result, err := s.MoveChapters(ctx, req.Selection, req.BookID, req.DryRun)
+		return bulkResponse{Result: result, Err: err}, nil
+	}
+}
+
+type importMarkdownRequest struct {
+	Doc    string
+	DryRun bool
+}
+
+type importMarkdownResponse struct {
+	Result ImportResult `json:"result"`
+	Err    error        `json:"err,omitempty"`
+}
+
+func (r importMarkdownResponse) error() error { return r.Err }
+
+func makeImportMarkdownEndpoint(s BulkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(importMarkdownRequest)
+		result, err := s.ImportMarkdown(ctx, req.Doc, req.DryRun)
+		return importMarkdownResponse{Result: result, Err: err}, nil
+	}
+}
+
+type tagsRequest struct {
+	ID  string
+	Tag string
+}
+
+type tagsResponse struct {
+	Tags []string `json:"tags"`
+	Err  error    `json:"err,omitempty"`
+}
+
+func (r tagsResponse) error() error { return r.Err }
+
+func makeBookTagsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(tagsRequest)
+		tags, err := s.BookTags(ctx, req.ID)
+		return tagsResponse{Tags: tags, Err: err}, nil
+	}
+}
+
+func makeTagBookEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(tagsRequest)
+		tags, err := s.TagBook(ctx, req.ID, req.Tag)
+		return tagsResponse{Tags: tags, Err: err}, nil
+	}
+}
+
+func makeUntagBookEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(tagsRequest)
+		tags, err := s.UntagBook(ctx, req.ID, req.Tag)
+		return tagsResponse{Tags: tags, Err: err}, nil
+	}
+}
+
+func makeChapterTagsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(tagsRequest)
+		tags, err := s.ChapterTags(ctx, req.ID)
+		return tagsResponse{Tags: tags, Err: err}, nil
+	}
+}
+
+func makeTagChapterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(tagsRequest)
+		tags, err := s.TagChapter(ctx, req.ID, req.Tag)
+		return tagsResponse{Tags: tags, Err: err}, nil
+	}
+}
+
+func makeUntagChapterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(tagsRequest)
+		tags, err := s.UntagChapter(ctx, req.ID, req.Tag)
+		return tagsResponse{Tags: tags, Err: err}, nil
+	}
+}
+
+type listTagsRequest struct{}
+
+type listTagsResponse struct {
+	Tags []TagCount `json:"tags"`
+	Err  error      `json:"err,omitempty"`
+}
+
+func (r listTagsResponse) error() error { return r.Err }
+
+func makeListTagsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listTagsRequest)
+		tags, err := s.Tags(ctx)
+		return listTagsResponse{Tags: tags, Err: err}, nil
+	}
+}
+
+type createCollectionRequest struct {
+	Name        string
+	Description string
+}
+
+type collectionResponse struct {
+	Collection prisma.Collection `json:"collection,omitempty"`
+	Err        error             `json:"err,omitempty"`
+}
+
+func (r collectionResponse) error() error { return r.Err }
+
+func makeCreateCollectionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createCollectionRequest)
+		collection, err := s.CreateCollection(ctx, req.Name, req.Description)
+		return collectionResponse{Collection: collection, Err: err}, nil
+	}
+}
+
+type listCollectionsRequest struct{}
+
+type listCollectionsResponse struct {
+	Collections []prisma.Collection `json:"collections,omitempty"`
+	Err         error               `json:"err,omitempty"`
+}
+
+func (r listCollectionsResponse) error() error { return r.Err }
+
+func makeListCollectionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listCollectionsRequest)
+		collections, err := s.Collections(ctx)
+		return listCollectionsResponse{Collections: collections, Err: err}, nil
+	}
+}
+
+type deleteCollectionRequest struct {
+	ID string
+}
+
+func makeDeleteCollectionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteCollectionRequest)
+		collection, err := s.DeleteCollection(ctx, req.ID)
+		return collectionResponse{Collection: collection, Err: err}, nil
+	}
+}
+
+type collectionBookRequest struct {
+	ID     string
+	BookID string
+}
+
+type collectionBookResponse struct {
+	Err error `json:"err,omitempty"`
+}
+
+func (r collectionBookResponse) error() error { return r.Err }
+
+func makeAddToCollectionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(collectionBookRequest)
+		err := s.AddToCollection(ctx, req.ID, req.BookID)
+		return collectionBookResponse{Err: err}, nil
+	}
+}
+
+func makeRemoveFromCollectionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(collectionBookRequest)
+		err := s.RemoveFromCollection(ctx, req.ID, req.BookID)
+		return collectionBookResponse{Err: err}, nil
+	}
+}
+
+type addNoteRequest struct {
+	ChapterID string
+	Note      NoteInput
+}
+
+type noteResponse struct {
+	Note prisma.Note `json:"note,omitempty"`
+	Err  error       `json:"err,omitempty"`
+}
+
+func (r noteResponse) error() error { return r.Err }
+
+func makeAddNoteEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(addNoteRequest)
+		note, err := s.AddNote(ctx, req.ChapterID, req.Note)
+		return noteResponse{Note: note, Err: err}, nil
+	}
+}
+
+type noteRequest struct {
+	ID   string
+	Note NoteInput
+}
+
+func makeGetNoteEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(noteRequest)
+		note, err := s.GetNote(ctx, req.ID)
+		return noteResponse{Note: note, Err: err}, nil
+	}
+}
+
+func makeUpdateNoteEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(noteRequest)
+		note, err := s.UpdateNote(ctx, req.ID, req.Note)
+		return noteResponse{Note: note, Err: err}, nil
+	}
+}
+
+func makeDeleteNoteEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(noteRequest)
+		note, err := s.DeleteNote(ctx, req.ID)
+		return noteResponse{Note: note, Err: err}, nil
+	}
+}
+
+type listNotesRequest struct {
+	ChapterID string
+}
+
+type listNotesResponse struct {
+	Notes []prisma.Note `json:"notes,omitempty"`
+	Err   error         `json:"err,omitempty"`
+}
+
+func (r listNotesResponse) error() error { return r.Err }
+
+func makeListNotesEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listNotesRequest)
+		notes, err := s.Notes(ctx, req.ChapterID)
+		return listNotesResponse{Notes: notes, Err: err}, nil
+	}
+}
+
+type addCardRequest struct {
+	ChapterID string
+	Card      CardInput
+}
+
+type cardResponse struct {
+	Card prisma.Card `json:"card,omitempty"`
+	Err  error       `json:"err,omitempty"`
+}
+
+func (r cardResponse) error() error { return r.Err }
+
+func makeAddCardEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(addCardRequest)
+		card, err := s.AddCard(ctx, req.ChapterID, req.Card)
+		return cardResponse{Card: card, Err: err}, nil
+	}
+}
+
+type cardRequest struct {
+	ID string
+}
+
+func makeGetCardEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(cardRequest)
+		card, err := s.GetCard(ctx, req.ID)
+		return cardResponse{Card: card, Err: err}, nil
+	}
+}
+
+func makeDeleteCardEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(cardRequest)
+		card, err := s.DeleteCard(ctx, req.ID)
+		return cardResponse{Card: card, Err: err}, nil
+	}
+}
+
+type listCardsRequest struct {
+	ChapterID string
+}
+
+type listCardsResponse struct {
+	Cards []prisma.Card `json:"cards,omitempty"`
+	Err   error         `json:"err,omitempty"`
+}
+
+func (r listCardsResponse) error() error { return r.Err }
+
+func makeListCardsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listCardsRequest)
+		cards, err := s.Cards(ctx, req.ChapterID)
+		return listCardsResponse{Cards: cards, Err: err}, nil
+	}
+}
+
+type dueCardsRequest struct {
+	BookID string
+	Limit  int32
+}
+
+func makeDueCardsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(dueCardsRequest)
+		cards, err := s.DueCards(ctx, req.BookID, req.Limit)
+		return listCardsResponse{Cards: cards, Err: err}, nil
+	}
+}
+
+type gradeCardRequest struct {
+	ID    string
+	Grade int32
+}
+
+func makeGradeCardEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(gradeCardRequest)
+		card, err := s.GradeCard(ctx, req.ID, req.Grade)
+		return cardResponse{Card: card, Err: err}, nil
+	}
+}
+
+type reviewStatsRequest struct {
+	BookID string
+}
+
+type reviewStatsResponse struct {
+	Stats ReviewStats `json:"stats"`
+	Err   error       `json:"err,omitempty"`
+}
+
+func (r reviewStatsResponse) error() error { return r.Err }
+
+func makeReviewStatsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(reviewStatsRequest)
+		stats, err := s.ReviewStats(ctx, req.BookID)
+		return reviewStatsResponse{Stats: stats, Err: err}, nil
+	}
+}
+
+type setChapterStatusRequest struct {
+	ID     string
+	Status prisma.ReadingStatus
+}
+
+type readingChapterResponse struct {
+	Chapter ReadingChapter `json:"chapter"`
+	Err     error          `json:"err,omitempty"`
+}
+
+func (r readingChapterResponse) error() error { return r.Err }
+
+func makeSetChapterStatusEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(setChapterStatusRequest)
+		chapter, err := s.SetChapterStatus(ctx, req.ID, req.Status)
+		return readingChapterResponse{Chapter: chapter, Err: err}, nil
+	}
+}
+
+type bookProgressRequest struct {
+	ID string
+}
+
+type bookProgressResponse struct {
+	Progress BookProgress `json:"progress"`
+	Err      error        `json:"err,omitempty"`
+}
+
+func (r bookProgressResponse) error() error { return r.Err }
+
+func makeBookProgressEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(bookProgressRequest)
+		progress, err := s.BookProgress(ctx, req.ID)
+		return bookProgressResponse{Progress: progress, Err: err}, nil
+	}
+}
+
+type currentlyReadingRequest struct{}
+
+type listBookProgressResponse struct {
+	Books []BookProgress `json:"books,omitempty"`
+	Err   error          `json:"err,omitempty"`
+}
+
+func (r listBookProgressResponse) error() error { return r.Err }
+
+func makeCurrentlyReadingEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		books, err := s.CurrentlyReading(ctx)
+		return listBookProgressResponse{Books: books, Err: err}, nil
+	}
+}
+
+type finishedBooksRequest struct {
+	From time.Time
+	To   time.Time
+}
+
+func makeFinishedBooksEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(finishedBooksRequest)
+		books, err := s.FinishedBooks(ctx, req.From, req.To)
+		return listBookProgressResponse{Books: books, Err: err}, nil
+	}
+}
+
+type startSessionRequest struct {
+	BookID    string
+	ChapterID string
+}
+
+type sessionResponse struct {
+	Session Session `json:"session"`
+	Err     error   `json:"err,omitempty"`
+}
+
+func (r sessionResponse) error() error { return r.Err }
+
+func makeStartSessionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(startSessionRequest)
+		session, err := s.StartSession(ctx, req.BookID, req.ChapterID)
+		return sessionResponse{Session: session, Err: err}, nil
+	}
+}
+
+type stopSessionRequest struct {
+	Pages int32
+}
+
+func makeStopSessionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(stopSessionRequest)
+		session, err := s.StopSession(ctx, req.Pages)
+		return sessionResponse{Session: session, Err: err}, nil
+	}
+}
+
+type currentSessionRequest struct{}
+
+func makeCurrentSessionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		session, err := s.CurrentSession(ctx)
+		return sessionResponse{Session: session, Err: err}, nil
+	}
+}
+
+type listSessionsRequest struct {
+	BookID string
+}
+
+type listSessionsResponse struct {
+	Sessions []Session `json:"sessions,omitempty"`
+	Err      error     `json:"err,omitempty"`
+}
+
+func (r listSessionsResponse) error() error { return r.Err }
+
+func makeListSessionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listSessionsRequest)
+		sessions, err := s.Sessions(ctx, req.BookID)
+		return listSessionsResponse{Sessions: sessions, Err: err}, nil
+	}
+}
+
+type readingStatsRequest struct {
+	Filter SessionFilter
+}
+
+type readingStatsResponse struct {
+	Stats ReadingStats `json:"stats"`
+	Err   error        `json:"err,omitempty"`
+}
+
+func (r readingStatsResponse) error() error { return r.Err }
+
+func makeReadingStatsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(readingStatsRequest)
+		stats, err := s.ReadingStats(ctx, req.Filter)
+		return readingStatsResponse{Stats: stats, Err: err}, nil
+	}
+}
+
+// bookVersion looks up the current version of a book for resolving an
+// If-Match header listing several.
+func bookVersion(ctx context.Context, s Service, id string) func() (int32, error) {
+	return func() (int32, error) {
+		book, err := s.GetBook(ctx, id)
+		return book.Revision, err
+	}
+}
+
+// chapterVersion looks up the current version of a chapter for resolving
+// an If-Match header listing several.
+func chapterVersion(ctx context.Context, s Service, id string) func() (int32, error) {
+	return func() (int32, error) {
+		chapter, err := s.GetChapter(ctx, id)
+		return chapter.Revision, err
+	}
+}
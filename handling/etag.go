@@ -0,0 +1,126 @@
+package handling
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Responses carrying a single book or chapter have a strong ETag made of
+// its version and a stamp of the time it last changed, e.g. "3.5f0c9a1e".
+// Tagging and reordering change a book or chapter without making a new
+// version, and the stamp tells these representations apart. Mutations take
+// the version they are conditioned on from the If-Match header, which
+// compares the version only; a bare version, e.g. "3", is accepted too.
+// Other GET responses have a weak ETag made of a hash of their body. GET
+// requests whose If-None-Match header matches the ETag are answered with
+// 304 Not Modified.
+
+func formatETag(version int32, updatedAt string) string {
+	sum := sha1.Sum([]byte(updatedAt))
+	return `"` + strconv.FormatInt(int64(version), 10) + "." + hex.EncodeToString(sum[:4]) + `"`
+}
+
+func responseETag(ctx context.Context, response interface{}, body []byte) string {
+	if v, ok := response.(versioner); ok {
+		if version, updatedAt := v.version(); version != AnyVersion {
+			return formatETag(version, updatedAt)
+		}
+	}
+	if !isGet(ctx) {
+		return ""
+	}
+	sum := sha1.Sum(body)
+	return `W/"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// ifMatch is the list of versions a mutation is conditioned on by its
+// If-Match header. An empty list matches any version.
+type ifMatch []int32
+
+// parseIfMatch returns the versions listed in the If-Match header of r.
+// A header none of whose ETags can match any version fails the request
+// with ErrVersionMismatch.
+func parseIfMatch(r *http.Request) (ifMatch, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		return nil, nil
+	}
+
+	var versions ifMatch
+	for _, etag := range strings.Split(header, ",") {
+		etag = strings.TrimSpace(etag)
+		if etag == "*" {
+			return nil, nil
+		}
+
+		// If-Match uses the strong comparison, so weak ETags never match.
+		if len(etag) < 2 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+			continue
+		}
+
+		tag := etag[1 : len(etag)-1]
+		if i := strings.IndexByte(tag, '.'); i >= 0 {
+			tag = tag[:i]
+		}
+
+		version, err := strconv.ParseInt(tag, 10, 32)
+		if err != nil || version <= 0 {
+			continue
+		}
+		versions = append(versions, int32(version))
+	}
+
+	if len(versions) == 0 {
+		return nil, ErrVersionMismatch
+	}
+	return versions, nil
+}
+
+// resolve returns the version to condition a mutation on: the current
+// version if the list has it, the first one otherwise, so that the
+// mutation fails as it should. The current version is only looked up when
+// the list has more than one.
+func (m ifMatch) resolve(current func() (int32, error)) int32 {
+	switch len(m) {
+	case 0:
+		return AnyVersion
+	case 1:
+		return m[0]
+	}
+
+	if version, err := current(); err == nil {
+		for _, v := range m {
+			if v == version {
+				return version
+			}
+		}
+	}
+	return m[0]
+}
+
+// etagMatches reports whether an If-None-Match header matches etag using
+// the weak comparison.
+func etagMatches(header string, etag string) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+func ifNoneMatch(ctx context.Context) string {
+	header, _ := ctx.Value(ifNoneMatchContextKey).(string)
+	return header
+}
+
+func isGet(ctx context.Context) bool {
+	return ctx.Value(requestMethodContextKey) == http.MethodGet
+}
@@ -0,0 +1,130 @@
+package handling
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   ifMatch
+		err    error
+	}{
+		{``, nil, nil},
+		{`*`, nil, nil},
+		{`"3"`, ifMatch{3}, nil},
+		{`"3.5f0c9a1e"`, ifMatch{3}, nil},
+		{`"2", "3.5f0c9a1e"`, ifMatch{2, 3}, nil},
+		{`W/"3", "4"`, ifMatch{4}, nil},
+		{`"2", *`, nil, nil},
+		{`W/"3"`, nil, ErrVersionMismatch},
+		{`3`, nil, ErrVersionMismatch},
+		{`"x"`, nil, ErrVersionMismatch},
+		{`"0"`, nil, ErrVersionMismatch},
+		{`"-1", "x"`, nil, ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		r, _ := http.NewRequest(http.MethodPut, "/", nil)
+		if tt.header != "" {
+			r.Header.Set("If-Match", tt.header)
+		}
+		got, err := parseIfMatch(r)
+		if !reflect.DeepEqual(got, tt.want) || err != tt.err {
+			t.Errorf("parseIfMatch(%q) = %v, %v, want %v, %v", tt.header, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestIfMatchResolve(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+
+	tests := []struct {
+		name    string
+		m       ifMatch
+		current int32
+		err     error
+		want    int32
+	}{
+		{"any", nil, 0, nil, AnyVersion},
+		{"one", ifMatch{4}, 0, nil, 4},
+		{"current listed", ifMatch{2, 3}, 3, nil, 3},
+		{"current not listed", ifMatch{2, 3}, 5, nil, 2},
+		{"lookup failed", ifMatch{2, 3}, 0, errLookup, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			looked := false
+			got := tt.m.resolve(func() (int32, error) {
+				looked = true
+				return tt.current, tt.err
+			})
+			if got != tt.want {
+				t.Errorf("resolve = %d, want %d", got, tt.want)
+			}
+			if looked != (len(tt.m) > 1) {
+				t.Errorf("current version looked up: %v, want %v", looked, len(tt.m) > 1)
+			}
+		})
+	}
+}
+
+func TestResponseETag(t *testing.T) {
+	get := context.WithValue(context.Background(), requestMethodContextKey, http.MethodGet)
+	put := context.WithValue(context.Background(), requestMethodContextKey, http.MethodPut)
+
+	book := prisma.Book{Revision: 3, UpdatedAt: "2026-10-19T10:00:00.000Z"}
+	etag := responseETag(put, getBookResponse{Book: book}, nil)
+	if !regexp.MustCompile(`^"3\.[0-9a-f]{8}"$`).MatchString(etag) {
+		t.Fatalf("got ETag %s, want the version and a stamp", etag)
+	}
+
+	tagged := book
+	tagged.UpdatedAt = "2026-10-19T10:05:00.000Z"
+	if other := responseETag(put, getBookResponse{Book: tagged}, nil); other == etag {
+		t.Errorf("got ETag %s for a book changed without a new version, want another", other)
+	}
+
+	r, _ := http.NewRequest(http.MethodPut, "/", nil)
+	r.Header.Set("If-Match", etag)
+	if m, err := parseIfMatch(r); err != nil || !reflect.DeepEqual(m, ifMatch{3}) {
+		t.Errorf("parseIfMatch(%s) = %v, %v, want [3]", etag, m, err)
+	}
+
+	body := []byte(`{"books":[]}` + "\n")
+	if etag := responseETag(get, listBooksResponse{}, body); !regexp.MustCompile(`^W/"[0-9a-f]{40}"$`).MatchString(etag) {
+		t.Errorf("got ETag %s for a list, want a weak one", etag)
+	}
+	if etag := responseETag(put, listBooksResponse{}, body); etag != "" {
+		t.Errorf("got ETag %s for a list not asked for by GET, want none", etag)
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	tests := []struct {
+		header, etag string
+		want         bool
+	}{
+		{``, `"3.5f0c9a1e"`, false},
+		{`*`, `"3.5f0c9a1e"`, true},
+		{`"3.5f0c9a1e"`, `"3.5f0c9a1e"`, true},
+		{`"3.00000000"`, `"3.5f0c9a1e"`, false},
+		{`"a", W/"b"`, `W/"b"`, true},
+		{`W/"b"`, `"b"`, true},
+		{`"c"`, `W/"b"`, false},
+	}
+
+	for _, tt := range tests {
+		if got := etagMatches(tt.header, tt.etag); got != tt.want {
+			t.Errorf("etagMatches(%q, %q) = %v, want %v", tt.header, tt.etag, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package handling
+
+import (
+	"context"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+type eventingService struct {
+	publisher EventPublisher
+	Service
+}
+
+// NewEventingService returns a new instance of a Service which publishes
+// an Event for every successful mutation.
+func NewEventingService(p EventPublisher, s Service) Service {
+	return &eventingService{
+		publisher: p,
+		Service:   s,
+	}
+}
+
+func (s *eventingService) AddBook(ctx context.Context, name string, description string, chapters []NewChapter) (prisma.Book, []prisma.Chapter, error) {
+	book, created, err := s.Service.AddBook(ctx, name, description, chapters)
+	if err != nil {
+		return book, created, err
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeBook, prisma.MutationTypeCreated, book.ID, book, nil))
+	for _, chapter := range created {
+		s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeCreated, book.ID, chapter, nil))
+	}
+	return book, created, nil
+}
+
+func (s *eventingService) DeleteBook(ctx context.Context, id string, version int32) (prisma.Book, error) {
+	book, err := s.Service.DeleteBook(ctx, id, version)
+	if err != nil {
+		return book, err
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeBook, prisma.MutationTypeDeleted, book.ID, nil, book))
+	return book, nil
+}
+
+func (s *eventingService) MergeBooks(ctx context.Context, sourceID string, targetID string, strategy MergeStrategy, archive bool, version int32) (prisma.Book, error) {
+	source, err := s.Service.GetBook(ctx, sourceID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	chapters, err := s.Service.Chapters(ctx, sourceID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	previous, err := s.Service.GetBook(ctx, targetID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	book, err := s.Service.MergeBooks(ctx, sourceID, targetID, strategy, archive, version)
+	if err != nil {
+		return book, err
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeBook, prisma.MutationTypeDeleted, source.ID, nil, source))
+	if book.Revision != previous.Revision {
+		s.publisher.Publish(ctx, NewEvent(EventTypeBook, prisma.MutationTypeUpdated, book.ID, book, previous))
+	}
+
+	if len(chapters) == 0 {
+		return book, nil
+	}
+
+	// The moved chapters leave the source, like a chapter moved to another
+	// book, and are published as they are in the target.
+	moved := make(map[string]bool, len(chapters))
+	for _, chapter := range chapters {
+		moved[chapter.ID] = true
+		s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeDeleted, source.ID, nil, chapter.Chapter))
+	}
+	merged, err := s.Service.Chapters(ctx, book.ID)
+	if err != nil {
+		return book, nil
+	}
+	for _, chapter := range merged {
+		if moved[chapter.ID] {
+			s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeCreated, book.ID, chapter.Chapter, nil))
+		}
+	}
+	return book, nil
+}
+
+func (s *eventingService) DuplicateBook(ctx context.Context, id string, name string) (prisma.Book, []prisma.Chapter, error) {
+	book, chapters, err := s.Service.DuplicateBook(ctx, id, name)
+	if err != nil {
+		return book, chapters, err
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeBook, prisma.MutationTypeCreated, book.ID, book, nil))
+	for _, chapter := range chapters {
+		s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeCreated, book.ID, chapter, nil))
+	}
+	return book, chapters, nil
+}
+
+func (s *eventingService) AddChapter(ctx context.Context, name string, description string, bookID string) (prisma.Chapter, error) {
+	chapter, err := s.Service.AddChapter(ctx, name, description, bookID)
+	if err != nil {
+		return chapter, err
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeCreated, bookID, chapter, nil))
+	return chapter, nil
+}
+
+func (s *eventingService) DeleteChapter(ctx context.Context, id string, version int32) (prisma.Chapter, error) {
+	// The book has to be resolved before the chapter is gone.
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := s.Service.DeleteChapter(ctx, id, version)
+	if err != nil {
+		return chapter, err
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeDeleted, book.ID, nil, chapter))
+	return chapter, nil
+}
+
+func (s *eventingService) RestoreBook(ctx context.Context, id string) (prisma.Book, error) {
+	book, err := s.Service.RestoreBook(ctx, id)
+	if err != nil {
+		return book, err
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeBook, prisma.MutationTypeCreated, book.ID, book, nil))
+	return book, nil
+}
+
+func (s *eventingService) RestoreChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	// Restoring a chapter restores its book if that is in the trash too.
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := s.Service.RestoreChapter(ctx, id)
+	if err != nil {
+		return chapter, err
+	}
+	if book.Deleted {
+		book.Deleted = false
+		s.publisher.Publish(ctx, NewEvent(EventTypeBook, prisma.MutationTypeCreated, book.ID, book, nil))
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeCreated, book.ID, chapter, nil))
+	return chapter, nil
+}
+
+func (s *eventingService) MoveChapter(ctx context.Context, id string, bookID string, position int32, version int32) (prisma.Chapter, error) {
+	previous, err := s.Service.GetChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	source, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := s.Service.MoveChapter(ctx, id, bookID, position, version)
+	if err != nil {
+		return chapter, err
+	}
+	switch {
+	case chapter.Revision == previous.Revision:
+	case source.ID == bookID:
+		s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeUpdated, bookID, chapter, previous))
+	default:
+		s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeDeleted, source.ID, nil, previous))
+		s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeCreated, bookID, chapter, nil))
+	}
+	return chapter, nil
+}
+
+func (s *eventingService) CopyChapter(ctx context.Context, id string, bookID string, position int32) (prisma.Chapter, error) {
+	chapter, err := s.Service.CopyChapter(ctx, id, bookID, position)
+	if err != nil {
+		return chapter, err
+	}
+	s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeCreated, bookID, chapter, nil))
+	return chapter, nil
+}
+
+func (s *eventingService) UpdateBook(ctx context.Context, id string, name string, description string, version int32) (prisma.Book, error) {
+	return s.publishBookUpdate(ctx, id, func() (prisma.Book, error) {
+		return s.Service.UpdateBook(ctx, id, name, description, version)
+	})
+}
+
+func (s *eventingService) UpdateBookMetadata(ctx context.Context, id string, m Metadata, version int32) (prisma.Book, error) {
+	return s.publishBookUpdate(ctx, id, func() (prisma.Book, error) {
+		return s.Service.UpdateBookMetadata(ctx, id, m, version)
+	})
+}
+
+func (s *eventingService) RevertBook(ctx context.Context, id string, number int32, version int32) (prisma.Book, error) {
+	return s.publishBookUpdate(ctx, id, func() (prisma.Book, error) {
+		return s.Service.RevertBook(ctx, id, number, version)
+	})
+}
+
+func (s *eventingService) UpdateChapter(ctx context.Context, id string, name string, description string, version int32) (prisma.Chapter, error) {
+	return s.publishChapterUpdate(ctx, id, func() (prisma.Chapter, error) {
+		return s.Service.UpdateChapter(ctx, id, name, description, version)
+	})
+}
+
+func (s *eventingService) RevertChapter(ctx context.Context, id string, number int32, version int32) (prisma.Chapter, error) {
+	return s.publishChapterUpdate(ctx, id, func() (prisma.Chapter, error) {
+		return s.Service.RevertChapter(ctx, id, number, version)
+	})
+}
+
+// publishBookUpdate runs update and publishes an update event if it
+// changed the book with the given id.
+func (s *eventingService) publishBookUpdate(ctx context.Context, id string, update func() (prisma.Book, error)) (prisma.Book, error) {
+	previous, err := s.Service.GetBook(ctx, id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	book, err := update()
+	if err != nil {
+		return book, err
+	}
+	if book.Revision != previous.Revision {
+		s.publisher.Publish(ctx, NewEvent(EventTypeBook, prisma.MutationTypeUpdated, book.ID, book, previous))
+	}
+	return book, nil
+}
+
+// publishChapterUpdate runs update and publishes an update event if it
+// changed the chapter with the given id.
+func (s *eventingService) publishChapterUpdate(ctx context.Context, id string, update func() (prisma.Chapter, error)) (prisma.Chapter, error) {
+	previous, err := s.Service.GetChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := update()
+	if err != nil {
+		return chapter, err
+	}
+	if chapter.Revision != previous.Revision {
+		s.publisher.Publish(ctx, NewEvent(EventTypeChapter, prisma.MutationTypeUpdated, book.ID, chapter, previous))
+	}
+	return chapter, nil
+}
@@ -0,0 +1,114 @@
+package handling
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// recordingPublisher keeps the events published to it.
+type recordingPublisher []Event
+
+func (p *recordingPublisher) Publish(_ context.Context, e Event) {
+	*p = append(*p, e)
+}
+
+// mergedService answers as if the book b1, with the chapters c1 and c2,
+// was merged into the book b2, with the chapter c3.
+type mergedService struct {
+	Service
+	merged bool
+}
+
+func (s *mergedService) GetBook(_ context.Context, id string) (prisma.Book, error) {
+	return prisma.Book{ID: id, Revision: 1}, nil
+}
+
+func (s *mergedService) Chapters(_ context.Context, bookID string) ([]ReadingChapter, error) {
+	ids := map[string][]string{"b1": {"c1", "c2"}, "b2": {"c3"}}[bookID]
+	if s.merged {
+		ids = map[string][]string{"b2": {"c3", "c1", "c2"}}[bookID]
+	}
+	chapters := make([]ReadingChapter, len(ids))
+	for i, id := range ids {
+		chapters[i] = ReadingChapter{Chapter: prisma.Chapter{ID: id}}
+	}
+	return chapters, nil
+}
+
+func (s *mergedService) MergeBooks(_ context.Context, _, targetID string, _ MergeStrategy, _ bool, _ int32) (prisma.Book, error) {
+	s.merged = true
+	return prisma.Book{ID: targetID, Revision: 1}, nil
+}
+
+func TestEventingMergeBooks(t *testing.T) {
+	var p recordingPublisher
+	s := NewEventingService(&p, &mergedService{})
+
+	if _, err := s.MergeBooks(context.Background(), "b1", "b2", MergeKeepTarget, false, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, e := range p {
+		id := ""
+		switch v := e.Node.(type) {
+		case prisma.Book:
+			id = v.ID
+		case prisma.Chapter:
+			id = v.ID
+		}
+		if v, ok := e.PreviousValues.(prisma.Chapter); ok {
+			id = v.ID
+		} else if v, ok := e.PreviousValues.(prisma.Book); ok {
+			id = v.ID
+		}
+		got = append(got, fmt.Sprintf("%s %s %s of %s", e.Type, e.Mutation, id, e.BookID))
+	}
+
+	want := []string{
+		"book DELETED b1 of b1",
+		"chapter DELETED c1 of b1",
+		"chapter DELETED c2 of b1",
+		"chapter CREATED c1 of b2",
+		"chapter CREATED c2 of b2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("published %q, want %q", got, want)
+	}
+}
+
+func TestMergeDataOutbox(t *testing.T) {
+	target := prisma.Book{ID: "b2", Revision: 1}
+	chapters := []prisma.Chapter{{ID: "c1", Position: 1}, {ID: "c2", Position: 2}}
+
+	data, err := mergeData("b1", target, target, Metadata{}, Metadata{}, chapters, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, e := range data.Outbox.Create {
+		var payload struct {
+			BookID string `json:"bookId"`
+		}
+		if err := json.Unmarshal([]byte(e.Payload), &payload); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, fmt.Sprintf("%s %s of %s", e.Type, e.AggregateID, payload.BookID))
+	}
+
+	want := []string{
+		"chapter.deleted c1 of b1",
+		"chapter.created c1 of b2",
+		"chapter.deleted c2 of b1",
+		"chapter.created c2 of b2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrote %q to the outbox, want %q", got, want)
+	}
+}
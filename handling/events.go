@@ -0,0 +1,143 @@
+package handling
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// EventType is the kind of entity an Event describes.
+type EventType string
+
+// Entities which changes are reported by the events feed.
+const (
+	EventTypeBook    EventType = "book"
+	EventTypeChapter EventType = "chapter"
+)
+
+// Event describes a created, updated or deleted book or chapter. It mirrors
+// the shape of the Prisma subscription payloads: Node holds the entity after
+// the change and is empty for deletions, PreviousValues holds the entity
+// before the change and is empty for creations.
+type Event struct {
+	ID             string              `json:"id"`
+	Time           time.Time           `json:"time"`
+	Type           EventType           `json:"type"`
+	Mutation       prisma.MutationType `json:"mutation"`
+	BookID         string              `json:"bookId"`
+	Node           interface{}         `json:"node,omitempty"`
+	PreviousValues interface{}         `json:"previousValues,omitempty"`
+}
+
+// NewEvent returns a new Event with a fresh ID and the current time.
+func NewEvent(typ EventType, mutation prisma.MutationType, bookID string, node, previous interface{}) Event {
+	return Event{
+		ID:             newID(),
+		Time:           time.Now().UTC(),
+		Type:           typ,
+		Mutation:       mutation,
+		BookID:         bookID,
+		Node:           node,
+		PreviousValues: previous,
+	}
+}
+
+// Name returns the name of the event, e.g. "book.created".
+func (e Event) Name() string {
+	return string(e.Type) + "." + strings.ToLower(string(e.Mutation))
+}
+
+// EventPublisher receives change events from the handling service.
+type EventPublisher interface {
+	Publish(ctx context.Context, e Event)
+}
+
+// EventPublishers fans an Event out to several publishers.
+type EventPublishers []EventPublisher
+
+// Publish implements EventPublisher.
+func (ps EventPublishers) Publish(ctx context.Context, e Event) {
+	for _, p := range ps {
+		p.Publish(ctx, e)
+	}
+}
+
+// EventSource streams change events. An empty bookID subscribes to the
+// events of all books. The returned channel is closed when ctx is done or
+// when the subscriber cannot keep up with the stream.
+type EventSource interface {
+	Subscribe(ctx context.Context, bookID string) (<-chan Event, error)
+}
+
+// EventHub is an in-memory EventPublisher and EventSource which fans out
+// published events to all matching subscribers. It does not depend on the
+// storage backend, so the feed works the same on every one of them.
+type EventHub struct {
+	mtx  sync.Mutex
+	subs map[*subscription]struct{}
+}
+
+type subscription struct {
+	bookID string
+	ch     chan Event
+}
+
+const subscriptionBuffer = 64
+
+// NewEventHub returns a new instance of an EventHub.
+func NewEventHub() *EventHub {
+	return &EventHub{
+		subs: make(map[*subscription]struct{}),
+	}
+}
+
+// Publish delivers e to every subscriber interested in it. Subscribers with
+// a full buffer are dropped rather than blocking the publisher.
+func (h *EventHub) Publish(_ context.Context, e Event) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	for sub := range h.subs {
+		if sub.bookID != "" && sub.bookID != e.BookID {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+// Subscribe implements EventSource.
+func (h *EventHub) Subscribe(ctx context.Context, bookID string) (<-chan Event, error) {
+	sub := &subscription{
+		bookID: bookID,
+		ch:     make(chan Event, subscriptionBuffer),
+	}
+
+	h.mtx.Lock()
+	h.subs[sub] = struct{}{}
+	h.mtx.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mtx.Lock()
+		h.remove(sub)
+		h.mtx.Unlock()
+	}()
+
+	return sub.ch, nil
+}
+
+// remove must be called with h.mtx held.
+func (h *EventHub) remove(sub *subscription) {
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	close(sub.ch)
+}
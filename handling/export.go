@@ -0,0 +1,515 @@
+package handling
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// errNotAcceptable is returned when a request accepts none of the formats
+// a book can be exported in.
+var errNotAcceptable = errors.New("not acceptable")
+
+// BookExport is a book to be exported with its chapters, in order. The
+// notes of a chapter are fetched only as it is written, by EachChapter, so
+// that the notes of a large book are never held all at once.
+type BookExport struct {
+	Book     prisma.Book
+	Metadata Metadata
+	chapters []prisma.Chapter
+	notes    func(ctx context.Context, chapterID string) ([]prisma.Note, error)
+}
+
+// ExportedChapter is a chapter of an exported book with its notes, oldest
+// first.
+type ExportedChapter struct {
+	prisma.Chapter
+	Notes []prisma.Note
+}
+
+func (s *service) ExportBook(ctx context.Context, id string) (BookExport, error) {
+	if id == "" {
+		return BookExport{}, ErrInvalidArgument
+	}
+
+	book, err := activeBook(ctx, id)
+	if err != nil {
+		return BookExport{}, err
+	}
+
+	m, err := bookMetadata(ctx, *book)
+	if err != nil {
+		return BookExport{}, err
+	}
+
+	chapters, err := orderedChapters(ctx, id)
+	if err != nil {
+		return BookExport{}, err
+	}
+
+	return BookExport{
+		Book:     *book,
+		Metadata: m,
+		chapters: chapters,
+		notes:    s.Notes,
+	}, nil
+}
+
+// EachChapter calls fn with the chapters of the book in order, each with
+// its notes, which are fetched once fn is done with the chapter before. A
+// chapter moved to the trash in the meantime is left out. EachChapter stops
+// at the first error.
+func (e BookExport) EachChapter(ctx context.Context, fn func(ExportedChapter) error) error {
+	for _, chapter := range e.chapters {
+		notes, err := e.notes(ctx, chapter.ID)
+		switch {
+		case err == ErrNotFound:
+			continue
+		case err != nil:
+			return err
+		}
+
+		if err := fn(ExportedChapter{Chapter: chapter, Notes: notes}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportFormat is a format a book can be exported in.
+type exportFormat struct {
+	name        string
+	ext         string
+	contentType string
+	write       func(ctx context.Context, w *bufio.Writer, export BookExport, flush func() error) error
+}
+
+// exportFormats are the formats a book can be exported in, in the order
+// they are preferred when a request accepts several equally.
+var exportFormats = []exportFormat{
+	{"md", "md", "text/markdown", writeMarkdownExport},
+	{"html", "html", "text/html", writeHTMLExport},
+	{"json", "json", "application/json", writeJSONExport},
+}
+
+// exportFormatByName returns the export format named by the format query
+// parameter.
+func exportFormatByName(name string) (exportFormat, bool) {
+	name = strings.ToLower(name)
+	switch name {
+	case "markdown":
+		name = "md"
+	case "htm":
+		name = "html"
+	}
+	for _, f := range exportFormats {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return exportFormat{}, false
+}
+
+// negotiateExportFormat returns the export format best matching the media
+// ranges of an Accept header, by their quality. A missing header accepts
+// anything, and so Markdown.
+func negotiateExportFormat(accept string) (exportFormat, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return exportFormats[0], true
+	}
+
+	best, bestQ := -1, 0.0
+	for i, f := range exportFormats {
+		q := acceptQuality(accept, f.contentType)
+		if q > bestQ {
+			best, bestQ = i, q
+		}
+	}
+	if best < 0 {
+		return exportFormat{}, false
+	}
+	return exportFormats[best], true
+}
+
+// acceptQuality returns the quality an Accept header gives contentType: that
+// of its most specific media range matching it, or 0 if none does.
+func acceptQuality(accept, contentType string) float64 {
+	typ := contentType[:strings.Index(contentType, "/")]
+
+	q, specificity := 0.0, -1
+	for _, part := range strings.Split(accept, ",") {
+		mediaRange, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		s := -1
+		switch mediaRange {
+		case contentType:
+			s = 2
+		case typ + "/*":
+			s = 1
+		case "*/*":
+			s = 0
+		}
+		if s <= specificity {
+			continue
+		}
+
+		rq := 1.0
+		if v, ok := params["q"]; ok {
+			if rq, err = strconv.ParseFloat(v, 64); err != nil || rq < 0 || rq > 1 {
+				continue
+			}
+		}
+		q, specificity = rq, s
+	}
+	return q
+}
+
+// exportDisposition returns the Content-Disposition of an export of book:
+// saved under the name of the book in lower case, with dashes for anything
+// but letters and digits, and under its ID by clients which only read an
+// ASCII filename.
+func exportDisposition(book prisma.Book, f exportFormat) string {
+	var b strings.Builder
+	dash := false
+	ascii := true
+	for _, r := range strings.ToLower(book.Name) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			dash = true
+			continue
+		}
+		if dash && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		dash = false
+		b.WriteRune(r)
+		ascii = ascii && r < utf8.RuneSelf
+	}
+
+	name := b.String()
+	if name == "" {
+		name = book.ID
+	}
+	fallback := name
+	if !ascii {
+		fallback = book.ID
+	}
+
+	return fmt.Sprintf("attachment; filename=%q; filename*=UTF-8''%s",
+		fallback+"."+f.ext, url.PathEscape(name+"."+f.ext))
+}
+
+// writeExport writes export in format f to w a chapter at a time, flushing
+// w after every chapter if it is an http.Flusher, so that a large book is
+// sent as it is read.
+func writeExport(ctx context.Context, w io.Writer, export BookExport, f exportFormat) error {
+	bw := bufio.NewWriter(w)
+	flush := func() error {
+		if err := bw.Flush(); err != nil {
+			return err
+		}
+		if fl, ok := w.(http.Flusher); ok {
+			fl.Flush()
+		}
+		return nil
+	}
+
+	if err := f.write(ctx, bw, export, flush); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// writeMarkdownExport writes export as Markdown, in the form ImportMarkdown
+// reads: a level one heading for the book and level two headings for its
+// chapters, each followed by its description. Notes follow the description
+// of their chapter, the quote as a block quote followed by the location and
+// the text. Lines of text which would read as headings or code fences are
+// escaped, so that importing an export gives back the book and chapters.
+func writeMarkdownExport(ctx context.Context, w *bufio.Writer, export BookExport, flush func() error) error {
+	md := &markdownWriter{w: w}
+	md.section("#", export.Book.Name, export.Book.Description)
+	if err := flush(); err != nil {
+		return err
+	}
+
+	return export.EachChapter(ctx, func(chapter ExportedChapter) error {
+		md.section("##", chapter.Name, chapter.Description)
+		for _, note := range chapter.Notes {
+			md.note(note)
+		}
+		return flush()
+	})
+}
+
+// markdownWriter writes Markdown blocks separated by blank lines.
+type markdownWriter struct {
+	w       *bufio.Writer
+	started bool
+}
+
+// block writes text, which has no blank lines around it, as a block.
+func (md *markdownWriter) block(text string) {
+	if md.started {
+		md.w.WriteString("\n")
+	}
+	md.w.WriteString(text + "\n")
+	md.started = true
+}
+
+func (md *markdownWriter) section(marker, title, text string) {
+	md.block(marker + " " + singleLine(title))
+	if text = normalizeText(text); text != "" {
+		md.block(escapeMarkdown(text))
+	}
+}
+
+func (md *markdownWriter) note(note prisma.Note) {
+	if quote := normalizeText(note.Quote); quote != "" {
+		lines := strings.Split(quote, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		md.block(strings.Join(lines, "\n"))
+	}
+	if location := singleLine(note.Location); location != "" {
+		md.block("— " + location)
+	}
+	if text := normalizeText(note.Text); text != "" {
+		md.block(escapeMarkdown(text))
+	}
+}
+
+// escapeMarkdown returns text with a backslash before every line which
+// parseMarkdown would take for a heading or a code fence, or for one
+// escaped.
+func escapeMarkdown(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if escapable(line) {
+			trimmed := strings.TrimLeft(line, " ")
+			lines[i] = line[:len(line)-len(trimmed)] + "\\" + trimmed
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// writeHTMLExport writes export as a standalone HTML document: an article
+// with a section for every chapter, and an aside for every note.
+func writeHTMLExport(ctx context.Context, w *bufio.Writer, export BookExport, flush func() error) error {
+	book := export.Book
+
+	w.WriteString("<!DOCTYPE html>\n")
+	if export.Metadata.Language != "" {
+		fmt.Fprintf(w, "<html lang=\"%s\">\n", html.EscapeString(export.Metadata.Language))
+	} else {
+		w.WriteString("<html>\n")
+	}
+	w.WriteString("<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(w, "<title>%s</title>\n", html.EscapeString(singleLine(book.Name)))
+	for _, author := range export.Metadata.Authors {
+		fmt.Fprintf(w, "<meta name=\"author\" content=\"%s\">\n", html.EscapeString(author))
+	}
+	w.WriteString("</head>\n<body>\n")
+
+	fmt.Fprintf(w, "<article id=\"book-%s\">\n", html.EscapeString(book.ID))
+	fmt.Fprintf(w, "<h1>%s</h1>\n", html.EscapeString(singleLine(book.Name)))
+	writeHTMLParagraphs(w, book.Description)
+	if err := flush(); err != nil {
+		return err
+	}
+
+	err := export.EachChapter(ctx, func(chapter ExportedChapter) error {
+		fmt.Fprintf(w, "<section id=\"chapter-%s\">\n", html.EscapeString(chapter.ID))
+		fmt.Fprintf(w, "<h2>%s</h2>\n", html.EscapeString(singleLine(chapter.Name)))
+		writeHTMLParagraphs(w, chapter.Description)
+		for _, note := range chapter.Notes {
+			writeHTMLNote(w, note)
+		}
+		w.WriteString("</section>\n")
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	w.WriteString("</article>\n</body>\n</html>\n")
+	return nil
+}
+
+func writeHTMLNote(w *bufio.Writer, note prisma.Note) {
+	fmt.Fprintf(w, "<aside class=\"note note-%s\" id=\"note-%s\">\n",
+		html.EscapeString(strings.ToLower(string(note.Color))), html.EscapeString(note.ID))
+	if quote := normalizeText(note.Quote); quote != "" {
+		w.WriteString("<blockquote>\n")
+		writeHTMLParagraphs(w, quote)
+		w.WriteString("</blockquote>\n")
+	}
+	if location := singleLine(note.Location); location != "" {
+		fmt.Fprintf(w, "<p class=\"location\">%s</p>\n", html.EscapeString(location))
+	}
+	writeHTMLParagraphs(w, note.Text)
+	w.WriteString("</aside>\n")
+}
+
+// writeHTMLParagraphs writes text as paragraphs, split on blank lines.
+func writeHTMLParagraphs(w *bufio.Writer, text string) {
+	text = normalizeText(text)
+	if text == "" {
+		return
+	}
+	for _, p := range strings.Split(text, "\n\n") {
+		fmt.Fprintf(w, "<p>%s</p>\n", strings.Replace(html.EscapeString(p), "\n", "<br>\n", -1))
+	}
+}
+
+// jsonExportBook and the types below it are the JSON form of an export.
+// They leave out timestamps, positions and revisions, so that an export
+// changes only as the content of the book does.
+type jsonExportBook struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Authors     []string `json:"authors"`
+	ISBN        string   `json:"isbn,omitempty"`
+	Publisher   string   `json:"publisher,omitempty"`
+	Year        int32    `json:"year,omitempty"`
+	Language    string   `json:"language,omitempty"`
+}
+
+type jsonExportChapter struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Notes       []jsonExportNote `json:"notes"`
+}
+
+type jsonExportNote struct {
+	ID       string           `json:"id"`
+	Quote    string           `json:"quote,omitempty"`
+	Text     string           `json:"text,omitempty"`
+	Location string           `json:"location,omitempty"`
+	Color    prisma.NoteColor `json:"color"`
+}
+
+// writeJSONExport writes export as an indented JSON object: the book, with
+// its chapters in a "chapters" array written one by one.
+func writeJSONExport(ctx context.Context, w *bufio.Writer, export BookExport, flush func() error) error {
+	book := export.Book
+	authors := export.Metadata.Authors
+	if authors == nil {
+		authors = []string{}
+	}
+
+	head, err := indentJSON(jsonExportBook{
+		ID:          book.ID,
+		Name:        book.Name,
+		Description: book.Description,
+		Authors:     authors,
+		ISBN:        export.Metadata.ISBN,
+		Publisher:   export.Metadata.Publisher,
+		Year:        export.Metadata.Year,
+		Language:    export.Metadata.Language,
+	}, "")
+	if err != nil {
+		return err
+	}
+
+	// Reopen the object of the book to append its chapters.
+	w.Write(bytes.TrimSuffix(head, []byte("\n}")))
+	w.WriteString(",\n  \"chapters\": [")
+	if err := flush(); err != nil {
+		return err
+	}
+
+	written := 0
+	err = export.EachChapter(ctx, func(chapter ExportedChapter) error {
+		c := jsonExportChapter{
+			ID:          chapter.ID,
+			Name:        chapter.Name,
+			Description: chapter.Description,
+			Notes:       make([]jsonExportNote, len(chapter.Notes)),
+		}
+		for j, note := range chapter.Notes {
+			c.Notes[j] = jsonExportNote{
+				ID:       note.ID,
+				Quote:    note.Quote,
+				Text:     note.Text,
+				Location: note.Location,
+				Color:    note.Color,
+			}
+		}
+
+		body, err := indentJSON(c, "    ")
+		if err != nil {
+			return err
+		}
+		if written > 0 {
+			w.WriteString(",")
+		}
+		w.WriteString("\n    ")
+		w.Write(body)
+		written++
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if written > 0 {
+		w.WriteString("\n  ")
+	}
+	w.WriteString("]\n}\n")
+	return nil
+}
+
+// indentJSON returns v as JSON indented by two spaces, each line but the
+// first prefixed, without escaping HTML and without a trailing newline.
+func indentJSON(v interface{}, prefix string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent(prefix, "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// normalizeText returns text with Unix line endings, without trailing
+// spaces, surrounding blank lines or more than one blank line in a row, so
+// that exports of the same text are the same.
+func normalizeText(text string) string {
+	lines := strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" && (len(kept) == 0 || kept[len(kept)-1] == "") {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.TrimRight(strings.Join(kept, "\n"), "\n")
+}
+
+// singleLine returns text on a single line, its runs of white space turned
+// into single spaces.
+func singleLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
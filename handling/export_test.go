@@ -0,0 +1,131 @@
+package handling
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+func TestMarkdownExportRoundTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		chapter     string
+		note        string
+	}{
+		{"plain", "A book.", "A chapter.", "A note."},
+		{"headings", "# Not a book\n\nText.", "## Not a chapter", "## sub"},
+		{"indented heading", "Text.\n   # Not a book", "Text.", ""},
+		{"escaped heading", "\\# Escaped already", "\\\\## Twice", ""},
+		{"fences", "```\n# In code\n```", "~~~~ unclosed", "```go"},
+		{"closing hashes", "# Title #", "Text. #", "#hashtag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var notes []prisma.Note
+			if tt.note != "" {
+				notes = []prisma.Note{{ID: "n", Text: tt.note}}
+			}
+			export := BookExport{
+				Book: prisma.Book{ID: "b", Name: "Book", Description: tt.description},
+				chapters: []prisma.Chapter{
+					{ID: "c1", Name: "One", Description: tt.chapter},
+					{ID: "c2", Name: "Two", Description: "Last."},
+				},
+				notes: func(_ context.Context, chapterID string) ([]prisma.Note, error) {
+					if chapterID == "c1" {
+						return notes, nil
+					}
+					return nil, nil
+				},
+			}
+
+			var buf bytes.Buffer
+			w := bufio.NewWriter(&buf)
+			if err := writeMarkdownExport(context.Background(), w, export, w.Flush); err != nil {
+				t.Fatal(err)
+			}
+			w.Flush()
+
+			books, err := parseMarkdown(buf.String())
+			if err != nil {
+				t.Fatalf("parseMarkdown: %v\n%s", err, buf.String())
+			}
+			if len(books) != 1 {
+				t.Fatalf("got %d books, want 1\n%s", len(books), buf.String())
+			}
+
+			book := books[0]
+			if book.Name != "Book" || book.Description != tt.description {
+				t.Errorf("got book %q: %q, want %q: %q", book.Name, book.Description, "Book", tt.description)
+			}
+			if len(book.Chapters) != 2 {
+				t.Fatalf("got %d chapters, want 2\n%s", len(book.Chapters), buf.String())
+			}
+
+			want := tt.chapter
+			if tt.note != "" {
+				want += "\n\n" + tt.note
+			}
+			if c := book.Chapters[0]; c.Name != "One" || c.Description != want {
+				t.Errorf("got chapter %q: %q, want %q: %q", c.Name, c.Description, "One", want)
+			}
+			if c := book.Chapters[1]; c.Name != "Two" || c.Description != "Last." {
+				t.Errorf("got chapter %q: %q, want %q: %q", c.Name, c.Description, "Two", "Last.")
+			}
+		})
+	}
+}
+
+func TestNegotiateExportFormat(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", "md"},
+		{"*/*", "md"},
+		{"text/*", "md"},
+		{"text/html", "html"},
+		{"application/json, text/html;q=0.5", "json"},
+		{"text/*;q=0.5, application/json", "json"},
+		{"text/html; q=0.8, text/markdown; q=0.9", "md"},
+		{"text/html;q=0, */*", "md"},
+		{"text/*, text/markdown;q=0", "html"},
+		{"text/markdown;q=0", ""},
+		{"image/png", ""},
+		{"garbage;;", ""},
+	}
+
+	for _, tt := range tests {
+		f, ok := negotiateExportFormat(tt.accept)
+		if f.name != tt.want || ok != (tt.want != "") {
+			t.Errorf("negotiateExportFormat(%q) = %q, %v, want %q", tt.accept, f.name, ok, tt.want)
+		}
+	}
+}
+
+func TestExportFormatByName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"md", "md"},
+		{"Markdown", "md"},
+		{"html", "html"},
+		{"HTM", "html"},
+		{"json", "json"},
+		{"pdf", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		f, ok := exportFormatByName(tt.name)
+		if f.name != tt.want || ok != (tt.want != "") {
+			t.Errorf("exportFormatByName(%q) = %q, %v, want %q", tt.name, f.name, ok, tt.want)
+		}
+	}
+}
@@ -68,7 +68,7 @@ func (client *Client) Book(params BookWhereUniqueInput) *BookExec {
 		params,
 		[2]string{"BookWhereUniqueInput!", "Book"},
 		"book",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
 
 	return &BookExec{ret}
 }
@@ -102,7 +102,7 @@ func (client *Client) Books(params *BooksParams) *BookExecArray {
 		wparams,
 		[3]string{"BookWhereInput", "BookOrderByInput", "Book"},
 		"books",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
 
 	return &BookExecArray{ret}
 }
@@ -121,13 +121,72 @@ func (client *Client) BooksConnection(params *BooksConnectionParams) BookConnect
 	panic("not implemented")
 }
 
+func (client *Client) Author(params AuthorWhereUniqueInput) *AuthorExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"AuthorWhereUniqueInput!", "Author"},
+		"author",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExec{ret}
+}
+
+type AuthorsParams struct {
+	Where   *AuthorWhereInput   `json:"where,omitempty"`
+	OrderBy *AuthorOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32              `json:"skip,omitempty"`
+	After   *string             `json:"after,omitempty"`
+	Before  *string             `json:"before,omitempty"`
+	First   *int32              `json:"first,omitempty"`
+	Last    *int32              `json:"last,omitempty"`
+}
+
+func (client *Client) Authors(params *AuthorsParams) *AuthorExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"AuthorWhereInput", "AuthorOrderByInput", "Author"},
+		"authors",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExecArray{ret}
+}
+
+type AuthorsConnectionParams struct {
+	Where   *AuthorWhereInput   `json:"where,omitempty"`
+	OrderBy *AuthorOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32              `json:"skip,omitempty"`
+	After   *string             `json:"after,omitempty"`
+	Before  *string             `json:"before,omitempty"`
+	First   *int32              `json:"first,omitempty"`
+	Last    *int32              `json:"last,omitempty"`
+}
+
+func (client *Client) AuthorsConnection(params *AuthorsConnectionParams) AuthorConnectionExec {
+	panic("not implemented")
+}
+
 func (client *Client) Chapter(params ChapterWhereUniqueInput) *ChapterExec {
 	ret := client.Client.GetOne(
 		nil,
 		params,
 		[2]string{"ChapterWhereUniqueInput!", "Chapter"},
 		"chapter",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
 
 	return &ChapterExec{ret}
 }
@@ -161,7 +220,7 @@ func (client *Client) Chapters(params *ChaptersParams) *ChapterExecArray {
 		wparams,
 		[3]string{"ChapterWhereInput", "ChapterOrderByInput", "Chapter"},
 		"chapters",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
 
 	return &ChapterExecArray{ret}
 }
@@ -180,550 +239,14466 @@ func (client *Client) ChaptersConnection(params *ChaptersConnectionParams) Chapt
 	panic("not implemented")
 }
 
-func (client *Client) CreateBook(params BookCreateInput) *BookExec {
-	ret := client.Client.Create(
+func (client *Client) Note(params NoteWhereUniqueInput) *NoteExec {
+	ret := client.Client.GetOne(
+		nil,
 		params,
-		[2]string{"BookCreateInput!", "Book"},
-		"createBook",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[2]string{"NoteWhereUniqueInput!", "Note"},
+		"note",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
 
-	return &BookExec{ret}
+	return &NoteExec{ret}
 }
 
-type BookUpdateParams struct {
-	Data  BookUpdateInput      `json:"data"`
-	Where BookWhereUniqueInput `json:"where"`
+type NotesParams struct {
+	Where   *NoteWhereInput   `json:"where,omitempty"`
+	OrderBy *NoteOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32            `json:"skip,omitempty"`
+	After   *string           `json:"after,omitempty"`
+	Before  *string           `json:"before,omitempty"`
+	First   *int32            `json:"first,omitempty"`
+	Last    *int32            `json:"last,omitempty"`
 }
 
-func (client *Client) UpdateBook(params BookUpdateParams) *BookExec {
-	ret := client.Client.Update(
-		prisma.UpdateParams{
-			Data:  params.Data,
-			Where: params.Where,
-		},
-		[3]string{"BookUpdateInput!", "BookWhereUniqueInput!", "Book"},
-		"updateBook",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+func (client *Client) Notes(params *NotesParams) *NoteExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
 
-	return &BookExec{ret}
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"NoteWhereInput", "NoteOrderByInput", "Note"},
+		"notes",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExecArray{ret}
 }
 
-type BookUpdateManyParams struct {
-	Data  BookUpdateManyMutationInput `json:"data"`
-	Where *BookWhereInput             `json:"where,omitempty"`
+type NotesConnectionParams struct {
+	Where   *NoteWhereInput   `json:"where,omitempty"`
+	OrderBy *NoteOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32            `json:"skip,omitempty"`
+	After   *string           `json:"after,omitempty"`
+	Before  *string           `json:"before,omitempty"`
+	First   *int32            `json:"first,omitempty"`
+	Last    *int32            `json:"last,omitempty"`
 }
 
-func (client *Client) UpdateManyBooks(params BookUpdateManyParams) *BatchPayloadExec {
-	exec := client.Client.UpdateMany(
-		prisma.UpdateParams{
-			Data:  params.Data,
-			Where: params.Where,
-		},
-		[2]string{"BookUpdateManyMutationInput!", "BookWhereInput"},
-		"updateManyBooks")
-	return &BatchPayloadExec{exec}
+func (client *Client) NotesConnection(params *NotesConnectionParams) NoteConnectionExec {
+	panic("not implemented")
 }
 
-type BookUpsertParams struct {
-	Where  BookWhereUniqueInput `json:"where"`
-	Create BookCreateInput      `json:"create"`
-	Update BookUpdateInput      `json:"update"`
+func (client *Client) Card(params CardWhereUniqueInput) *CardExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"CardWhereUniqueInput!", "Card"},
+		"card",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExec{ret}
 }
 
-func (client *Client) UpsertBook(params BookUpsertParams) *BookExec {
-	uparams := &prisma.UpsertParams{
-		Where:  params.Where,
-		Create: params.Create,
-		Update: params.Update,
+type CardsParams struct {
+	Where   *CardWhereInput   `json:"where,omitempty"`
+	OrderBy *CardOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32            `json:"skip,omitempty"`
+	After   *string           `json:"after,omitempty"`
+	Before  *string           `json:"before,omitempty"`
+	First   *int32            `json:"first,omitempty"`
+	Last    *int32            `json:"last,omitempty"`
+}
+
+func (client *Client) Cards(params *CardsParams) *CardExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
 	}
-	ret := client.Client.Upsert(
-		uparams,
-		[4]string{"BookWhereUniqueInput!", "BookCreateInput!", "BookUpdateInput!", "Book"},
-		"upsertBook",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
 
-	return &BookExec{ret}
-}
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"CardWhereInput", "CardOrderByInput", "Card"},
+		"cards",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
 
-func (client *Client) DeleteBook(params BookWhereUniqueInput) *BookExec {
-	ret := client.Client.Delete(
-		params,
-		[2]string{"BookWhereUniqueInput!", "Book"},
-		"deleteBook",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+	return &CardExecArray{ret}
+}
 
-	return &BookExec{ret}
+type CardsConnectionParams struct {
+	Where   *CardWhereInput   `json:"where,omitempty"`
+	OrderBy *CardOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32            `json:"skip,omitempty"`
+	After   *string           `json:"after,omitempty"`
+	Before  *string           `json:"before,omitempty"`
+	First   *int32            `json:"first,omitempty"`
+	Last    *int32            `json:"last,omitempty"`
 }
 
-func (client *Client) DeleteManyBooks(params *BookWhereInput) *BatchPayloadExec {
-	exec := client.Client.DeleteMany(params, "BookWhereInput", "deleteManyBooks")
-	return &BatchPayloadExec{exec}
+func (client *Client) CardsConnection(params *CardsConnectionParams) CardConnectionExec {
+	panic("not implemented")
 }
 
-func (client *Client) CreateChapter(params ChapterCreateInput) *ChapterExec {
-	ret := client.Client.Create(
+func (client *Client) Review(params ReviewWhereUniqueInput) *ReviewExec {
+	ret := client.Client.GetOne(
+		nil,
 		params,
-		[2]string{"ChapterCreateInput!", "Chapter"},
-		"createChapter",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[2]string{"ReviewWhereUniqueInput!", "Review"},
+		"review",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
 
-	return &ChapterExec{ret}
+	return &ReviewExec{ret}
 }
 
-type ChapterUpdateParams struct {
-	Data  ChapterUpdateInput      `json:"data"`
-	Where ChapterWhereUniqueInput `json:"where"`
+type ReviewsParams struct {
+	Where   *ReviewWhereInput   `json:"where,omitempty"`
+	OrderBy *ReviewOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32              `json:"skip,omitempty"`
+	After   *string             `json:"after,omitempty"`
+	Before  *string             `json:"before,omitempty"`
+	First   *int32              `json:"first,omitempty"`
+	Last    *int32              `json:"last,omitempty"`
 }
 
-func (client *Client) UpdateChapter(params ChapterUpdateParams) *ChapterExec {
-	ret := client.Client.Update(
-		prisma.UpdateParams{
-			Data:  params.Data,
-			Where: params.Where,
-		},
-		[3]string{"ChapterUpdateInput!", "ChapterWhereUniqueInput!", "Chapter"},
-		"updateChapter",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+func (client *Client) Reviews(params *ReviewsParams) *ReviewExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
 
-	return &ChapterExec{ret}
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"ReviewWhereInput", "ReviewOrderByInput", "Review"},
+		"reviews",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewExecArray{ret}
 }
 
-type ChapterUpdateManyParams struct {
-	Data  ChapterUpdateManyMutationInput `json:"data"`
-	Where *ChapterWhereInput             `json:"where,omitempty"`
+type ReviewsConnectionParams struct {
+	Where   *ReviewWhereInput   `json:"where,omitempty"`
+	OrderBy *ReviewOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32              `json:"skip,omitempty"`
+	After   *string             `json:"after,omitempty"`
+	Before  *string             `json:"before,omitempty"`
+	First   *int32              `json:"first,omitempty"`
+	Last    *int32              `json:"last,omitempty"`
 }
 
-func (client *Client) UpdateManyChapters(params ChapterUpdateManyParams) *BatchPayloadExec {
-	exec := client.Client.UpdateMany(
-		prisma.UpdateParams{
-			Data:  params.Data,
-			Where: params.Where,
-		},
-		[2]string{"ChapterUpdateManyMutationInput!", "ChapterWhereInput"},
-		"updateManyChapters")
-	return &BatchPayloadExec{exec}
+func (client *Client) ReviewsConnection(params *ReviewsConnectionParams) ReviewConnectionExec {
+	panic("not implemented")
 }
 
-type ChapterUpsertParams struct {
-	Where  ChapterWhereUniqueInput `json:"where"`
-	Create ChapterCreateInput      `json:"create"`
-	Update ChapterUpdateInput      `json:"update"`
+func (client *Client) ReadingProgress(params ReadingProgressWhereUniqueInput) *ReadingProgressExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"ReadingProgressWhereUniqueInput!", "ReadingProgress"},
+		"readingProgress",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExec{ret}
 }
 
-func (client *Client) UpsertChapter(params ChapterUpsertParams) *ChapterExec {
-	uparams := &prisma.UpsertParams{
-		Where:  params.Where,
-		Create: params.Create,
-		Update: params.Update,
+type ReadingProgressesParams struct {
+	Where   *ReadingProgressWhereInput   `json:"where,omitempty"`
+	OrderBy *ReadingProgressOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                       `json:"skip,omitempty"`
+	After   *string                      `json:"after,omitempty"`
+	Before  *string                      `json:"before,omitempty"`
+	First   *int32                       `json:"first,omitempty"`
+	Last    *int32                       `json:"last,omitempty"`
+}
+
+func (client *Client) ReadingProgresses(params *ReadingProgressesParams) *ReadingProgressExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
 	}
-	ret := client.Client.Upsert(
-		uparams,
-		[4]string{"ChapterWhereUniqueInput!", "ChapterCreateInput!", "ChapterUpdateInput!", "Chapter"},
-		"upsertChapter",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
 
-	return &ChapterExec{ret}
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"ReadingProgressWhereInput", "ReadingProgressOrderByInput", "ReadingProgress"},
+		"readingProgresses",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExecArray{ret}
 }
 
-func (client *Client) DeleteChapter(params ChapterWhereUniqueInput) *ChapterExec {
-	ret := client.Client.Delete(
+type ReadingProgressesConnectionParams struct {
+	Where   *ReadingProgressWhereInput   `json:"where,omitempty"`
+	OrderBy *ReadingProgressOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                       `json:"skip,omitempty"`
+	After   *string                      `json:"after,omitempty"`
+	Before  *string                      `json:"before,omitempty"`
+	First   *int32                       `json:"first,omitempty"`
+	Last    *int32                       `json:"last,omitempty"`
+}
+
+func (client *Client) ReadingProgressesConnection(params *ReadingProgressesConnectionParams) ReadingProgressConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) ReadingSession(params ReadingSessionWhereUniqueInput) *ReadingSessionExec {
+	ret := client.Client.GetOne(
+		nil,
 		params,
-		[2]string{"ChapterWhereUniqueInput!", "Chapter"},
-		"deleteChapter",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[2]string{"ReadingSessionWhereUniqueInput!", "ReadingSession"},
+		"readingSession",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
 
-	return &ChapterExec{ret}
+	return &ReadingSessionExec{ret}
 }
 
-func (client *Client) DeleteManyChapters(params *ChapterWhereInput) *BatchPayloadExec {
-	exec := client.Client.DeleteMany(params, "ChapterWhereInput", "deleteManyChapters")
-	return &BatchPayloadExec{exec}
+type ReadingSessionsParams struct {
+	Where   *ReadingSessionWhereInput   `json:"where,omitempty"`
+	OrderBy *ReadingSessionOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                      `json:"skip,omitempty"`
+	After   *string                     `json:"after,omitempty"`
+	Before  *string                     `json:"before,omitempty"`
+	First   *int32                      `json:"first,omitempty"`
+	Last    *int32                      `json:"last,omitempty"`
+}
+
+func (client *Client) ReadingSessions(params *ReadingSessionsParams) *ReadingSessionExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"ReadingSessionWhereInput", "ReadingSessionOrderByInput", "ReadingSession"},
+		"readingSessions",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExecArray{ret}
+}
+
+type ReadingSessionsConnectionParams struct {
+	Where   *ReadingSessionWhereInput   `json:"where,omitempty"`
+	OrderBy *ReadingSessionOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                      `json:"skip,omitempty"`
+	After   *string                     `json:"after,omitempty"`
+	Before  *string                     `json:"before,omitempty"`
+	First   *int32                      `json:"first,omitempty"`
+	Last    *int32                      `json:"last,omitempty"`
+}
+
+func (client *Client) ReadingSessionsConnection(params *ReadingSessionsConnectionParams) ReadingSessionConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) Tag(params TagWhereUniqueInput) *TagExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"TagWhereUniqueInput!", "Tag"},
+		"tag",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExec{ret}
+}
+
+type TagsParams struct {
+	Where   *TagWhereInput   `json:"where,omitempty"`
+	OrderBy *TagOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32           `json:"skip,omitempty"`
+	After   *string          `json:"after,omitempty"`
+	Before  *string          `json:"before,omitempty"`
+	First   *int32           `json:"first,omitempty"`
+	Last    *int32           `json:"last,omitempty"`
+}
+
+func (client *Client) Tags(params *TagsParams) *TagExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"TagWhereInput", "TagOrderByInput", "Tag"},
+		"tags",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExecArray{ret}
+}
+
+type TagsConnectionParams struct {
+	Where   *TagWhereInput   `json:"where,omitempty"`
+	OrderBy *TagOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32           `json:"skip,omitempty"`
+	After   *string          `json:"after,omitempty"`
+	Before  *string          `json:"before,omitempty"`
+	First   *int32           `json:"first,omitempty"`
+	Last    *int32           `json:"last,omitempty"`
+}
+
+func (client *Client) TagsConnection(params *TagsConnectionParams) TagConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) Collection(params CollectionWhereUniqueInput) *CollectionExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"CollectionWhereUniqueInput!", "Collection"},
+		"collection",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExec{ret}
+}
+
+type CollectionsParams struct {
+	Where   *CollectionWhereInput   `json:"where,omitempty"`
+	OrderBy *CollectionOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                  `json:"skip,omitempty"`
+	After   *string                 `json:"after,omitempty"`
+	Before  *string                 `json:"before,omitempty"`
+	First   *int32                  `json:"first,omitempty"`
+	Last    *int32                  `json:"last,omitempty"`
+}
+
+func (client *Client) Collections(params *CollectionsParams) *CollectionExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"CollectionWhereInput", "CollectionOrderByInput", "Collection"},
+		"collections",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExecArray{ret}
+}
+
+type CollectionsConnectionParams struct {
+	Where   *CollectionWhereInput   `json:"where,omitempty"`
+	OrderBy *CollectionOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                  `json:"skip,omitempty"`
+	After   *string                 `json:"after,omitempty"`
+	Before  *string                 `json:"before,omitempty"`
+	First   *int32                  `json:"first,omitempty"`
+	Last    *int32                  `json:"last,omitempty"`
+}
+
+func (client *Client) CollectionsConnection(params *CollectionsConnectionParams) CollectionConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) Reminder(params ReminderWhereUniqueInput) *ReminderExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"ReminderWhereUniqueInput!", "Reminder"},
+		"reminder",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExec{ret}
+}
+
+type RemindersParams struct {
+	Where   *ReminderWhereInput   `json:"where,omitempty"`
+	OrderBy *ReminderOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                `json:"skip,omitempty"`
+	After   *string               `json:"after,omitempty"`
+	Before  *string               `json:"before,omitempty"`
+	First   *int32                `json:"first,omitempty"`
+	Last    *int32                `json:"last,omitempty"`
+}
+
+func (client *Client) Reminders(params *RemindersParams) *ReminderExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"ReminderWhereInput", "ReminderOrderByInput", "Reminder"},
+		"reminders",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExecArray{ret}
+}
+
+type RemindersConnectionParams struct {
+	Where   *ReminderWhereInput   `json:"where,omitempty"`
+	OrderBy *ReminderOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                `json:"skip,omitempty"`
+	After   *string               `json:"after,omitempty"`
+	Before  *string               `json:"before,omitempty"`
+	First   *int32                `json:"first,omitempty"`
+	Last    *int32                `json:"last,omitempty"`
+}
+
+func (client *Client) RemindersConnection(params *RemindersConnectionParams) ReminderConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) CalendarFeed(params CalendarFeedWhereUniqueInput) *CalendarFeedExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"CalendarFeedWhereUniqueInput!", "CalendarFeed"},
+		"calendarFeed",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
+
+	return &CalendarFeedExec{ret}
+}
+
+type CalendarFeedsParams struct {
+	Where   *CalendarFeedWhereInput   `json:"where,omitempty"`
+	OrderBy *CalendarFeedOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                    `json:"skip,omitempty"`
+	After   *string                   `json:"after,omitempty"`
+	Before  *string                   `json:"before,omitempty"`
+	First   *int32                    `json:"first,omitempty"`
+	Last    *int32                    `json:"last,omitempty"`
+}
+
+func (client *Client) CalendarFeeds(params *CalendarFeedsParams) *CalendarFeedExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"CalendarFeedWhereInput", "CalendarFeedOrderByInput", "CalendarFeed"},
+		"calendarFeeds",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
+
+	return &CalendarFeedExecArray{ret}
+}
+
+type CalendarFeedsConnectionParams struct {
+	Where   *CalendarFeedWhereInput   `json:"where,omitempty"`
+	OrderBy *CalendarFeedOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                    `json:"skip,omitempty"`
+	After   *string                   `json:"after,omitempty"`
+	Before  *string                   `json:"before,omitempty"`
+	First   *int32                    `json:"first,omitempty"`
+	Last    *int32                    `json:"last,omitempty"`
+}
+
+func (client *Client) CalendarFeedsConnection(params *CalendarFeedsConnectionParams) CalendarFeedConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) ReminderFiring(params ReminderFiringWhereUniqueInput) *ReminderFiringExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"ReminderFiringWhereUniqueInput!", "ReminderFiring"},
+		"reminderFiring",
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
+
+	return &ReminderFiringExec{ret}
+}
+
+type ReminderFiringsParams struct {
+	Where   *ReminderFiringWhereInput   `json:"where,omitempty"`
+	OrderBy *ReminderFiringOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                      `json:"skip,omitempty"`
+	After   *string                     `json:"after,omitempty"`
+	Before  *string                     `json:"before,omitempty"`
+	First   *int32                      `json:"first,omitempty"`
+	Last    *int32                      `json:"last,omitempty"`
+}
+
+func (client *Client) ReminderFirings(params *ReminderFiringsParams) *ReminderFiringExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"ReminderFiringWhereInput", "ReminderFiringOrderByInput", "ReminderFiring"},
+		"reminderFirings",
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
+
+	return &ReminderFiringExecArray{ret}
+}
+
+type ReminderFiringsConnectionParams struct {
+	Where   *ReminderFiringWhereInput   `json:"where,omitempty"`
+	OrderBy *ReminderFiringOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                      `json:"skip,omitempty"`
+	After   *string                     `json:"after,omitempty"`
+	Before  *string                     `json:"before,omitempty"`
+	First   *int32                      `json:"first,omitempty"`
+	Last    *int32                      `json:"last,omitempty"`
+}
+
+func (client *Client) ReminderFiringsConnection(params *ReminderFiringsConnectionParams) ReminderFiringConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) Webhook(params WebhookWhereUniqueInput) *WebhookExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"WebhookWhereUniqueInput!", "Webhook"},
+		"webhook",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExec{ret}
+}
+
+type WebhooksParams struct {
+	Where   *WebhookWhereInput   `json:"where,omitempty"`
+	OrderBy *WebhookOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32               `json:"skip,omitempty"`
+	After   *string              `json:"after,omitempty"`
+	Before  *string              `json:"before,omitempty"`
+	First   *int32               `json:"first,omitempty"`
+	Last    *int32               `json:"last,omitempty"`
+}
+
+func (client *Client) Webhooks(params *WebhooksParams) *WebhookExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"WebhookWhereInput", "WebhookOrderByInput", "Webhook"},
+		"webhooks",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExecArray{ret}
+}
+
+type WebhooksConnectionParams struct {
+	Where   *WebhookWhereInput   `json:"where,omitempty"`
+	OrderBy *WebhookOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32               `json:"skip,omitempty"`
+	After   *string              `json:"after,omitempty"`
+	Before  *string              `json:"before,omitempty"`
+	First   *int32               `json:"first,omitempty"`
+	Last    *int32               `json:"last,omitempty"`
+}
+
+func (client *Client) WebhooksConnection(params *WebhooksConnectionParams) WebhookConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) WebhookDelivery(params WebhookDeliveryWhereUniqueInput) *WebhookDeliveryExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"WebhookDeliveryWhereUniqueInput!", "WebhookDelivery"},
+		"webhookDelivery",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExec{ret}
+}
+
+type WebhookDeliveriesParams struct {
+	Where   *WebhookDeliveryWhereInput   `json:"where,omitempty"`
+	OrderBy *WebhookDeliveryOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                       `json:"skip,omitempty"`
+	After   *string                      `json:"after,omitempty"`
+	Before  *string                      `json:"before,omitempty"`
+	First   *int32                       `json:"first,omitempty"`
+	Last    *int32                       `json:"last,omitempty"`
+}
+
+func (client *Client) WebhookDeliveries(params *WebhookDeliveriesParams) *WebhookDeliveryExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"WebhookDeliveryWhereInput", "WebhookDeliveryOrderByInput", "WebhookDelivery"},
+		"webhookDeliveries",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExecArray{ret}
+}
+
+type WebhookDeliveriesConnectionParams struct {
+	Where   *WebhookDeliveryWhereInput   `json:"where,omitempty"`
+	OrderBy *WebhookDeliveryOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                       `json:"skip,omitempty"`
+	After   *string                      `json:"after,omitempty"`
+	Before  *string                      `json:"before,omitempty"`
+	First   *int32                       `json:"first,omitempty"`
+	Last    *int32                       `json:"last,omitempty"`
+}
+
+func (client *Client) WebhookDeliveriesConnection(params *WebhookDeliveriesConnectionParams) WebhookDeliveryConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) OutboxEvent(params OutboxEventWhereUniqueInput) *OutboxEventExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"OutboxEventWhereUniqueInput!", "OutboxEvent"},
+		"outboxEvent",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExec{ret}
+}
+
+type OutboxEventsParams struct {
+	Where   *OutboxEventWhereInput   `json:"where,omitempty"`
+	OrderBy *OutboxEventOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                   `json:"skip,omitempty"`
+	After   *string                  `json:"after,omitempty"`
+	Before  *string                  `json:"before,omitempty"`
+	First   *int32                   `json:"first,omitempty"`
+	Last    *int32                   `json:"last,omitempty"`
+}
+
+func (client *Client) OutboxEvents(params *OutboxEventsParams) *OutboxEventExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"OutboxEventWhereInput", "OutboxEventOrderByInput", "OutboxEvent"},
+		"outboxEvents",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExecArray{ret}
+}
+
+type OutboxEventsConnectionParams struct {
+	Where   *OutboxEventWhereInput   `json:"where,omitempty"`
+	OrderBy *OutboxEventOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                   `json:"skip,omitempty"`
+	After   *string                  `json:"after,omitempty"`
+	Before  *string                  `json:"before,omitempty"`
+	First   *int32                   `json:"first,omitempty"`
+	Last    *int32                   `json:"last,omitempty"`
+}
+
+func (client *Client) OutboxEventsConnection(params *OutboxEventsConnectionParams) OutboxEventConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) AuditEntry(params AuditEntryWhereUniqueInput) *AuditEntryExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"AuditEntryWhereUniqueInput!", "AuditEntry"},
+		"auditEntry",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryExec{ret}
+}
+
+type AuditEntriesParams struct {
+	Where   *AuditEntryWhereInput   `json:"where,omitempty"`
+	OrderBy *AuditEntryOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                  `json:"skip,omitempty"`
+	After   *string                 `json:"after,omitempty"`
+	Before  *string                 `json:"before,omitempty"`
+	First   *int32                  `json:"first,omitempty"`
+	Last    *int32                  `json:"last,omitempty"`
+}
+
+func (client *Client) AuditEntries(params *AuditEntriesParams) *AuditEntryExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"AuditEntryWhereInput", "AuditEntryOrderByInput", "AuditEntry"},
+		"auditEntries",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryExecArray{ret}
+}
+
+type AuditEntriesConnectionParams struct {
+	Where   *AuditEntryWhereInput   `json:"where,omitempty"`
+	OrderBy *AuditEntryOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                  `json:"skip,omitempty"`
+	After   *string                 `json:"after,omitempty"`
+	Before  *string                 `json:"before,omitempty"`
+	First   *int32                  `json:"first,omitempty"`
+	Last    *int32                  `json:"last,omitempty"`
+}
+
+func (client *Client) AuditEntriesConnection(params *AuditEntriesConnectionParams) AuditEntryConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) Revision(params RevisionWhereUniqueInput) *RevisionExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"RevisionWhereUniqueInput!", "Revision"},
+		"revision",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExec{ret}
+}
+
+type RevisionsParams struct {
+	Where   *RevisionWhereInput   `json:"where,omitempty"`
+	OrderBy *RevisionOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                `json:"skip,omitempty"`
+	After   *string               `json:"after,omitempty"`
+	Before  *string               `json:"before,omitempty"`
+	First   *int32                `json:"first,omitempty"`
+	Last    *int32                `json:"last,omitempty"`
+}
+
+func (client *Client) Revisions(params *RevisionsParams) *RevisionExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"RevisionWhereInput", "RevisionOrderByInput", "Revision"},
+		"revisions",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExecArray{ret}
+}
+
+type RevisionsConnectionParams struct {
+	Where   *RevisionWhereInput   `json:"where,omitempty"`
+	OrderBy *RevisionOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                `json:"skip,omitempty"`
+	After   *string               `json:"after,omitempty"`
+	Before  *string               `json:"before,omitempty"`
+	First   *int32                `json:"first,omitempty"`
+	Last    *int32                `json:"last,omitempty"`
+}
+
+func (client *Client) RevisionsConnection(params *RevisionsConnectionParams) RevisionConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) IdempotencyKey(params IdempotencyKeyWhereUniqueInput) *IdempotencyKeyExec {
+	ret := client.Client.GetOne(
+		nil,
+		params,
+		[2]string{"IdempotencyKeyWhereUniqueInput!", "IdempotencyKey"},
+		"idempotencyKey",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyExec{ret}
+}
+
+type IdempotencyKeysParams struct {
+	Where   *IdempotencyKeyWhereInput   `json:"where,omitempty"`
+	OrderBy *IdempotencyKeyOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                      `json:"skip,omitempty"`
+	After   *string                     `json:"after,omitempty"`
+	Before  *string                     `json:"before,omitempty"`
+	First   *int32                      `json:"first,omitempty"`
+	Last    *int32                      `json:"last,omitempty"`
+}
+
+func (client *Client) IdempotencyKeys(params *IdempotencyKeysParams) *IdempotencyKeyExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := client.Client.GetMany(
+		nil,
+		wparams,
+		[3]string{"IdempotencyKeyWhereInput", "IdempotencyKeyOrderByInput", "IdempotencyKey"},
+		"idempotencyKeys",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyExecArray{ret}
+}
+
+type IdempotencyKeysConnectionParams struct {
+	Where   *IdempotencyKeyWhereInput   `json:"where,omitempty"`
+	OrderBy *IdempotencyKeyOrderByInput `json:"orderBy,omitempty"`
+	Skip    *int32                      `json:"skip,omitempty"`
+	After   *string                     `json:"after,omitempty"`
+	Before  *string                     `json:"before,omitempty"`
+	First   *int32                      `json:"first,omitempty"`
+	Last    *int32                      `json:"last,omitempty"`
+}
+
+func (client *Client) IdempotencyKeysConnection(params *IdempotencyKeysConnectionParams) IdempotencyKeyConnectionExec {
+	panic("not implemented")
+}
+
+func (client *Client) CreateBook(params BookCreateInput) *BookExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"BookCreateInput!", "Book"},
+		"createBook",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+type BookUpdateParams struct {
+	Data  BookUpdateInput      `json:"data"`
+	Where BookWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateBook(params BookUpdateParams) *BookExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"BookUpdateInput!", "BookWhereUniqueInput!", "Book"},
+		"updateBook",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+type BookUpdateManyParams struct {
+	Data  BookUpdateManyMutationInput `json:"data"`
+	Where *BookWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyBooks(params BookUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"BookUpdateManyMutationInput!", "BookWhereInput"},
+		"updateManyBooks")
+	return &BatchPayloadExec{exec}
+}
+
+type BookUpsertParams struct {
+	Where  BookWhereUniqueInput `json:"where"`
+	Create BookCreateInput      `json:"create"`
+	Update BookUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertBook(params BookUpsertParams) *BookExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"BookWhereUniqueInput!", "BookCreateInput!", "BookUpdateInput!", "Book"},
+		"upsertBook",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+func (client *Client) DeleteBook(params BookWhereUniqueInput) *BookExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"BookWhereUniqueInput!", "Book"},
+		"deleteBook",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+func (client *Client) DeleteManyBooks(params *BookWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "BookWhereInput", "deleteManyBooks")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateAuthor(params AuthorCreateInput) *AuthorExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"AuthorCreateInput!", "Author"},
+		"createAuthor",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExec{ret}
+}
+
+type AuthorUpdateParams struct {
+	Data  AuthorUpdateInput      `json:"data"`
+	Where AuthorWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateAuthor(params AuthorUpdateParams) *AuthorExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"AuthorUpdateInput!", "AuthorWhereUniqueInput!", "Author"},
+		"updateAuthor",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExec{ret}
+}
+
+type AuthorUpdateManyParams struct {
+	Data  AuthorUpdateManyMutationInput `json:"data"`
+	Where *AuthorWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyAuthors(params AuthorUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"AuthorUpdateManyMutationInput!", "AuthorWhereInput"},
+		"updateManyAuthors")
+	return &BatchPayloadExec{exec}
+}
+
+type AuthorUpsertParams struct {
+	Where  AuthorWhereUniqueInput `json:"where"`
+	Create AuthorCreateInput      `json:"create"`
+	Update AuthorUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertAuthor(params AuthorUpsertParams) *AuthorExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"AuthorWhereUniqueInput!", "AuthorCreateInput!", "AuthorUpdateInput!", "Author"},
+		"upsertAuthor",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExec{ret}
+}
+
+func (client *Client) DeleteAuthor(params AuthorWhereUniqueInput) *AuthorExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"AuthorWhereUniqueInput!", "Author"},
+		"deleteAuthor",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExec{ret}
+}
+
+func (client *Client) DeleteManyAuthors(params *AuthorWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "AuthorWhereInput", "deleteManyAuthors")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateChapter(params ChapterCreateInput) *ChapterExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"ChapterCreateInput!", "Chapter"},
+		"createChapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+type ChapterUpdateParams struct {
+	Data  ChapterUpdateInput      `json:"data"`
+	Where ChapterWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateChapter(params ChapterUpdateParams) *ChapterExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"ChapterUpdateInput!", "ChapterWhereUniqueInput!", "Chapter"},
+		"updateChapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+type ChapterUpdateManyParams struct {
+	Data  ChapterUpdateManyMutationInput `json:"data"`
+	Where *ChapterWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyChapters(params ChapterUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"ChapterUpdateManyMutationInput!", "ChapterWhereInput"},
+		"updateManyChapters")
+	return &BatchPayloadExec{exec}
+}
+
+type ChapterUpsertParams struct {
+	Where  ChapterWhereUniqueInput `json:"where"`
+	Create ChapterCreateInput      `json:"create"`
+	Update ChapterUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertChapter(params ChapterUpsertParams) *ChapterExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"ChapterWhereUniqueInput!", "ChapterCreateInput!", "ChapterUpdateInput!", "Chapter"},
+		"upsertChapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (client *Client) DeleteChapter(params ChapterWhereUniqueInput) *ChapterExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"ChapterWhereUniqueInput!", "Chapter"},
+		"deleteChapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (client *Client) DeleteManyChapters(params *ChapterWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "ChapterWhereInput", "deleteManyChapters")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateNote(params NoteCreateInput) *NoteExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"NoteCreateInput!", "Note"},
+		"createNote",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExec{ret}
+}
+
+type NoteUpdateParams struct {
+	Data  NoteUpdateInput      `json:"data"`
+	Where NoteWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateNote(params NoteUpdateParams) *NoteExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"NoteUpdateInput!", "NoteWhereUniqueInput!", "Note"},
+		"updateNote",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExec{ret}
+}
+
+type NoteUpdateManyParams struct {
+	Data  NoteUpdateManyMutationInput `json:"data"`
+	Where *NoteWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyNotes(params NoteUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"NoteUpdateManyMutationInput!", "NoteWhereInput"},
+		"updateManyNotes")
+	return &BatchPayloadExec{exec}
+}
+
+type NoteUpsertParams struct {
+	Where  NoteWhereUniqueInput `json:"where"`
+	Create NoteCreateInput      `json:"create"`
+	Update NoteUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertNote(params NoteUpsertParams) *NoteExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"NoteWhereUniqueInput!", "NoteCreateInput!", "NoteUpdateInput!", "Note"},
+		"upsertNote",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExec{ret}
+}
+
+func (client *Client) DeleteNote(params NoteWhereUniqueInput) *NoteExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"NoteWhereUniqueInput!", "Note"},
+		"deleteNote",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExec{ret}
+}
+
+func (client *Client) DeleteManyNotes(params *NoteWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "NoteWhereInput", "deleteManyNotes")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateCard(params CardCreateInput) *CardExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"CardCreateInput!", "Card"},
+		"createCard",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExec{ret}
+}
+
+type CardUpdateParams struct {
+	Data  CardUpdateInput      `json:"data"`
+	Where CardWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateCard(params CardUpdateParams) *CardExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"CardUpdateInput!", "CardWhereUniqueInput!", "Card"},
+		"updateCard",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExec{ret}
+}
+
+type CardUpdateManyParams struct {
+	Data  CardUpdateManyMutationInput `json:"data"`
+	Where *CardWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyCards(params CardUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"CardUpdateManyMutationInput!", "CardWhereInput"},
+		"updateManyCards")
+	return &BatchPayloadExec{exec}
+}
+
+type CardUpsertParams struct {
+	Where  CardWhereUniqueInput `json:"where"`
+	Create CardCreateInput      `json:"create"`
+	Update CardUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertCard(params CardUpsertParams) *CardExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"CardWhereUniqueInput!", "CardCreateInput!", "CardUpdateInput!", "Card"},
+		"upsertCard",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExec{ret}
+}
+
+func (client *Client) DeleteCard(params CardWhereUniqueInput) *CardExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"CardWhereUniqueInput!", "Card"},
+		"deleteCard",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExec{ret}
+}
+
+func (client *Client) DeleteManyCards(params *CardWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "CardWhereInput", "deleteManyCards")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateReview(params ReviewCreateInput) *ReviewExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"ReviewCreateInput!", "Review"},
+		"createReview",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewExec{ret}
+}
+
+type ReviewUpdateParams struct {
+	Data  ReviewUpdateInput      `json:"data"`
+	Where ReviewWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateReview(params ReviewUpdateParams) *ReviewExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"ReviewUpdateInput!", "ReviewWhereUniqueInput!", "Review"},
+		"updateReview",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewExec{ret}
+}
+
+type ReviewUpdateManyParams struct {
+	Data  ReviewUpdateManyMutationInput `json:"data"`
+	Where *ReviewWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyReviews(params ReviewUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"ReviewUpdateManyMutationInput!", "ReviewWhereInput"},
+		"updateManyReviews")
+	return &BatchPayloadExec{exec}
+}
+
+type ReviewUpsertParams struct {
+	Where  ReviewWhereUniqueInput `json:"where"`
+	Create ReviewCreateInput      `json:"create"`
+	Update ReviewUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertReview(params ReviewUpsertParams) *ReviewExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"ReviewWhereUniqueInput!", "ReviewCreateInput!", "ReviewUpdateInput!", "Review"},
+		"upsertReview",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewExec{ret}
+}
+
+func (client *Client) DeleteReview(params ReviewWhereUniqueInput) *ReviewExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"ReviewWhereUniqueInput!", "Review"},
+		"deleteReview",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewExec{ret}
+}
+
+func (client *Client) DeleteManyReviews(params *ReviewWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "ReviewWhereInput", "deleteManyReviews")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateReadingProgress(params ReadingProgressCreateInput) *ReadingProgressExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"ReadingProgressCreateInput!", "ReadingProgress"},
+		"createReadingProgress",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExec{ret}
+}
+
+type ReadingProgressUpdateParams struct {
+	Data  ReadingProgressUpdateInput      `json:"data"`
+	Where ReadingProgressWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateReadingProgress(params ReadingProgressUpdateParams) *ReadingProgressExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"ReadingProgressUpdateInput!", "ReadingProgressWhereUniqueInput!", "ReadingProgress"},
+		"updateReadingProgress",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExec{ret}
+}
+
+type ReadingProgressUpdateManyParams struct {
+	Data  ReadingProgressUpdateManyMutationInput `json:"data"`
+	Where *ReadingProgressWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyReadingProgresses(params ReadingProgressUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"ReadingProgressUpdateManyMutationInput!", "ReadingProgressWhereInput"},
+		"updateManyReadingProgresses")
+	return &BatchPayloadExec{exec}
+}
+
+type ReadingProgressUpsertParams struct {
+	Where  ReadingProgressWhereUniqueInput `json:"where"`
+	Create ReadingProgressCreateInput      `json:"create"`
+	Update ReadingProgressUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertReadingProgress(params ReadingProgressUpsertParams) *ReadingProgressExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"ReadingProgressWhereUniqueInput!", "ReadingProgressCreateInput!", "ReadingProgressUpdateInput!", "ReadingProgress"},
+		"upsertReadingProgress",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExec{ret}
+}
+
+func (client *Client) DeleteReadingProgress(params ReadingProgressWhereUniqueInput) *ReadingProgressExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"ReadingProgressWhereUniqueInput!", "ReadingProgress"},
+		"deleteReadingProgress",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExec{ret}
+}
+
+func (client *Client) DeleteManyReadingProgresses(params *ReadingProgressWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "ReadingProgressWhereInput", "deleteManyReadingProgresses")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateReadingSession(params ReadingSessionCreateInput) *ReadingSessionExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"ReadingSessionCreateInput!", "ReadingSession"},
+		"createReadingSession",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExec{ret}
+}
+
+type ReadingSessionUpdateParams struct {
+	Data  ReadingSessionUpdateInput      `json:"data"`
+	Where ReadingSessionWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateReadingSession(params ReadingSessionUpdateParams) *ReadingSessionExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"ReadingSessionUpdateInput!", "ReadingSessionWhereUniqueInput!", "ReadingSession"},
+		"updateReadingSession",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExec{ret}
+}
+
+type ReadingSessionUpdateManyParams struct {
+	Data  ReadingSessionUpdateManyMutationInput `json:"data"`
+	Where *ReadingSessionWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyReadingSessions(params ReadingSessionUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"ReadingSessionUpdateManyMutationInput!", "ReadingSessionWhereInput"},
+		"updateManyReadingSessions")
+	return &BatchPayloadExec{exec}
+}
+
+type ReadingSessionUpsertParams struct {
+	Where  ReadingSessionWhereUniqueInput `json:"where"`
+	Create ReadingSessionCreateInput      `json:"create"`
+	Update ReadingSessionUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertReadingSession(params ReadingSessionUpsertParams) *ReadingSessionExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"ReadingSessionWhereUniqueInput!", "ReadingSessionCreateInput!", "ReadingSessionUpdateInput!", "ReadingSession"},
+		"upsertReadingSession",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExec{ret}
+}
+
+func (client *Client) DeleteReadingSession(params ReadingSessionWhereUniqueInput) *ReadingSessionExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"ReadingSessionWhereUniqueInput!", "ReadingSession"},
+		"deleteReadingSession",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExec{ret}
+}
+
+func (client *Client) DeleteManyReadingSessions(params *ReadingSessionWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "ReadingSessionWhereInput", "deleteManyReadingSessions")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateTag(params TagCreateInput) *TagExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"TagCreateInput!", "Tag"},
+		"createTag",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExec{ret}
+}
+
+type TagUpdateParams struct {
+	Data  TagUpdateInput      `json:"data"`
+	Where TagWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateTag(params TagUpdateParams) *TagExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"TagUpdateInput!", "TagWhereUniqueInput!", "Tag"},
+		"updateTag",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExec{ret}
+}
+
+type TagUpdateManyParams struct {
+	Data  TagUpdateManyMutationInput `json:"data"`
+	Where *TagWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyTags(params TagUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"TagUpdateManyMutationInput!", "TagWhereInput"},
+		"updateManyTags")
+	return &BatchPayloadExec{exec}
+}
+
+type TagUpsertParams struct {
+	Where  TagWhereUniqueInput `json:"where"`
+	Create TagCreateInput      `json:"create"`
+	Update TagUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertTag(params TagUpsertParams) *TagExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"TagWhereUniqueInput!", "TagCreateInput!", "TagUpdateInput!", "Tag"},
+		"upsertTag",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExec{ret}
+}
+
+func (client *Client) DeleteTag(params TagWhereUniqueInput) *TagExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"TagWhereUniqueInput!", "Tag"},
+		"deleteTag",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExec{ret}
+}
+
+func (client *Client) DeleteManyTags(params *TagWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "TagWhereInput", "deleteManyTags")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateCollection(params CollectionCreateInput) *CollectionExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"CollectionCreateInput!", "Collection"},
+		"createCollection",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExec{ret}
+}
+
+type CollectionUpdateParams struct {
+	Data  CollectionUpdateInput      `json:"data"`
+	Where CollectionWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateCollection(params CollectionUpdateParams) *CollectionExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"CollectionUpdateInput!", "CollectionWhereUniqueInput!", "Collection"},
+		"updateCollection",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExec{ret}
+}
+
+type CollectionUpdateManyParams struct {
+	Data  CollectionUpdateManyMutationInput `json:"data"`
+	Where *CollectionWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyCollections(params CollectionUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"CollectionUpdateManyMutationInput!", "CollectionWhereInput"},
+		"updateManyCollections")
+	return &BatchPayloadExec{exec}
+}
+
+type CollectionUpsertParams struct {
+	Where  CollectionWhereUniqueInput `json:"where"`
+	Create CollectionCreateInput      `json:"create"`
+	Update CollectionUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertCollection(params CollectionUpsertParams) *CollectionExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"CollectionWhereUniqueInput!", "CollectionCreateInput!", "CollectionUpdateInput!", "Collection"},
+		"upsertCollection",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExec{ret}
+}
+
+func (client *Client) DeleteCollection(params CollectionWhereUniqueInput) *CollectionExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"CollectionWhereUniqueInput!", "Collection"},
+		"deleteCollection",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExec{ret}
+}
+
+func (client *Client) DeleteManyCollections(params *CollectionWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "CollectionWhereInput", "deleteManyCollections")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateReminder(params ReminderCreateInput) *ReminderExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"ReminderCreateInput!", "Reminder"},
+		"createReminder",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExec{ret}
+}
+
+type ReminderUpdateParams struct {
+	Data  ReminderUpdateInput      `json:"data"`
+	Where ReminderWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateReminder(params ReminderUpdateParams) *ReminderExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"ReminderUpdateInput!", "ReminderWhereUniqueInput!", "Reminder"},
+		"updateReminder",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExec{ret}
+}
+
+type ReminderUpdateManyParams struct {
+	Data  ReminderUpdateManyMutationInput `json:"data"`
+	Where *ReminderWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyReminders(params ReminderUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"ReminderUpdateManyMutationInput!", "ReminderWhereInput"},
+		"updateManyReminders")
+	return &BatchPayloadExec{exec}
+}
+
+type ReminderUpsertParams struct {
+	Where  ReminderWhereUniqueInput `json:"where"`
+	Create ReminderCreateInput      `json:"create"`
+	Update ReminderUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertReminder(params ReminderUpsertParams) *ReminderExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"ReminderWhereUniqueInput!", "ReminderCreateInput!", "ReminderUpdateInput!", "Reminder"},
+		"upsertReminder",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExec{ret}
+}
+
+func (client *Client) DeleteReminder(params ReminderWhereUniqueInput) *ReminderExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"ReminderWhereUniqueInput!", "Reminder"},
+		"deleteReminder",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExec{ret}
+}
+
+func (client *Client) DeleteManyReminders(params *ReminderWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "ReminderWhereInput", "deleteManyReminders")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateCalendarFeed(params CalendarFeedCreateInput) *CalendarFeedExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"CalendarFeedCreateInput!", "CalendarFeed"},
+		"createCalendarFeed",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
+
+	return &CalendarFeedExec{ret}
+}
+
+type CalendarFeedUpdateParams struct {
+	Data  CalendarFeedUpdateInput      `json:"data"`
+	Where CalendarFeedWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateCalendarFeed(params CalendarFeedUpdateParams) *CalendarFeedExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"CalendarFeedUpdateInput!", "CalendarFeedWhereUniqueInput!", "CalendarFeed"},
+		"updateCalendarFeed",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
+
+	return &CalendarFeedExec{ret}
+}
+
+type CalendarFeedUpdateManyParams struct {
+	Data  CalendarFeedUpdateManyMutationInput `json:"data"`
+	Where *CalendarFeedWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyCalendarFeeds(params CalendarFeedUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"CalendarFeedUpdateManyMutationInput!", "CalendarFeedWhereInput"},
+		"updateManyCalendarFeeds")
+	return &BatchPayloadExec{exec}
+}
+
+type CalendarFeedUpsertParams struct {
+	Where  CalendarFeedWhereUniqueInput `json:"where"`
+	Create CalendarFeedCreateInput      `json:"create"`
+	Update CalendarFeedUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertCalendarFeed(params CalendarFeedUpsertParams) *CalendarFeedExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"CalendarFeedWhereUniqueInput!", "CalendarFeedCreateInput!", "CalendarFeedUpdateInput!", "CalendarFeed"},
+		"upsertCalendarFeed",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
+
+	return &CalendarFeedExec{ret}
+}
+
+func (client *Client) DeleteCalendarFeed(params CalendarFeedWhereUniqueInput) *CalendarFeedExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"CalendarFeedWhereUniqueInput!", "CalendarFeed"},
+		"deleteCalendarFeed",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
+
+	return &CalendarFeedExec{ret}
+}
+
+func (client *Client) DeleteManyCalendarFeeds(params *CalendarFeedWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "CalendarFeedWhereInput", "deleteManyCalendarFeeds")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateReminderFiring(params ReminderFiringCreateInput) *ReminderFiringExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"ReminderFiringCreateInput!", "ReminderFiring"},
+		"createReminderFiring",
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
+
+	return &ReminderFiringExec{ret}
+}
+
+type ReminderFiringUpdateParams struct {
+	Data  ReminderFiringUpdateInput      `json:"data"`
+	Where ReminderFiringWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateReminderFiring(params ReminderFiringUpdateParams) *ReminderFiringExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"ReminderFiringUpdateInput!", "ReminderFiringWhereUniqueInput!", "ReminderFiring"},
+		"updateReminderFiring",
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
+
+	return &ReminderFiringExec{ret}
+}
+
+type ReminderFiringUpdateManyParams struct {
+	Data  ReminderFiringUpdateManyMutationInput `json:"data"`
+	Where *ReminderFiringWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyReminderFirings(params ReminderFiringUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"ReminderFiringUpdateManyMutationInput!", "ReminderFiringWhereInput"},
+		"updateManyReminderFirings")
+	return &BatchPayloadExec{exec}
+}
+
+type ReminderFiringUpsertParams struct {
+	Where  ReminderFiringWhereUniqueInput `json:"where"`
+	Create ReminderFiringCreateInput      `json:"create"`
+	Update ReminderFiringUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertReminderFiring(params ReminderFiringUpsertParams) *ReminderFiringExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"ReminderFiringWhereUniqueInput!", "ReminderFiringCreateInput!", "ReminderFiringUpdateInput!", "ReminderFiring"},
+		"upsertReminderFiring",
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
+
+	return &ReminderFiringExec{ret}
+}
+
+func (client *Client) DeleteReminderFiring(params ReminderFiringWhereUniqueInput) *ReminderFiringExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"ReminderFiringWhereUniqueInput!", "ReminderFiring"},
+		"deleteReminderFiring",
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
+
+	return &ReminderFiringExec{ret}
+}
+
+func (client *Client) DeleteManyReminderFirings(params *ReminderFiringWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "ReminderFiringWhereInput", "deleteManyReminderFirings")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateWebhook(params WebhookCreateInput) *WebhookExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"WebhookCreateInput!", "Webhook"},
+		"createWebhook",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExec{ret}
+}
+
+type WebhookUpdateParams struct {
+	Data  WebhookUpdateInput      `json:"data"`
+	Where WebhookWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateWebhook(params WebhookUpdateParams) *WebhookExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"WebhookUpdateInput!", "WebhookWhereUniqueInput!", "Webhook"},
+		"updateWebhook",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExec{ret}
+}
+
+type WebhookUpdateManyParams struct {
+	Data  WebhookUpdateManyMutationInput `json:"data"`
+	Where *WebhookWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyWebhooks(params WebhookUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"WebhookUpdateManyMutationInput!", "WebhookWhereInput"},
+		"updateManyWebhooks")
+	return &BatchPayloadExec{exec}
+}
+
+type WebhookUpsertParams struct {
+	Where  WebhookWhereUniqueInput `json:"where"`
+	Create WebhookCreateInput      `json:"create"`
+	Update WebhookUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertWebhook(params WebhookUpsertParams) *WebhookExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"WebhookWhereUniqueInput!", "WebhookCreateInput!", "WebhookUpdateInput!", "Webhook"},
+		"upsertWebhook",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExec{ret}
+}
+
+func (client *Client) DeleteWebhook(params WebhookWhereUniqueInput) *WebhookExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"WebhookWhereUniqueInput!", "Webhook"},
+		"deleteWebhook",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExec{ret}
+}
+
+func (client *Client) DeleteManyWebhooks(params *WebhookWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "WebhookWhereInput", "deleteManyWebhooks")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateWebhookDelivery(params WebhookDeliveryCreateInput) *WebhookDeliveryExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"WebhookDeliveryCreateInput!", "WebhookDelivery"},
+		"createWebhookDelivery",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExec{ret}
+}
+
+type WebhookDeliveryUpdateParams struct {
+	Data  WebhookDeliveryUpdateInput      `json:"data"`
+	Where WebhookDeliveryWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateWebhookDelivery(params WebhookDeliveryUpdateParams) *WebhookDeliveryExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"WebhookDeliveryUpdateInput!", "WebhookDeliveryWhereUniqueInput!", "WebhookDelivery"},
+		"updateWebhookDelivery",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExec{ret}
+}
+
+type WebhookDeliveryUpdateManyParams struct {
+	Data  WebhookDeliveryUpdateManyMutationInput `json:"data"`
+	Where *WebhookDeliveryWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyWebhookDeliveries(params WebhookDeliveryUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"WebhookDeliveryUpdateManyMutationInput!", "WebhookDeliveryWhereInput"},
+		"updateManyWebhookDeliveries")
+	return &BatchPayloadExec{exec}
+}
+
+type WebhookDeliveryUpsertParams struct {
+	Where  WebhookDeliveryWhereUniqueInput `json:"where"`
+	Create WebhookDeliveryCreateInput      `json:"create"`
+	Update WebhookDeliveryUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertWebhookDelivery(params WebhookDeliveryUpsertParams) *WebhookDeliveryExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"WebhookDeliveryWhereUniqueInput!", "WebhookDeliveryCreateInput!", "WebhookDeliveryUpdateInput!", "WebhookDelivery"},
+		"upsertWebhookDelivery",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExec{ret}
+}
+
+func (client *Client) DeleteWebhookDelivery(params WebhookDeliveryWhereUniqueInput) *WebhookDeliveryExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"WebhookDeliveryWhereUniqueInput!", "WebhookDelivery"},
+		"deleteWebhookDelivery",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExec{ret}
+}
+
+func (client *Client) DeleteManyWebhookDeliveries(params *WebhookDeliveryWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "WebhookDeliveryWhereInput", "deleteManyWebhookDeliveries")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateOutboxEvent(params OutboxEventCreateInput) *OutboxEventExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"OutboxEventCreateInput!", "OutboxEvent"},
+		"createOutboxEvent",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExec{ret}
+}
+
+type OutboxEventUpdateParams struct {
+	Data  OutboxEventUpdateInput      `json:"data"`
+	Where OutboxEventWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateOutboxEvent(params OutboxEventUpdateParams) *OutboxEventExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"OutboxEventUpdateInput!", "OutboxEventWhereUniqueInput!", "OutboxEvent"},
+		"updateOutboxEvent",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExec{ret}
+}
+
+type OutboxEventUpdateManyParams struct {
+	Data  OutboxEventUpdateManyMutationInput `json:"data"`
+	Where *OutboxEventWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyOutboxEvents(params OutboxEventUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"OutboxEventUpdateManyMutationInput!", "OutboxEventWhereInput"},
+		"updateManyOutboxEvents")
+	return &BatchPayloadExec{exec}
+}
+
+type OutboxEventUpsertParams struct {
+	Where  OutboxEventWhereUniqueInput `json:"where"`
+	Create OutboxEventCreateInput      `json:"create"`
+	Update OutboxEventUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertOutboxEvent(params OutboxEventUpsertParams) *OutboxEventExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"OutboxEventWhereUniqueInput!", "OutboxEventCreateInput!", "OutboxEventUpdateInput!", "OutboxEvent"},
+		"upsertOutboxEvent",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExec{ret}
+}
+
+func (client *Client) DeleteOutboxEvent(params OutboxEventWhereUniqueInput) *OutboxEventExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"OutboxEventWhereUniqueInput!", "OutboxEvent"},
+		"deleteOutboxEvent",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExec{ret}
+}
+
+func (client *Client) DeleteManyOutboxEvents(params *OutboxEventWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "OutboxEventWhereInput", "deleteManyOutboxEvents")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateAuditEntry(params AuditEntryCreateInput) *AuditEntryExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"AuditEntryCreateInput!", "AuditEntry"},
+		"createAuditEntry",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryExec{ret}
+}
+
+type AuditEntryUpdateParams struct {
+	Data  AuditEntryUpdateInput      `json:"data"`
+	Where AuditEntryWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateAuditEntry(params AuditEntryUpdateParams) *AuditEntryExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"AuditEntryUpdateInput!", "AuditEntryWhereUniqueInput!", "AuditEntry"},
+		"updateAuditEntry",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryExec{ret}
+}
+
+type AuditEntryUpdateManyParams struct {
+	Data  AuditEntryUpdateManyMutationInput `json:"data"`
+	Where *AuditEntryWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyAuditEntries(params AuditEntryUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"AuditEntryUpdateManyMutationInput!", "AuditEntryWhereInput"},
+		"updateManyAuditEntries")
+	return &BatchPayloadExec{exec}
+}
+
+type AuditEntryUpsertParams struct {
+	Where  AuditEntryWhereUniqueInput `json:"where"`
+	Create AuditEntryCreateInput      `json:"create"`
+	Update AuditEntryUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertAuditEntry(params AuditEntryUpsertParams) *AuditEntryExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"AuditEntryWhereUniqueInput!", "AuditEntryCreateInput!", "AuditEntryUpdateInput!", "AuditEntry"},
+		"upsertAuditEntry",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryExec{ret}
+}
+
+func (client *Client) DeleteAuditEntry(params AuditEntryWhereUniqueInput) *AuditEntryExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"AuditEntryWhereUniqueInput!", "AuditEntry"},
+		"deleteAuditEntry",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryExec{ret}
+}
+
+func (client *Client) DeleteManyAuditEntries(params *AuditEntryWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "AuditEntryWhereInput", "deleteManyAuditEntries")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateRevision(params RevisionCreateInput) *RevisionExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"RevisionCreateInput!", "Revision"},
+		"createRevision",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExec{ret}
+}
+
+type RevisionUpdateParams struct {
+	Data  RevisionUpdateInput      `json:"data"`
+	Where RevisionWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateRevision(params RevisionUpdateParams) *RevisionExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"RevisionUpdateInput!", "RevisionWhereUniqueInput!", "Revision"},
+		"updateRevision",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExec{ret}
+}
+
+type RevisionUpdateManyParams struct {
+	Data  RevisionUpdateManyMutationInput `json:"data"`
+	Where *RevisionWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyRevisions(params RevisionUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"RevisionUpdateManyMutationInput!", "RevisionWhereInput"},
+		"updateManyRevisions")
+	return &BatchPayloadExec{exec}
+}
+
+type RevisionUpsertParams struct {
+	Where  RevisionWhereUniqueInput `json:"where"`
+	Create RevisionCreateInput      `json:"create"`
+	Update RevisionUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertRevision(params RevisionUpsertParams) *RevisionExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"RevisionWhereUniqueInput!", "RevisionCreateInput!", "RevisionUpdateInput!", "Revision"},
+		"upsertRevision",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExec{ret}
+}
+
+func (client *Client) DeleteRevision(params RevisionWhereUniqueInput) *RevisionExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"RevisionWhereUniqueInput!", "Revision"},
+		"deleteRevision",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExec{ret}
+}
+
+func (client *Client) DeleteManyRevisions(params *RevisionWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "RevisionWhereInput", "deleteManyRevisions")
+	return &BatchPayloadExec{exec}
+}
+
+func (client *Client) CreateIdempotencyKey(params IdempotencyKeyCreateInput) *IdempotencyKeyExec {
+	ret := client.Client.Create(
+		params,
+		[2]string{"IdempotencyKeyCreateInput!", "IdempotencyKey"},
+		"createIdempotencyKey",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyExec{ret}
+}
+
+type IdempotencyKeyUpdateParams struct {
+	Data  IdempotencyKeyUpdateInput      `json:"data"`
+	Where IdempotencyKeyWhereUniqueInput `json:"where"`
+}
+
+func (client *Client) UpdateIdempotencyKey(params IdempotencyKeyUpdateParams) *IdempotencyKeyExec {
+	ret := client.Client.Update(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[3]string{"IdempotencyKeyUpdateInput!", "IdempotencyKeyWhereUniqueInput!", "IdempotencyKey"},
+		"updateIdempotencyKey",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyExec{ret}
+}
+
+type IdempotencyKeyUpdateManyParams struct {
+	Data  IdempotencyKeyUpdateManyMutationInput `json:"data"`
+	Where *IdempotencyKeyWhereInput             `json:"where,omitempty"`
+}
+
+func (client *Client) UpdateManyIdempotencyKeys(params IdempotencyKeyUpdateManyParams) *BatchPayloadExec {
+	exec := client.Client.UpdateMany(
+		prisma.UpdateParams{
+			Data:  params.Data,
+			Where: params.Where,
+		},
+		[2]string{"IdempotencyKeyUpdateManyMutationInput!", "IdempotencyKeyWhereInput"},
+		"updateManyIdempotencyKeys")
+	return &BatchPayloadExec{exec}
+}
+
+type IdempotencyKeyUpsertParams struct {
+	Where  IdempotencyKeyWhereUniqueInput `json:"where"`
+	Create IdempotencyKeyCreateInput      `json:"create"`
+	Update IdempotencyKeyUpdateInput      `json:"update"`
+}
+
+func (client *Client) UpsertIdempotencyKey(params IdempotencyKeyUpsertParams) *IdempotencyKeyExec {
+	uparams := &prisma.UpsertParams{
+		Where:  params.Where,
+		Create: params.Create,
+		Update: params.Update,
+	}
+	ret := client.Client.Upsert(
+		uparams,
+		[4]string{"IdempotencyKeyWhereUniqueInput!", "IdempotencyKeyCreateInput!", "IdempotencyKeyUpdateInput!", "IdempotencyKey"},
+		"upsertIdempotencyKey",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyExec{ret}
+}
+
+func (client *Client) DeleteIdempotencyKey(params IdempotencyKeyWhereUniqueInput) *IdempotencyKeyExec {
+	ret := client.Client.Delete(
+		params,
+		[2]string{"IdempotencyKeyWhereUniqueInput!", "IdempotencyKey"},
+		"deleteIdempotencyKey",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyExec{ret}
+}
+
+func (client *Client) DeleteManyIdempotencyKeys(params *IdempotencyKeyWhereInput) *BatchPayloadExec {
+	exec := client.Client.DeleteMany(params, "IdempotencyKeyWhereInput", "deleteManyIdempotencyKeys")
+	return &BatchPayloadExec{exec}
 }
 
 type ChapterOrderByInput string
 
-const (
-	ChapterOrderByInputIDAsc           ChapterOrderByInput = "id_ASC"
-	ChapterOrderByInputIDDesc          ChapterOrderByInput = "id_DESC"
-	ChapterOrderByInputCreatedAtAsc    ChapterOrderByInput = "createdAt_ASC"
-	ChapterOrderByInputCreatedAtDesc   ChapterOrderByInput = "createdAt_DESC"
-	ChapterOrderByInputUpdatedAtAsc    ChapterOrderByInput = "updatedAt_ASC"
-	ChapterOrderByInputUpdatedAtDesc   ChapterOrderByInput = "updatedAt_DESC"
-	ChapterOrderByInputNameAsc         ChapterOrderByInput = "name_ASC"
-	ChapterOrderByInputNameDesc        ChapterOrderByInput = "name_DESC"
-	ChapterOrderByInputDescriptionAsc  ChapterOrderByInput = "description_ASC"
-	ChapterOrderByInputDescriptionDesc ChapterOrderByInput = "description_DESC"
-)
+const (
+	ChapterOrderByInputIDAsc           ChapterOrderByInput = "id_ASC"
+	ChapterOrderByInputIDDesc          ChapterOrderByInput = "id_DESC"
+	ChapterOrderByInputCreatedAtAsc    ChapterOrderByInput = "createdAt_ASC"
+	ChapterOrderByInputCreatedAtDesc   ChapterOrderByInput = "createdAt_DESC"
+	ChapterOrderByInputUpdatedAtAsc    ChapterOrderByInput = "updatedAt_ASC"
+	ChapterOrderByInputUpdatedAtDesc   ChapterOrderByInput = "updatedAt_DESC"
+	ChapterOrderByInputNameAsc         ChapterOrderByInput = "name_ASC"
+	ChapterOrderByInputNameDesc        ChapterOrderByInput = "name_DESC"
+	ChapterOrderByInputDescriptionAsc  ChapterOrderByInput = "description_ASC"
+	ChapterOrderByInputDescriptionDesc ChapterOrderByInput = "description_DESC"
+	ChapterOrderByInputPositionAsc     ChapterOrderByInput = "position_ASC"
+	ChapterOrderByInputPositionDesc    ChapterOrderByInput = "position_DESC"
+	ChapterOrderByInputDeletedAsc      ChapterOrderByInput = "deleted_ASC"
+	ChapterOrderByInputDeletedDesc     ChapterOrderByInput = "deleted_DESC"
+	ChapterOrderByInputDeletedAtAsc    ChapterOrderByInput = "deletedAt_ASC"
+	ChapterOrderByInputDeletedAtDesc   ChapterOrderByInput = "deletedAt_DESC"
+	ChapterOrderByInputRevisionAsc     ChapterOrderByInput = "revision_ASC"
+	ChapterOrderByInputRevisionDesc    ChapterOrderByInput = "revision_DESC"
+)
+
+type BookOrderByInput string
+
+const (
+	BookOrderByInputIDAsc           BookOrderByInput = "id_ASC"
+	BookOrderByInputIDDesc          BookOrderByInput = "id_DESC"
+	BookOrderByInputCreatedAtAsc    BookOrderByInput = "createdAt_ASC"
+	BookOrderByInputCreatedAtDesc   BookOrderByInput = "createdAt_DESC"
+	BookOrderByInputUpdatedAtAsc    BookOrderByInput = "updatedAt_ASC"
+	BookOrderByInputUpdatedAtDesc   BookOrderByInput = "updatedAt_DESC"
+	BookOrderByInputNameAsc         BookOrderByInput = "name_ASC"
+	BookOrderByInputNameDesc        BookOrderByInput = "name_DESC"
+	BookOrderByInputDescriptionAsc  BookOrderByInput = "description_ASC"
+	BookOrderByInputDescriptionDesc BookOrderByInput = "description_DESC"
+	BookOrderByInputIsbnAsc         BookOrderByInput = "isbn_ASC"
+	BookOrderByInputIsbnDesc        BookOrderByInput = "isbn_DESC"
+	BookOrderByInputPublisherAsc    BookOrderByInput = "publisher_ASC"
+	BookOrderByInputPublisherDesc   BookOrderByInput = "publisher_DESC"
+	BookOrderByInputYearAsc         BookOrderByInput = "year_ASC"
+	BookOrderByInputYearDesc        BookOrderByInput = "year_DESC"
+	BookOrderByInputLanguageAsc     BookOrderByInput = "language_ASC"
+	BookOrderByInputLanguageDesc    BookOrderByInput = "language_DESC"
+	BookOrderByInputDeletedAsc      BookOrderByInput = "deleted_ASC"
+	BookOrderByInputDeletedDesc     BookOrderByInput = "deleted_DESC"
+	BookOrderByInputDeletedAtAsc    BookOrderByInput = "deletedAt_ASC"
+	BookOrderByInputDeletedAtDesc   BookOrderByInput = "deletedAt_DESC"
+	BookOrderByInputRevisionAsc     BookOrderByInput = "revision_ASC"
+	BookOrderByInputRevisionDesc    BookOrderByInput = "revision_DESC"
+)
+
+type MutationType string
+
+const (
+	MutationTypeCreated MutationType = "CREATED"
+	MutationTypeUpdated MutationType = "UPDATED"
+	MutationTypeDeleted MutationType = "DELETED"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "PENDING"
+	DeliveryStatusSucceeded DeliveryStatus = "SUCCEEDED"
+	DeliveryStatusDead      DeliveryStatus = "DEAD"
+)
+
+type WebhookDeliveryOrderByInput string
+
+const (
+	WebhookDeliveryOrderByInputIDAsc              WebhookDeliveryOrderByInput = "id_ASC"
+	WebhookDeliveryOrderByInputIDDesc             WebhookDeliveryOrderByInput = "id_DESC"
+	WebhookDeliveryOrderByInputCreatedAtAsc       WebhookDeliveryOrderByInput = "createdAt_ASC"
+	WebhookDeliveryOrderByInputCreatedAtDesc      WebhookDeliveryOrderByInput = "createdAt_DESC"
+	WebhookDeliveryOrderByInputUpdatedAtAsc       WebhookDeliveryOrderByInput = "updatedAt_ASC"
+	WebhookDeliveryOrderByInputUpdatedAtDesc      WebhookDeliveryOrderByInput = "updatedAt_DESC"
+	WebhookDeliveryOrderByInputEventAsc           WebhookDeliveryOrderByInput = "event_ASC"
+	WebhookDeliveryOrderByInputEventDesc          WebhookDeliveryOrderByInput = "event_DESC"
+	WebhookDeliveryOrderByInputPayloadAsc         WebhookDeliveryOrderByInput = "payload_ASC"
+	WebhookDeliveryOrderByInputPayloadDesc        WebhookDeliveryOrderByInput = "payload_DESC"
+	WebhookDeliveryOrderByInputStatusAsc          WebhookDeliveryOrderByInput = "status_ASC"
+	WebhookDeliveryOrderByInputStatusDesc         WebhookDeliveryOrderByInput = "status_DESC"
+	WebhookDeliveryOrderByInputAttemptsAsc        WebhookDeliveryOrderByInput = "attempts_ASC"
+	WebhookDeliveryOrderByInputAttemptsDesc       WebhookDeliveryOrderByInput = "attempts_DESC"
+	WebhookDeliveryOrderByInputNextAttemptAtAsc   WebhookDeliveryOrderByInput = "nextAttemptAt_ASC"
+	WebhookDeliveryOrderByInputNextAttemptAtDesc  WebhookDeliveryOrderByInput = "nextAttemptAt_DESC"
+	WebhookDeliveryOrderByInputLastStatusCodeAsc  WebhookDeliveryOrderByInput = "lastStatusCode_ASC"
+	WebhookDeliveryOrderByInputLastStatusCodeDesc WebhookDeliveryOrderByInput = "lastStatusCode_DESC"
+	WebhookDeliveryOrderByInputLastErrorAsc       WebhookDeliveryOrderByInput = "lastError_ASC"
+	WebhookDeliveryOrderByInputLastErrorDesc      WebhookDeliveryOrderByInput = "lastError_DESC"
+)
+
+type WebhookOrderByInput string
+
+const (
+	WebhookOrderByInputIDAsc         WebhookOrderByInput = "id_ASC"
+	WebhookOrderByInputIDDesc        WebhookOrderByInput = "id_DESC"
+	WebhookOrderByInputCreatedAtAsc  WebhookOrderByInput = "createdAt_ASC"
+	WebhookOrderByInputCreatedAtDesc WebhookOrderByInput = "createdAt_DESC"
+	WebhookOrderByInputUpdatedAtAsc  WebhookOrderByInput = "updatedAt_ASC"
+	WebhookOrderByInputUpdatedAtDesc WebhookOrderByInput = "updatedAt_DESC"
+	WebhookOrderByInputURLAsc        WebhookOrderByInput = "url_ASC"
+	WebhookOrderByInputURLDesc       WebhookOrderByInput = "url_DESC"
+	WebhookOrderByInputSecretAsc     WebhookOrderByInput = "secret_ASC"
+	WebhookOrderByInputSecretDesc    WebhookOrderByInput = "secret_DESC"
+	WebhookOrderByInputEventsAsc     WebhookOrderByInput = "events_ASC"
+	WebhookOrderByInputEventsDesc    WebhookOrderByInput = "events_DESC"
+)
+
+type OutboxStatus string
+
+const (
+	OutboxStatusReady     OutboxStatus = "READY"
+	OutboxStatusPublished OutboxStatus = "PUBLISHED"
+)
+
+type OutboxEventOrderByInput string
+
+const (
+	OutboxEventOrderByInputIDAsc           OutboxEventOrderByInput = "id_ASC"
+	OutboxEventOrderByInputIDDesc          OutboxEventOrderByInput = "id_DESC"
+	OutboxEventOrderByInputCreatedAtAsc    OutboxEventOrderByInput = "createdAt_ASC"
+	OutboxEventOrderByInputCreatedAtDesc   OutboxEventOrderByInput = "createdAt_DESC"
+	OutboxEventOrderByInputUpdatedAtAsc    OutboxEventOrderByInput = "updatedAt_ASC"
+	OutboxEventOrderByInputUpdatedAtDesc   OutboxEventOrderByInput = "updatedAt_DESC"
+	OutboxEventOrderByInputTypeAsc         OutboxEventOrderByInput = "type_ASC"
+	OutboxEventOrderByInputTypeDesc        OutboxEventOrderByInput = "type_DESC"
+	OutboxEventOrderByInputAggregateAsc    OutboxEventOrderByInput = "aggregate_ASC"
+	OutboxEventOrderByInputAggregateDesc   OutboxEventOrderByInput = "aggregate_DESC"
+	OutboxEventOrderByInputAggregateIDAsc  OutboxEventOrderByInput = "aggregateId_ASC"
+	OutboxEventOrderByInputAggregateIDDesc OutboxEventOrderByInput = "aggregateId_DESC"
+	OutboxEventOrderByInputPayloadAsc      OutboxEventOrderByInput = "payload_ASC"
+	OutboxEventOrderByInputPayloadDesc     OutboxEventOrderByInput = "payload_DESC"
+	OutboxEventOrderByInputStatusAsc       OutboxEventOrderByInput = "status_ASC"
+	OutboxEventOrderByInputStatusDesc      OutboxEventOrderByInput = "status_DESC"
+	OutboxEventOrderByInputPublishedAtAsc  OutboxEventOrderByInput = "publishedAt_ASC"
+	OutboxEventOrderByInputPublishedAtDesc OutboxEventOrderByInput = "publishedAt_DESC"
+	OutboxEventOrderByInputSequenceAsc     OutboxEventOrderByInput = "sequence_ASC"
+	OutboxEventOrderByInputSequenceDesc    OutboxEventOrderByInput = "sequence_DESC"
+)
+
+type AuditEntryOrderByInput string
+
+const (
+	AuditEntryOrderByInputIDAsc         AuditEntryOrderByInput = "id_ASC"
+	AuditEntryOrderByInputIDDesc        AuditEntryOrderByInput = "id_DESC"
+	AuditEntryOrderByInputCreatedAtAsc  AuditEntryOrderByInput = "createdAt_ASC"
+	AuditEntryOrderByInputCreatedAtDesc AuditEntryOrderByInput = "createdAt_DESC"
+	AuditEntryOrderByInputActorAsc      AuditEntryOrderByInput = "actor_ASC"
+	AuditEntryOrderByInputActorDesc     AuditEntryOrderByInput = "actor_DESC"
+	AuditEntryOrderByInputMethodAsc     AuditEntryOrderByInput = "method_ASC"
+	AuditEntryOrderByInputMethodDesc    AuditEntryOrderByInput = "method_DESC"
+	AuditEntryOrderByInputRequestIDAsc  AuditEntryOrderByInput = "requestId_ASC"
+	AuditEntryOrderByInputRequestIDDesc AuditEntryOrderByInput = "requestId_DESC"
+	AuditEntryOrderByInputBookIDAsc     AuditEntryOrderByInput = "bookId_ASC"
+	AuditEntryOrderByInputBookIDDesc    AuditEntryOrderByInput = "bookId_DESC"
+	AuditEntryOrderByInputChapterIDAsc  AuditEntryOrderByInput = "chapterId_ASC"
+	AuditEntryOrderByInputChapterIDDesc AuditEntryOrderByInput = "chapterId_DESC"
+	AuditEntryOrderByInputBeforeAsc     AuditEntryOrderByInput = "before_ASC"
+	AuditEntryOrderByInputBeforeDesc    AuditEntryOrderByInput = "before_DESC"
+	AuditEntryOrderByInputAfterAsc      AuditEntryOrderByInput = "after_ASC"
+	AuditEntryOrderByInputAfterDesc     AuditEntryOrderByInput = "after_DESC"
+)
+
+type RevisionOrderByInput string
+
+const (
+	RevisionOrderByInputIDAsc           RevisionOrderByInput = "id_ASC"
+	RevisionOrderByInputIDDesc          RevisionOrderByInput = "id_DESC"
+	RevisionOrderByInputCreatedAtAsc    RevisionOrderByInput = "createdAt_ASC"
+	RevisionOrderByInputCreatedAtDesc   RevisionOrderByInput = "createdAt_DESC"
+	RevisionOrderByInputKeyAsc          RevisionOrderByInput = "key_ASC"
+	RevisionOrderByInputKeyDesc         RevisionOrderByInput = "key_DESC"
+	RevisionOrderByInputNumberAsc       RevisionOrderByInput = "number_ASC"
+	RevisionOrderByInputNumberDesc      RevisionOrderByInput = "number_DESC"
+	RevisionOrderByInputNameAsc         RevisionOrderByInput = "name_ASC"
+	RevisionOrderByInputNameDesc        RevisionOrderByInput = "name_DESC"
+	RevisionOrderByInputDescriptionAsc  RevisionOrderByInput = "description_ASC"
+	RevisionOrderByInputDescriptionDesc RevisionOrderByInput = "description_DESC"
+)
+
+type IdempotencyKeyOrderByInput string
+
+const (
+	IdempotencyKeyOrderByInputIDAsc           IdempotencyKeyOrderByInput = "id_ASC"
+	IdempotencyKeyOrderByInputIDDesc          IdempotencyKeyOrderByInput = "id_DESC"
+	IdempotencyKeyOrderByInputCreatedAtAsc    IdempotencyKeyOrderByInput = "createdAt_ASC"
+	IdempotencyKeyOrderByInputCreatedAtDesc   IdempotencyKeyOrderByInput = "createdAt_DESC"
+	IdempotencyKeyOrderByInputKeyAsc          IdempotencyKeyOrderByInput = "key_ASC"
+	IdempotencyKeyOrderByInputKeyDesc         IdempotencyKeyOrderByInput = "key_DESC"
+	IdempotencyKeyOrderByInputRequestHashAsc  IdempotencyKeyOrderByInput = "requestHash_ASC"
+	IdempotencyKeyOrderByInputRequestHashDesc IdempotencyKeyOrderByInput = "requestHash_DESC"
+	IdempotencyKeyOrderByInputStatusCodeAsc   IdempotencyKeyOrderByInput = "statusCode_ASC"
+	IdempotencyKeyOrderByInputStatusCodeDesc  IdempotencyKeyOrderByInput = "statusCode_DESC"
+	IdempotencyKeyOrderByInputContentTypeAsc  IdempotencyKeyOrderByInput = "contentType_ASC"
+	IdempotencyKeyOrderByInputContentTypeDesc IdempotencyKeyOrderByInput = "contentType_DESC"
+	IdempotencyKeyOrderByInputEtagAsc         IdempotencyKeyOrderByInput = "etag_ASC"
+	IdempotencyKeyOrderByInputEtagDesc        IdempotencyKeyOrderByInput = "etag_DESC"
+	IdempotencyKeyOrderByInputBodyAsc         IdempotencyKeyOrderByInput = "body_ASC"
+	IdempotencyKeyOrderByInputBodyDesc        IdempotencyKeyOrderByInput = "body_DESC"
+	IdempotencyKeyOrderByInputExpiresAtAsc    IdempotencyKeyOrderByInput = "expiresAt_ASC"
+	IdempotencyKeyOrderByInputExpiresAtDesc   IdempotencyKeyOrderByInput = "expiresAt_DESC"
+)
+
+type AuthorOrderByInput string
+
+const (
+	AuthorOrderByInputIDAsc         AuthorOrderByInput = "id_ASC"
+	AuthorOrderByInputIDDesc        AuthorOrderByInput = "id_DESC"
+	AuthorOrderByInputCreatedAtAsc  AuthorOrderByInput = "createdAt_ASC"
+	AuthorOrderByInputCreatedAtDesc AuthorOrderByInput = "createdAt_DESC"
+	AuthorOrderByInputUpdatedAtAsc  AuthorOrderByInput = "updatedAt_ASC"
+	AuthorOrderByInputUpdatedAtDesc AuthorOrderByInput = "updatedAt_DESC"
+	AuthorOrderByInputNameAsc       AuthorOrderByInput = "name_ASC"
+	AuthorOrderByInputNameDesc      AuthorOrderByInput = "name_DESC"
+)
+
+type CollectionOrderByInput string
+
+const (
+	CollectionOrderByInputIDAsc           CollectionOrderByInput = "id_ASC"
+	CollectionOrderByInputIDDesc          CollectionOrderByInput = "id_DESC"
+	CollectionOrderByInputCreatedAtAsc    CollectionOrderByInput = "createdAt_ASC"
+	CollectionOrderByInputCreatedAtDesc   CollectionOrderByInput = "createdAt_DESC"
+	CollectionOrderByInputUpdatedAtAsc    CollectionOrderByInput = "updatedAt_ASC"
+	CollectionOrderByInputUpdatedAtDesc   CollectionOrderByInput = "updatedAt_DESC"
+	CollectionOrderByInputNameAsc         CollectionOrderByInput = "name_ASC"
+	CollectionOrderByInputNameDesc        CollectionOrderByInput = "name_DESC"
+	CollectionOrderByInputDescriptionAsc  CollectionOrderByInput = "description_ASC"
+	CollectionOrderByInputDescriptionDesc CollectionOrderByInput = "description_DESC"
+)
+
+type TagOrderByInput string
+
+const (
+	TagOrderByInputIDAsc         TagOrderByInput = "id_ASC"
+	TagOrderByInputIDDesc        TagOrderByInput = "id_DESC"
+	TagOrderByInputCreatedAtAsc  TagOrderByInput = "createdAt_ASC"
+	TagOrderByInputCreatedAtDesc TagOrderByInput = "createdAt_DESC"
+	TagOrderByInputUpdatedAtAsc  TagOrderByInput = "updatedAt_ASC"
+	TagOrderByInputUpdatedAtDesc TagOrderByInput = "updatedAt_DESC"
+	TagOrderByInputNameAsc       TagOrderByInput = "name_ASC"
+	TagOrderByInputNameDesc      TagOrderByInput = "name_DESC"
+)
+
+type NoteColor string
+
+const (
+	NoteColorYellow NoteColor = "YELLOW"
+	NoteColorGreen  NoteColor = "GREEN"
+	NoteColorBlue   NoteColor = "BLUE"
+	NoteColorPink   NoteColor = "PINK"
+	NoteColorOrange NoteColor = "ORANGE"
+)
+
+type NoteOrderByInput string
+
+const (
+	NoteOrderByInputIDAsc         NoteOrderByInput = "id_ASC"
+	NoteOrderByInputIDDesc        NoteOrderByInput = "id_DESC"
+	NoteOrderByInputCreatedAtAsc  NoteOrderByInput = "createdAt_ASC"
+	NoteOrderByInputCreatedAtDesc NoteOrderByInput = "createdAt_DESC"
+	NoteOrderByInputUpdatedAtAsc  NoteOrderByInput = "updatedAt_ASC"
+	NoteOrderByInputUpdatedAtDesc NoteOrderByInput = "updatedAt_DESC"
+	NoteOrderByInputQuoteAsc      NoteOrderByInput = "quote_ASC"
+	NoteOrderByInputQuoteDesc     NoteOrderByInput = "quote_DESC"
+	NoteOrderByInputTextAsc       NoteOrderByInput = "text_ASC"
+	NoteOrderByInputTextDesc      NoteOrderByInput = "text_DESC"
+	NoteOrderByInputLocationAsc   NoteOrderByInput = "location_ASC"
+	NoteOrderByInputLocationDesc  NoteOrderByInput = "location_DESC"
+	NoteOrderByInputColorAsc      NoteOrderByInput = "color_ASC"
+	NoteOrderByInputColorDesc     NoteOrderByInput = "color_DESC"
+)
+
+type ReviewOrderByInput string
+
+const (
+	ReviewOrderByInputIDAsc         ReviewOrderByInput = "id_ASC"
+	ReviewOrderByInputIDDesc        ReviewOrderByInput = "id_DESC"
+	ReviewOrderByInputCreatedAtAsc  ReviewOrderByInput = "createdAt_ASC"
+	ReviewOrderByInputCreatedAtDesc ReviewOrderByInput = "createdAt_DESC"
+	ReviewOrderByInputKeyAsc        ReviewOrderByInput = "key_ASC"
+	ReviewOrderByInputKeyDesc       ReviewOrderByInput = "key_DESC"
+	ReviewOrderByInputGradeAsc      ReviewOrderByInput = "grade_ASC"
+	ReviewOrderByInputGradeDesc     ReviewOrderByInput = "grade_DESC"
+	ReviewOrderByInputEaseAsc       ReviewOrderByInput = "ease_ASC"
+	ReviewOrderByInputEaseDesc      ReviewOrderByInput = "ease_DESC"
+	ReviewOrderByInputIntervalAsc   ReviewOrderByInput = "interval_ASC"
+	ReviewOrderByInputIntervalDesc  ReviewOrderByInput = "interval_DESC"
+	ReviewOrderByInputDueAsc        ReviewOrderByInput = "due_ASC"
+	ReviewOrderByInputDueDesc       ReviewOrderByInput = "due_DESC"
+)
+
+type CardOrderByInput string
+
+const (
+	CardOrderByInputIDAsc              CardOrderByInput = "id_ASC"
+	CardOrderByInputIDDesc             CardOrderByInput = "id_DESC"
+	CardOrderByInputCreatedAtAsc       CardOrderByInput = "createdAt_ASC"
+	CardOrderByInputCreatedAtDesc      CardOrderByInput = "createdAt_DESC"
+	CardOrderByInputUpdatedAtAsc       CardOrderByInput = "updatedAt_ASC"
+	CardOrderByInputUpdatedAtDesc      CardOrderByInput = "updatedAt_DESC"
+	CardOrderByInputFrontAsc           CardOrderByInput = "front_ASC"
+	CardOrderByInputFrontDesc          CardOrderByInput = "front_DESC"
+	CardOrderByInputBackAsc            CardOrderByInput = "back_ASC"
+	CardOrderByInputBackDesc           CardOrderByInput = "back_DESC"
+	CardOrderByInputEaseAsc            CardOrderByInput = "ease_ASC"
+	CardOrderByInputEaseDesc           CardOrderByInput = "ease_DESC"
+	CardOrderByInputIntervalAsc        CardOrderByInput = "interval_ASC"
+	CardOrderByInputIntervalDesc       CardOrderByInput = "interval_DESC"
+	CardOrderByInputRepetitionsAsc     CardOrderByInput = "repetitions_ASC"
+	CardOrderByInputRepetitionsDesc    CardOrderByInput = "repetitions_DESC"
+	CardOrderByInputLapsesAsc          CardOrderByInput = "lapses_ASC"
+	CardOrderByInputLapsesDesc         CardOrderByInput = "lapses_DESC"
+	CardOrderByInputReviewCountAsc     CardOrderByInput = "reviewCount_ASC"
+	CardOrderByInputReviewCountDesc    CardOrderByInput = "reviewCount_DESC"
+	CardOrderByInputDueAsc             CardOrderByInput = "due_ASC"
+	CardOrderByInputDueDesc            CardOrderByInput = "due_DESC"
+	CardOrderByInputLastReviewedAtAsc  CardOrderByInput = "lastReviewedAt_ASC"
+	CardOrderByInputLastReviewedAtDesc CardOrderByInput = "lastReviewedAt_DESC"
+)
+
+type ReadingStatus string
+
+const (
+	ReadingStatusUnread   ReadingStatus = "UNREAD"
+	ReadingStatusReading  ReadingStatus = "READING"
+	ReadingStatusFinished ReadingStatus = "FINISHED"
+)
+
+type ReadingProgressOrderByInput string
+
+const (
+	ReadingProgressOrderByInputIDAsc          ReadingProgressOrderByInput = "id_ASC"
+	ReadingProgressOrderByInputIDDesc         ReadingProgressOrderByInput = "id_DESC"
+	ReadingProgressOrderByInputCreatedAtAsc   ReadingProgressOrderByInput = "createdAt_ASC"
+	ReadingProgressOrderByInputCreatedAtDesc  ReadingProgressOrderByInput = "createdAt_DESC"
+	ReadingProgressOrderByInputUpdatedAtAsc   ReadingProgressOrderByInput = "updatedAt_ASC"
+	ReadingProgressOrderByInputUpdatedAtDesc  ReadingProgressOrderByInput = "updatedAt_DESC"
+	ReadingProgressOrderByInputKeyAsc         ReadingProgressOrderByInput = "key_ASC"
+	ReadingProgressOrderByInputKeyDesc        ReadingProgressOrderByInput = "key_DESC"
+	ReadingProgressOrderByInputUserAsc        ReadingProgressOrderByInput = "user_ASC"
+	ReadingProgressOrderByInputUserDesc       ReadingProgressOrderByInput = "user_DESC"
+	ReadingProgressOrderByInputStatusAsc      ReadingProgressOrderByInput = "status_ASC"
+	ReadingProgressOrderByInputStatusDesc     ReadingProgressOrderByInput = "status_DESC"
+	ReadingProgressOrderByInputStartedAtAsc   ReadingProgressOrderByInput = "startedAt_ASC"
+	ReadingProgressOrderByInputStartedAtDesc  ReadingProgressOrderByInput = "startedAt_DESC"
+	ReadingProgressOrderByInputFinishedAtAsc  ReadingProgressOrderByInput = "finishedAt_ASC"
+	ReadingProgressOrderByInputFinishedAtDesc ReadingProgressOrderByInput = "finishedAt_DESC"
+)
+
+type ReadingSessionOrderByInput string
+
+const (
+	ReadingSessionOrderByInputIDAsc         ReadingSessionOrderByInput = "id_ASC"
+	ReadingSessionOrderByInputIDDesc        ReadingSessionOrderByInput = "id_DESC"
+	ReadingSessionOrderByInputCreatedAtAsc  ReadingSessionOrderByInput = "createdAt_ASC"
+	ReadingSessionOrderByInputCreatedAtDesc ReadingSessionOrderByInput = "createdAt_DESC"
+	ReadingSessionOrderByInputUpdatedAtAsc  ReadingSessionOrderByInput = "updatedAt_ASC"
+	ReadingSessionOrderByInputUpdatedAtDesc ReadingSessionOrderByInput = "updatedAt_DESC"
+	ReadingSessionOrderByInputKeyAsc        ReadingSessionOrderByInput = "key_ASC"
+	ReadingSessionOrderByInputKeyDesc       ReadingSessionOrderByInput = "key_DESC"
+	ReadingSessionOrderByInputUserAsc       ReadingSessionOrderByInput = "user_ASC"
+	ReadingSessionOrderByInputUserDesc      ReadingSessionOrderByInput = "user_DESC"
+	ReadingSessionOrderByInputOpenAsc       ReadingSessionOrderByInput = "open_ASC"
+	ReadingSessionOrderByInputOpenDesc      ReadingSessionOrderByInput = "open_DESC"
+	ReadingSessionOrderByInputStartedAtAsc  ReadingSessionOrderByInput = "startedAt_ASC"
+	ReadingSessionOrderByInputStartedAtDesc ReadingSessionOrderByInput = "startedAt_DESC"
+	ReadingSessionOrderByInputEndedAtAsc    ReadingSessionOrderByInput = "endedAt_ASC"
+	ReadingSessionOrderByInputEndedAtDesc   ReadingSessionOrderByInput = "endedAt_DESC"
+	ReadingSessionOrderByInputDurationAsc   ReadingSessionOrderByInput = "duration_ASC"
+	ReadingSessionOrderByInputDurationDesc  ReadingSessionOrderByInput = "duration_DESC"
+	ReadingSessionOrderByInputPagesAsc      ReadingSessionOrderByInput = "pages_ASC"
+	ReadingSessionOrderByInputPagesDesc     ReadingSessionOrderByInput = "pages_DESC"
+)
+
+type FiringStatus string
+
+const (
+	FiringStatusPending FiringStatus = "PENDING"
+	FiringStatusSent    FiringStatus = "SENT"
+	FiringStatusFailed  FiringStatus = "FAILED"
+)
+
+type ReminderFiringOrderByInput string
+
+const (
+	ReminderFiringOrderByInputIDAsc           ReminderFiringOrderByInput = "id_ASC"
+	ReminderFiringOrderByInputIDDesc          ReminderFiringOrderByInput = "id_DESC"
+	ReminderFiringOrderByInputCreatedAtAsc    ReminderFiringOrderByInput = "createdAt_ASC"
+	ReminderFiringOrderByInputCreatedAtDesc   ReminderFiringOrderByInput = "createdAt_DESC"
+	ReminderFiringOrderByInputUpdatedAtAsc    ReminderFiringOrderByInput = "updatedAt_ASC"
+	ReminderFiringOrderByInputUpdatedAtDesc   ReminderFiringOrderByInput = "updatedAt_DESC"
+	ReminderFiringOrderByInputKeyAsc          ReminderFiringOrderByInput = "key_ASC"
+	ReminderFiringOrderByInputKeyDesc         ReminderFiringOrderByInput = "key_DESC"
+	ReminderFiringOrderByInputScheduledAtAsc  ReminderFiringOrderByInput = "scheduledAt_ASC"
+	ReminderFiringOrderByInputScheduledAtDesc ReminderFiringOrderByInput = "scheduledAt_DESC"
+	ReminderFiringOrderByInputStatusAsc       ReminderFiringOrderByInput = "status_ASC"
+	ReminderFiringOrderByInputStatusDesc      ReminderFiringOrderByInput = "status_DESC"
+	ReminderFiringOrderByInputAttemptsAsc     ReminderFiringOrderByInput = "attempts_ASC"
+	ReminderFiringOrderByInputAttemptsDesc    ReminderFiringOrderByInput = "attempts_DESC"
+	ReminderFiringOrderByInputLastErrorAsc    ReminderFiringOrderByInput = "lastError_ASC"
+	ReminderFiringOrderByInputLastErrorDesc   ReminderFiringOrderByInput = "lastError_DESC"
+)
+
+type ReminderOrderByInput string
+
+const (
+	ReminderOrderByInputIDAsc           ReminderOrderByInput = "id_ASC"
+	ReminderOrderByInputIDDesc          ReminderOrderByInput = "id_DESC"
+	ReminderOrderByInputCreatedAtAsc    ReminderOrderByInput = "createdAt_ASC"
+	ReminderOrderByInputCreatedAtDesc   ReminderOrderByInput = "createdAt_DESC"
+	ReminderOrderByInputUpdatedAtAsc    ReminderOrderByInput = "updatedAt_ASC"
+	ReminderOrderByInputUpdatedAtDesc   ReminderOrderByInput = "updatedAt_DESC"
+	ReminderOrderByInputUserAsc         ReminderOrderByInput = "user_ASC"
+	ReminderOrderByInputUserDesc        ReminderOrderByInput = "user_DESC"
+	ReminderOrderByInputMessageAsc      ReminderOrderByInput = "message_ASC"
+	ReminderOrderByInputMessageDesc     ReminderOrderByInput = "message_DESC"
+	ReminderOrderByInputRuleAsc         ReminderOrderByInput = "rule_ASC"
+	ReminderOrderByInputRuleDesc        ReminderOrderByInput = "rule_DESC"
+	ReminderOrderByInputTimezoneAsc     ReminderOrderByInput = "timezone_ASC"
+	ReminderOrderByInputTimezoneDesc    ReminderOrderByInput = "timezone_DESC"
+	ReminderOrderByInputNotifierAsc     ReminderOrderByInput = "notifier_ASC"
+	ReminderOrderByInputNotifierDesc    ReminderOrderByInput = "notifier_DESC"
+	ReminderOrderByInputTargetAsc       ReminderOrderByInput = "target_ASC"
+	ReminderOrderByInputTargetDesc      ReminderOrderByInput = "target_DESC"
+	ReminderOrderByInputActiveAsc       ReminderOrderByInput = "active_ASC"
+	ReminderOrderByInputActiveDesc      ReminderOrderByInput = "active_DESC"
+	ReminderOrderByInputNextFireAtAsc   ReminderOrderByInput = "nextFireAt_ASC"
+	ReminderOrderByInputNextFireAtDesc  ReminderOrderByInput = "nextFireAt_DESC"
+	ReminderOrderByInputLastFiredAtAsc  ReminderOrderByInput = "lastFiredAt_ASC"
+	ReminderOrderByInputLastFiredAtDesc ReminderOrderByInput = "lastFiredAt_DESC"
+)
+
+type CalendarFeedOrderByInput string
+
+const (
+	CalendarFeedOrderByInputIDAsc         CalendarFeedOrderByInput = "id_ASC"
+	CalendarFeedOrderByInputIDDesc        CalendarFeedOrderByInput = "id_DESC"
+	CalendarFeedOrderByInputCreatedAtAsc  CalendarFeedOrderByInput = "createdAt_ASC"
+	CalendarFeedOrderByInputCreatedAtDesc CalendarFeedOrderByInput = "createdAt_DESC"
+	CalendarFeedOrderByInputUpdatedAtAsc  CalendarFeedOrderByInput = "updatedAt_ASC"
+	CalendarFeedOrderByInputUpdatedAtDesc CalendarFeedOrderByInput = "updatedAt_DESC"
+	CalendarFeedOrderByInputUserAsc       CalendarFeedOrderByInput = "user_ASC"
+	CalendarFeedOrderByInputUserDesc      CalendarFeedOrderByInput = "user_DESC"
+	CalendarFeedOrderByInputTokenHashAsc  CalendarFeedOrderByInput = "tokenHash_ASC"
+	CalendarFeedOrderByInputTokenHashDesc CalendarFeedOrderByInput = "tokenHash_DESC"
+)
+
+type ChapterUpdateManyWithoutBookInput struct {
+	Create     []ChapterCreateWithoutBookInput                `json:"create,omitempty"`
+	Delete     []ChapterWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []ChapterWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []ChapterWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []ChapterWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []ChapterUpdateWithWhereUniqueWithoutBookInput `json:"update,omitempty"`
+	Upsert     []ChapterUpsertWithWhereUniqueWithoutBookInput `json:"upsert,omitempty"`
+	DeleteMany []ChapterScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []ChapterUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type BookWhereUniqueInput struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type ChapterUpdateWithoutBookDataInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterWhereInput struct {
+	ID                       *string                    `json:"id,omitempty"`
+	IDNot                    *string                    `json:"id_not,omitempty"`
+	IDIn                     []string                   `json:"id_in,omitempty"`
+	IDNotIn                  []string                   `json:"id_not_in,omitempty"`
+	IDLt                     *string                    `json:"id_lt,omitempty"`
+	IDLte                    *string                    `json:"id_lte,omitempty"`
+	IDGt                     *string                    `json:"id_gt,omitempty"`
+	IDGte                    *string                    `json:"id_gte,omitempty"`
+	IDContains               *string                    `json:"id_contains,omitempty"`
+	IDNotContains            *string                    `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                    `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                    `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                    `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                    `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                    `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                    `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string                   `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string                   `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                    `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                    `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                    `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                    `json:"createdAt_gte,omitempty"`
+	UpdatedAt                *string                    `json:"updatedAt,omitempty"`
+	UpdatedAtNot             *string                    `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn              []string                   `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn           []string                   `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt              *string                    `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte             *string                    `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt              *string                    `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte             *string                    `json:"updatedAt_gte,omitempty"`
+	Name                     *string                    `json:"name,omitempty"`
+	NameNot                  *string                    `json:"name_not,omitempty"`
+	NameIn                   []string                   `json:"name_in,omitempty"`
+	NameNotIn                []string                   `json:"name_not_in,omitempty"`
+	NameLt                   *string                    `json:"name_lt,omitempty"`
+	NameLte                  *string                    `json:"name_lte,omitempty"`
+	NameGt                   *string                    `json:"name_gt,omitempty"`
+	NameGte                  *string                    `json:"name_gte,omitempty"`
+	NameContains             *string                    `json:"name_contains,omitempty"`
+	NameNotContains          *string                    `json:"name_not_contains,omitempty"`
+	NameStartsWith           *string                    `json:"name_starts_with,omitempty"`
+	NameNotStartsWith        *string                    `json:"name_not_starts_with,omitempty"`
+	NameEndsWith             *string                    `json:"name_ends_with,omitempty"`
+	NameNotEndsWith          *string                    `json:"name_not_ends_with,omitempty"`
+	Description              *string                    `json:"description,omitempty"`
+	DescriptionNot           *string                    `json:"description_not,omitempty"`
+	DescriptionIn            []string                   `json:"description_in,omitempty"`
+	DescriptionNotIn         []string                   `json:"description_not_in,omitempty"`
+	DescriptionLt            *string                    `json:"description_lt,omitempty"`
+	DescriptionLte           *string                    `json:"description_lte,omitempty"`
+	DescriptionGt            *string                    `json:"description_gt,omitempty"`
+	DescriptionGte           *string                    `json:"description_gte,omitempty"`
+	DescriptionContains      *string                    `json:"description_contains,omitempty"`
+	DescriptionNotContains   *string                    `json:"description_not_contains,omitempty"`
+	DescriptionStartsWith    *string                    `json:"description_starts_with,omitempty"`
+	DescriptionNotStartsWith *string                    `json:"description_not_starts_with,omitempty"`
+	DescriptionEndsWith      *string                    `json:"description_ends_with,omitempty"`
+	DescriptionNotEndsWith   *string                    `json:"description_not_ends_with,omitempty"`
+	Position                 *float64                   `json:"position,omitempty"`
+	PositionNot              *float64                   `json:"position_not,omitempty"`
+	PositionIn               []float64                  `json:"position_in,omitempty"`
+	PositionNotIn            []float64                  `json:"position_not_in,omitempty"`
+	PositionLt               *float64                   `json:"position_lt,omitempty"`
+	PositionLte              *float64                   `json:"position_lte,omitempty"`
+	PositionGt               *float64                   `json:"position_gt,omitempty"`
+	PositionGte              *float64                   `json:"position_gte,omitempty"`
+	Deleted                  *bool                      `json:"deleted,omitempty"`
+	DeletedNot               *bool                      `json:"deleted_not,omitempty"`
+	DeletedAt                *string                    `json:"deletedAt,omitempty"`
+	DeletedAtNot             *string                    `json:"deletedAt_not,omitempty"`
+	DeletedAtIn              []string                   `json:"deletedAt_in,omitempty"`
+	DeletedAtNotIn           []string                   `json:"deletedAt_not_in,omitempty"`
+	DeletedAtLt              *string                    `json:"deletedAt_lt,omitempty"`
+	DeletedAtLte             *string                    `json:"deletedAt_lte,omitempty"`
+	DeletedAtGt              *string                    `json:"deletedAt_gt,omitempty"`
+	DeletedAtGte             *string                    `json:"deletedAt_gte,omitempty"`
+	Revision                 *int32                     `json:"revision,omitempty"`
+	RevisionNot              *int32                     `json:"revision_not,omitempty"`
+	RevisionIn               []int32                    `json:"revision_in,omitempty"`
+	RevisionNotIn            []int32                    `json:"revision_not_in,omitempty"`
+	RevisionLt               *int32                     `json:"revision_lt,omitempty"`
+	RevisionLte              *int32                     `json:"revision_lte,omitempty"`
+	RevisionGt               *int32                     `json:"revision_gt,omitempty"`
+	RevisionGte              *int32                     `json:"revision_gte,omitempty"`
+	TagsEvery                *TagWhereInput             `json:"tags_every,omitempty"`
+	TagsSome                 *TagWhereInput             `json:"tags_some,omitempty"`
+	TagsNone                 *TagWhereInput             `json:"tags_none,omitempty"`
+	NotesEvery               *NoteWhereInput            `json:"notes_every,omitempty"`
+	NotesSome                *NoteWhereInput            `json:"notes_some,omitempty"`
+	NotesNone                *NoteWhereInput            `json:"notes_none,omitempty"`
+	CardsEvery               *CardWhereInput            `json:"cards_every,omitempty"`
+	CardsSome                *CardWhereInput            `json:"cards_some,omitempty"`
+	CardsNone                *CardWhereInput            `json:"cards_none,omitempty"`
+	ProgressEvery            *ReadingProgressWhereInput `json:"progress_every,omitempty"`
+	ProgressSome             *ReadingProgressWhereInput `json:"progress_some,omitempty"`
+	ProgressNone             *ReadingProgressWhereInput `json:"progress_none,omitempty"`
+	SessionsEvery            *ReadingSessionWhereInput  `json:"sessions_every,omitempty"`
+	SessionsSome             *ReadingSessionWhereInput  `json:"sessions_some,omitempty"`
+	SessionsNone             *ReadingSessionWhereInput  `json:"sessions_none,omitempty"`
+	RemindersEvery           *ReminderWhereInput        `json:"reminders_every,omitempty"`
+	RemindersSome            *ReminderWhereInput        `json:"reminders_some,omitempty"`
+	RemindersNone            *ReminderWhereInput        `json:"reminders_none,omitempty"`
+	RevisionsEvery           *RevisionWhereInput        `json:"revisions_every,omitempty"`
+	RevisionsSome            *RevisionWhereInput        `json:"revisions_some,omitempty"`
+	RevisionsNone            *RevisionWhereInput        `json:"revisions_none,omitempty"`
+	Book                     *BookWhereInput            `json:"book,omitempty"`
+	OutboxEvery              *OutboxEventWhereInput     `json:"outbox_every,omitempty"`
+	OutboxSome               *OutboxEventWhereInput     `json:"outbox_some,omitempty"`
+	OutboxNone               *OutboxEventWhereInput     `json:"outbox_none,omitempty"`
+	And                      []ChapterWhereInput        `json:"AND,omitempty"`
+	Or                       []ChapterWhereInput        `json:"OR,omitempty"`
+	Not                      []ChapterWhereInput        `json:"NOT,omitempty"`
+}
+
+type ChapterCreateInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput             `json:"book"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateManyWithWhereNestedInput struct {
+	Where ChapterScalarWhereInput    `json:"where"`
+	Data  ChapterUpdateManyDataInput `json:"data"`
+}
+
+type ChapterUpsertWithWhereUniqueWithoutBookInput struct {
+	Where  ChapterWhereUniqueInput           `json:"where"`
+	Update ChapterUpdateWithoutBookDataInput `json:"update"`
+	Create ChapterCreateWithoutBookInput     `json:"create"`
+}
+
+type BookSubscriptionWhereInput struct {
+	MutationIn                 []MutationType               `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                      `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                     `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                     `json:"updatedFields_contains_some,omitempty"`
+	Node                       *BookWhereInput              `json:"node,omitempty"`
+	And                        []BookSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []BookSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []BookSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type BookCreateInput struct {
+	ID          *string                                   `json:"id,omitempty"`
+	Name        string                                    `json:"name"`
+	Description string                                    `json:"description"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorCreateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagCreateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionCreateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionCreateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterCreateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookUpsertWithoutChaptersInput struct {
+	Update BookUpdateWithoutChaptersDataInput `json:"update"`
+	Create BookCreateWithoutChaptersInput     `json:"create"`
+}
+
+type ChapterCreateManyWithoutBookInput struct {
+	Create  []ChapterCreateWithoutBookInput `json:"create,omitempty"`
+	Connect []ChapterWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type BookUpdateOneRequiredWithoutChaptersInput struct {
+	Create  *BookCreateWithoutChaptersInput     `json:"create,omitempty"`
+	Update  *BookUpdateWithoutChaptersDataInput `json:"update,omitempty"`
+	Upsert  *BookUpsertWithoutChaptersInput     `json:"upsert,omitempty"`
+	Connect *BookWhereUniqueInput               `json:"connect,omitempty"`
+}
+
+type ChapterCreateWithoutBookInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterWhereUniqueInput struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type BookUpdateInput struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorUpdateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagUpdateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionUpdateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterUpdateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookCreateOneWithoutChaptersInput struct {
+	Create  *BookCreateWithoutChaptersInput `json:"create,omitempty"`
+	Connect *BookWhereUniqueInput           `json:"connect,omitempty"`
+}
+
+type BookUpdateManyMutationInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Isbn        *string `json:"isbn,omitempty"`
+	Publisher   *string `json:"publisher,omitempty"`
+	Year        *int32  `json:"year,omitempty"`
+	Language    *string `json:"language,omitempty"`
+	Deleted     *bool   `json:"deleted,omitempty"`
+	DeletedAt   *string `json:"deletedAt,omitempty"`
+	Revision    *int32  `json:"revision,omitempty"`
+}
+
+type ChapterUpdateManyMutationInput struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Position    *float64 `json:"position,omitempty"`
+	Deleted     *bool    `json:"deleted,omitempty"`
+	DeletedAt   *string  `json:"deletedAt,omitempty"`
+	Revision    *int32   `json:"revision,omitempty"`
+}
+
+type ChapterScalarWhereInput struct {
+	ID                       *string                   `json:"id,omitempty"`
+	IDNot                    *string                   `json:"id_not,omitempty"`
+	IDIn                     []string                  `json:"id_in,omitempty"`
+	IDNotIn                  []string                  `json:"id_not_in,omitempty"`
+	IDLt                     *string                   `json:"id_lt,omitempty"`
+	IDLte                    *string                   `json:"id_lte,omitempty"`
+	IDGt                     *string                   `json:"id_gt,omitempty"`
+	IDGte                    *string                   `json:"id_gte,omitempty"`
+	IDContains               *string                   `json:"id_contains,omitempty"`
+	IDNotContains            *string                   `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                   `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                   `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                   `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                   `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                   `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                   `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string                  `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string                  `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                   `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                   `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                   `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                   `json:"createdAt_gte,omitempty"`
+	UpdatedAt                *string                   `json:"updatedAt,omitempty"`
+	UpdatedAtNot             *string                   `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn              []string                  `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn           []string                  `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt              *string                   `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte             *string                   `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt              *string                   `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte             *string                   `json:"updatedAt_gte,omitempty"`
+	Name                     *string                   `json:"name,omitempty"`
+	NameNot                  *string                   `json:"name_not,omitempty"`
+	NameIn                   []string                  `json:"name_in,omitempty"`
+	NameNotIn                []string                  `json:"name_not_in,omitempty"`
+	NameLt                   *string                   `json:"name_lt,omitempty"`
+	NameLte                  *string                   `json:"name_lte,omitempty"`
+	NameGt                   *string                   `json:"name_gt,omitempty"`
+	NameGte                  *string                   `json:"name_gte,omitempty"`
+	NameContains             *string                   `json:"name_contains,omitempty"`
+	NameNotContains          *string                   `json:"name_not_contains,omitempty"`
+	NameStartsWith           *string                   `json:"name_starts_with,omitempty"`
+	NameNotStartsWith        *string                   `json:"name_not_starts_with,omitempty"`
+	NameEndsWith             *string                   `json:"name_ends_with,omitempty"`
+	NameNotEndsWith          *string                   `json:"name_not_ends_with,omitempty"`
+	Description              *string                   `json:"description,omitempty"`
+	DescriptionNot           *string                   `json:"description_not,omitempty"`
+	DescriptionIn            []string                  `json:"description_in,omitempty"`
+	DescriptionNotIn         []string                  `json:"description_not_in,omitempty"`
+	DescriptionLt            *string                   `json:"description_lt,omitempty"`
+	DescriptionLte           *string                   `json:"description_lte,omitempty"`
+	DescriptionGt            *string                   `json:"description_gt,omitempty"`
+	DescriptionGte           *string                   `json:"description_gte,omitempty"`
+	DescriptionContains      *string                   `json:"description_contains,omitempty"`
+	DescriptionNotContains   *string                   `json:"description_not_contains,omitempty"`
+	DescriptionStartsWith    *string                   `json:"description_starts_with,omitempty"`
+	DescriptionNotStartsWith *string                   `json:"description_not_starts_with,omitempty"`
+	DescriptionEndsWith      *string                   `json:"description_ends_with,omitempty"`
+	DescriptionNotEndsWith   *string                   `json:"description_not_ends_with,omitempty"`
+	Position                 *float64                  `json:"position,omitempty"`
+	PositionNot              *float64                  `json:"position_not,omitempty"`
+	PositionIn               []float64                 `json:"position_in,omitempty"`
+	PositionNotIn            []float64                 `json:"position_not_in,omitempty"`
+	PositionLt               *float64                  `json:"position_lt,omitempty"`
+	PositionLte              *float64                  `json:"position_lte,omitempty"`
+	PositionGt               *float64                  `json:"position_gt,omitempty"`
+	PositionGte              *float64                  `json:"position_gte,omitempty"`
+	Deleted                  *bool                     `json:"deleted,omitempty"`
+	DeletedNot               *bool                     `json:"deleted_not,omitempty"`
+	DeletedAt                *string                   `json:"deletedAt,omitempty"`
+	DeletedAtNot             *string                   `json:"deletedAt_not,omitempty"`
+	DeletedAtIn              []string                  `json:"deletedAt_in,omitempty"`
+	DeletedAtNotIn           []string                  `json:"deletedAt_not_in,omitempty"`
+	DeletedAtLt              *string                   `json:"deletedAt_lt,omitempty"`
+	DeletedAtLte             *string                   `json:"deletedAt_lte,omitempty"`
+	DeletedAtGt              *string                   `json:"deletedAt_gt,omitempty"`
+	DeletedAtGte             *string                   `json:"deletedAt_gte,omitempty"`
+	Revision                 *int32                    `json:"revision,omitempty"`
+	RevisionNot              *int32                    `json:"revision_not,omitempty"`
+	RevisionIn               []int32                   `json:"revision_in,omitempty"`
+	RevisionNotIn            []int32                   `json:"revision_not_in,omitempty"`
+	RevisionLt               *int32                    `json:"revision_lt,omitempty"`
+	RevisionLte              *int32                    `json:"revision_lte,omitempty"`
+	RevisionGt               *int32                    `json:"revision_gt,omitempty"`
+	RevisionGte              *int32                    `json:"revision_gte,omitempty"`
+	And                      []ChapterScalarWhereInput `json:"AND,omitempty"`
+	Or                       []ChapterScalarWhereInput `json:"OR,omitempty"`
+	Not                      []ChapterScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type ChapterUpdateManyDataInput struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Position    *float64 `json:"position,omitempty"`
+	Deleted     *bool    `json:"deleted,omitempty"`
+	DeletedAt   *string  `json:"deletedAt,omitempty"`
+	Revision    *int32   `json:"revision,omitempty"`
+}
+
+type BookWhereInput struct {
+	ID                       *string                   `json:"id,omitempty"`
+	IDNot                    *string                   `json:"id_not,omitempty"`
+	IDIn                     []string                  `json:"id_in,omitempty"`
+	IDNotIn                  []string                  `json:"id_not_in,omitempty"`
+	IDLt                     *string                   `json:"id_lt,omitempty"`
+	IDLte                    *string                   `json:"id_lte,omitempty"`
+	IDGt                     *string                   `json:"id_gt,omitempty"`
+	IDGte                    *string                   `json:"id_gte,omitempty"`
+	IDContains               *string                   `json:"id_contains,omitempty"`
+	IDNotContains            *string                   `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                   `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                   `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                   `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                   `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                   `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                   `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string                  `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string                  `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                   `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                   `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                   `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                   `json:"createdAt_gte,omitempty"`
+	UpdatedAt                *string                   `json:"updatedAt,omitempty"`
+	UpdatedAtNot             *string                   `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn              []string                  `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn           []string                  `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt              *string                   `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte             *string                   `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt              *string                   `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte             *string                   `json:"updatedAt_gte,omitempty"`
+	Name                     *string                   `json:"name,omitempty"`
+	NameNot                  *string                   `json:"name_not,omitempty"`
+	NameIn                   []string                  `json:"name_in,omitempty"`
+	NameNotIn                []string                  `json:"name_not_in,omitempty"`
+	NameLt                   *string                   `json:"name_lt,omitempty"`
+	NameLte                  *string                   `json:"name_lte,omitempty"`
+	NameGt                   *string                   `json:"name_gt,omitempty"`
+	NameGte                  *string                   `json:"name_gte,omitempty"`
+	NameContains             *string                   `json:"name_contains,omitempty"`
+	NameNotContains          *string                   `json:"name_not_contains,omitempty"`
+	NameStartsWith           *string                   `json:"name_starts_with,omitempty"`
+	NameNotStartsWith        *string                   `json:"name_not_starts_with,omitempty"`
+	NameEndsWith             *string                   `json:"name_ends_with,omitempty"`
+	NameNotEndsWith          *string                   `json:"name_not_ends_with,omitempty"`
+	Description              *string                   `json:"description,omitempty"`
+	DescriptionNot           *string                   `json:"description_not,omitempty"`
+	DescriptionIn            []string                  `json:"description_in,omitempty"`
+	DescriptionNotIn         []string                  `json:"description_not_in,omitempty"`
+	DescriptionLt            *string                   `json:"description_lt,omitempty"`
+	DescriptionLte           *string                   `json:"description_lte,omitempty"`
+	DescriptionGt            *string                   `json:"description_gt,omitempty"`
+	DescriptionGte           *string                   `json:"description_gte,omitempty"`
+	DescriptionContains      *string                   `json:"description_contains,omitempty"`
+	DescriptionNotContains   *string                   `json:"description_not_contains,omitempty"`
+	DescriptionStartsWith    *string                   `json:"description_starts_with,omitempty"`
+	DescriptionNotStartsWith *string                   `json:"description_not_starts_with,omitempty"`
+	DescriptionEndsWith      *string                   `json:"description_ends_with,omitempty"`
+	DescriptionNotEndsWith   *string                   `json:"description_not_ends_with,omitempty"`
+	Isbn                     *string                   `json:"isbn,omitempty"`
+	IsbnNot                  *string                   `json:"isbn_not,omitempty"`
+	IsbnIn                   []string                  `json:"isbn_in,omitempty"`
+	IsbnNotIn                []string                  `json:"isbn_not_in,omitempty"`
+	IsbnLt                   *string                   `json:"isbn_lt,omitempty"`
+	IsbnLte                  *string                   `json:"isbn_lte,omitempty"`
+	IsbnGt                   *string                   `json:"isbn_gt,omitempty"`
+	IsbnGte                  *string                   `json:"isbn_gte,omitempty"`
+	IsbnContains             *string                   `json:"isbn_contains,omitempty"`
+	IsbnNotContains          *string                   `json:"isbn_not_contains,omitempty"`
+	IsbnStartsWith           *string                   `json:"isbn_starts_with,omitempty"`
+	IsbnNotStartsWith        *string                   `json:"isbn_not_starts_with,omitempty"`
+	IsbnEndsWith             *string                   `json:"isbn_ends_with,omitempty"`
+	IsbnNotEndsWith          *string                   `json:"isbn_not_ends_with,omitempty"`
+	Publisher                *string                   `json:"publisher,omitempty"`
+	PublisherNot             *string                   `json:"publisher_not,omitempty"`
+	PublisherIn              []string                  `json:"publisher_in,omitempty"`
+	PublisherNotIn           []string                  `json:"publisher_not_in,omitempty"`
+	PublisherLt              *string                   `json:"publisher_lt,omitempty"`
+	PublisherLte             *string                   `json:"publisher_lte,omitempty"`
+	PublisherGt              *string                   `json:"publisher_gt,omitempty"`
+	PublisherGte             *string                   `json:"publisher_gte,omitempty"`
+	PublisherContains        *string                   `json:"publisher_contains,omitempty"`
+	PublisherNotContains     *string                   `json:"publisher_not_contains,omitempty"`
+	PublisherStartsWith      *string                   `json:"publisher_starts_with,omitempty"`
+	PublisherNotStartsWith   *string                   `json:"publisher_not_starts_with,omitempty"`
+	PublisherEndsWith        *string                   `json:"publisher_ends_with,omitempty"`
+	PublisherNotEndsWith     *string                   `json:"publisher_not_ends_with,omitempty"`
+	Year                     *int32                    `json:"year,omitempty"`
+	YearNot                  *int32                    `json:"year_not,omitempty"`
+	YearIn                   []int32                   `json:"year_in,omitempty"`
+	YearNotIn                []int32                   `json:"year_not_in,omitempty"`
+	YearLt                   *int32                    `json:"year_lt,omitempty"`
+	YearLte                  *int32                    `json:"year_lte,omitempty"`
+	YearGt                   *int32                    `json:"year_gt,omitempty"`
+	YearGte                  *int32                    `json:"year_gte,omitempty"`
+	Language                 *string                   `json:"language,omitempty"`
+	LanguageNot              *string                   `json:"language_not,omitempty"`
+	LanguageIn               []string                  `json:"language_in,omitempty"`
+	LanguageNotIn            []string                  `json:"language_not_in,omitempty"`
+	LanguageLt               *string                   `json:"language_lt,omitempty"`
+	LanguageLte              *string                   `json:"language_lte,omitempty"`
+	LanguageGt               *string                   `json:"language_gt,omitempty"`
+	LanguageGte              *string                   `json:"language_gte,omitempty"`
+	LanguageContains         *string                   `json:"language_contains,omitempty"`
+	LanguageNotContains      *string                   `json:"language_not_contains,omitempty"`
+	LanguageStartsWith       *string                   `json:"language_starts_with,omitempty"`
+	LanguageNotStartsWith    *string                   `json:"language_not_starts_with,omitempty"`
+	LanguageEndsWith         *string                   `json:"language_ends_with,omitempty"`
+	LanguageNotEndsWith      *string                   `json:"language_not_ends_with,omitempty"`
+	Deleted                  *bool                     `json:"deleted,omitempty"`
+	DeletedNot               *bool                     `json:"deleted_not,omitempty"`
+	DeletedAt                *string                   `json:"deletedAt,omitempty"`
+	DeletedAtNot             *string                   `json:"deletedAt_not,omitempty"`
+	DeletedAtIn              []string                  `json:"deletedAt_in,omitempty"`
+	DeletedAtNotIn           []string                  `json:"deletedAt_not_in,omitempty"`
+	DeletedAtLt              *string                   `json:"deletedAt_lt,omitempty"`
+	DeletedAtLte             *string                   `json:"deletedAt_lte,omitempty"`
+	DeletedAtGt              *string                   `json:"deletedAt_gt,omitempty"`
+	DeletedAtGte             *string                   `json:"deletedAt_gte,omitempty"`
+	Revision                 *int32                    `json:"revision,omitempty"`
+	RevisionNot              *int32                    `json:"revision_not,omitempty"`
+	RevisionIn               []int32                   `json:"revision_in,omitempty"`
+	RevisionNotIn            []int32                   `json:"revision_not_in,omitempty"`
+	RevisionLt               *int32                    `json:"revision_lt,omitempty"`
+	RevisionLte              *int32                    `json:"revision_lte,omitempty"`
+	RevisionGt               *int32                    `json:"revision_gt,omitempty"`
+	RevisionGte              *int32                    `json:"revision_gte,omitempty"`
+	AuthorsEvery             *AuthorWhereInput         `json:"authors_every,omitempty"`
+	AuthorsSome              *AuthorWhereInput         `json:"authors_some,omitempty"`
+	AuthorsNone              *AuthorWhereInput         `json:"authors_none,omitempty"`
+	TagsEvery                *TagWhereInput            `json:"tags_every,omitempty"`
+	TagsSome                 *TagWhereInput            `json:"tags_some,omitempty"`
+	TagsNone                 *TagWhereInput            `json:"tags_none,omitempty"`
+	CollectionsEvery         *CollectionWhereInput     `json:"collections_every,omitempty"`
+	CollectionsSome          *CollectionWhereInput     `json:"collections_some,omitempty"`
+	CollectionsNone          *CollectionWhereInput     `json:"collections_none,omitempty"`
+	RevisionsEvery           *RevisionWhereInput       `json:"revisions_every,omitempty"`
+	RevisionsSome            *RevisionWhereInput       `json:"revisions_some,omitempty"`
+	RevisionsNone            *RevisionWhereInput       `json:"revisions_none,omitempty"`
+	ChaptersEvery            *ChapterWhereInput        `json:"chapters_every,omitempty"`
+	ChaptersSome             *ChapterWhereInput        `json:"chapters_some,omitempty"`
+	ChaptersNone             *ChapterWhereInput        `json:"chapters_none,omitempty"`
+	SessionsEvery            *ReadingSessionWhereInput `json:"sessions_every,omitempty"`
+	SessionsSome             *ReadingSessionWhereInput `json:"sessions_some,omitempty"`
+	SessionsNone             *ReadingSessionWhereInput `json:"sessions_none,omitempty"`
+	RemindersEvery           *ReminderWhereInput       `json:"reminders_every,omitempty"`
+	RemindersSome            *ReminderWhereInput       `json:"reminders_some,omitempty"`
+	RemindersNone            *ReminderWhereInput       `json:"reminders_none,omitempty"`
+	OutboxEvery              *OutboxEventWhereInput    `json:"outbox_every,omitempty"`
+	OutboxSome               *OutboxEventWhereInput    `json:"outbox_some,omitempty"`
+	OutboxNone               *OutboxEventWhereInput    `json:"outbox_none,omitempty"`
+	And                      []BookWhereInput          `json:"AND,omitempty"`
+	Or                       []BookWhereInput          `json:"OR,omitempty"`
+	Not                      []BookWhereInput          `json:"NOT,omitempty"`
+}
+
+type ChapterUpdateWithWhereUniqueWithoutBookInput struct {
+	Where ChapterWhereUniqueInput           `json:"where"`
+	Data  ChapterUpdateWithoutBookDataInput `json:"data"`
+}
+
+type BookUpdateWithoutChaptersDataInput struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorUpdateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagUpdateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionUpdateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type ChapterSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                  `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                         `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                        `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                        `json:"updatedFields_contains_some,omitempty"`
+	Node                       *ChapterWhereInput              `json:"node,omitempty"`
+	And                        []ChapterSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []ChapterSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []ChapterSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type BookCreateWithoutChaptersInput struct {
+	ID          *string                                   `json:"id,omitempty"`
+	Name        string                                    `json:"name"`
+	Description string                                    `json:"description"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorCreateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagCreateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionCreateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionCreateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput    `json:"book,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type WebhookWhereUniqueInput struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type WebhookWhereInput struct {
+	ID                  *string                    `json:"id,omitempty"`
+	IDNot               *string                    `json:"id_not,omitempty"`
+	IDIn                []string                   `json:"id_in,omitempty"`
+	IDNotIn             []string                   `json:"id_not_in,omitempty"`
+	IDLt                *string                    `json:"id_lt,omitempty"`
+	IDLte               *string                    `json:"id_lte,omitempty"`
+	IDGt                *string                    `json:"id_gt,omitempty"`
+	IDGte               *string                    `json:"id_gte,omitempty"`
+	IDContains          *string                    `json:"id_contains,omitempty"`
+	IDNotContains       *string                    `json:"id_not_contains,omitempty"`
+	IDStartsWith        *string                    `json:"id_starts_with,omitempty"`
+	IDNotStartsWith     *string                    `json:"id_not_starts_with,omitempty"`
+	IDEndsWith          *string                    `json:"id_ends_with,omitempty"`
+	IDNotEndsWith       *string                    `json:"id_not_ends_with,omitempty"`
+	CreatedAt           *string                    `json:"createdAt,omitempty"`
+	CreatedAtNot        *string                    `json:"createdAt_not,omitempty"`
+	CreatedAtIn         []string                   `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn      []string                   `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt         *string                    `json:"createdAt_lt,omitempty"`
+	CreatedAtLte        *string                    `json:"createdAt_lte,omitempty"`
+	CreatedAtGt         *string                    `json:"createdAt_gt,omitempty"`
+	CreatedAtGte        *string                    `json:"createdAt_gte,omitempty"`
+	UpdatedAt           *string                    `json:"updatedAt,omitempty"`
+	UpdatedAtNot        *string                    `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn         []string                   `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn      []string                   `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt         *string                    `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte        *string                    `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt         *string                    `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte        *string                    `json:"updatedAt_gte,omitempty"`
+	URL                 *string                    `json:"url,omitempty"`
+	URLNot              *string                    `json:"url_not,omitempty"`
+	URLIn               []string                   `json:"url_in,omitempty"`
+	URLNotIn            []string                   `json:"url_not_in,omitempty"`
+	URLLt               *string                    `json:"url_lt,omitempty"`
+	URLLte              *string                    `json:"url_lte,omitempty"`
+	URLGt               *string                    `json:"url_gt,omitempty"`
+	URLGte              *string                    `json:"url_gte,omitempty"`
+	URLContains         *string                    `json:"url_contains,omitempty"`
+	URLNotContains      *string                    `json:"url_not_contains,omitempty"`
+	URLStartsWith       *string                    `json:"url_starts_with,omitempty"`
+	URLNotStartsWith    *string                    `json:"url_not_starts_with,omitempty"`
+	URLEndsWith         *string                    `json:"url_ends_with,omitempty"`
+	URLNotEndsWith      *string                    `json:"url_not_ends_with,omitempty"`
+	Secret              *string                    `json:"secret,omitempty"`
+	SecretNot           *string                    `json:"secret_not,omitempty"`
+	SecretIn            []string                   `json:"secret_in,omitempty"`
+	SecretNotIn         []string                   `json:"secret_not_in,omitempty"`
+	SecretLt            *string                    `json:"secret_lt,omitempty"`
+	SecretLte           *string                    `json:"secret_lte,omitempty"`
+	SecretGt            *string                    `json:"secret_gt,omitempty"`
+	SecretGte           *string                    `json:"secret_gte,omitempty"`
+	SecretContains      *string                    `json:"secret_contains,omitempty"`
+	SecretNotContains   *string                    `json:"secret_not_contains,omitempty"`
+	SecretStartsWith    *string                    `json:"secret_starts_with,omitempty"`
+	SecretNotStartsWith *string                    `json:"secret_not_starts_with,omitempty"`
+	SecretEndsWith      *string                    `json:"secret_ends_with,omitempty"`
+	SecretNotEndsWith   *string                    `json:"secret_not_ends_with,omitempty"`
+	Events              *string                    `json:"events,omitempty"`
+	EventsNot           *string                    `json:"events_not,omitempty"`
+	EventsIn            []string                   `json:"events_in,omitempty"`
+	EventsNotIn         []string                   `json:"events_not_in,omitempty"`
+	EventsLt            *string                    `json:"events_lt,omitempty"`
+	EventsLte           *string                    `json:"events_lte,omitempty"`
+	EventsGt            *string                    `json:"events_gt,omitempty"`
+	EventsGte           *string                    `json:"events_gte,omitempty"`
+	EventsContains      *string                    `json:"events_contains,omitempty"`
+	EventsNotContains   *string                    `json:"events_not_contains,omitempty"`
+	EventsStartsWith    *string                    `json:"events_starts_with,omitempty"`
+	EventsNotStartsWith *string                    `json:"events_not_starts_with,omitempty"`
+	EventsEndsWith      *string                    `json:"events_ends_with,omitempty"`
+	EventsNotEndsWith   *string                    `json:"events_not_ends_with,omitempty"`
+	DeliveriesEvery     *WebhookDeliveryWhereInput `json:"deliveries_every,omitempty"`
+	DeliveriesSome      *WebhookDeliveryWhereInput `json:"deliveries_some,omitempty"`
+	DeliveriesNone      *WebhookDeliveryWhereInput `json:"deliveries_none,omitempty"`
+	And                 []WebhookWhereInput        `json:"AND,omitempty"`
+	Or                  []WebhookWhereInput        `json:"OR,omitempty"`
+	Not                 []WebhookWhereInput        `json:"NOT,omitempty"`
+}
+
+type WebhookCreateInput struct {
+	ID         *string                                       `json:"id,omitempty"`
+	URL        string                                        `json:"url"`
+	Secret     string                                        `json:"secret"`
+	Events     string                                        `json:"events"`
+	Deliveries *WebhookDeliveryCreateManyWithoutWebhookInput `json:"deliveries,omitempty"`
+}
+
+type WebhookUpdateInput struct {
+	URL        *string                                       `json:"url,omitempty"`
+	Secret     *string                                       `json:"secret,omitempty"`
+	Events     *string                                       `json:"events,omitempty"`
+	Deliveries *WebhookDeliveryUpdateManyWithoutWebhookInput `json:"deliveries,omitempty"`
+}
+
+type WebhookUpdateManyMutationInput struct {
+	URL    *string `json:"url,omitempty"`
+	Secret *string `json:"secret,omitempty"`
+	Events *string `json:"events,omitempty"`
+}
+
+type WebhookSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                  `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                         `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                        `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                        `json:"updatedFields_contains_some,omitempty"`
+	Node                       *WebhookWhereInput              `json:"node,omitempty"`
+	And                        []WebhookSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []WebhookSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []WebhookSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type WebhookDeliveryWhereUniqueInput struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type WebhookDeliveryWhereInput struct {
+	ID                     *string                     `json:"id,omitempty"`
+	IDNot                  *string                     `json:"id_not,omitempty"`
+	IDIn                   []string                    `json:"id_in,omitempty"`
+	IDNotIn                []string                    `json:"id_not_in,omitempty"`
+	IDLt                   *string                     `json:"id_lt,omitempty"`
+	IDLte                  *string                     `json:"id_lte,omitempty"`
+	IDGt                   *string                     `json:"id_gt,omitempty"`
+	IDGte                  *string                     `json:"id_gte,omitempty"`
+	IDContains             *string                     `json:"id_contains,omitempty"`
+	IDNotContains          *string                     `json:"id_not_contains,omitempty"`
+	IDStartsWith           *string                     `json:"id_starts_with,omitempty"`
+	IDNotStartsWith        *string                     `json:"id_not_starts_with,omitempty"`
+	IDEndsWith             *string                     `json:"id_ends_with,omitempty"`
+	IDNotEndsWith          *string                     `json:"id_not_ends_with,omitempty"`
+	CreatedAt              *string                     `json:"createdAt,omitempty"`
+	CreatedAtNot           *string                     `json:"createdAt_not,omitempty"`
+	CreatedAtIn            []string                    `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn         []string                    `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt            *string                     `json:"createdAt_lt,omitempty"`
+	CreatedAtLte           *string                     `json:"createdAt_lte,omitempty"`
+	CreatedAtGt            *string                     `json:"createdAt_gt,omitempty"`
+	CreatedAtGte           *string                     `json:"createdAt_gte,omitempty"`
+	UpdatedAt              *string                     `json:"updatedAt,omitempty"`
+	UpdatedAtNot           *string                     `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn            []string                    `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn         []string                    `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt            *string                     `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte           *string                     `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt            *string                     `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte           *string                     `json:"updatedAt_gte,omitempty"`
+	Event                  *string                     `json:"event,omitempty"`
+	EventNot               *string                     `json:"event_not,omitempty"`
+	EventIn                []string                    `json:"event_in,omitempty"`
+	EventNotIn             []string                    `json:"event_not_in,omitempty"`
+	EventLt                *string                     `json:"event_lt,omitempty"`
+	EventLte               *string                     `json:"event_lte,omitempty"`
+	EventGt                *string                     `json:"event_gt,omitempty"`
+	EventGte               *string                     `json:"event_gte,omitempty"`
+	EventContains          *string                     `json:"event_contains,omitempty"`
+	EventNotContains       *string                     `json:"event_not_contains,omitempty"`
+	EventStartsWith        *string                     `json:"event_starts_with,omitempty"`
+	EventNotStartsWith     *string                     `json:"event_not_starts_with,omitempty"`
+	EventEndsWith          *string                     `json:"event_ends_with,omitempty"`
+	EventNotEndsWith       *string                     `json:"event_not_ends_with,omitempty"`
+	Payload                *string                     `json:"payload,omitempty"`
+	PayloadNot             *string                     `json:"payload_not,omitempty"`
+	PayloadIn              []string                    `json:"payload_in,omitempty"`
+	PayloadNotIn           []string                    `json:"payload_not_in,omitempty"`
+	PayloadLt              *string                     `json:"payload_lt,omitempty"`
+	PayloadLte             *string                     `json:"payload_lte,omitempty"`
+	PayloadGt              *string                     `json:"payload_gt,omitempty"`
+	PayloadGte             *string                     `json:"payload_gte,omitempty"`
+	PayloadContains        *string                     `json:"payload_contains,omitempty"`
+	PayloadNotContains     *string                     `json:"payload_not_contains,omitempty"`
+	PayloadStartsWith      *string                     `json:"payload_starts_with,omitempty"`
+	PayloadNotStartsWith   *string                     `json:"payload_not_starts_with,omitempty"`
+	PayloadEndsWith        *string                     `json:"payload_ends_with,omitempty"`
+	PayloadNotEndsWith     *string                     `json:"payload_not_ends_with,omitempty"`
+	Status                 *DeliveryStatus             `json:"status,omitempty"`
+	StatusNot              *DeliveryStatus             `json:"status_not,omitempty"`
+	StatusIn               []DeliveryStatus            `json:"status_in,omitempty"`
+	StatusNotIn            []DeliveryStatus            `json:"status_not_in,omitempty"`
+	Attempts               *int32                      `json:"attempts,omitempty"`
+	AttemptsNot            *int32                      `json:"attempts_not,omitempty"`
+	AttemptsIn             []int32                     `json:"attempts_in,omitempty"`
+	AttemptsNotIn          []int32                     `json:"attempts_not_in,omitempty"`
+	AttemptsLt             *int32                      `json:"attempts_lt,omitempty"`
+	AttemptsLte            *int32                      `json:"attempts_lte,omitempty"`
+	AttemptsGt             *int32                      `json:"attempts_gt,omitempty"`
+	AttemptsGte            *int32                      `json:"attempts_gte,omitempty"`
+	NextAttemptAt          *string                     `json:"nextAttemptAt,omitempty"`
+	NextAttemptAtNot       *string                     `json:"nextAttemptAt_not,omitempty"`
+	NextAttemptAtIn        []string                    `json:"nextAttemptAt_in,omitempty"`
+	NextAttemptAtNotIn     []string                    `json:"nextAttemptAt_not_in,omitempty"`
+	NextAttemptAtLt        *string                     `json:"nextAttemptAt_lt,omitempty"`
+	NextAttemptAtLte       *string                     `json:"nextAttemptAt_lte,omitempty"`
+	NextAttemptAtGt        *string                     `json:"nextAttemptAt_gt,omitempty"`
+	NextAttemptAtGte       *string                     `json:"nextAttemptAt_gte,omitempty"`
+	LastStatusCode         *int32                      `json:"lastStatusCode,omitempty"`
+	LastStatusCodeNot      *int32                      `json:"lastStatusCode_not,omitempty"`
+	LastStatusCodeIn       []int32                     `json:"lastStatusCode_in,omitempty"`
+	LastStatusCodeNotIn    []int32                     `json:"lastStatusCode_not_in,omitempty"`
+	LastStatusCodeLt       *int32                      `json:"lastStatusCode_lt,omitempty"`
+	LastStatusCodeLte      *int32                      `json:"lastStatusCode_lte,omitempty"`
+	LastStatusCodeGt       *int32                      `json:"lastStatusCode_gt,omitempty"`
+	LastStatusCodeGte      *int32                      `json:"lastStatusCode_gte,omitempty"`
+	LastError              *string                     `json:"lastError,omitempty"`
+	LastErrorNot           *string                     `json:"lastError_not,omitempty"`
+	LastErrorIn            []string                    `json:"lastError_in,omitempty"`
+	LastErrorNotIn         []string                    `json:"lastError_not_in,omitempty"`
+	LastErrorLt            *string                     `json:"lastError_lt,omitempty"`
+	LastErrorLte           *string                     `json:"lastError_lte,omitempty"`
+	LastErrorGt            *string                     `json:"lastError_gt,omitempty"`
+	LastErrorGte           *string                     `json:"lastError_gte,omitempty"`
+	LastErrorContains      *string                     `json:"lastError_contains,omitempty"`
+	LastErrorNotContains   *string                     `json:"lastError_not_contains,omitempty"`
+	LastErrorStartsWith    *string                     `json:"lastError_starts_with,omitempty"`
+	LastErrorNotStartsWith *string                     `json:"lastError_not_starts_with,omitempty"`
+	LastErrorEndsWith      *string                     `json:"lastError_ends_with,omitempty"`
+	LastErrorNotEndsWith   *string                     `json:"lastError_not_ends_with,omitempty"`
+	Webhook                *WebhookWhereInput          `json:"webhook,omitempty"`
+	And                    []WebhookDeliveryWhereInput `json:"AND,omitempty"`
+	Or                     []WebhookDeliveryWhereInput `json:"OR,omitempty"`
+	Not                    []WebhookDeliveryWhereInput `json:"NOT,omitempty"`
+}
+
+type WebhookDeliveryCreateInput struct {
+	ID             *string                                `json:"id,omitempty"`
+	Event          string                                 `json:"event"`
+	Payload        string                                 `json:"payload"`
+	Status         *DeliveryStatus                        `json:"status,omitempty"`
+	Attempts       *int32                                 `json:"attempts,omitempty"`
+	NextAttemptAt  *string                                `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int32                                 `json:"lastStatusCode,omitempty"`
+	LastError      *string                                `json:"lastError,omitempty"`
+	Webhook        WebhookCreateOneWithoutDeliveriesInput `json:"webhook"`
+}
+
+type WebhookDeliveryUpdateInput struct {
+	Event          *string                                         `json:"event,omitempty"`
+	Payload        *string                                         `json:"payload,omitempty"`
+	Status         *DeliveryStatus                                 `json:"status,omitempty"`
+	Attempts       *int32                                          `json:"attempts,omitempty"`
+	NextAttemptAt  *string                                         `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int32                                          `json:"lastStatusCode,omitempty"`
+	LastError      *string                                         `json:"lastError,omitempty"`
+	Webhook        *WebhookUpdateOneRequiredWithoutDeliveriesInput `json:"webhook,omitempty"`
+}
+
+type WebhookDeliveryUpdateManyMutationInput struct {
+	Event          *string         `json:"event,omitempty"`
+	Payload        *string         `json:"payload,omitempty"`
+	Status         *DeliveryStatus `json:"status,omitempty"`
+	Attempts       *int32          `json:"attempts,omitempty"`
+	NextAttemptAt  *string         `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int32          `json:"lastStatusCode,omitempty"`
+	LastError      *string         `json:"lastError,omitempty"`
+}
+
+type WebhookDeliverySubscriptionWhereInput struct {
+	MutationIn                 []MutationType                          `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                                 `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                                `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                                `json:"updatedFields_contains_some,omitempty"`
+	Node                       *WebhookDeliveryWhereInput              `json:"node,omitempty"`
+	And                        []WebhookDeliverySubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []WebhookDeliverySubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []WebhookDeliverySubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type WebhookDeliveryCreateWithoutWebhookInput struct {
+	ID             *string         `json:"id,omitempty"`
+	Event          string          `json:"event"`
+	Payload        string          `json:"payload"`
+	Status         *DeliveryStatus `json:"status,omitempty"`
+	Attempts       *int32          `json:"attempts,omitempty"`
+	NextAttemptAt  *string         `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int32          `json:"lastStatusCode,omitempty"`
+	LastError      *string         `json:"lastError,omitempty"`
+}
+
+type WebhookDeliveryCreateManyWithoutWebhookInput struct {
+	Create  []WebhookDeliveryCreateWithoutWebhookInput `json:"create,omitempty"`
+	Connect []WebhookDeliveryWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type WebhookDeliveryUpdateWithoutWebhookDataInput struct {
+	Event          *string         `json:"event,omitempty"`
+	Payload        *string         `json:"payload,omitempty"`
+	Status         *DeliveryStatus `json:"status,omitempty"`
+	Attempts       *int32          `json:"attempts,omitempty"`
+	NextAttemptAt  *string         `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int32          `json:"lastStatusCode,omitempty"`
+	LastError      *string         `json:"lastError,omitempty"`
+}
+
+type WebhookDeliveryUpdateManyWithoutWebhookInput struct {
+	Create     []WebhookDeliveryCreateWithoutWebhookInput                `json:"create,omitempty"`
+	Delete     []WebhookDeliveryWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []WebhookDeliveryWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []WebhookDeliveryWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []WebhookDeliveryWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []WebhookDeliveryUpdateWithWhereUniqueWithoutWebhookInput `json:"update,omitempty"`
+	Upsert     []WebhookDeliveryUpsertWithWhereUniqueWithoutWebhookInput `json:"upsert,omitempty"`
+	DeleteMany []WebhookDeliveryScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []WebhookDeliveryUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type WebhookDeliveryUpdateWithWhereUniqueWithoutWebhookInput struct {
+	Where WebhookDeliveryWhereUniqueInput              `json:"where"`
+	Data  WebhookDeliveryUpdateWithoutWebhookDataInput `json:"data"`
+}
+
+type WebhookDeliveryUpsertWithWhereUniqueWithoutWebhookInput struct {
+	Where  WebhookDeliveryWhereUniqueInput              `json:"where"`
+	Update WebhookDeliveryUpdateWithoutWebhookDataInput `json:"update"`
+	Create WebhookDeliveryCreateWithoutWebhookInput     `json:"create"`
+}
+
+type WebhookDeliveryScalarWhereInput struct {
+	ID                     *string                           `json:"id,omitempty"`
+	IDNot                  *string                           `json:"id_not,omitempty"`
+	IDIn                   []string                          `json:"id_in,omitempty"`
+	IDNotIn                []string                          `json:"id_not_in,omitempty"`
+	IDLt                   *string                           `json:"id_lt,omitempty"`
+	IDLte                  *string                           `json:"id_lte,omitempty"`
+	IDGt                   *string                           `json:"id_gt,omitempty"`
+	IDGte                  *string                           `json:"id_gte,omitempty"`
+	IDContains             *string                           `json:"id_contains,omitempty"`
+	IDNotContains          *string                           `json:"id_not_contains,omitempty"`
+	IDStartsWith           *string                           `json:"id_starts_with,omitempty"`
+	IDNotStartsWith        *string                           `json:"id_not_starts_with,omitempty"`
+	IDEndsWith             *string                           `json:"id_ends_with,omitempty"`
+	IDNotEndsWith          *string                           `json:"id_not_ends_with,omitempty"`
+	CreatedAt              *string                           `json:"createdAt,omitempty"`
+	CreatedAtNot           *string                           `json:"createdAt_not,omitempty"`
+	CreatedAtIn            []string                          `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn         []string                          `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt            *string                           `json:"createdAt_lt,omitempty"`
+	CreatedAtLte           *string                           `json:"createdAt_lte,omitempty"`
+	CreatedAtGt            *string                           `json:"createdAt_gt,omitempty"`
+	CreatedAtGte           *string                           `json:"createdAt_gte,omitempty"`
+	UpdatedAt              *string                           `json:"updatedAt,omitempty"`
+	UpdatedAtNot           *string                           `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn            []string                          `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn         []string                          `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt            *string                           `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte           *string                           `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt            *string                           `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte           *string                           `json:"updatedAt_gte,omitempty"`
+	Event                  *string                           `json:"event,omitempty"`
+	EventNot               *string                           `json:"event_not,omitempty"`
+	EventIn                []string                          `json:"event_in,omitempty"`
+	EventNotIn             []string                          `json:"event_not_in,omitempty"`
+	EventLt                *string                           `json:"event_lt,omitempty"`
+	EventLte               *string                           `json:"event_lte,omitempty"`
+	EventGt                *string                           `json:"event_gt,omitempty"`
+	EventGte               *string                           `json:"event_gte,omitempty"`
+	EventContains          *string                           `json:"event_contains,omitempty"`
+	EventNotContains       *string                           `json:"event_not_contains,omitempty"`
+	EventStartsWith        *string                           `json:"event_starts_with,omitempty"`
+	EventNotStartsWith     *string                           `json:"event_not_starts_with,omitempty"`
+	EventEndsWith          *string                           `json:"event_ends_with,omitempty"`
+	EventNotEndsWith       *string                           `json:"event_not_ends_with,omitempty"`
+	Payload                *string                           `json:"payload,omitempty"`
+	PayloadNot             *string                           `json:"payload_not,omitempty"`
+	PayloadIn              []string                          `json:"payload_in,omitempty"`
+	PayloadNotIn           []string                          `json:"payload_not_in,omitempty"`
+	PayloadLt              *string                           `json:"payload_lt,omitempty"`
+	PayloadLte             *string                           `json:"payload_lte,omitempty"`
+	PayloadGt              *string                           `json:"payload_gt,omitempty"`
+	PayloadGte             *string                           `json:"payload_gte,omitempty"`
+	PayloadContains        *string                           `json:"payload_contains,omitempty"`
+	PayloadNotContains     *string                           `json:"payload_not_contains,omitempty"`
+	PayloadStartsWith      *string                           `json:"payload_starts_with,omitempty"`
+	PayloadNotStartsWith   *string                           `json:"payload_not_starts_with,omitempty"`
+	PayloadEndsWith        *string                           `json:"payload_ends_with,omitempty"`
+	PayloadNotEndsWith     *string                           `json:"payload_not_ends_with,omitempty"`
+	Status                 *DeliveryStatus                   `json:"status,omitempty"`
+	StatusNot              *DeliveryStatus                   `json:"status_not,omitempty"`
+	StatusIn               []DeliveryStatus                  `json:"status_in,omitempty"`
+	StatusNotIn            []DeliveryStatus                  `json:"status_not_in,omitempty"`
+	Attempts               *int32                            `json:"attempts,omitempty"`
+	AttemptsNot            *int32                            `json:"attempts_not,omitempty"`
+	AttemptsIn             []int32                           `json:"attempts_in,omitempty"`
+	AttemptsNotIn          []int32                           `json:"attempts_not_in,omitempty"`
+	AttemptsLt             *int32                            `json:"attempts_lt,omitempty"`
+	AttemptsLte            *int32                            `json:"attempts_lte,omitempty"`
+	AttemptsGt             *int32                            `json:"attempts_gt,omitempty"`
+	AttemptsGte            *int32                            `json:"attempts_gte,omitempty"`
+	NextAttemptAt          *string                           `json:"nextAttemptAt,omitempty"`
+	NextAttemptAtNot       *string                           `json:"nextAttemptAt_not,omitempty"`
+	NextAttemptAtIn        []string                          `json:"nextAttemptAt_in,omitempty"`
+	NextAttemptAtNotIn     []string                          `json:"nextAttemptAt_not_in,omitempty"`
+	NextAttemptAtLt        *string                           `json:"nextAttemptAt_lt,omitempty"`
+	NextAttemptAtLte       *string                           `json:"nextAttemptAt_lte,omitempty"`
+	NextAttemptAtGt        *string                           `json:"nextAttemptAt_gt,omitempty"`
+	NextAttemptAtGte       *string                           `json:"nextAttemptAt_gte,omitempty"`
+	LastStatusCode         *int32                            `json:"lastStatusCode,omitempty"`
+	LastStatusCodeNot      *int32                            `json:"lastStatusCode_not,omitempty"`
+	LastStatusCodeIn       []int32                           `json:"lastStatusCode_in,omitempty"`
+	LastStatusCodeNotIn    []int32                           `json:"lastStatusCode_not_in,omitempty"`
+	LastStatusCodeLt       *int32                            `json:"lastStatusCode_lt,omitempty"`
+	LastStatusCodeLte      *int32                            `json:"lastStatusCode_lte,omitempty"`
+	LastStatusCodeGt       *int32                            `json:"lastStatusCode_gt,omitempty"`
+	LastStatusCodeGte      *int32                            `json:"lastStatusCode_gte,omitempty"`
+	LastError              *string                           `json:"lastError,omitempty"`
+	LastErrorNot           *string                           `json:"lastError_not,omitempty"`
+	LastErrorIn            []string                          `json:"lastError_in,omitempty"`
+	LastErrorNotIn         []string                          `json:"lastError_not_in,omitempty"`
+	LastErrorLt            *string                           `json:"lastError_lt,omitempty"`
+	LastErrorLte           *string                           `json:"lastError_lte,omitempty"`
+	LastErrorGt            *string                           `json:"lastError_gt,omitempty"`
+	LastErrorGte           *string                           `json:"lastError_gte,omitempty"`
+	LastErrorContains      *string                           `json:"lastError_contains,omitempty"`
+	LastErrorNotContains   *string                           `json:"lastError_not_contains,omitempty"`
+	LastErrorStartsWith    *string                           `json:"lastError_starts_with,omitempty"`
+	LastErrorNotStartsWith *string                           `json:"lastError_not_starts_with,omitempty"`
+	LastErrorEndsWith      *string                           `json:"lastError_ends_with,omitempty"`
+	LastErrorNotEndsWith   *string                           `json:"lastError_not_ends_with,omitempty"`
+	And                    []WebhookDeliveryScalarWhereInput `json:"AND,omitempty"`
+	Or                     []WebhookDeliveryScalarWhereInput `json:"OR,omitempty"`
+	Not                    []WebhookDeliveryScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type WebhookDeliveryUpdateManyWithWhereNestedInput struct {
+	Where WebhookDeliveryScalarWhereInput    `json:"where"`
+	Data  WebhookDeliveryUpdateManyDataInput `json:"data"`
+}
+
+type WebhookDeliveryUpdateManyDataInput struct {
+	Event          *string         `json:"event,omitempty"`
+	Payload        *string         `json:"payload,omitempty"`
+	Status         *DeliveryStatus `json:"status,omitempty"`
+	Attempts       *int32          `json:"attempts,omitempty"`
+	NextAttemptAt  *string         `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int32          `json:"lastStatusCode,omitempty"`
+	LastError      *string         `json:"lastError,omitempty"`
+}
+
+type WebhookCreateWithoutDeliveriesInput struct {
+	ID     *string `json:"id,omitempty"`
+	URL    string  `json:"url"`
+	Secret string  `json:"secret"`
+	Events string  `json:"events"`
+}
+
+type WebhookCreateOneWithoutDeliveriesInput struct {
+	Create  *WebhookCreateWithoutDeliveriesInput `json:"create,omitempty"`
+	Connect *WebhookWhereUniqueInput             `json:"connect,omitempty"`
+}
+
+type WebhookUpdateWithoutDeliveriesDataInput struct {
+	URL    *string `json:"url,omitempty"`
+	Secret *string `json:"secret,omitempty"`
+	Events *string `json:"events,omitempty"`
+}
+
+type WebhookUpdateOneRequiredWithoutDeliveriesInput struct {
+	Create  *WebhookCreateWithoutDeliveriesInput     `json:"create,omitempty"`
+	Update  *WebhookUpdateWithoutDeliveriesDataInput `json:"update,omitempty"`
+	Upsert  *WebhookUpsertWithoutDeliveriesInput     `json:"upsert,omitempty"`
+	Connect *WebhookWhereUniqueInput                 `json:"connect,omitempty"`
+}
+
+type WebhookUpsertWithoutDeliveriesInput struct {
+	Update WebhookUpdateWithoutDeliveriesDataInput `json:"update"`
+	Create WebhookCreateWithoutDeliveriesInput     `json:"create"`
+}
+
+type OutboxEventWhereUniqueInput struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type OutboxEventWhereInput struct {
+	ID                       *string                 `json:"id,omitempty"`
+	IDNot                    *string                 `json:"id_not,omitempty"`
+	IDIn                     []string                `json:"id_in,omitempty"`
+	IDNotIn                  []string                `json:"id_not_in,omitempty"`
+	IDLt                     *string                 `json:"id_lt,omitempty"`
+	IDLte                    *string                 `json:"id_lte,omitempty"`
+	IDGt                     *string                 `json:"id_gt,omitempty"`
+	IDGte                    *string                 `json:"id_gte,omitempty"`
+	IDContains               *string                 `json:"id_contains,omitempty"`
+	IDNotContains            *string                 `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                 `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                 `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                 `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                 `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                 `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                 `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string                `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string                `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                 `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                 `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                 `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                 `json:"createdAt_gte,omitempty"`
+	UpdatedAt                *string                 `json:"updatedAt,omitempty"`
+	UpdatedAtNot             *string                 `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn              []string                `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn           []string                `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt              *string                 `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte             *string                 `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt              *string                 `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte             *string                 `json:"updatedAt_gte,omitempty"`
+	Type                     *string                 `json:"type,omitempty"`
+	TypeNot                  *string                 `json:"type_not,omitempty"`
+	TypeIn                   []string                `json:"type_in,omitempty"`
+	TypeNotIn                []string                `json:"type_not_in,omitempty"`
+	TypeLt                   *string                 `json:"type_lt,omitempty"`
+	TypeLte                  *string                 `json:"type_lte,omitempty"`
+	TypeGt                   *string                 `json:"type_gt,omitempty"`
+	TypeGte                  *string                 `json:"type_gte,omitempty"`
+	TypeContains             *string                 `json:"type_contains,omitempty"`
+	TypeNotContains          *string                 `json:"type_not_contains,omitempty"`
+	TypeStartsWith           *string                 `json:"type_starts_with,omitempty"`
+	TypeNotStartsWith        *string                 `json:"type_not_starts_with,omitempty"`
+	TypeEndsWith             *string                 `json:"type_ends_with,omitempty"`
+	TypeNotEndsWith          *string                 `json:"type_not_ends_with,omitempty"`
+	Aggregate                *string                 `json:"aggregate,omitempty"`
+	AggregateNot             *string                 `json:"aggregate_not,omitempty"`
+	AggregateIn              []string                `json:"aggregate_in,omitempty"`
+	AggregateNotIn           []string                `json:"aggregate_not_in,omitempty"`
+	AggregateLt              *string                 `json:"aggregate_lt,omitempty"`
+	AggregateLte             *string                 `json:"aggregate_lte,omitempty"`
+	AggregateGt              *string                 `json:"aggregate_gt,omitempty"`
+	AggregateGte             *string                 `json:"aggregate_gte,omitempty"`
+	AggregateContains        *string                 `json:"aggregate_contains,omitempty"`
+	AggregateNotContains     *string                 `json:"aggregate_not_contains,omitempty"`
+	AggregateStartsWith      *string                 `json:"aggregate_starts_with,omitempty"`
+	AggregateNotStartsWith   *string                 `json:"aggregate_not_starts_with,omitempty"`
+	AggregateEndsWith        *string                 `json:"aggregate_ends_with,omitempty"`
+	AggregateNotEndsWith     *string                 `json:"aggregate_not_ends_with,omitempty"`
+	AggregateID              *string                 `json:"aggregateId,omitempty"`
+	AggregateIDNot           *string                 `json:"aggregateId_not,omitempty"`
+	AggregateIDIn            []string                `json:"aggregateId_in,omitempty"`
+	AggregateIDNotIn         []string                `json:"aggregateId_not_in,omitempty"`
+	AggregateIDLt            *string                 `json:"aggregateId_lt,omitempty"`
+	AggregateIDLte           *string                 `json:"aggregateId_lte,omitempty"`
+	AggregateIDGt            *string                 `json:"aggregateId_gt,omitempty"`
+	AggregateIDGte           *string                 `json:"aggregateId_gte,omitempty"`
+	AggregateIDContains      *string                 `json:"aggregateId_contains,omitempty"`
+	AggregateIDNotContains   *string                 `json:"aggregateId_not_contains,omitempty"`
+	AggregateIDStartsWith    *string                 `json:"aggregateId_starts_with,omitempty"`
+	AggregateIDNotStartsWith *string                 `json:"aggregateId_not_starts_with,omitempty"`
+	AggregateIDEndsWith      *string                 `json:"aggregateId_ends_with,omitempty"`
+	AggregateIDNotEndsWith   *string                 `json:"aggregateId_not_ends_with,omitempty"`
+	Payload                  *string                 `json:"payload,omitempty"`
+	PayloadNot               *string                 `json:"payload_not,omitempty"`
+	PayloadIn                []string                `json:"payload_in,omitempty"`
+	PayloadNotIn             []string                `json:"payload_not_in,omitempty"`
+	PayloadLt                *string                 `json:"payload_lt,omitempty"`
+	PayloadLte               *string                 `json:"payload_lte,omitempty"`
+	PayloadGt                *string                 `json:"payload_gt,omitempty"`
+	PayloadGte               *string                 `json:"payload_gte,omitempty"`
+	PayloadContains          *string                 `json:"payload_contains,omitempty"`
+	PayloadNotContains       *string                 `json:"payload_not_contains,omitempty"`
+	PayloadStartsWith        *string                 `json:"payload_starts_with,omitempty"`
+	PayloadNotStartsWith     *string                 `json:"payload_not_starts_with,omitempty"`
+	PayloadEndsWith          *string                 `json:"payload_ends_with,omitempty"`
+	PayloadNotEndsWith       *string                 `json:"payload_not_ends_with,omitempty"`
+	Status                   *OutboxStatus           `json:"status,omitempty"`
+	StatusNot                *OutboxStatus           `json:"status_not,omitempty"`
+	StatusIn                 []OutboxStatus          `json:"status_in,omitempty"`
+	StatusNotIn              []OutboxStatus          `json:"status_not_in,omitempty"`
+	PublishedAt              *string                 `json:"publishedAt,omitempty"`
+	PublishedAtNot           *string                 `json:"publishedAt_not,omitempty"`
+	PublishedAtIn            []string                `json:"publishedAt_in,omitempty"`
+	PublishedAtNotIn         []string                `json:"publishedAt_not_in,omitempty"`
+	PublishedAtLt            *string                 `json:"publishedAt_lt,omitempty"`
+	PublishedAtLte           *string                 `json:"publishedAt_lte,omitempty"`
+	PublishedAtGt            *string                 `json:"publishedAt_gt,omitempty"`
+	PublishedAtGte           *string                 `json:"publishedAt_gte,omitempty"`
+	Sequence                 *string                 `json:"sequence,omitempty"`
+	SequenceNot              *string                 `json:"sequence_not,omitempty"`
+	SequenceIn               []string                `json:"sequence_in,omitempty"`
+	SequenceNotIn            []string                `json:"sequence_not_in,omitempty"`
+	SequenceLt               *string                 `json:"sequence_lt,omitempty"`
+	SequenceLte              *string                 `json:"sequence_lte,omitempty"`
+	SequenceGt               *string                 `json:"sequence_gt,omitempty"`
+	SequenceGte              *string                 `json:"sequence_gte,omitempty"`
+	SequenceContains         *string                 `json:"sequence_contains,omitempty"`
+	SequenceNotContains      *string                 `json:"sequence_not_contains,omitempty"`
+	SequenceStartsWith       *string                 `json:"sequence_starts_with,omitempty"`
+	SequenceNotStartsWith    *string                 `json:"sequence_not_starts_with,omitempty"`
+	SequenceEndsWith         *string                 `json:"sequence_ends_with,omitempty"`
+	SequenceNotEndsWith      *string                 `json:"sequence_not_ends_with,omitempty"`
+	Book                     *BookWhereInput         `json:"book,omitempty"`
+	Chapter                  *ChapterWhereInput      `json:"chapter,omitempty"`
+	And                      []OutboxEventWhereInput `json:"AND,omitempty"`
+	Or                       []OutboxEventWhereInput `json:"OR,omitempty"`
+	Not                      []OutboxEventWhereInput `json:"NOT,omitempty"`
+}
+
+type OutboxEventCreateInput struct {
+	ID          *string                             `json:"id,omitempty"`
+	Type        string                              `json:"type"`
+	Aggregate   string                              `json:"aggregate"`
+	AggregateID string                              `json:"aggregateId"`
+	Payload     string                              `json:"payload"`
+	Status      *OutboxStatus                       `json:"status,omitempty"`
+	PublishedAt *string                             `json:"publishedAt,omitempty"`
+	Sequence    *string                             `json:"sequence,omitempty"`
+	Book        *BookCreateOneWithoutOutboxInput    `json:"book,omitempty"`
+	Chapter     *ChapterCreateOneWithoutOutboxInput `json:"chapter,omitempty"`
+}
+
+type OutboxEventUpdateInput struct {
+	Type        *string                             `json:"type,omitempty"`
+	Aggregate   *string                             `json:"aggregate,omitempty"`
+	AggregateID *string                             `json:"aggregateId,omitempty"`
+	Payload     *string                             `json:"payload,omitempty"`
+	Status      *OutboxStatus                       `json:"status,omitempty"`
+	PublishedAt *string                             `json:"publishedAt,omitempty"`
+	Sequence    *string                             `json:"sequence,omitempty"`
+	Book        *BookUpdateOneWithoutOutboxInput    `json:"book,omitempty"`
+	Chapter     *ChapterUpdateOneWithoutOutboxInput `json:"chapter,omitempty"`
+}
+
+type OutboxEventUpdateManyMutationInput struct {
+	Type        *string       `json:"type,omitempty"`
+	Aggregate   *string       `json:"aggregate,omitempty"`
+	AggregateID *string       `json:"aggregateId,omitempty"`
+	Payload     *string       `json:"payload,omitempty"`
+	Status      *OutboxStatus `json:"status,omitempty"`
+	PublishedAt *string       `json:"publishedAt,omitempty"`
+	Sequence    *string       `json:"sequence,omitempty"`
+}
+
+type OutboxEventSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                      `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                             `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                            `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                            `json:"updatedFields_contains_some,omitempty"`
+	Node                       *OutboxEventWhereInput              `json:"node,omitempty"`
+	And                        []OutboxEventSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []OutboxEventSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []OutboxEventSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type OutboxEventCreateWithoutBookInput struct {
+	ID          *string                             `json:"id,omitempty"`
+	Type        string                              `json:"type"`
+	Aggregate   string                              `json:"aggregate"`
+	AggregateID string                              `json:"aggregateId"`
+	Payload     string                              `json:"payload"`
+	Status      *OutboxStatus                       `json:"status,omitempty"`
+	PublishedAt *string                             `json:"publishedAt,omitempty"`
+	Sequence    *string                             `json:"sequence,omitempty"`
+	Chapter     *ChapterCreateOneWithoutOutboxInput `json:"chapter,omitempty"`
+}
+
+type OutboxEventCreateManyWithoutBookInput struct {
+	Create  []OutboxEventCreateWithoutBookInput `json:"create,omitempty"`
+	Connect []OutboxEventWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type OutboxEventUpdateWithoutBookDataInput struct {
+	Type        *string                             `json:"type,omitempty"`
+	Aggregate   *string                             `json:"aggregate,omitempty"`
+	AggregateID *string                             `json:"aggregateId,omitempty"`
+	Payload     *string                             `json:"payload,omitempty"`
+	Status      *OutboxStatus                       `json:"status,omitempty"`
+	PublishedAt *string                             `json:"publishedAt,omitempty"`
+	Sequence    *string                             `json:"sequence,omitempty"`
+	Chapter     *ChapterUpdateOneWithoutOutboxInput `json:"chapter,omitempty"`
+}
+
+type OutboxEventUpdateManyWithoutBookInput struct {
+	Create     []OutboxEventCreateWithoutBookInput                `json:"create,omitempty"`
+	Delete     []OutboxEventWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []OutboxEventWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []OutboxEventWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []OutboxEventWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []OutboxEventUpdateWithWhereUniqueWithoutBookInput `json:"update,omitempty"`
+	Upsert     []OutboxEventUpsertWithWhereUniqueWithoutBookInput `json:"upsert,omitempty"`
+	DeleteMany []OutboxEventScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []OutboxEventUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type OutboxEventUpdateWithWhereUniqueWithoutBookInput struct {
+	Where OutboxEventWhereUniqueInput           `json:"where"`
+	Data  OutboxEventUpdateWithoutBookDataInput `json:"data"`
+}
+
+type OutboxEventUpsertWithWhereUniqueWithoutBookInput struct {
+	Where  OutboxEventWhereUniqueInput           `json:"where"`
+	Update OutboxEventUpdateWithoutBookDataInput `json:"update"`
+	Create OutboxEventCreateWithoutBookInput     `json:"create"`
+}
+
+type OutboxEventScalarWhereInput struct {
+	ID                       *string                       `json:"id,omitempty"`
+	IDNot                    *string                       `json:"id_not,omitempty"`
+	IDIn                     []string                      `json:"id_in,omitempty"`
+	IDNotIn                  []string                      `json:"id_not_in,omitempty"`
+	IDLt                     *string                       `json:"id_lt,omitempty"`
+	IDLte                    *string                       `json:"id_lte,omitempty"`
+	IDGt                     *string                       `json:"id_gt,omitempty"`
+	IDGte                    *string                       `json:"id_gte,omitempty"`
+	IDContains               *string                       `json:"id_contains,omitempty"`
+	IDNotContains            *string                       `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                       `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                       `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                       `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                       `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                       `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                       `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string                      `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string                      `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                       `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                       `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                       `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                       `json:"createdAt_gte,omitempty"`
+	UpdatedAt                *string                       `json:"updatedAt,omitempty"`
+	UpdatedAtNot             *string                       `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn              []string                      `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn           []string                      `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt              *string                       `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte             *string                       `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt              *string                       `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte             *string                       `json:"updatedAt_gte,omitempty"`
+	Type                     *string                       `json:"type,omitempty"`
+	TypeNot                  *string                       `json:"type_not,omitempty"`
+	TypeIn                   []string                      `json:"type_in,omitempty"`
+	TypeNotIn                []string                      `json:"type_not_in,omitempty"`
+	TypeLt                   *string                       `json:"type_lt,omitempty"`
+	TypeLte                  *string                       `json:"type_lte,omitempty"`
+	TypeGt                   *string                       `json:"type_gt,omitempty"`
+	TypeGte                  *string                       `json:"type_gte,omitempty"`
+	TypeContains             *string                       `json:"type_contains,omitempty"`
+	TypeNotContains          *string                       `json:"type_not_contains,omitempty"`
+	TypeStartsWith           *string                       `json:"type_starts_with,omitempty"`
+	TypeNotStartsWith        *string                       `json:"type_not_starts_with,omitempty"`
+	TypeEndsWith             *string                       `json:"type_ends_with,omitempty"`
+	TypeNotEndsWith          *string                       `json:"type_not_ends_with,omitempty"`
+	Aggregate                *string                       `json:"aggregate,omitempty"`
+	AggregateNot             *string                       `json:"aggregate_not,omitempty"`
+	AggregateIn              []string                      `json:"aggregate_in,omitempty"`
+	AggregateNotIn           []string                      `json:"aggregate_not_in,omitempty"`
+	AggregateLt              *string                       `json:"aggregate_lt,omitempty"`
+	AggregateLte             *string                       `json:"aggregate_lte,omitempty"`
+	AggregateGt              *string                       `json:"aggregate_gt,omitempty"`
+	AggregateGte             *string                       `json:"aggregate_gte,omitempty"`
+	AggregateContains        *string                       `json:"aggregate_contains,omitempty"`
+	AggregateNotContains     *string                       `json:"aggregate_not_contains,omitempty"`
+	AggregateStartsWith      *string                       `json:"aggregate_starts_with,omitempty"`
+	AggregateNotStartsWith   *string                       `json:"aggregate_not_starts_with,omitempty"`
+	AggregateEndsWith        *string                       `json:"aggregate_ends_with,omitempty"`
+	AggregateNotEndsWith     *string                       `json:"aggregate_not_ends_with,omitempty"`
+	AggregateID              *string                       `json:"aggregateId,omitempty"`
+	AggregateIDNot           *string                       `json:"aggregateId_not,omitempty"`
+	AggregateIDIn            []string                      `json:"aggregateId_in,omitempty"`
+	AggregateIDNotIn         []string                      `json:"aggregateId_not_in,omitempty"`
+	AggregateIDLt            *string                       `json:"aggregateId_lt,omitempty"`
+	AggregateIDLte           *string                       `json:"aggregateId_lte,omitempty"`
+	AggregateIDGt            *string                       `json:"aggregateId_gt,omitempty"`
+	AggregateIDGte           *string                       `json:"aggregateId_gte,omitempty"`
+	AggregateIDContains      *string                       `json:"aggregateId_contains,omitempty"`
+	AggregateIDNotContains   *string                       `json:"aggregateId_not_contains,omitempty"`
+	AggregateIDStartsWith    *string                       `json:"aggregateId_starts_with,omitempty"`
+	AggregateIDNotStartsWith *string                       `json:"aggregateId_not_starts_with,omitempty"`
+	AggregateIDEndsWith      *string                       `json:"aggregateId_ends_with,omitempty"`
+	AggregateIDNotEndsWith   *string                       `json:"aggregateId_not_ends_with,omitempty"`
+	Payload                  *string                       `json:"payload,omitempty"`
+	PayloadNot               *string                       `json:"payload_not,omitempty"`
+	PayloadIn                []string                      `json:"payload_in,omitempty"`
+	PayloadNotIn             []string                      `json:"payload_not_in,omitempty"`
+	PayloadLt                *string                       `json:"payload_lt,omitempty"`
+	PayloadLte               *string                       `json:"payload_lte,omitempty"`
+	PayloadGt                *string                       `json:"payload_gt,omitempty"`
+	PayloadGte               *string                       `json:"payload_gte,omitempty"`
+	PayloadContains          *string                       `json:"payload_contains,omitempty"`
+	PayloadNotContains       *string                       `json:"payload_not_contains,omitempty"`
+	PayloadStartsWith        *string                       `json:"payload_starts_with,omitempty"`
+	PayloadNotStartsWith     *string                       `json:"payload_not_starts_with,omitempty"`
+	PayloadEndsWith          *string                       `json:"payload_ends_with,omitempty"`
+	PayloadNotEndsWith       *string                       `json:"payload_not_ends_with,omitempty"`
+	Status                   *OutboxStatus                 `json:"status,omitempty"`
+	StatusNot                *OutboxStatus                 `json:"status_not,omitempty"`
+	StatusIn                 []OutboxStatus                `json:"status_in,omitempty"`
+	StatusNotIn              []OutboxStatus                `json:"status_not_in,omitempty"`
+	PublishedAt              *string                       `json:"publishedAt,omitempty"`
+	PublishedAtNot           *string                       `json:"publishedAt_not,omitempty"`
+	PublishedAtIn            []string                      `json:"publishedAt_in,omitempty"`
+	PublishedAtNotIn         []string                      `json:"publishedAt_not_in,omitempty"`
+	PublishedAtLt            *string                       `json:"publishedAt_lt,omitempty"`
+	PublishedAtLte           *string                       `json:"publishedAt_lte,omitempty"`
+	PublishedAtGt            *string                       `json:"publishedAt_gt,omitempty"`
+	PublishedAtGte           *string                       `json:"publishedAt_gte,omitempty"`
+	Sequence                 *string                       `json:"sequence,omitempty"`
+	SequenceNot              *string                       `json:"sequence_not,omitempty"`
+	SequenceIn               []string                      `json:"sequence_in,omitempty"`
+	SequenceNotIn            []string                      `json:"sequence_not_in,omitempty"`
+	SequenceLt               *string                       `json:"sequence_lt,omitempty"`
+	SequenceLte              *string                       `json:"sequence_lte,omitempty"`
+	SequenceGt               *string                       `json:"sequence_gt,omitempty"`
+	SequenceGte              *string                       `json:"sequence_gte,omitempty"`
+	SequenceContains         *string                       `json:"sequence_contains,omitempty"`
+	SequenceNotContains      *string                       `json:"sequence_not_contains,omitempty"`
+	SequenceStartsWith       *string                       `json:"sequence_starts_with,omitempty"`
+	SequenceNotStartsWith    *string                       `json:"sequence_not_starts_with,omitempty"`
+	SequenceEndsWith         *string                       `json:"sequence_ends_with,omitempty"`
+	SequenceNotEndsWith      *string                       `json:"sequence_not_ends_with,omitempty"`
+	And                      []OutboxEventScalarWhereInput `json:"AND,omitempty"`
+	Or                       []OutboxEventScalarWhereInput `json:"OR,omitempty"`
+	Not                      []OutboxEventScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type OutboxEventUpdateManyWithWhereNestedInput struct {
+	Where OutboxEventScalarWhereInput    `json:"where"`
+	Data  OutboxEventUpdateManyDataInput `json:"data"`
+}
+
+type OutboxEventUpdateManyDataInput struct {
+	Type        *string       `json:"type,omitempty"`
+	Aggregate   *string       `json:"aggregate,omitempty"`
+	AggregateID *string       `json:"aggregateId,omitempty"`
+	Payload     *string       `json:"payload,omitempty"`
+	Status      *OutboxStatus `json:"status,omitempty"`
+	PublishedAt *string       `json:"publishedAt,omitempty"`
+	Sequence    *string       `json:"sequence,omitempty"`
+}
+
+type OutboxEventCreateWithoutChapterInput struct {
+	ID          *string                          `json:"id,omitempty"`
+	Type        string                           `json:"type"`
+	Aggregate   string                           `json:"aggregate"`
+	AggregateID string                           `json:"aggregateId"`
+	Payload     string                           `json:"payload"`
+	Status      *OutboxStatus                    `json:"status,omitempty"`
+	PublishedAt *string                          `json:"publishedAt,omitempty"`
+	Sequence    *string                          `json:"sequence,omitempty"`
+	Book        *BookCreateOneWithoutOutboxInput `json:"book,omitempty"`
+}
+
+type OutboxEventCreateManyWithoutChapterInput struct {
+	Create  []OutboxEventCreateWithoutChapterInput `json:"create,omitempty"`
+	Connect []OutboxEventWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type OutboxEventUpdateWithoutChapterDataInput struct {
+	Type        *string                          `json:"type,omitempty"`
+	Aggregate   *string                          `json:"aggregate,omitempty"`
+	AggregateID *string                          `json:"aggregateId,omitempty"`
+	Payload     *string                          `json:"payload,omitempty"`
+	Status      *OutboxStatus                    `json:"status,omitempty"`
+	PublishedAt *string                          `json:"publishedAt,omitempty"`
+	Sequence    *string                          `json:"sequence,omitempty"`
+	Book        *BookUpdateOneWithoutOutboxInput `json:"book,omitempty"`
+}
+
+type OutboxEventUpdateManyWithoutChapterInput struct {
+	Create     []OutboxEventCreateWithoutChapterInput                `json:"create,omitempty"`
+	Delete     []OutboxEventWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []OutboxEventWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []OutboxEventWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []OutboxEventWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []OutboxEventUpdateWithWhereUniqueWithoutChapterInput `json:"update,omitempty"`
+	Upsert     []OutboxEventUpsertWithWhereUniqueWithoutChapterInput `json:"upsert,omitempty"`
+	DeleteMany []OutboxEventScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []OutboxEventUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type OutboxEventUpdateWithWhereUniqueWithoutChapterInput struct {
+	Where OutboxEventWhereUniqueInput              `json:"where"`
+	Data  OutboxEventUpdateWithoutChapterDataInput `json:"data"`
+}
+
+type OutboxEventUpsertWithWhereUniqueWithoutChapterInput struct {
+	Where  OutboxEventWhereUniqueInput              `json:"where"`
+	Update OutboxEventUpdateWithoutChapterDataInput `json:"update"`
+	Create OutboxEventCreateWithoutChapterInput     `json:"create"`
+}
+
+type BookCreateWithoutOutboxInput struct {
+	ID          *string                                   `json:"id,omitempty"`
+	Name        string                                    `json:"name"`
+	Description string                                    `json:"description"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorCreateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagCreateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionCreateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionCreateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterCreateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutBookInput       `json:"reminders,omitempty"`
+}
+
+type BookCreateOneWithoutOutboxInput struct {
+	Create  *BookCreateWithoutOutboxInput `json:"create,omitempty"`
+	Connect *BookWhereUniqueInput         `json:"connect,omitempty"`
+}
+
+type BookUpdateWithoutOutboxDataInput struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorUpdateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagUpdateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionUpdateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterUpdateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutBookInput       `json:"reminders,omitempty"`
+}
+
+type BookUpdateOneWithoutOutboxInput struct {
+	Create     *BookCreateWithoutOutboxInput     `json:"create,omitempty"`
+	Update     *BookUpdateWithoutOutboxDataInput `json:"update,omitempty"`
+	Upsert     *BookUpsertWithoutOutboxInput     `json:"upsert,omitempty"`
+	Delete     *bool                             `json:"delete,omitempty"`
+	Disconnect *bool                             `json:"disconnect,omitempty"`
+	Connect    *BookWhereUniqueInput             `json:"connect,omitempty"`
+}
+
+type BookUpsertWithoutOutboxInput struct {
+	Update BookUpdateWithoutOutboxDataInput `json:"update"`
+	Create BookCreateWithoutOutboxInput     `json:"create"`
+}
+
+type ChapterCreateWithoutOutboxInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput             `json:"book"`
+}
+
+type ChapterCreateOneWithoutOutboxInput struct {
+	Create  *ChapterCreateWithoutOutboxInput `json:"create,omitempty"`
+	Connect *ChapterWhereUniqueInput         `json:"connect,omitempty"`
+}
+
+type ChapterUpdateWithoutOutboxDataInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput    `json:"book,omitempty"`
+}
+
+type ChapterUpdateOneWithoutOutboxInput struct {
+	Create     *ChapterCreateWithoutOutboxInput     `json:"create,omitempty"`
+	Update     *ChapterUpdateWithoutOutboxDataInput `json:"update,omitempty"`
+	Upsert     *ChapterUpsertWithoutOutboxInput     `json:"upsert,omitempty"`
+	Delete     *bool                                `json:"delete,omitempty"`
+	Disconnect *bool                                `json:"disconnect,omitempty"`
+	Connect    *ChapterWhereUniqueInput             `json:"connect,omitempty"`
+}
+
+type ChapterUpsertWithoutOutboxInput struct {
+	Update ChapterUpdateWithoutOutboxDataInput `json:"update"`
+	Create ChapterCreateWithoutOutboxInput     `json:"create"`
+}
+
+type AuditEntryWhereUniqueInput struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type AuditEntryWhereInput struct {
+	ID                     *string                `json:"id,omitempty"`
+	IDNot                  *string                `json:"id_not,omitempty"`
+	IDIn                   []string               `json:"id_in,omitempty"`
+	IDNotIn                []string               `json:"id_not_in,omitempty"`
+	IDLt                   *string                `json:"id_lt,omitempty"`
+	IDLte                  *string                `json:"id_lte,omitempty"`
+	IDGt                   *string                `json:"id_gt,omitempty"`
+	IDGte                  *string                `json:"id_gte,omitempty"`
+	IDContains             *string                `json:"id_contains,omitempty"`
+	IDNotContains          *string                `json:"id_not_contains,omitempty"`
+	IDStartsWith           *string                `json:"id_starts_with,omitempty"`
+	IDNotStartsWith        *string                `json:"id_not_starts_with,omitempty"`
+	IDEndsWith             *string                `json:"id_ends_with,omitempty"`
+	IDNotEndsWith          *string                `json:"id_not_ends_with,omitempty"`
+	CreatedAt              *string                `json:"createdAt,omitempty"`
+	CreatedAtNot           *string                `json:"createdAt_not,omitempty"`
+	CreatedAtIn            []string               `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn         []string               `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt            *string                `json:"createdAt_lt,omitempty"`
+	CreatedAtLte           *string                `json:"createdAt_lte,omitempty"`
+	CreatedAtGt            *string                `json:"createdAt_gt,omitempty"`
+	CreatedAtGte           *string                `json:"createdAt_gte,omitempty"`
+	Actor                  *string                `json:"actor,omitempty"`
+	ActorNot               *string                `json:"actor_not,omitempty"`
+	ActorIn                []string               `json:"actor_in,omitempty"`
+	ActorNotIn             []string               `json:"actor_not_in,omitempty"`
+	ActorLt                *string                `json:"actor_lt,omitempty"`
+	ActorLte               *string                `json:"actor_lte,omitempty"`
+	ActorGt                *string                `json:"actor_gt,omitempty"`
+	ActorGte               *string                `json:"actor_gte,omitempty"`
+	ActorContains          *string                `json:"actor_contains,omitempty"`
+	ActorNotContains       *string                `json:"actor_not_contains,omitempty"`
+	ActorStartsWith        *string                `json:"actor_starts_with,omitempty"`
+	ActorNotStartsWith     *string                `json:"actor_not_starts_with,omitempty"`
+	ActorEndsWith          *string                `json:"actor_ends_with,omitempty"`
+	ActorNotEndsWith       *string                `json:"actor_not_ends_with,omitempty"`
+	Method                 *string                `json:"method,omitempty"`
+	MethodNot              *string                `json:"method_not,omitempty"`
+	MethodIn               []string               `json:"method_in,omitempty"`
+	MethodNotIn            []string               `json:"method_not_in,omitempty"`
+	MethodLt               *string                `json:"method_lt,omitempty"`
+	MethodLte              *string                `json:"method_lte,omitempty"`
+	MethodGt               *string                `json:"method_gt,omitempty"`
+	MethodGte              *string                `json:"method_gte,omitempty"`
+	MethodContains         *string                `json:"method_contains,omitempty"`
+	MethodNotContains      *string                `json:"method_not_contains,omitempty"`
+	MethodStartsWith       *string                `json:"method_starts_with,omitempty"`
+	MethodNotStartsWith    *string                `json:"method_not_starts_with,omitempty"`
+	MethodEndsWith         *string                `json:"method_ends_with,omitempty"`
+	MethodNotEndsWith      *string                `json:"method_not_ends_with,omitempty"`
+	RequestID              *string                `json:"requestId,omitempty"`
+	RequestIDNot           *string                `json:"requestId_not,omitempty"`
+	RequestIDIn            []string               `json:"requestId_in,omitempty"`
+	RequestIDNotIn         []string               `json:"requestId_not_in,omitempty"`
+	RequestIDLt            *string                `json:"requestId_lt,omitempty"`
+	RequestIDLte           *string                `json:"requestId_lte,omitempty"`
+	RequestIDGt            *string                `json:"requestId_gt,omitempty"`
+	RequestIDGte           *string                `json:"requestId_gte,omitempty"`
+	RequestIDContains      *string                `json:"requestId_contains,omitempty"`
+	RequestIDNotContains   *string                `json:"requestId_not_contains,omitempty"`
+	RequestIDStartsWith    *string                `json:"requestId_starts_with,omitempty"`
+	RequestIDNotStartsWith *string                `json:"requestId_not_starts_with,omitempty"`
+	RequestIDEndsWith      *string                `json:"requestId_ends_with,omitempty"`
+	RequestIDNotEndsWith   *string                `json:"requestId_not_ends_with,omitempty"`
+	BookID                 *string                `json:"bookId,omitempty"`
+	BookIDNot              *string                `json:"bookId_not,omitempty"`
+	BookIDIn               []string               `json:"bookId_in,omitempty"`
+	BookIDNotIn            []string               `json:"bookId_not_in,omitempty"`
+	BookIDLt               *string                `json:"bookId_lt,omitempty"`
+	BookIDLte              *string                `json:"bookId_lte,omitempty"`
+	BookIDGt               *string                `json:"bookId_gt,omitempty"`
+	BookIDGte              *string                `json:"bookId_gte,omitempty"`
+	BookIDContains         *string                `json:"bookId_contains,omitempty"`
+	BookIDNotContains      *string                `json:"bookId_not_contains,omitempty"`
+	BookIDStartsWith       *string                `json:"bookId_starts_with,omitempty"`
+	BookIDNotStartsWith    *string                `json:"bookId_not_starts_with,omitempty"`
+	BookIDEndsWith         *string                `json:"bookId_ends_with,omitempty"`
+	BookIDNotEndsWith      *string                `json:"bookId_not_ends_with,omitempty"`
+	ChapterID              *string                `json:"chapterId,omitempty"`
+	ChapterIDNot           *string                `json:"chapterId_not,omitempty"`
+	ChapterIDIn            []string               `json:"chapterId_in,omitempty"`
+	ChapterIDNotIn         []string               `json:"chapterId_not_in,omitempty"`
+	ChapterIDLt            *string                `json:"chapterId_lt,omitempty"`
+	ChapterIDLte           *string                `json:"chapterId_lte,omitempty"`
+	ChapterIDGt            *string                `json:"chapterId_gt,omitempty"`
+	ChapterIDGte           *string                `json:"chapterId_gte,omitempty"`
+	ChapterIDContains      *string                `json:"chapterId_contains,omitempty"`
+	ChapterIDNotContains   *string                `json:"chapterId_not_contains,omitempty"`
+	ChapterIDStartsWith    *string                `json:"chapterId_starts_with,omitempty"`
+	ChapterIDNotStartsWith *string                `json:"chapterId_not_starts_with,omitempty"`
+	ChapterIDEndsWith      *string                `json:"chapterId_ends_with,omitempty"`
+	ChapterIDNotEndsWith   *string                `json:"chapterId_not_ends_with,omitempty"`
+	Before                 *string                `json:"before,omitempty"`
+	BeforeNot              *string                `json:"before_not,omitempty"`
+	BeforeIn               []string               `json:"before_in,omitempty"`
+	BeforeNotIn            []string               `json:"before_not_in,omitempty"`
+	BeforeLt               *string                `json:"before_lt,omitempty"`
+	BeforeLte              *string                `json:"before_lte,omitempty"`
+	BeforeGt               *string                `json:"before_gt,omitempty"`
+	BeforeGte              *string                `json:"before_gte,omitempty"`
+	BeforeContains         *string                `json:"before_contains,omitempty"`
+	BeforeNotContains      *string                `json:"before_not_contains,omitempty"`
+	BeforeStartsWith       *string                `json:"before_starts_with,omitempty"`
+	BeforeNotStartsWith    *string                `json:"before_not_starts_with,omitempty"`
+	BeforeEndsWith         *string                `json:"before_ends_with,omitempty"`
+	BeforeNotEndsWith      *string                `json:"before_not_ends_with,omitempty"`
+	After                  *string                `json:"after,omitempty"`
+	AfterNot               *string                `json:"after_not,omitempty"`
+	AfterIn                []string               `json:"after_in,omitempty"`
+	AfterNotIn             []string               `json:"after_not_in,omitempty"`
+	AfterLt                *string                `json:"after_lt,omitempty"`
+	AfterLte               *string                `json:"after_lte,omitempty"`
+	AfterGt                *string                `json:"after_gt,omitempty"`
+	AfterGte               *string                `json:"after_gte,omitempty"`
+	AfterContains          *string                `json:"after_contains,omitempty"`
+	AfterNotContains       *string                `json:"after_not_contains,omitempty"`
+	AfterStartsWith        *string                `json:"after_starts_with,omitempty"`
+	AfterNotStartsWith     *string                `json:"after_not_starts_with,omitempty"`
+	AfterEndsWith          *string                `json:"after_ends_with,omitempty"`
+	AfterNotEndsWith       *string                `json:"after_not_ends_with,omitempty"`
+	And                    []AuditEntryWhereInput `json:"AND,omitempty"`
+	Or                     []AuditEntryWhereInput `json:"OR,omitempty"`
+	Not                    []AuditEntryWhereInput `json:"NOT,omitempty"`
+}
+
+type AuditEntryCreateInput struct {
+	ID        *string `json:"id,omitempty"`
+	Actor     string  `json:"actor"`
+	Method    string  `json:"method"`
+	RequestID string  `json:"requestId"`
+	BookID    *string `json:"bookId,omitempty"`
+	ChapterID *string `json:"chapterId,omitempty"`
+	Before    *string `json:"before,omitempty"`
+	After     *string `json:"after,omitempty"`
+}
+
+type AuditEntryUpdateInput struct {
+	Actor     *string `json:"actor,omitempty"`
+	Method    *string `json:"method,omitempty"`
+	RequestID *string `json:"requestId,omitempty"`
+	BookID    *string `json:"bookId,omitempty"`
+	ChapterID *string `json:"chapterId,omitempty"`
+	Before    *string `json:"before,omitempty"`
+	After     *string `json:"after,omitempty"`
+}
+
+type AuditEntryUpdateManyMutationInput struct {
+	Actor     *string `json:"actor,omitempty"`
+	Method    *string `json:"method,omitempty"`
+	RequestID *string `json:"requestId,omitempty"`
+	BookID    *string `json:"bookId,omitempty"`
+	ChapterID *string `json:"chapterId,omitempty"`
+	Before    *string `json:"before,omitempty"`
+	After     *string `json:"after,omitempty"`
+}
+
+type AuditEntrySubscriptionWhereInput struct {
+	MutationIn                 []MutationType                     `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                            `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                           `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                           `json:"updatedFields_contains_some,omitempty"`
+	Node                       *AuditEntryWhereInput              `json:"node,omitempty"`
+	And                        []AuditEntrySubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []AuditEntrySubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []AuditEntrySubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type RevisionWhereUniqueInput struct {
+	ID  *string `json:"id,omitempty"`
+	Key *string `json:"key,omitempty"`
+}
+
+type RevisionWhereInput struct {
+	ID                       *string              `json:"id,omitempty"`
+	IDNot                    *string              `json:"id_not,omitempty"`
+	IDIn                     []string             `json:"id_in,omitempty"`
+	IDNotIn                  []string             `json:"id_not_in,omitempty"`
+	IDLt                     *string              `json:"id_lt,omitempty"`
+	IDLte                    *string              `json:"id_lte,omitempty"`
+	IDGt                     *string              `json:"id_gt,omitempty"`
+	IDGte                    *string              `json:"id_gte,omitempty"`
+	IDContains               *string              `json:"id_contains,omitempty"`
+	IDNotContains            *string              `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string              `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string              `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string              `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string              `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string              `json:"createdAt,omitempty"`
+	CreatedAtNot             *string              `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string             `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string             `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string              `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string              `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string              `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string              `json:"createdAt_gte,omitempty"`
+	Key                      *string              `json:"key,omitempty"`
+	KeyNot                   *string              `json:"key_not,omitempty"`
+	KeyIn                    []string             `json:"key_in,omitempty"`
+	KeyNotIn                 []string             `json:"key_not_in,omitempty"`
+	KeyLt                    *string              `json:"key_lt,omitempty"`
+	KeyLte                   *string              `json:"key_lte,omitempty"`
+	KeyGt                    *string              `json:"key_gt,omitempty"`
+	KeyGte                   *string              `json:"key_gte,omitempty"`
+	KeyContains              *string              `json:"key_contains,omitempty"`
+	KeyNotContains           *string              `json:"key_not_contains,omitempty"`
+	KeyStartsWith            *string              `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith         *string              `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith              *string              `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith           *string              `json:"key_not_ends_with,omitempty"`
+	Number                   *int32               `json:"number,omitempty"`
+	NumberNot                *int32               `json:"number_not,omitempty"`
+	NumberIn                 []int32              `json:"number_in,omitempty"`
+	NumberNotIn              []int32              `json:"number_not_in,omitempty"`
+	NumberLt                 *int32               `json:"number_lt,omitempty"`
+	NumberLte                *int32               `json:"number_lte,omitempty"`
+	NumberGt                 *int32               `json:"number_gt,omitempty"`
+	NumberGte                *int32               `json:"number_gte,omitempty"`
+	Name                     *string              `json:"name,omitempty"`
+	NameNot                  *string              `json:"name_not,omitempty"`
+	NameIn                   []string             `json:"name_in,omitempty"`
+	NameNotIn                []string             `json:"name_not_in,omitempty"`
+	NameLt                   *string              `json:"name_lt,omitempty"`
+	NameLte                  *string              `json:"name_lte,omitempty"`
+	NameGt                   *string              `json:"name_gt,omitempty"`
+	NameGte                  *string              `json:"name_gte,omitempty"`
+	NameContains             *string              `json:"name_contains,omitempty"`
+	NameNotContains          *string              `json:"name_not_contains,omitempty"`
+	NameStartsWith           *string              `json:"name_starts_with,omitempty"`
+	NameNotStartsWith        *string              `json:"name_not_starts_with,omitempty"`
+	NameEndsWith             *string              `json:"name_ends_with,omitempty"`
+	NameNotEndsWith          *string              `json:"name_not_ends_with,omitempty"`
+	Description              *string              `json:"description,omitempty"`
+	DescriptionNot           *string              `json:"description_not,omitempty"`
+	DescriptionIn            []string             `json:"description_in,omitempty"`
+	DescriptionNotIn         []string             `json:"description_not_in,omitempty"`
+	DescriptionLt            *string              `json:"description_lt,omitempty"`
+	DescriptionLte           *string              `json:"description_lte,omitempty"`
+	DescriptionGt            *string              `json:"description_gt,omitempty"`
+	DescriptionGte           *string              `json:"description_gte,omitempty"`
+	DescriptionContains      *string              `json:"description_contains,omitempty"`
+	DescriptionNotContains   *string              `json:"description_not_contains,omitempty"`
+	DescriptionStartsWith    *string              `json:"description_starts_with,omitempty"`
+	DescriptionNotStartsWith *string              `json:"description_not_starts_with,omitempty"`
+	DescriptionEndsWith      *string              `json:"description_ends_with,omitempty"`
+	DescriptionNotEndsWith   *string              `json:"description_not_ends_with,omitempty"`
+	Book                     *BookWhereInput      `json:"book,omitempty"`
+	Chapter                  *ChapterWhereInput   `json:"chapter,omitempty"`
+	And                      []RevisionWhereInput `json:"AND,omitempty"`
+	Or                       []RevisionWhereInput `json:"OR,omitempty"`
+	Not                      []RevisionWhereInput `json:"NOT,omitempty"`
+}
+
+type RevisionCreateInput struct {
+	ID          *string                                `json:"id,omitempty"`
+	Key         string                                 `json:"key"`
+	Number      int32                                  `json:"number"`
+	Name        string                                 `json:"name"`
+	Description string                                 `json:"description"`
+	Book        *BookCreateOneWithoutRevisionsInput    `json:"book,omitempty"`
+	Chapter     *ChapterCreateOneWithoutRevisionsInput `json:"chapter,omitempty"`
+}
+
+type RevisionUpdateInput struct {
+	Key         *string                                `json:"key,omitempty"`
+	Number      *int32                                 `json:"number,omitempty"`
+	Name        *string                                `json:"name,omitempty"`
+	Description *string                                `json:"description,omitempty"`
+	Book        *BookUpdateOneWithoutRevisionsInput    `json:"book,omitempty"`
+	Chapter     *ChapterUpdateOneWithoutRevisionsInput `json:"chapter,omitempty"`
+}
+
+type RevisionUpdateManyMutationInput struct {
+	Key         *string `json:"key,omitempty"`
+	Number      *int32  `json:"number,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type RevisionSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                   `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                          `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                         `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                         `json:"updatedFields_contains_some,omitempty"`
+	Node                       *RevisionWhereInput              `json:"node,omitempty"`
+	And                        []RevisionSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []RevisionSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []RevisionSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type RevisionCreateWithoutBookInput struct {
+	ID          *string                                `json:"id,omitempty"`
+	Key         string                                 `json:"key"`
+	Number      int32                                  `json:"number"`
+	Name        string                                 `json:"name"`
+	Description string                                 `json:"description"`
+	Chapter     *ChapterCreateOneWithoutRevisionsInput `json:"chapter,omitempty"`
+}
+
+type RevisionCreateManyWithoutBookInput struct {
+	Create  []RevisionCreateWithoutBookInput `json:"create,omitempty"`
+	Connect []RevisionWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type RevisionUpdateWithoutBookDataInput struct {
+	Key         *string                                `json:"key,omitempty"`
+	Number      *int32                                 `json:"number,omitempty"`
+	Name        *string                                `json:"name,omitempty"`
+	Description *string                                `json:"description,omitempty"`
+	Chapter     *ChapterUpdateOneWithoutRevisionsInput `json:"chapter,omitempty"`
+}
+
+type RevisionUpdateManyWithoutBookInput struct {
+	Create     []RevisionCreateWithoutBookInput                `json:"create,omitempty"`
+	Delete     []RevisionWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []RevisionWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []RevisionWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []RevisionWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []RevisionUpdateWithWhereUniqueWithoutBookInput `json:"update,omitempty"`
+	Upsert     []RevisionUpsertWithWhereUniqueWithoutBookInput `json:"upsert,omitempty"`
+	DeleteMany []RevisionScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []RevisionUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type RevisionUpdateWithWhereUniqueWithoutBookInput struct {
+	Where RevisionWhereUniqueInput           `json:"where"`
+	Data  RevisionUpdateWithoutBookDataInput `json:"data"`
+}
+
+type RevisionUpsertWithWhereUniqueWithoutBookInput struct {
+	Where  RevisionWhereUniqueInput           `json:"where"`
+	Update RevisionUpdateWithoutBookDataInput `json:"update"`
+	Create RevisionCreateWithoutBookInput     `json:"create"`
+}
+
+type RevisionScalarWhereInput struct {
+	ID                       *string                    `json:"id,omitempty"`
+	IDNot                    *string                    `json:"id_not,omitempty"`
+	IDIn                     []string                   `json:"id_in,omitempty"`
+	IDNotIn                  []string                   `json:"id_not_in,omitempty"`
+	IDLt                     *string                    `json:"id_lt,omitempty"`
+	IDLte                    *string                    `json:"id_lte,omitempty"`
+	IDGt                     *string                    `json:"id_gt,omitempty"`
+	IDGte                    *string                    `json:"id_gte,omitempty"`
+	IDContains               *string                    `json:"id_contains,omitempty"`
+	IDNotContains            *string                    `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                    `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                    `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                    `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                    `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                    `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                    `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string                   `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string                   `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                    `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                    `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                    `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                    `json:"createdAt_gte,omitempty"`
+	Key                      *string                    `json:"key,omitempty"`
+	KeyNot                   *string                    `json:"key_not,omitempty"`
+	KeyIn                    []string                   `json:"key_in,omitempty"`
+	KeyNotIn                 []string                   `json:"key_not_in,omitempty"`
+	KeyLt                    *string                    `json:"key_lt,omitempty"`
+	KeyLte                   *string                    `json:"key_lte,omitempty"`
+	KeyGt                    *string                    `json:"key_gt,omitempty"`
+	KeyGte                   *string                    `json:"key_gte,omitempty"`
+	KeyContains              *string                    `json:"key_contains,omitempty"`
+	KeyNotContains           *string                    `json:"key_not_contains,omitempty"`
+	KeyStartsWith            *string                    `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith         *string                    `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith              *string                    `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith           *string                    `json:"key_not_ends_with,omitempty"`
+	Number                   *int32                     `json:"number,omitempty"`
+	NumberNot                *int32                     `json:"number_not,omitempty"`
+	NumberIn                 []int32                    `json:"number_in,omitempty"`
+	NumberNotIn              []int32                    `json:"number_not_in,omitempty"`
+	NumberLt                 *int32                     `json:"number_lt,omitempty"`
+	NumberLte                *int32                     `json:"number_lte,omitempty"`
+	NumberGt                 *int32                     `json:"number_gt,omitempty"`
+	NumberGte                *int32                     `json:"number_gte,omitempty"`
+	Name                     *string                    `json:"name,omitempty"`
+	NameNot                  *string                    `json:"name_not,omitempty"`
+	NameIn                   []string                   `json:"name_in,omitempty"`
+	NameNotIn                []string                   `json:"name_not_in,omitempty"`
+	NameLt                   *string                    `json:"name_lt,omitempty"`
+	NameLte                  *string                    `json:"name_lte,omitempty"`
+	NameGt                   *string                    `json:"name_gt,omitempty"`
+	NameGte                  *string                    `json:"name_gte,omitempty"`
+	NameContains             *string                    `json:"name_contains,omitempty"`
+	NameNotContains          *string                    `json:"name_not_contains,omitempty"`
+	NameStartsWith           *string                    `json:"name_starts_with,omitempty"`
+	NameNotStartsWith        *string                    `json:"name_not_starts_with,omitempty"`
+	NameEndsWith             *string                    `json:"name_ends_with,omitempty"`
+	NameNotEndsWith          *string                    `json:"name_not_ends_with,omitempty"`
+	Description              *string                    `json:"description,omitempty"`
+	DescriptionNot           *string                    `json:"description_not,omitempty"`
+	DescriptionIn            []string                   `json:"description_in,omitempty"`
+	DescriptionNotIn         []string                   `json:"description_not_in,omitempty"`
+	DescriptionLt            *string                    `json:"description_lt,omitempty"`
+	DescriptionLte           *string                    `json:"description_lte,omitempty"`
+	DescriptionGt            *string                    `json:"description_gt,omitempty"`
+	DescriptionGte           *string                    `json:"description_gte,omitempty"`
+	DescriptionContains      *string                    `json:"description_contains,omitempty"`
+	DescriptionNotContains   *string                    `json:"description_not_contains,omitempty"`
+	DescriptionStartsWith    *string                    `json:"description_starts_with,omitempty"`
+	DescriptionNotStartsWith *string                    `json:"description_not_starts_with,omitempty"`
+	DescriptionEndsWith      *string                    `json:"description_ends_with,omitempty"`
+	DescriptionNotEndsWith   *string                    `json:"description_not_ends_with,omitempty"`
+	And                      []RevisionScalarWhereInput `json:"AND,omitempty"`
+	Or                       []RevisionScalarWhereInput `json:"OR,omitempty"`
+	Not                      []RevisionScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type RevisionUpdateManyWithWhereNestedInput struct {
+	Where RevisionScalarWhereInput    `json:"where"`
+	Data  RevisionUpdateManyDataInput `json:"data"`
+}
+
+type RevisionUpdateManyDataInput struct {
+	Key         *string `json:"key,omitempty"`
+	Number      *int32  `json:"number,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type RevisionCreateWithoutChapterInput struct {
+	ID          *string                             `json:"id,omitempty"`
+	Key         string                              `json:"key"`
+	Number      int32                               `json:"number"`
+	Name        string                              `json:"name"`
+	Description string                              `json:"description"`
+	Book        *BookCreateOneWithoutRevisionsInput `json:"book,omitempty"`
+}
+
+type RevisionCreateManyWithoutChapterInput struct {
+	Create  []RevisionCreateWithoutChapterInput `json:"create,omitempty"`
+	Connect []RevisionWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type RevisionUpdateWithoutChapterDataInput struct {
+	Key         *string                             `json:"key,omitempty"`
+	Number      *int32                              `json:"number,omitempty"`
+	Name        *string                             `json:"name,omitempty"`
+	Description *string                             `json:"description,omitempty"`
+	Book        *BookUpdateOneWithoutRevisionsInput `json:"book,omitempty"`
+}
+
+type RevisionUpdateManyWithoutChapterInput struct {
+	Create     []RevisionCreateWithoutChapterInput                `json:"create,omitempty"`
+	Delete     []RevisionWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []RevisionWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []RevisionWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []RevisionWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []RevisionUpdateWithWhereUniqueWithoutChapterInput `json:"update,omitempty"`
+	Upsert     []RevisionUpsertWithWhereUniqueWithoutChapterInput `json:"upsert,omitempty"`
+	DeleteMany []RevisionScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []RevisionUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type RevisionUpdateWithWhereUniqueWithoutChapterInput struct {
+	Where RevisionWhereUniqueInput              `json:"where"`
+	Data  RevisionUpdateWithoutChapterDataInput `json:"data"`
+}
+
+type RevisionUpsertWithWhereUniqueWithoutChapterInput struct {
+	Where  RevisionWhereUniqueInput              `json:"where"`
+	Update RevisionUpdateWithoutChapterDataInput `json:"update"`
+	Create RevisionCreateWithoutChapterInput     `json:"create"`
+}
+
+type BookCreateWithoutRevisionsInput struct {
+	ID          *string                                   `json:"id,omitempty"`
+	Name        string                                    `json:"name"`
+	Description string                                    `json:"description"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorCreateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagCreateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionCreateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Chapters    *ChapterCreateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookCreateOneWithoutRevisionsInput struct {
+	Create  *BookCreateWithoutRevisionsInput `json:"create,omitempty"`
+	Connect *BookWhereUniqueInput            `json:"connect,omitempty"`
+}
+
+type BookUpdateWithoutRevisionsDataInput struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorUpdateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagUpdateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionUpdateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Chapters    *ChapterUpdateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookUpdateOneWithoutRevisionsInput struct {
+	Create     *BookCreateWithoutRevisionsInput     `json:"create,omitempty"`
+	Update     *BookUpdateWithoutRevisionsDataInput `json:"update,omitempty"`
+	Upsert     *BookUpsertWithoutRevisionsInput     `json:"upsert,omitempty"`
+	Delete     *bool                                `json:"delete,omitempty"`
+	Disconnect *bool                                `json:"disconnect,omitempty"`
+	Connect    *BookWhereUniqueInput                `json:"connect,omitempty"`
+}
+
+type BookUpsertWithoutRevisionsInput struct {
+	Update BookUpdateWithoutRevisionsDataInput `json:"update"`
+	Create BookCreateWithoutRevisionsInput     `json:"create"`
+}
+
+type ChapterCreateWithoutRevisionsInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput             `json:"book"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterCreateOneWithoutRevisionsInput struct {
+	Create  *ChapterCreateWithoutRevisionsInput `json:"create,omitempty"`
+	Connect *ChapterWhereUniqueInput            `json:"connect,omitempty"`
+}
+
+type ChapterUpdateWithoutRevisionsDataInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput    `json:"book,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateOneWithoutRevisionsInput struct {
+	Create     *ChapterCreateWithoutRevisionsInput     `json:"create,omitempty"`
+	Update     *ChapterUpdateWithoutRevisionsDataInput `json:"update,omitempty"`
+	Upsert     *ChapterUpsertWithoutRevisionsInput     `json:"upsert,omitempty"`
+	Delete     *bool                                   `json:"delete,omitempty"`
+	Disconnect *bool                                   `json:"disconnect,omitempty"`
+	Connect    *ChapterWhereUniqueInput                `json:"connect,omitempty"`
+}
+
+type ChapterUpsertWithoutRevisionsInput struct {
+	Update ChapterUpdateWithoutRevisionsDataInput `json:"update"`
+	Create ChapterCreateWithoutRevisionsInput     `json:"create"`
+}
+
+type IdempotencyKeyWhereUniqueInput struct {
+	ID  *string `json:"id,omitempty"`
+	Key *string `json:"key,omitempty"`
+}
+
+type IdempotencyKeyWhereInput struct {
+	ID                       *string                    `json:"id,omitempty"`
+	IDNot                    *string                    `json:"id_not,omitempty"`
+	IDIn                     []string                   `json:"id_in,omitempty"`
+	IDNotIn                  []string                   `json:"id_not_in,omitempty"`
+	IDLt                     *string                    `json:"id_lt,omitempty"`
+	IDLte                    *string                    `json:"id_lte,omitempty"`
+	IDGt                     *string                    `json:"id_gt,omitempty"`
+	IDGte                    *string                    `json:"id_gte,omitempty"`
+	IDContains               *string                    `json:"id_contains,omitempty"`
+	IDNotContains            *string                    `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                    `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                    `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                    `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                    `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                    `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                    `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string                   `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string                   `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                    `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                    `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                    `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                    `json:"createdAt_gte,omitempty"`
+	Key                      *string                    `json:"key,omitempty"`
+	KeyNot                   *string                    `json:"key_not,omitempty"`
+	KeyIn                    []string                   `json:"key_in,omitempty"`
+	KeyNotIn                 []string                   `json:"key_not_in,omitempty"`
+	KeyLt                    *string                    `json:"key_lt,omitempty"`
+	KeyLte                   *string                    `json:"key_lte,omitempty"`
+	KeyGt                    *string                    `json:"key_gt,omitempty"`
+	KeyGte                   *string                    `json:"key_gte,omitempty"`
+	KeyContains              *string                    `json:"key_contains,omitempty"`
+	KeyNotContains           *string                    `json:"key_not_contains,omitempty"`
+	KeyStartsWith            *string                    `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith         *string                    `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith              *string                    `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith           *string                    `json:"key_not_ends_with,omitempty"`
+	RequestHash              *string                    `json:"requestHash,omitempty"`
+	RequestHashNot           *string                    `json:"requestHash_not,omitempty"`
+	RequestHashIn            []string                   `json:"requestHash_in,omitempty"`
+	RequestHashNotIn         []string                   `json:"requestHash_not_in,omitempty"`
+	RequestHashLt            *string                    `json:"requestHash_lt,omitempty"`
+	RequestHashLte           *string                    `json:"requestHash_lte,omitempty"`
+	RequestHashGt            *string                    `json:"requestHash_gt,omitempty"`
+	RequestHashGte           *string                    `json:"requestHash_gte,omitempty"`
+	RequestHashContains      *string                    `json:"requestHash_contains,omitempty"`
+	RequestHashNotContains   *string                    `json:"requestHash_not_contains,omitempty"`
+	RequestHashStartsWith    *string                    `json:"requestHash_starts_with,omitempty"`
+	RequestHashNotStartsWith *string                    `json:"requestHash_not_starts_with,omitempty"`
+	RequestHashEndsWith      *string                    `json:"requestHash_ends_with,omitempty"`
+	RequestHashNotEndsWith   *string                    `json:"requestHash_not_ends_with,omitempty"`
+	StatusCode               *int32                     `json:"statusCode,omitempty"`
+	StatusCodeNot            *int32                     `json:"statusCode_not,omitempty"`
+	StatusCodeIn             []int32                    `json:"statusCode_in,omitempty"`
+	StatusCodeNotIn          []int32                    `json:"statusCode_not_in,omitempty"`
+	StatusCodeLt             *int32                     `json:"statusCode_lt,omitempty"`
+	StatusCodeLte            *int32                     `json:"statusCode_lte,omitempty"`
+	StatusCodeGt             *int32                     `json:"statusCode_gt,omitempty"`
+	StatusCodeGte            *int32                     `json:"statusCode_gte,omitempty"`
+	ContentType              *string                    `json:"contentType,omitempty"`
+	ContentTypeNot           *string                    `json:"contentType_not,omitempty"`
+	ContentTypeIn            []string                   `json:"contentType_in,omitempty"`
+	ContentTypeNotIn         []string                   `json:"contentType_not_in,omitempty"`
+	ContentTypeLt            *string                    `json:"contentType_lt,omitempty"`
+	ContentTypeLte           *string                    `json:"contentType_lte,omitempty"`
+	ContentTypeGt            *string                    `json:"contentType_gt,omitempty"`
+	ContentTypeGte           *string                    `json:"contentType_gte,omitempty"`
+	ContentTypeContains      *string                    `json:"contentType_contains,omitempty"`
+	ContentTypeNotContains   *string                    `json:"contentType_not_contains,omitempty"`
+	ContentTypeStartsWith    *string                    `json:"contentType_starts_with,omitempty"`
+	ContentTypeNotStartsWith *string                    `json:"contentType_not_starts_with,omitempty"`
+	ContentTypeEndsWith      *string                    `json:"contentType_ends_with,omitempty"`
+	ContentTypeNotEndsWith   *string                    `json:"contentType_not_ends_with,omitempty"`
+	Etag                     *string                    `json:"etag,omitempty"`
+	EtagNot                  *string                    `json:"etag_not,omitempty"`
+	EtagIn                   []string                   `json:"etag_in,omitempty"`
+	EtagNotIn                []string                   `json:"etag_not_in,omitempty"`
+	EtagLt                   *string                    `json:"etag_lt,omitempty"`
+	EtagLte                  *string                    `json:"etag_lte,omitempty"`
+	EtagGt                   *string                    `json:"etag_gt,omitempty"`
+	EtagGte                  *string                    `json:"etag_gte,omitempty"`
+	EtagContains             *string                    `json:"etag_contains,omitempty"`
+	EtagNotContains          *string                    `json:"etag_not_contains,omitempty"`
+	EtagStartsWith           *string                    `json:"etag_starts_with,omitempty"`
+	EtagNotStartsWith        *string                    `json:"etag_not_starts_with,omitempty"`
+	EtagEndsWith             *string                    `json:"etag_ends_with,omitempty"`
+	EtagNotEndsWith          *string                    `json:"etag_not_ends_with,omitempty"`
+	Body                     *string                    `json:"body,omitempty"`
+	BodyNot                  *string                    `json:"body_not,omitempty"`
+	BodyIn                   []string                   `json:"body_in,omitempty"`
+	BodyNotIn                []string                   `json:"body_not_in,omitempty"`
+	BodyLt                   *string                    `json:"body_lt,omitempty"`
+	BodyLte                  *string                    `json:"body_lte,omitempty"`
+	BodyGt                   *string                    `json:"body_gt,omitempty"`
+	BodyGte                  *string                    `json:"body_gte,omitempty"`
+	BodyContains             *string                    `json:"body_contains,omitempty"`
+	BodyNotContains          *string                    `json:"body_not_contains,omitempty"`
+	BodyStartsWith           *string                    `json:"body_starts_with,omitempty"`
+	BodyNotStartsWith        *string                    `json:"body_not_starts_with,omitempty"`
+	BodyEndsWith             *string                    `json:"body_ends_with,omitempty"`
+	BodyNotEndsWith          *string                    `json:"body_not_ends_with,omitempty"`
+	ExpiresAt                *string                    `json:"expiresAt,omitempty"`
+	ExpiresAtNot             *string                    `json:"expiresAt_not,omitempty"`
+	ExpiresAtIn              []string                   `json:"expiresAt_in,omitempty"`
+	ExpiresAtNotIn           []string                   `json:"expiresAt_not_in,omitempty"`
+	ExpiresAtLt              *string                    `json:"expiresAt_lt,omitempty"`
+	ExpiresAtLte             *string                    `json:"expiresAt_lte,omitempty"`
+	ExpiresAtGt              *string                    `json:"expiresAt_gt,omitempty"`
+	ExpiresAtGte             *string                    `json:"expiresAt_gte,omitempty"`
+	And                      []IdempotencyKeyWhereInput `json:"AND,omitempty"`
+	Or                       []IdempotencyKeyWhereInput `json:"OR,omitempty"`
+	Not                      []IdempotencyKeyWhereInput `json:"NOT,omitempty"`
+}
+
+type IdempotencyKeyCreateInput struct {
+	ID          *string `json:"id,omitempty"`
+	Key         string  `json:"key"`
+	RequestHash string  `json:"requestHash"`
+	StatusCode  *int32  `json:"statusCode,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+	Etag        *string `json:"etag,omitempty"`
+	Body        *string `json:"body,omitempty"`
+	ExpiresAt   string  `json:"expiresAt"`
+}
+
+type IdempotencyKeyUpdateInput struct {
+	Key         *string `json:"key,omitempty"`
+	RequestHash *string `json:"requestHash,omitempty"`
+	StatusCode  *int32  `json:"statusCode,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+	Etag        *string `json:"etag,omitempty"`
+	Body        *string `json:"body,omitempty"`
+	ExpiresAt   *string `json:"expiresAt,omitempty"`
+}
+
+type IdempotencyKeyUpdateManyMutationInput struct {
+	Key         *string `json:"key,omitempty"`
+	RequestHash *string `json:"requestHash,omitempty"`
+	StatusCode  *int32  `json:"statusCode,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+	Etag        *string `json:"etag,omitempty"`
+	Body        *string `json:"body,omitempty"`
+	ExpiresAt   *string `json:"expiresAt,omitempty"`
+}
+
+type IdempotencyKeySubscriptionWhereInput struct {
+	MutationIn                 []MutationType                         `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                                `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                               `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                               `json:"updatedFields_contains_some,omitempty"`
+	Node                       *IdempotencyKeyWhereInput              `json:"node,omitempty"`
+	And                        []IdempotencyKeySubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []IdempotencyKeySubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []IdempotencyKeySubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type AuthorWhereUniqueInput struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type AuthorWhereInput struct {
+	ID                *string            `json:"id,omitempty"`
+	IDNot             *string            `json:"id_not,omitempty"`
+	IDIn              []string           `json:"id_in,omitempty"`
+	IDNotIn           []string           `json:"id_not_in,omitempty"`
+	IDLt              *string            `json:"id_lt,omitempty"`
+	IDLte             *string            `json:"id_lte,omitempty"`
+	IDGt              *string            `json:"id_gt,omitempty"`
+	IDGte             *string            `json:"id_gte,omitempty"`
+	IDContains        *string            `json:"id_contains,omitempty"`
+	IDNotContains     *string            `json:"id_not_contains,omitempty"`
+	IDStartsWith      *string            `json:"id_starts_with,omitempty"`
+	IDNotStartsWith   *string            `json:"id_not_starts_with,omitempty"`
+	IDEndsWith        *string            `json:"id_ends_with,omitempty"`
+	IDNotEndsWith     *string            `json:"id_not_ends_with,omitempty"`
+	CreatedAt         *string            `json:"createdAt,omitempty"`
+	CreatedAtNot      *string            `json:"createdAt_not,omitempty"`
+	CreatedAtIn       []string           `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn    []string           `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt       *string            `json:"createdAt_lt,omitempty"`
+	CreatedAtLte      *string            `json:"createdAt_lte,omitempty"`
+	CreatedAtGt       *string            `json:"createdAt_gt,omitempty"`
+	CreatedAtGte      *string            `json:"createdAt_gte,omitempty"`
+	UpdatedAt         *string            `json:"updatedAt,omitempty"`
+	UpdatedAtNot      *string            `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn       []string           `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn    []string           `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt       *string            `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte      *string            `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt       *string            `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte      *string            `json:"updatedAt_gte,omitempty"`
+	Name              *string            `json:"name,omitempty"`
+	NameNot           *string            `json:"name_not,omitempty"`
+	NameIn            []string           `json:"name_in,omitempty"`
+	NameNotIn         []string           `json:"name_not_in,omitempty"`
+	NameLt            *string            `json:"name_lt,omitempty"`
+	NameLte           *string            `json:"name_lte,omitempty"`
+	NameGt            *string            `json:"name_gt,omitempty"`
+	NameGte           *string            `json:"name_gte,omitempty"`
+	NameContains      *string            `json:"name_contains,omitempty"`
+	NameNotContains   *string            `json:"name_not_contains,omitempty"`
+	NameStartsWith    *string            `json:"name_starts_with,omitempty"`
+	NameNotStartsWith *string            `json:"name_not_starts_with,omitempty"`
+	NameEndsWith      *string            `json:"name_ends_with,omitempty"`
+	NameNotEndsWith   *string            `json:"name_not_ends_with,omitempty"`
+	BooksEvery        *BookWhereInput    `json:"books_every,omitempty"`
+	BooksSome         *BookWhereInput    `json:"books_some,omitempty"`
+	BooksNone         *BookWhereInput    `json:"books_none,omitempty"`
+	And               []AuthorWhereInput `json:"AND,omitempty"`
+	Or                []AuthorWhereInput `json:"OR,omitempty"`
+	Not               []AuthorWhereInput `json:"NOT,omitempty"`
+}
+
+type AuthorCreateInput struct {
+	ID    *string                            `json:"id,omitempty"`
+	Name  string                             `json:"name"`
+	Books *BookCreateManyWithoutAuthorsInput `json:"books,omitempty"`
+}
+
+type AuthorUpdateInput struct {
+	Name  *string                            `json:"name,omitempty"`
+	Books *BookUpdateManyWithoutAuthorsInput `json:"books,omitempty"`
+}
+
+type AuthorUpdateManyMutationInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type AuthorSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                 `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                        `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                       `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                       `json:"updatedFields_contains_some,omitempty"`
+	Node                       *AuthorWhereInput              `json:"node,omitempty"`
+	And                        []AuthorSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []AuthorSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []AuthorSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type AuthorCreateWithoutBooksInput struct {
+	ID   *string `json:"id,omitempty"`
+	Name string  `json:"name"`
+}
+
+type AuthorCreateManyWithoutBooksInput struct {
+	Create  []AuthorCreateWithoutBooksInput `json:"create,omitempty"`
+	Connect []AuthorWhereUniqueInput        `json:"connect,omitempty"`
+}
+
+type AuthorUpdateWithoutBooksDataInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type AuthorUpdateManyWithoutBooksInput struct {
+	Create     []AuthorCreateWithoutBooksInput                `json:"create,omitempty"`
+	Delete     []AuthorWhereUniqueInput                       `json:"delete,omitempty"`
+	Connect    []AuthorWhereUniqueInput                       `json:"connect,omitempty"`
+	Set        []AuthorWhereUniqueInput                       `json:"set,omitempty"`
+	Disconnect []AuthorWhereUniqueInput                       `json:"disconnect,omitempty"`
+	Update     []AuthorUpdateWithWhereUniqueWithoutBooksInput `json:"update,omitempty"`
+	Upsert     []AuthorUpsertWithWhereUniqueWithoutBooksInput `json:"upsert,omitempty"`
+	DeleteMany []AuthorScalarWhereInput                       `json:"deleteMany,omitempty"`
+	UpdateMany []AuthorUpdateManyWithWhereNestedInput         `json:"updateMany,omitempty"`
+}
+
+type AuthorUpdateWithWhereUniqueWithoutBooksInput struct {
+	Where AuthorWhereUniqueInput            `json:"where"`
+	Data  AuthorUpdateWithoutBooksDataInput `json:"data"`
+}
+
+type AuthorUpsertWithWhereUniqueWithoutBooksInput struct {
+	Where  AuthorWhereUniqueInput            `json:"where"`
+	Update AuthorUpdateWithoutBooksDataInput `json:"update"`
+	Create AuthorCreateWithoutBooksInput     `json:"create"`
+}
+
+type AuthorScalarWhereInput struct {
+	ID                *string                  `json:"id,omitempty"`
+	IDNot             *string                  `json:"id_not,omitempty"`
+	IDIn              []string                 `json:"id_in,omitempty"`
+	IDNotIn           []string                 `json:"id_not_in,omitempty"`
+	IDLt              *string                  `json:"id_lt,omitempty"`
+	IDLte             *string                  `json:"id_lte,omitempty"`
+	IDGt              *string                  `json:"id_gt,omitempty"`
+	IDGte             *string                  `json:"id_gte,omitempty"`
+	IDContains        *string                  `json:"id_contains,omitempty"`
+	IDNotContains     *string                  `json:"id_not_contains,omitempty"`
+	IDStartsWith      *string                  `json:"id_starts_with,omitempty"`
+	IDNotStartsWith   *string                  `json:"id_not_starts_with,omitempty"`
+	IDEndsWith        *string                  `json:"id_ends_with,omitempty"`
+	IDNotEndsWith     *string                  `json:"id_not_ends_with,omitempty"`
+	CreatedAt         *string                  `json:"createdAt,omitempty"`
+	CreatedAtNot      *string                  `json:"createdAt_not,omitempty"`
+	CreatedAtIn       []string                 `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn    []string                 `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt       *string                  `json:"createdAt_lt,omitempty"`
+	CreatedAtLte      *string                  `json:"createdAt_lte,omitempty"`
+	CreatedAtGt       *string                  `json:"createdAt_gt,omitempty"`
+	CreatedAtGte      *string                  `json:"createdAt_gte,omitempty"`
+	UpdatedAt         *string                  `json:"updatedAt,omitempty"`
+	UpdatedAtNot      *string                  `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn       []string                 `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn    []string                 `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt       *string                  `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte      *string                  `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt       *string                  `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte      *string                  `json:"updatedAt_gte,omitempty"`
+	Name              *string                  `json:"name,omitempty"`
+	NameNot           *string                  `json:"name_not,omitempty"`
+	NameIn            []string                 `json:"name_in,omitempty"`
+	NameNotIn         []string                 `json:"name_not_in,omitempty"`
+	NameLt            *string                  `json:"name_lt,omitempty"`
+	NameLte           *string                  `json:"name_lte,omitempty"`
+	NameGt            *string                  `json:"name_gt,omitempty"`
+	NameGte           *string                  `json:"name_gte,omitempty"`
+	NameContains      *string                  `json:"name_contains,omitempty"`
+	NameNotContains   *string                  `json:"name_not_contains,omitempty"`
+	NameStartsWith    *string                  `json:"name_starts_with,omitempty"`
+	NameNotStartsWith *string                  `json:"name_not_starts_with,omitempty"`
+	NameEndsWith      *string                  `json:"name_ends_with,omitempty"`
+	NameNotEndsWith   *string                  `json:"name_not_ends_with,omitempty"`
+	And               []AuthorScalarWhereInput `json:"AND,omitempty"`
+	Or                []AuthorScalarWhereInput `json:"OR,omitempty"`
+	Not               []AuthorScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type AuthorUpdateManyWithWhereNestedInput struct {
+	Where AuthorScalarWhereInput    `json:"where"`
+	Data  AuthorUpdateManyDataInput `json:"data"`
+}
+
+type AuthorUpdateManyDataInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type BookCreateWithoutAuthorsInput struct {
+	ID          *string                                   `json:"id,omitempty"`
+	Name        string                                    `json:"name"`
+	Description string                                    `json:"description"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionCreateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionCreateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterCreateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookCreateManyWithoutAuthorsInput struct {
+	Create  []BookCreateWithoutAuthorsInput `json:"create,omitempty"`
+	Connect []BookWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type BookUpdateWithoutAuthorsDataInput struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionUpdateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterUpdateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookUpdateManyWithoutAuthorsInput struct {
+	Create     []BookCreateWithoutAuthorsInput                `json:"create,omitempty"`
+	Delete     []BookWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []BookWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []BookWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []BookWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []BookUpdateWithWhereUniqueWithoutAuthorsInput `json:"update,omitempty"`
+	Upsert     []BookUpsertWithWhereUniqueWithoutAuthorsInput `json:"upsert,omitempty"`
+	DeleteMany []BookScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []BookUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type BookUpdateWithWhereUniqueWithoutAuthorsInput struct {
+	Where BookWhereUniqueInput              `json:"where"`
+	Data  BookUpdateWithoutAuthorsDataInput `json:"data"`
+}
+
+type BookUpsertWithWhereUniqueWithoutAuthorsInput struct {
+	Where  BookWhereUniqueInput              `json:"where"`
+	Update BookUpdateWithoutAuthorsDataInput `json:"update"`
+	Create BookCreateWithoutAuthorsInput     `json:"create"`
+}
+
+type BookScalarWhereInput struct {
+	ID                       *string                `json:"id,omitempty"`
+	IDNot                    *string                `json:"id_not,omitempty"`
+	IDIn                     []string               `json:"id_in,omitempty"`
+	IDNotIn                  []string               `json:"id_not_in,omitempty"`
+	IDLt                     *string                `json:"id_lt,omitempty"`
+	IDLte                    *string                `json:"id_lte,omitempty"`
+	IDGt                     *string                `json:"id_gt,omitempty"`
+	IDGte                    *string                `json:"id_gte,omitempty"`
+	IDContains               *string                `json:"id_contains,omitempty"`
+	IDNotContains            *string                `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string               `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string               `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                `json:"createdAt_gte,omitempty"`
+	UpdatedAt                *string                `json:"updatedAt,omitempty"`
+	UpdatedAtNot             *string                `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn              []string               `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn           []string               `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt              *string                `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte             *string                `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt              *string                `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte             *string                `json:"updatedAt_gte,omitempty"`
+	Name                     *string                `json:"name,omitempty"`
+	NameNot                  *string                `json:"name_not,omitempty"`
+	NameIn                   []string               `json:"name_in,omitempty"`
+	NameNotIn                []string               `json:"name_not_in,omitempty"`
+	NameLt                   *string                `json:"name_lt,omitempty"`
+	NameLte                  *string                `json:"name_lte,omitempty"`
+	NameGt                   *string                `json:"name_gt,omitempty"`
+	NameGte                  *string                `json:"name_gte,omitempty"`
+	NameContains             *string                `json:"name_contains,omitempty"`
+	NameNotContains          *string                `json:"name_not_contains,omitempty"`
+	NameStartsWith           *string                `json:"name_starts_with,omitempty"`
+	NameNotStartsWith        *string                `json:"name_not_starts_with,omitempty"`
+	NameEndsWith             *string                `json:"name_ends_with,omitempty"`
+	NameNotEndsWith          *string                `json:"name_not_ends_with,omitempty"`
+	Description              *string                `json:"description,omitempty"`
+	DescriptionNot           *string                `json:"description_not,omitempty"`
+	DescriptionIn            []string               `json:"description_in,omitempty"`
+	DescriptionNotIn         []string               `json:"description_not_in,omitempty"`
+	DescriptionLt            *string                `json:"description_lt,omitempty"`
+	DescriptionLte           *string                `json:"description_lte,omitempty"`
+	DescriptionGt            *string                `json:"description_gt,omitempty"`
+	DescriptionGte           *string                `json:"description_gte,omitempty"`
+	DescriptionContains      *string                `json:"description_contains,omitempty"`
+	DescriptionNotContains   *string                `json:"description_not_contains,omitempty"`
+	DescriptionStartsWith    *string                `json:"description_starts_with,omitempty"`
+	DescriptionNotStartsWith *string                `json:"description_not_starts_with,omitempty"`
+	DescriptionEndsWith      *string                `json:"description_ends_with,omitempty"`
+	DescriptionNotEndsWith   *string                `json:"description_not_ends_with,omitempty"`
+	Isbn                     *string                `json:"isbn,omitempty"`
+	IsbnNot                  *string                `json:"isbn_not,omitempty"`
+	IsbnIn                   []string               `json:"isbn_in,omitempty"`
+	IsbnNotIn                []string               `json:"isbn_not_in,omitempty"`
+	IsbnLt                   *string                `json:"isbn_lt,omitempty"`
+	IsbnLte                  *string                `json:"isbn_lte,omitempty"`
+	IsbnGt                   *string                `json:"isbn_gt,omitempty"`
+	IsbnGte                  *string                `json:"isbn_gte,omitempty"`
+	IsbnContains             *string                `json:"isbn_contains,omitempty"`
+	IsbnNotContains          *string                `json:"isbn_not_contains,omitempty"`
+	IsbnStartsWith           *string                `json:"isbn_starts_with,omitempty"`
+	IsbnNotStartsWith        *string                `json:"isbn_not_starts_with,omitempty"`
+	IsbnEndsWith             *string                `json:"isbn_ends_with,omitempty"`
+	IsbnNotEndsWith          *string                `json:"isbn_not_ends_with,omitempty"`
+	Publisher                *string                `json:"publisher,omitempty"`
+	PublisherNot             *string                `json:"publisher_not,omitempty"`
+	PublisherIn              []string               `json:"publisher_in,omitempty"`
+	PublisherNotIn           []string               `json:"publisher_not_in,omitempty"`
+	PublisherLt              *string                `json:"publisher_lt,omitempty"`
+	PublisherLte             *string                `json:"publisher_lte,omitempty"`
+	PublisherGt              *string                `json:"publisher_gt,omitempty"`
+	PublisherGte             *string                `json:"publisher_gte,omitempty"`
+	PublisherContains        *string                `json:"publisher_contains,omitempty"`
+	PublisherNotContains     *string                `json:"publisher_not_contains,omitempty"`
+	PublisherStartsWith      *string                `json:"publisher_starts_with,omitempty"`
+	PublisherNotStartsWith   *string                `json:"publisher_not_starts_with,omitempty"`
+	PublisherEndsWith        *string                `json:"publisher_ends_with,omitempty"`
+	PublisherNotEndsWith     *string                `json:"publisher_not_ends_with,omitempty"`
+	Year                     *int32                 `json:"year,omitempty"`
+	YearNot                  *int32                 `json:"year_not,omitempty"`
+	YearIn                   []int32                `json:"year_in,omitempty"`
+	YearNotIn                []int32                `json:"year_not_in,omitempty"`
+	YearLt                   *int32                 `json:"year_lt,omitempty"`
+	YearLte                  *int32                 `json:"year_lte,omitempty"`
+	YearGt                   *int32                 `json:"year_gt,omitempty"`
+	YearGte                  *int32                 `json:"year_gte,omitempty"`
+	Language                 *string                `json:"language,omitempty"`
+	LanguageNot              *string                `json:"language_not,omitempty"`
+	LanguageIn               []string               `json:"language_in,omitempty"`
+	LanguageNotIn            []string               `json:"language_not_in,omitempty"`
+	LanguageLt               *string                `json:"language_lt,omitempty"`
+	LanguageLte              *string                `json:"language_lte,omitempty"`
+	LanguageGt               *string                `json:"language_gt,omitempty"`
+	LanguageGte              *string                `json:"language_gte,omitempty"`
+	LanguageContains         *string                `json:"language_contains,omitempty"`
+	LanguageNotContains      *string                `json:"language_not_contains,omitempty"`
+	LanguageStartsWith       *string                `json:"language_starts_with,omitempty"`
+	LanguageNotStartsWith    *string                `json:"language_not_starts_with,omitempty"`
+	LanguageEndsWith         *string                `json:"language_ends_with,omitempty"`
+	LanguageNotEndsWith      *string                `json:"language_not_ends_with,omitempty"`
+	Deleted                  *bool                  `json:"deleted,omitempty"`
+	DeletedNot               *bool                  `json:"deleted_not,omitempty"`
+	DeletedAt                *string                `json:"deletedAt,omitempty"`
+	DeletedAtNot             *string                `json:"deletedAt_not,omitempty"`
+	DeletedAtIn              []string               `json:"deletedAt_in,omitempty"`
+	DeletedAtNotIn           []string               `json:"deletedAt_not_in,omitempty"`
+	DeletedAtLt              *string                `json:"deletedAt_lt,omitempty"`
+	DeletedAtLte             *string                `json:"deletedAt_lte,omitempty"`
+	DeletedAtGt              *string                `json:"deletedAt_gt,omitempty"`
+	DeletedAtGte             *string                `json:"deletedAt_gte,omitempty"`
+	Revision                 *int32                 `json:"revision,omitempty"`
+	RevisionNot              *int32                 `json:"revision_not,omitempty"`
+	RevisionIn               []int32                `json:"revision_in,omitempty"`
+	RevisionNotIn            []int32                `json:"revision_not_in,omitempty"`
+	RevisionLt               *int32                 `json:"revision_lt,omitempty"`
+	RevisionLte              *int32                 `json:"revision_lte,omitempty"`
+	RevisionGt               *int32                 `json:"revision_gt,omitempty"`
+	RevisionGte              *int32                 `json:"revision_gte,omitempty"`
+	And                      []BookScalarWhereInput `json:"AND,omitempty"`
+	Or                       []BookScalarWhereInput `json:"OR,omitempty"`
+	Not                      []BookScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type BookUpdateManyWithWhereNestedInput struct {
+	Where BookScalarWhereInput    `json:"where"`
+	Data  BookUpdateManyDataInput `json:"data"`
+}
+
+type BookUpdateManyDataInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Isbn        *string `json:"isbn,omitempty"`
+	Publisher   *string `json:"publisher,omitempty"`
+	Year        *int32  `json:"year,omitempty"`
+	Language    *string `json:"language,omitempty"`
+	Deleted     *bool   `json:"deleted,omitempty"`
+	DeletedAt   *string `json:"deletedAt,omitempty"`
+	Revision    *int32  `json:"revision,omitempty"`
+}
+
+type TagWhereUniqueInput struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type TagWhereInput struct {
+	ID                *string            `json:"id,omitempty"`
+	IDNot             *string            `json:"id_not,omitempty"`
+	IDIn              []string           `json:"id_in,omitempty"`
+	IDNotIn           []string           `json:"id_not_in,omitempty"`
+	IDLt              *string            `json:"id_lt,omitempty"`
+	IDLte             *string            `json:"id_lte,omitempty"`
+	IDGt              *string            `json:"id_gt,omitempty"`
+	IDGte             *string            `json:"id_gte,omitempty"`
+	IDContains        *string            `json:"id_contains,omitempty"`
+	IDNotContains     *string            `json:"id_not_contains,omitempty"`
+	IDStartsWith      *string            `json:"id_starts_with,omitempty"`
+	IDNotStartsWith   *string            `json:"id_not_starts_with,omitempty"`
+	IDEndsWith        *string            `json:"id_ends_with,omitempty"`
+	IDNotEndsWith     *string            `json:"id_not_ends_with,omitempty"`
+	CreatedAt         *string            `json:"createdAt,omitempty"`
+	CreatedAtNot      *string            `json:"createdAt_not,omitempty"`
+	CreatedAtIn       []string           `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn    []string           `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt       *string            `json:"createdAt_lt,omitempty"`
+	CreatedAtLte      *string            `json:"createdAt_lte,omitempty"`
+	CreatedAtGt       *string            `json:"createdAt_gt,omitempty"`
+	CreatedAtGte      *string            `json:"createdAt_gte,omitempty"`
+	UpdatedAt         *string            `json:"updatedAt,omitempty"`
+	UpdatedAtNot      *string            `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn       []string           `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn    []string           `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt       *string            `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte      *string            `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt       *string            `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte      *string            `json:"updatedAt_gte,omitempty"`
+	Name              *string            `json:"name,omitempty"`
+	NameNot           *string            `json:"name_not,omitempty"`
+	NameIn            []string           `json:"name_in,omitempty"`
+	NameNotIn         []string           `json:"name_not_in,omitempty"`
+	NameLt            *string            `json:"name_lt,omitempty"`
+	NameLte           *string            `json:"name_lte,omitempty"`
+	NameGt            *string            `json:"name_gt,omitempty"`
+	NameGte           *string            `json:"name_gte,omitempty"`
+	NameContains      *string            `json:"name_contains,omitempty"`
+	NameNotContains   *string            `json:"name_not_contains,omitempty"`
+	NameStartsWith    *string            `json:"name_starts_with,omitempty"`
+	NameNotStartsWith *string            `json:"name_not_starts_with,omitempty"`
+	NameEndsWith      *string            `json:"name_ends_with,omitempty"`
+	NameNotEndsWith   *string            `json:"name_not_ends_with,omitempty"`
+	BooksEvery        *BookWhereInput    `json:"books_every,omitempty"`
+	BooksSome         *BookWhereInput    `json:"books_some,omitempty"`
+	BooksNone         *BookWhereInput    `json:"books_none,omitempty"`
+	ChaptersEvery     *ChapterWhereInput `json:"chapters_every,omitempty"`
+	ChaptersSome      *ChapterWhereInput `json:"chapters_some,omitempty"`
+	ChaptersNone      *ChapterWhereInput `json:"chapters_none,omitempty"`
+	And               []TagWhereInput    `json:"AND,omitempty"`
+	Or                []TagWhereInput    `json:"OR,omitempty"`
+	Not               []TagWhereInput    `json:"NOT,omitempty"`
+}
+
+type TagCreateInput struct {
+	ID       *string                            `json:"id,omitempty"`
+	Name     string                             `json:"name"`
+	Books    *BookCreateManyWithoutTagsInput    `json:"books,omitempty"`
+	Chapters *ChapterCreateManyWithoutTagsInput `json:"chapters,omitempty"`
+}
+
+type TagUpdateInput struct {
+	Name     *string                            `json:"name,omitempty"`
+	Books    *BookUpdateManyWithoutTagsInput    `json:"books,omitempty"`
+	Chapters *ChapterUpdateManyWithoutTagsInput `json:"chapters,omitempty"`
+}
+
+type TagUpdateManyMutationInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type TagSubscriptionWhereInput struct {
+	MutationIn                 []MutationType              `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                     `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                    `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                    `json:"updatedFields_contains_some,omitempty"`
+	Node                       *TagWhereInput              `json:"node,omitempty"`
+	And                        []TagSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []TagSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []TagSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type CollectionWhereUniqueInput struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type CollectionWhereInput struct {
+	ID                       *string                `json:"id,omitempty"`
+	IDNot                    *string                `json:"id_not,omitempty"`
+	IDIn                     []string               `json:"id_in,omitempty"`
+	IDNotIn                  []string               `json:"id_not_in,omitempty"`
+	IDLt                     *string                `json:"id_lt,omitempty"`
+	IDLte                    *string                `json:"id_lte,omitempty"`
+	IDGt                     *string                `json:"id_gt,omitempty"`
+	IDGte                    *string                `json:"id_gte,omitempty"`
+	IDContains               *string                `json:"id_contains,omitempty"`
+	IDNotContains            *string                `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string               `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string               `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                `json:"createdAt_gte,omitempty"`
+	UpdatedAt                *string                `json:"updatedAt,omitempty"`
+	UpdatedAtNot             *string                `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn              []string               `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn           []string               `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt              *string                `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte             *string                `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt              *string                `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte             *string                `json:"updatedAt_gte,omitempty"`
+	Name                     *string                `json:"name,omitempty"`
+	NameNot                  *string                `json:"name_not,omitempty"`
+	NameIn                   []string               `json:"name_in,omitempty"`
+	NameNotIn                []string               `json:"name_not_in,omitempty"`
+	NameLt                   *string                `json:"name_lt,omitempty"`
+	NameLte                  *string                `json:"name_lte,omitempty"`
+	NameGt                   *string                `json:"name_gt,omitempty"`
+	NameGte                  *string                `json:"name_gte,omitempty"`
+	NameContains             *string                `json:"name_contains,omitempty"`
+	NameNotContains          *string                `json:"name_not_contains,omitempty"`
+	NameStartsWith           *string                `json:"name_starts_with,omitempty"`
+	NameNotStartsWith        *string                `json:"name_not_starts_with,omitempty"`
+	NameEndsWith             *string                `json:"name_ends_with,omitempty"`
+	NameNotEndsWith          *string                `json:"name_not_ends_with,omitempty"`
+	Description              *string                `json:"description,omitempty"`
+	DescriptionNot           *string                `json:"description_not,omitempty"`
+	DescriptionIn            []string               `json:"description_in,omitempty"`
+	DescriptionNotIn         []string               `json:"description_not_in,omitempty"`
+	DescriptionLt            *string                `json:"description_lt,omitempty"`
+	DescriptionLte           *string                `json:"description_lte,omitempty"`
+	DescriptionGt            *string                `json:"description_gt,omitempty"`
+	DescriptionGte           *string                `json:"description_gte,omitempty"`
+	DescriptionContains      *string                `json:"description_contains,omitempty"`
+	DescriptionNotContains   *string                `json:"description_not_contains,omitempty"`
+	DescriptionStartsWith    *string                `json:"description_starts_with,omitempty"`
+	DescriptionNotStartsWith *string                `json:"description_not_starts_with,omitempty"`
+	DescriptionEndsWith      *string                `json:"description_ends_with,omitempty"`
+	DescriptionNotEndsWith   *string                `json:"description_not_ends_with,omitempty"`
+	BooksEvery               *BookWhereInput        `json:"books_every,omitempty"`
+	BooksSome                *BookWhereInput        `json:"books_some,omitempty"`
+	BooksNone                *BookWhereInput        `json:"books_none,omitempty"`
+	And                      []CollectionWhereInput `json:"AND,omitempty"`
+	Or                       []CollectionWhereInput `json:"OR,omitempty"`
+	Not                      []CollectionWhereInput `json:"NOT,omitempty"`
+}
+
+type CollectionCreateInput struct {
+	ID          *string                                `json:"id,omitempty"`
+	Name        string                                 `json:"name"`
+	Description *string                                `json:"description,omitempty"`
+	Books       *BookCreateManyWithoutCollectionsInput `json:"books,omitempty"`
+}
+
+type CollectionUpdateInput struct {
+	Name        *string                                `json:"name,omitempty"`
+	Description *string                                `json:"description,omitempty"`
+	Books       *BookUpdateManyWithoutCollectionsInput `json:"books,omitempty"`
+}
+
+type CollectionUpdateManyMutationInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type CollectionSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                     `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                            `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                           `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                           `json:"updatedFields_contains_some,omitempty"`
+	Node                       *CollectionWhereInput              `json:"node,omitempty"`
+	And                        []CollectionSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []CollectionSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []CollectionSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type TagCreateWithoutBooksInput struct {
+	ID       *string                            `json:"id,omitempty"`
+	Name     string                             `json:"name"`
+	Chapters *ChapterCreateManyWithoutTagsInput `json:"chapters,omitempty"`
+}
+
+type TagCreateManyWithoutBooksInput struct {
+	Create  []TagCreateWithoutBooksInput `json:"create,omitempty"`
+	Connect []TagWhereUniqueInput        `json:"connect,omitempty"`
+}
+
+type TagUpdateWithoutBooksDataInput struct {
+	Name     *string                            `json:"name,omitempty"`
+	Chapters *ChapterUpdateManyWithoutTagsInput `json:"chapters,omitempty"`
+}
+
+type TagUpdateManyWithoutBooksInput struct {
+	Create     []TagCreateWithoutBooksInput                `json:"create,omitempty"`
+	Delete     []TagWhereUniqueInput                       `json:"delete,omitempty"`
+	Connect    []TagWhereUniqueInput                       `json:"connect,omitempty"`
+	Set        []TagWhereUniqueInput                       `json:"set,omitempty"`
+	Disconnect []TagWhereUniqueInput                       `json:"disconnect,omitempty"`
+	Update     []TagUpdateWithWhereUniqueWithoutBooksInput `json:"update,omitempty"`
+	Upsert     []TagUpsertWithWhereUniqueWithoutBooksInput `json:"upsert,omitempty"`
+	DeleteMany []TagScalarWhereInput                       `json:"deleteMany,omitempty"`
+	UpdateMany []TagUpdateManyWithWhereNestedInput         `json:"updateMany,omitempty"`
+}
+
+type TagUpdateWithWhereUniqueWithoutBooksInput struct {
+	Where TagWhereUniqueInput            `json:"where"`
+	Data  TagUpdateWithoutBooksDataInput `json:"data"`
+}
+
+type TagUpsertWithWhereUniqueWithoutBooksInput struct {
+	Where  TagWhereUniqueInput            `json:"where"`
+	Update TagUpdateWithoutBooksDataInput `json:"update"`
+	Create TagCreateWithoutBooksInput     `json:"create"`
+}
+
+type TagScalarWhereInput struct {
+	ID                *string               `json:"id,omitempty"`
+	IDNot             *string               `json:"id_not,omitempty"`
+	IDIn              []string              `json:"id_in,omitempty"`
+	IDNotIn           []string              `json:"id_not_in,omitempty"`
+	IDLt              *string               `json:"id_lt,omitempty"`
+	IDLte             *string               `json:"id_lte,omitempty"`
+	IDGt              *string               `json:"id_gt,omitempty"`
+	IDGte             *string               `json:"id_gte,omitempty"`
+	IDContains        *string               `json:"id_contains,omitempty"`
+	IDNotContains     *string               `json:"id_not_contains,omitempty"`
+	IDStartsWith      *string               `json:"id_starts_with,omitempty"`
+	IDNotStartsWith   *string               `json:"id_not_starts_with,omitempty"`
+	IDEndsWith        *string               `json:"id_ends_with,omitempty"`
+	IDNotEndsWith     *string               `json:"id_not_ends_with,omitempty"`
+	CreatedAt         *string               `json:"createdAt,omitempty"`
+	CreatedAtNot      *string               `json:"createdAt_not,omitempty"`
+	CreatedAtIn       []string              `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn    []string              `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt       *string               `json:"createdAt_lt,omitempty"`
+	CreatedAtLte      *string               `json:"createdAt_lte,omitempty"`
+	CreatedAtGt       *string               `json:"createdAt_gt,omitempty"`
+	CreatedAtGte      *string               `json:"createdAt_gte,omitempty"`
+	UpdatedAt         *string               `json:"updatedAt,omitempty"`
+	UpdatedAtNot      *string               `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn       []string              `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn    []string              `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt       *string               `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte      *string               `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt       *string               `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte      *string               `json:"updatedAt_gte,omitempty"`
+	Name              *string               `json:"name,omitempty"`
+	NameNot           *string               `json:"name_not,omitempty"`
+	NameIn            []string              `json:"name_in,omitempty"`
+	NameNotIn         []string              `json:"name_not_in,omitempty"`
+	NameLt            *string               `json:"name_lt,omitempty"`
+	NameLte           *string               `json:"name_lte,omitempty"`
+	NameGt            *string               `json:"name_gt,omitempty"`
+	NameGte           *string               `json:"name_gte,omitempty"`
+	NameContains      *string               `json:"name_contains,omitempty"`
+	NameNotContains   *string               `json:"name_not_contains,omitempty"`
+	NameStartsWith    *string               `json:"name_starts_with,omitempty"`
+	NameNotStartsWith *string               `json:"name_not_starts_with,omitempty"`
+	NameEndsWith      *string               `json:"name_ends_with,omitempty"`
+	NameNotEndsWith   *string               `json:"name_not_ends_with,omitempty"`
+	And               []TagScalarWhereInput `json:"AND,omitempty"`
+	Or                []TagScalarWhereInput `json:"OR,omitempty"`
+	Not               []TagScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type TagUpdateManyWithWhereNestedInput struct {
+	Where TagScalarWhereInput    `json:"where"`
+	Data  TagUpdateManyDataInput `json:"data"`
+}
+
+type TagUpdateManyDataInput struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type CollectionCreateWithoutBooksInput struct {
+	ID          *string `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+type CollectionCreateManyWithoutBooksInput struct {
+	Create  []CollectionCreateWithoutBooksInput `json:"create,omitempty"`
+	Connect []CollectionWhereUniqueInput        `json:"connect,omitempty"`
+}
+
+type CollectionUpdateWithoutBooksDataInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type CollectionUpdateManyWithoutBooksInput struct {
+	Create     []CollectionCreateWithoutBooksInput                `json:"create,omitempty"`
+	Delete     []CollectionWhereUniqueInput                       `json:"delete,omitempty"`
+	Connect    []CollectionWhereUniqueInput                       `json:"connect,omitempty"`
+	Set        []CollectionWhereUniqueInput                       `json:"set,omitempty"`
+	Disconnect []CollectionWhereUniqueInput                       `json:"disconnect,omitempty"`
+	Update     []CollectionUpdateWithWhereUniqueWithoutBooksInput `json:"update,omitempty"`
+	Upsert     []CollectionUpsertWithWhereUniqueWithoutBooksInput `json:"upsert,omitempty"`
+	DeleteMany []CollectionScalarWhereInput                       `json:"deleteMany,omitempty"`
+	UpdateMany []CollectionUpdateManyWithWhereNestedInput         `json:"updateMany,omitempty"`
+}
+
+type CollectionUpdateWithWhereUniqueWithoutBooksInput struct {
+	Where CollectionWhereUniqueInput            `json:"where"`
+	Data  CollectionUpdateWithoutBooksDataInput `json:"data"`
+}
+
+type CollectionUpsertWithWhereUniqueWithoutBooksInput struct {
+	Where  CollectionWhereUniqueInput            `json:"where"`
+	Update CollectionUpdateWithoutBooksDataInput `json:"update"`
+	Create CollectionCreateWithoutBooksInput     `json:"create"`
+}
+
+type CollectionScalarWhereInput struct {
+	ID                       *string                      `json:"id,omitempty"`
+	IDNot                    *string                      `json:"id_not,omitempty"`
+	IDIn                     []string                     `json:"id_in,omitempty"`
+	IDNotIn                  []string                     `json:"id_not_in,omitempty"`
+	IDLt                     *string                      `json:"id_lt,omitempty"`
+	IDLte                    *string                      `json:"id_lte,omitempty"`
+	IDGt                     *string                      `json:"id_gt,omitempty"`
+	IDGte                    *string                      `json:"id_gte,omitempty"`
+	IDContains               *string                      `json:"id_contains,omitempty"`
+	IDNotContains            *string                      `json:"id_not_contains,omitempty"`
+	IDStartsWith             *string                      `json:"id_starts_with,omitempty"`
+	IDNotStartsWith          *string                      `json:"id_not_starts_with,omitempty"`
+	IDEndsWith               *string                      `json:"id_ends_with,omitempty"`
+	IDNotEndsWith            *string                      `json:"id_not_ends_with,omitempty"`
+	CreatedAt                *string                      `json:"createdAt,omitempty"`
+	CreatedAtNot             *string                      `json:"createdAt_not,omitempty"`
+	CreatedAtIn              []string                     `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn           []string                     `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt              *string                      `json:"createdAt_lt,omitempty"`
+	CreatedAtLte             *string                      `json:"createdAt_lte,omitempty"`
+	CreatedAtGt              *string                      `json:"createdAt_gt,omitempty"`
+	CreatedAtGte             *string                      `json:"createdAt_gte,omitempty"`
+	UpdatedAt                *string                      `json:"updatedAt,omitempty"`
+	UpdatedAtNot             *string                      `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn              []string                     `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn           []string                     `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt              *string                      `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte             *string                      `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt              *string                      `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte             *string                      `json:"updatedAt_gte,omitempty"`
+	Name                     *string                      `json:"name,omitempty"`
+	NameNot                  *string                      `json:"name_not,omitempty"`
+	NameIn                   []string                     `json:"name_in,omitempty"`
+	NameNotIn                []string                     `json:"name_not_in,omitempty"`
+	NameLt                   *string                      `json:"name_lt,omitempty"`
+	NameLte                  *string                      `json:"name_lte,omitempty"`
+	NameGt                   *string                      `json:"name_gt,omitempty"`
+	NameGte                  *string                      `json:"name_gte,omitempty"`
+	NameContains             *string                      `json:"name_contains,omitempty"`
+	NameNotContains          *string                      `json:"name_not_contains,omitempty"`
+	NameStartsWith           *string                      `json:"name_starts_with,omitempty"`
+	NameNotStartsWith        *string                      `json:"name_not_starts_with,omitempty"`
+	NameEndsWith             *string                      `json:"name_ends_with,omitempty"`
+	NameNotEndsWith          *string                      `json:"name_not_ends_with,omitempty"`
+	Description              *string                      `json:"description,omitempty"`
+	DescriptionNot           *string                      `json:"description_not,omitempty"`
+	DescriptionIn            []string                     `json:"description_in,omitempty"`
+	DescriptionNotIn         []string                     `json:"description_not_in,omitempty"`
+	DescriptionLt            *string                      `json:"description_lt,omitempty"`
+	DescriptionLte           *string                      `json:"description_lte,omitempty"`
+	DescriptionGt            *string                      `json:"description_gt,omitempty"`
+	DescriptionGte           *string                      `json:"description_gte,omitempty"`
+	DescriptionContains      *string                      `json:"description_contains,omitempty"`
+	DescriptionNotContains   *string                      `json:"description_not_contains,omitempty"`
+	DescriptionStartsWith    *string                      `json:"description_starts_with,omitempty"`
+	DescriptionNotStartsWith *string                      `json:"description_not_starts_with,omitempty"`
+	DescriptionEndsWith      *string                      `json:"description_ends_with,omitempty"`
+	DescriptionNotEndsWith   *string                      `json:"description_not_ends_with,omitempty"`
+	And                      []CollectionScalarWhereInput `json:"AND,omitempty"`
+	Or                       []CollectionScalarWhereInput `json:"OR,omitempty"`
+	Not                      []CollectionScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type CollectionUpdateManyWithWhereNestedInput struct {
+	Where CollectionScalarWhereInput    `json:"where"`
+	Data  CollectionUpdateManyDataInput `json:"data"`
+}
+
+type CollectionUpdateManyDataInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type TagCreateWithoutChaptersInput struct {
+	ID    *string                         `json:"id,omitempty"`
+	Name  string                          `json:"name"`
+	Books *BookCreateManyWithoutTagsInput `json:"books,omitempty"`
+}
+
+type TagCreateManyWithoutChaptersInput struct {
+	Create  []TagCreateWithoutChaptersInput `json:"create,omitempty"`
+	Connect []TagWhereUniqueInput           `json:"connect,omitempty"`
+}
+
+type TagUpdateWithoutChaptersDataInput struct {
+	Name  *string                         `json:"name,omitempty"`
+	Books *BookUpdateManyWithoutTagsInput `json:"books,omitempty"`
+}
+
+type TagUpdateManyWithoutChaptersInput struct {
+	Create     []TagCreateWithoutChaptersInput                `json:"create,omitempty"`
+	Delete     []TagWhereUniqueInput                          `json:"delete,omitempty"`
+	Connect    []TagWhereUniqueInput                          `json:"connect,omitempty"`
+	Set        []TagWhereUniqueInput                          `json:"set,omitempty"`
+	Disconnect []TagWhereUniqueInput                          `json:"disconnect,omitempty"`
+	Update     []TagUpdateWithWhereUniqueWithoutChaptersInput `json:"update,omitempty"`
+	Upsert     []TagUpsertWithWhereUniqueWithoutChaptersInput `json:"upsert,omitempty"`
+	DeleteMany []TagScalarWhereInput                          `json:"deleteMany,omitempty"`
+	UpdateMany []TagUpdateManyWithWhereNestedInput            `json:"updateMany,omitempty"`
+}
+
+type TagUpdateWithWhereUniqueWithoutChaptersInput struct {
+	Where TagWhereUniqueInput               `json:"where"`
+	Data  TagUpdateWithoutChaptersDataInput `json:"data"`
+}
+
+type TagUpsertWithWhereUniqueWithoutChaptersInput struct {
+	Where  TagWhereUniqueInput               `json:"where"`
+	Update TagUpdateWithoutChaptersDataInput `json:"update"`
+	Create TagCreateWithoutChaptersInput     `json:"create"`
+}
+
+type BookCreateWithoutTagsInput struct {
+	ID          *string                                   `json:"id,omitempty"`
+	Name        string                                    `json:"name"`
+	Description string                                    `json:"description"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorCreateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Collections *CollectionCreateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionCreateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterCreateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookCreateManyWithoutTagsInput struct {
+	Create  []BookCreateWithoutTagsInput `json:"create,omitempty"`
+	Connect []BookWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type BookUpdateWithoutTagsDataInput struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorUpdateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Collections *CollectionUpdateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterUpdateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookUpdateManyWithoutTagsInput struct {
+	Create     []BookCreateWithoutTagsInput                `json:"create,omitempty"`
+	Delete     []BookWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []BookWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []BookWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []BookWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []BookUpdateWithWhereUniqueWithoutTagsInput `json:"update,omitempty"`
+	Upsert     []BookUpsertWithWhereUniqueWithoutTagsInput `json:"upsert,omitempty"`
+	DeleteMany []BookScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []BookUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type BookUpdateWithWhereUniqueWithoutTagsInput struct {
+	Where BookWhereUniqueInput           `json:"where"`
+	Data  BookUpdateWithoutTagsDataInput `json:"data"`
+}
+
+type BookUpsertWithWhereUniqueWithoutTagsInput struct {
+	Where  BookWhereUniqueInput           `json:"where"`
+	Update BookUpdateWithoutTagsDataInput `json:"update"`
+	Create BookCreateWithoutTagsInput     `json:"create"`
+}
+
+type ChapterCreateWithoutTagsInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput             `json:"book"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterCreateManyWithoutTagsInput struct {
+	Create  []ChapterCreateWithoutTagsInput `json:"create,omitempty"`
+	Connect []ChapterWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type ChapterUpdateWithoutTagsDataInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput    `json:"book,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateManyWithoutTagsInput struct {
+	Create     []ChapterCreateWithoutTagsInput                `json:"create,omitempty"`
+	Delete     []ChapterWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []ChapterWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []ChapterWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []ChapterWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []ChapterUpdateWithWhereUniqueWithoutTagsInput `json:"update,omitempty"`
+	Upsert     []ChapterUpsertWithWhereUniqueWithoutTagsInput `json:"upsert,omitempty"`
+	DeleteMany []ChapterScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []ChapterUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type ChapterUpdateWithWhereUniqueWithoutTagsInput struct {
+	Where ChapterWhereUniqueInput           `json:"where"`
+	Data  ChapterUpdateWithoutTagsDataInput `json:"data"`
+}
+
+type ChapterUpsertWithWhereUniqueWithoutTagsInput struct {
+	Where  ChapterWhereUniqueInput           `json:"where"`
+	Update ChapterUpdateWithoutTagsDataInput `json:"update"`
+	Create ChapterCreateWithoutTagsInput     `json:"create"`
+}
+
+type BookCreateWithoutCollectionsInput struct {
+	ID          *string                                   `json:"id,omitempty"`
+	Name        string                                    `json:"name"`
+	Description string                                    `json:"description"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorCreateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagCreateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Revisions   *RevisionCreateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterCreateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookCreateManyWithoutCollectionsInput struct {
+	Create  []BookCreateWithoutCollectionsInput `json:"create,omitempty"`
+	Connect []BookWhereUniqueInput              `json:"connect,omitempty"`
+}
+
+type BookUpdateWithoutCollectionsDataInput struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorUpdateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagUpdateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterUpdateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutBookInput `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutBookInput       `json:"reminders,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookUpdateManyWithoutCollectionsInput struct {
+	Create     []BookCreateWithoutCollectionsInput                `json:"create,omitempty"`
+	Delete     []BookWhereUniqueInput                             `json:"delete,omitempty"`
+	Connect    []BookWhereUniqueInput                             `json:"connect,omitempty"`
+	Set        []BookWhereUniqueInput                             `json:"set,omitempty"`
+	Disconnect []BookWhereUniqueInput                             `json:"disconnect,omitempty"`
+	Update     []BookUpdateWithWhereUniqueWithoutCollectionsInput `json:"update,omitempty"`
+	Upsert     []BookUpsertWithWhereUniqueWithoutCollectionsInput `json:"upsert,omitempty"`
+	DeleteMany []BookScalarWhereInput                             `json:"deleteMany,omitempty"`
+	UpdateMany []BookUpdateManyWithWhereNestedInput               `json:"updateMany,omitempty"`
+}
+
+type BookUpdateWithWhereUniqueWithoutCollectionsInput struct {
+	Where BookWhereUniqueInput                  `json:"where"`
+	Data  BookUpdateWithoutCollectionsDataInput `json:"data"`
+}
+
+type BookUpsertWithWhereUniqueWithoutCollectionsInput struct {
+	Where  BookWhereUniqueInput                  `json:"where"`
+	Update BookUpdateWithoutCollectionsDataInput `json:"update"`
+	Create BookCreateWithoutCollectionsInput     `json:"create"`
+}
+
+type NoteWhereUniqueInput struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type NoteWhereInput struct {
+	ID                    *string            `json:"id,omitempty"`
+	IDNot                 *string            `json:"id_not,omitempty"`
+	IDIn                  []string           `json:"id_in,omitempty"`
+	IDNotIn               []string           `json:"id_not_in,omitempty"`
+	IDLt                  *string            `json:"id_lt,omitempty"`
+	IDLte                 *string            `json:"id_lte,omitempty"`
+	IDGt                  *string            `json:"id_gt,omitempty"`
+	IDGte                 *string            `json:"id_gte,omitempty"`
+	IDContains            *string            `json:"id_contains,omitempty"`
+	IDNotContains         *string            `json:"id_not_contains,omitempty"`
+	IDStartsWith          *string            `json:"id_starts_with,omitempty"`
+	IDNotStartsWith       *string            `json:"id_not_starts_with,omitempty"`
+	IDEndsWith            *string            `json:"id_ends_with,omitempty"`
+	IDNotEndsWith         *string            `json:"id_not_ends_with,omitempty"`
+	CreatedAt             *string            `json:"createdAt,omitempty"`
+	CreatedAtNot          *string            `json:"createdAt_not,omitempty"`
+	CreatedAtIn           []string           `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn        []string           `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt           *string            `json:"createdAt_lt,omitempty"`
+	CreatedAtLte          *string            `json:"createdAt_lte,omitempty"`
+	CreatedAtGt           *string            `json:"createdAt_gt,omitempty"`
+	CreatedAtGte          *string            `json:"createdAt_gte,omitempty"`
+	UpdatedAt             *string            `json:"updatedAt,omitempty"`
+	UpdatedAtNot          *string            `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn           []string           `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn        []string           `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt           *string            `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte          *string            `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt           *string            `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte          *string            `json:"updatedAt_gte,omitempty"`
+	Quote                 *string            `json:"quote,omitempty"`
+	QuoteNot              *string            `json:"quote_not,omitempty"`
+	QuoteIn               []string           `json:"quote_in,omitempty"`
+	QuoteNotIn            []string           `json:"quote_not_in,omitempty"`
+	QuoteLt               *string            `json:"quote_lt,omitempty"`
+	QuoteLte              *string            `json:"quote_lte,omitempty"`
+	QuoteGt               *string            `json:"quote_gt,omitempty"`
+	QuoteGte              *string            `json:"quote_gte,omitempty"`
+	QuoteContains         *string            `json:"quote_contains,omitempty"`
+	QuoteNotContains      *string            `json:"quote_not_contains,omitempty"`
+	QuoteStartsWith       *string            `json:"quote_starts_with,omitempty"`
+	QuoteNotStartsWith    *string            `json:"quote_not_starts_with,omitempty"`
+	QuoteEndsWith         *string            `json:"quote_ends_with,omitempty"`
+	QuoteNotEndsWith      *string            `json:"quote_not_ends_with,omitempty"`
+	Text                  *string            `json:"text,omitempty"`
+	TextNot               *string            `json:"text_not,omitempty"`
+	TextIn                []string           `json:"text_in,omitempty"`
+	TextNotIn             []string           `json:"text_not_in,omitempty"`
+	TextLt                *string            `json:"text_lt,omitempty"`
+	TextLte               *string            `json:"text_lte,omitempty"`
+	TextGt                *string            `json:"text_gt,omitempty"`
+	TextGte               *string            `json:"text_gte,omitempty"`
+	TextContains          *string            `json:"text_contains,omitempty"`
+	TextNotContains       *string            `json:"text_not_contains,omitempty"`
+	TextStartsWith        *string            `json:"text_starts_with,omitempty"`
+	TextNotStartsWith     *string            `json:"text_not_starts_with,omitempty"`
+	TextEndsWith          *string            `json:"text_ends_with,omitempty"`
+	TextNotEndsWith       *string            `json:"text_not_ends_with,omitempty"`
+	Location              *string            `json:"location,omitempty"`
+	LocationNot           *string            `json:"location_not,omitempty"`
+	LocationIn            []string           `json:"location_in,omitempty"`
+	LocationNotIn         []string           `json:"location_not_in,omitempty"`
+	LocationLt            *string            `json:"location_lt,omitempty"`
+	LocationLte           *string            `json:"location_lte,omitempty"`
+	LocationGt            *string            `json:"location_gt,omitempty"`
+	LocationGte           *string            `json:"location_gte,omitempty"`
+	LocationContains      *string            `json:"location_contains,omitempty"`
+	LocationNotContains   *string            `json:"location_not_contains,omitempty"`
+	LocationStartsWith    *string            `json:"location_starts_with,omitempty"`
+	LocationNotStartsWith *string            `json:"location_not_starts_with,omitempty"`
+	LocationEndsWith      *string            `json:"location_ends_with,omitempty"`
+	LocationNotEndsWith   *string            `json:"location_not_ends_with,omitempty"`
+	Color                 *NoteColor         `json:"color,omitempty"`
+	ColorNot              *NoteColor         `json:"color_not,omitempty"`
+	ColorIn               []NoteColor        `json:"color_in,omitempty"`
+	ColorNotIn            []NoteColor        `json:"color_not_in,omitempty"`
+	Chapter               *ChapterWhereInput `json:"chapter,omitempty"`
+	CardsEvery            *CardWhereInput    `json:"cards_every,omitempty"`
+	CardsSome             *CardWhereInput    `json:"cards_some,omitempty"`
+	CardsNone             *CardWhereInput    `json:"cards_none,omitempty"`
+	And                   []NoteWhereInput   `json:"AND,omitempty"`
+	Or                    []NoteWhereInput   `json:"OR,omitempty"`
+	Not                   []NoteWhereInput   `json:"NOT,omitempty"`
+}
+
+type NoteCreateInput struct {
+	ID       *string                           `json:"id,omitempty"`
+	Quote    string                            `json:"quote"`
+	Text     string                            `json:"text"`
+	Location string                            `json:"location"`
+	Color    *NoteColor                        `json:"color,omitempty"`
+	Chapter  ChapterCreateOneWithoutNotesInput `json:"chapter"`
+	Cards    *CardCreateManyWithoutNoteInput   `json:"cards,omitempty"`
+}
+
+type NoteUpdateInput struct {
+	Quote    *string                                    `json:"quote,omitempty"`
+	Text     *string                                    `json:"text,omitempty"`
+	Location *string                                    `json:"location,omitempty"`
+	Color    *NoteColor                                 `json:"color,omitempty"`
+	Chapter  *ChapterUpdateOneRequiredWithoutNotesInput `json:"chapter,omitempty"`
+	Cards    *CardUpdateManyWithoutNoteInput            `json:"cards,omitempty"`
+}
+
+type NoteUpdateManyMutationInput struct {
+	Quote    *string    `json:"quote,omitempty"`
+	Text     *string    `json:"text,omitempty"`
+	Location *string    `json:"location,omitempty"`
+	Color    *NoteColor `json:"color,omitempty"`
+}
+
+type NoteSubscriptionWhereInput struct {
+	MutationIn                 []MutationType               `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                      `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                     `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                     `json:"updatedFields_contains_some,omitempty"`
+	Node                       *NoteWhereInput              `json:"node,omitempty"`
+	And                        []NoteSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []NoteSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []NoteSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type NoteCreateWithoutChapterInput struct {
+	ID       *string                         `json:"id,omitempty"`
+	Quote    string                          `json:"quote"`
+	Text     string                          `json:"text"`
+	Location string                          `json:"location"`
+	Color    *NoteColor                      `json:"color,omitempty"`
+	Cards    *CardCreateManyWithoutNoteInput `json:"cards,omitempty"`
+}
+
+type NoteCreateManyWithoutChapterInput struct {
+	Create  []NoteCreateWithoutChapterInput `json:"create,omitempty"`
+	Connect []NoteWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type NoteUpdateWithoutChapterDataInput struct {
+	Quote    *string                         `json:"quote,omitempty"`
+	Text     *string                         `json:"text,omitempty"`
+	Location *string                         `json:"location,omitempty"`
+	Color    *NoteColor                      `json:"color,omitempty"`
+	Cards    *CardUpdateManyWithoutNoteInput `json:"cards,omitempty"`
+}
+
+type NoteUpdateManyWithoutChapterInput struct {
+	Create     []NoteCreateWithoutChapterInput                `json:"create,omitempty"`
+	Delete     []NoteWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []NoteWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []NoteWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []NoteWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []NoteUpdateWithWhereUniqueWithoutChapterInput `json:"update,omitempty"`
+	Upsert     []NoteUpsertWithWhereUniqueWithoutChapterInput `json:"upsert,omitempty"`
+	DeleteMany []NoteScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []NoteUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type NoteUpdateWithWhereUniqueWithoutChapterInput struct {
+	Where NoteWhereUniqueInput              `json:"where"`
+	Data  NoteUpdateWithoutChapterDataInput `json:"data"`
+}
+
+type NoteUpsertWithWhereUniqueWithoutChapterInput struct {
+	Where  NoteWhereUniqueInput              `json:"where"`
+	Update NoteUpdateWithoutChapterDataInput `json:"update"`
+	Create NoteCreateWithoutChapterInput     `json:"create"`
+}
+
+type NoteScalarWhereInput struct {
+	ID                    *string                `json:"id,omitempty"`
+	IDNot                 *string                `json:"id_not,omitempty"`
+	IDIn                  []string               `json:"id_in,omitempty"`
+	IDNotIn               []string               `json:"id_not_in,omitempty"`
+	IDLt                  *string                `json:"id_lt,omitempty"`
+	IDLte                 *string                `json:"id_lte,omitempty"`
+	IDGt                  *string                `json:"id_gt,omitempty"`
+	IDGte                 *string                `json:"id_gte,omitempty"`
+	IDContains            *string                `json:"id_contains,omitempty"`
+	IDNotContains         *string                `json:"id_not_contains,omitempty"`
+	IDStartsWith          *string                `json:"id_starts_with,omitempty"`
+	IDNotStartsWith       *string                `json:"id_not_starts_with,omitempty"`
+	IDEndsWith            *string                `json:"id_ends_with,omitempty"`
+	IDNotEndsWith         *string                `json:"id_not_ends_with,omitempty"`
+	CreatedAt             *string                `json:"createdAt,omitempty"`
+	CreatedAtNot          *string                `json:"createdAt_not,omitempty"`
+	CreatedAtIn           []string               `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn        []string               `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt           *string                `json:"createdAt_lt,omitempty"`
+	CreatedAtLte          *string                `json:"createdAt_lte,omitempty"`
+	CreatedAtGt           *string                `json:"createdAt_gt,omitempty"`
+	CreatedAtGte          *string                `json:"createdAt_gte,omitempty"`
+	UpdatedAt             *string                `json:"updatedAt,omitempty"`
+	UpdatedAtNot          *string                `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn           []string               `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn        []string               `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt           *string                `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte          *string                `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt           *string                `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte          *string                `json:"updatedAt_gte,omitempty"`
+	Quote                 *string                `json:"quote,omitempty"`
+	QuoteNot              *string                `json:"quote_not,omitempty"`
+	QuoteIn               []string               `json:"quote_in,omitempty"`
+	QuoteNotIn            []string               `json:"quote_not_in,omitempty"`
+	QuoteLt               *string                `json:"quote_lt,omitempty"`
+	QuoteLte              *string                `json:"quote_lte,omitempty"`
+	QuoteGt               *string                `json:"quote_gt,omitempty"`
+	QuoteGte              *string                `json:"quote_gte,omitempty"`
+	QuoteContains         *string                `json:"quote_contains,omitempty"`
+	QuoteNotContains      *string                `json:"quote_not_contains,omitempty"`
+	QuoteStartsWith       *string                `json:"quote_starts_with,omitempty"`
+	QuoteNotStartsWith    *string                `json:"quote_not_starts_with,omitempty"`
+	QuoteEndsWith         *string                `json:"quote_ends_with,omitempty"`
+	QuoteNotEndsWith      *string                `json:"quote_not_ends_with,omitempty"`
+	Text                  *string                `json:"text,omitempty"`
+	TextNot               *string                `json:"text_not,omitempty"`
+	TextIn                []string               `json:"text_in,omitempty"`
+	TextNotIn             []string               `json:"text_not_in,omitempty"`
+	TextLt                *string                `json:"text_lt,omitempty"`
+	TextLte               *string                `json:"text_lte,omitempty"`
+	TextGt                *string                `json:"text_gt,omitempty"`
+	TextGte               *string                `json:"text_gte,omitempty"`
+	TextContains          *string                `json:"text_contains,omitempty"`
+	TextNotContains       *string                `json:"text_not_contains,omitempty"`
+	TextStartsWith        *string                `json:"text_starts_with,omitempty"`
+	TextNotStartsWith     *string                `json:"text_not_starts_with,omitempty"`
+	TextEndsWith          *string                `json:"text_ends_with,omitempty"`
+	TextNotEndsWith       *string                `json:"text_not_ends_with,omitempty"`
+	Location              *string                `json:"location,omitempty"`
+	LocationNot           *string                `json:"location_not,omitempty"`
+	LocationIn            []string               `json:"location_in,omitempty"`
+	LocationNotIn         []string               `json:"location_not_in,omitempty"`
+	LocationLt            *string                `json:"location_lt,omitempty"`
+	LocationLte           *string                `json:"location_lte,omitempty"`
+	LocationGt            *string                `json:"location_gt,omitempty"`
+	LocationGte           *string                `json:"location_gte,omitempty"`
+	LocationContains      *string                `json:"location_contains,omitempty"`
+	LocationNotContains   *string                `json:"location_not_contains,omitempty"`
+	LocationStartsWith    *string                `json:"location_starts_with,omitempty"`
+	LocationNotStartsWith *string                `json:"location_not_starts_with,omitempty"`
+	LocationEndsWith      *string                `json:"location_ends_with,omitempty"`
+	LocationNotEndsWith   *string                `json:"location_not_ends_with,omitempty"`
+	Color                 *NoteColor             `json:"color,omitempty"`
+	ColorNot              *NoteColor             `json:"color_not,omitempty"`
+	ColorIn               []NoteColor            `json:"color_in,omitempty"`
+	ColorNotIn            []NoteColor            `json:"color_not_in,omitempty"`
+	And                   []NoteScalarWhereInput `json:"AND,omitempty"`
+	Or                    []NoteScalarWhereInput `json:"OR,omitempty"`
+	Not                   []NoteScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type NoteUpdateManyWithWhereNestedInput struct {
+	Where NoteScalarWhereInput    `json:"where"`
+	Data  NoteUpdateManyDataInput `json:"data"`
+}
+
+type NoteUpdateManyDataInput struct {
+	Quote    *string    `json:"quote,omitempty"`
+	Text     *string    `json:"text,omitempty"`
+	Location *string    `json:"location,omitempty"`
+	Color    *NoteColor `json:"color,omitempty"`
+}
+
+type ChapterCreateWithoutNotesInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput             `json:"book"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterCreateOneWithoutNotesInput struct {
+	Create  *ChapterCreateWithoutNotesInput `json:"create,omitempty"`
+	Connect *ChapterWhereUniqueInput        `json:"connect,omitempty"`
+}
+
+type ChapterUpdateWithoutNotesDataInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput    `json:"book,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateOneRequiredWithoutNotesInput struct {
+	Create  *ChapterCreateWithoutNotesInput     `json:"create,omitempty"`
+	Update  *ChapterUpdateWithoutNotesDataInput `json:"update,omitempty"`
+	Upsert  *ChapterUpsertWithoutNotesInput     `json:"upsert,omitempty"`
+	Connect *ChapterWhereUniqueInput            `json:"connect,omitempty"`
+}
+
+type ChapterUpsertWithoutNotesInput struct {
+	Update ChapterUpdateWithoutNotesDataInput `json:"update"`
+	Create ChapterCreateWithoutNotesInput     `json:"create"`
+}
+
+type CardWhereUniqueInput struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type CardWhereInput struct {
+	ID                  *string            `json:"id,omitempty"`
+	IDNot               *string            `json:"id_not,omitempty"`
+	IDIn                []string           `json:"id_in,omitempty"`
+	IDNotIn             []string           `json:"id_not_in,omitempty"`
+	IDLt                *string            `json:"id_lt,omitempty"`
+	IDLte               *string            `json:"id_lte,omitempty"`
+	IDGt                *string            `json:"id_gt,omitempty"`
+	IDGte               *string            `json:"id_gte,omitempty"`
+	IDContains          *string            `json:"id_contains,omitempty"`
+	IDNotContains       *string            `json:"id_not_contains,omitempty"`
+	IDStartsWith        *string            `json:"id_starts_with,omitempty"`
+	IDNotStartsWith     *string            `json:"id_not_starts_with,omitempty"`
+	IDEndsWith          *string            `json:"id_ends_with,omitempty"`
+	IDNotEndsWith       *string            `json:"id_not_ends_with,omitempty"`
+	CreatedAt           *string            `json:"createdAt,omitempty"`
+	CreatedAtNot        *string            `json:"createdAt_not,omitempty"`
+	CreatedAtIn         []string           `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn      []string           `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt         *string            `json:"createdAt_lt,omitempty"`
+	CreatedAtLte        *string            `json:"createdAt_lte,omitempty"`
+	CreatedAtGt         *string            `json:"createdAt_gt,omitempty"`
+	CreatedAtGte        *string            `json:"createdAt_gte,omitempty"`
+	UpdatedAt           *string            `json:"updatedAt,omitempty"`
+	UpdatedAtNot        *string            `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn         []string           `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn      []string           `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt         *string            `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte        *string            `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt         *string            `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte        *string            `json:"updatedAt_gte,omitempty"`
+	Front               *string            `json:"front,omitempty"`
+	FrontNot            *string            `json:"front_not,omitempty"`
+	FrontIn             []string           `json:"front_in,omitempty"`
+	FrontNotIn          []string           `json:"front_not_in,omitempty"`
+	FrontLt             *string            `json:"front_lt,omitempty"`
+	FrontLte            *string            `json:"front_lte,omitempty"`
+	FrontGt             *string            `json:"front_gt,omitempty"`
+	FrontGte            *string            `json:"front_gte,omitempty"`
+	FrontContains       *string            `json:"front_contains,omitempty"`
+	FrontNotContains    *string            `json:"front_not_contains,omitempty"`
+	FrontStartsWith     *string            `json:"front_starts_with,omitempty"`
+	FrontNotStartsWith  *string            `json:"front_not_starts_with,omitempty"`
+	FrontEndsWith       *string            `json:"front_ends_with,omitempty"`
+	FrontNotEndsWith    *string            `json:"front_not_ends_with,omitempty"`
+	Back                *string            `json:"back,omitempty"`
+	BackNot             *string            `json:"back_not,omitempty"`
+	BackIn              []string           `json:"back_in,omitempty"`
+	BackNotIn           []string           `json:"back_not_in,omitempty"`
+	BackLt              *string            `json:"back_lt,omitempty"`
+	BackLte             *string            `json:"back_lte,omitempty"`
+	BackGt              *string            `json:"back_gt,omitempty"`
+	BackGte             *string            `json:"back_gte,omitempty"`
+	BackContains        *string            `json:"back_contains,omitempty"`
+	BackNotContains     *string            `json:"back_not_contains,omitempty"`
+	BackStartsWith      *string            `json:"back_starts_with,omitempty"`
+	BackNotStartsWith   *string            `json:"back_not_starts_with,omitempty"`
+	BackEndsWith        *string            `json:"back_ends_with,omitempty"`
+	BackNotEndsWith     *string            `json:"back_not_ends_with,omitempty"`
+	Ease                *float64           `json:"ease,omitempty"`
+	EaseNot             *float64           `json:"ease_not,omitempty"`
+	EaseIn              []float64          `json:"ease_in,omitempty"`
+	EaseNotIn           []float64          `json:"ease_not_in,omitempty"`
+	EaseLt              *float64           `json:"ease_lt,omitempty"`
+	EaseLte             *float64           `json:"ease_lte,omitempty"`
+	EaseGt              *float64           `json:"ease_gt,omitempty"`
+	EaseGte             *float64           `json:"ease_gte,omitempty"`
+	Interval            *int32             `json:"interval,omitempty"`
+	IntervalNot         *int32             `json:"interval_not,omitempty"`
+	IntervalIn          []int32            `json:"interval_in,omitempty"`
+	IntervalNotIn       []int32            `json:"interval_not_in,omitempty"`
+	IntervalLt          *int32             `json:"interval_lt,omitempty"`
+	IntervalLte         *int32             `json:"interval_lte,omitempty"`
+	IntervalGt          *int32             `json:"interval_gt,omitempty"`
+	IntervalGte         *int32             `json:"interval_gte,omitempty"`
+	Repetitions         *int32             `json:"repetitions,omitempty"`
+	RepetitionsNot      *int32             `json:"repetitions_not,omitempty"`
+	RepetitionsIn       []int32            `json:"repetitions_in,omitempty"`
+	RepetitionsNotIn    []int32            `json:"repetitions_not_in,omitempty"`
+	RepetitionsLt       *int32             `json:"repetitions_lt,omitempty"`
+	RepetitionsLte      *int32             `json:"repetitions_lte,omitempty"`
+	RepetitionsGt       *int32             `json:"repetitions_gt,omitempty"`
+	RepetitionsGte      *int32             `json:"repetitions_gte,omitempty"`
+	Lapses              *int32             `json:"lapses,omitempty"`
+	LapsesNot           *int32             `json:"lapses_not,omitempty"`
+	LapsesIn            []int32            `json:"lapses_in,omitempty"`
+	LapsesNotIn         []int32            `json:"lapses_not_in,omitempty"`
+	LapsesLt            *int32             `json:"lapses_lt,omitempty"`
+	LapsesLte           *int32             `json:"lapses_lte,omitempty"`
+	LapsesGt            *int32             `json:"lapses_gt,omitempty"`
+	LapsesGte           *int32             `json:"lapses_gte,omitempty"`
+	ReviewCount         *int32             `json:"reviewCount,omitempty"`
+	ReviewCountNot      *int32             `json:"reviewCount_not,omitempty"`
+	ReviewCountIn       []int32            `json:"reviewCount_in,omitempty"`
+	ReviewCountNotIn    []int32            `json:"reviewCount_not_in,omitempty"`
+	ReviewCountLt       *int32             `json:"reviewCount_lt,omitempty"`
+	ReviewCountLte      *int32             `json:"reviewCount_lte,omitempty"`
+	ReviewCountGt       *int32             `json:"reviewCount_gt,omitempty"`
+	ReviewCountGte      *int32             `json:"reviewCount_gte,omitempty"`
+	Due                 *string            `json:"due,omitempty"`
+	DueNot              *string            `json:"due_not,omitempty"`
+	DueIn               []string           `json:"due_in,omitempty"`
+	DueNotIn            []string           `json:"due_not_in,omitempty"`
+	DueLt               *string            `json:"due_lt,omitempty"`
+	DueLte              *string            `json:"due_lte,omitempty"`
+	DueGt               *string            `json:"due_gt,omitempty"`
+	DueGte              *string            `json:"due_gte,omitempty"`
+	LastReviewedAt      *string            `json:"lastReviewedAt,omitempty"`
+	LastReviewedAtNot   *string            `json:"lastReviewedAt_not,omitempty"`
+	LastReviewedAtIn    []string           `json:"lastReviewedAt_in,omitempty"`
+	LastReviewedAtNotIn []string           `json:"lastReviewedAt_not_in,omitempty"`
+	LastReviewedAtLt    *string            `json:"lastReviewedAt_lt,omitempty"`
+	LastReviewedAtLte   *string            `json:"lastReviewedAt_lte,omitempty"`
+	LastReviewedAtGt    *string            `json:"lastReviewedAt_gt,omitempty"`
+	LastReviewedAtGte   *string            `json:"lastReviewedAt_gte,omitempty"`
+	Chapter             *ChapterWhereInput `json:"chapter,omitempty"`
+	Note                *NoteWhereInput    `json:"note,omitempty"`
+	ReviewsEvery        *ReviewWhereInput  `json:"reviews_every,omitempty"`
+	ReviewsSome         *ReviewWhereInput  `json:"reviews_some,omitempty"`
+	ReviewsNone         *ReviewWhereInput  `json:"reviews_none,omitempty"`
+	And                 []CardWhereInput   `json:"AND,omitempty"`
+	Or                  []CardWhereInput   `json:"OR,omitempty"`
+	Not                 []CardWhereInput   `json:"NOT,omitempty"`
+}
+
+type CardCreateInput struct {
+	ID             *string                           `json:"id,omitempty"`
+	Front          string                            `json:"front"`
+	Back           string                            `json:"back"`
+	Ease           *float64                          `json:"ease,omitempty"`
+	Interval       *int32                            `json:"interval,omitempty"`
+	Repetitions    *int32                            `json:"repetitions,omitempty"`
+	Lapses         *int32                            `json:"lapses,omitempty"`
+	ReviewCount    *int32                            `json:"reviewCount,omitempty"`
+	Due            string                            `json:"due"`
+	LastReviewedAt *string                           `json:"lastReviewedAt,omitempty"`
+	Chapter        ChapterCreateOneWithoutCardsInput `json:"chapter"`
+	Note           *NoteCreateOneWithoutCardsInput   `json:"note,omitempty"`
+	Reviews        *ReviewCreateManyWithoutCardInput `json:"reviews,omitempty"`
+}
+
+type CardUpdateInput struct {
+	Front          *string                                    `json:"front,omitempty"`
+	Back           *string                                    `json:"back,omitempty"`
+	Ease           *float64                                   `json:"ease,omitempty"`
+	Interval       *int32                                     `json:"interval,omitempty"`
+	Repetitions    *int32                                     `json:"repetitions,omitempty"`
+	Lapses         *int32                                     `json:"lapses,omitempty"`
+	ReviewCount    *int32                                     `json:"reviewCount,omitempty"`
+	Due            *string                                    `json:"due,omitempty"`
+	LastReviewedAt *string                                    `json:"lastReviewedAt,omitempty"`
+	Chapter        *ChapterUpdateOneRequiredWithoutCardsInput `json:"chapter,omitempty"`
+	Note           *NoteUpdateOneWithoutCardsInput            `json:"note,omitempty"`
+	Reviews        *ReviewUpdateManyWithoutCardInput          `json:"reviews,omitempty"`
+}
+
+type CardUpdateManyMutationInput struct {
+	Front          *string  `json:"front,omitempty"`
+	Back           *string  `json:"back,omitempty"`
+	Ease           *float64 `json:"ease,omitempty"`
+	Interval       *int32   `json:"interval,omitempty"`
+	Repetitions    *int32   `json:"repetitions,omitempty"`
+	Lapses         *int32   `json:"lapses,omitempty"`
+	ReviewCount    *int32   `json:"reviewCount,omitempty"`
+	Due            *string  `json:"due,omitempty"`
+	LastReviewedAt *string  `json:"lastReviewedAt,omitempty"`
+}
+
+type CardSubscriptionWhereInput struct {
+	MutationIn                 []MutationType               `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                      `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                     `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                     `json:"updatedFields_contains_some,omitempty"`
+	Node                       *CardWhereInput              `json:"node,omitempty"`
+	And                        []CardSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []CardSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []CardSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type ReviewWhereUniqueInput struct {
+	ID  *string `json:"id,omitempty"`
+	Key *string `json:"key,omitempty"`
+}
+
+type ReviewWhereInput struct {
+	ID               *string            `json:"id,omitempty"`
+	IDNot            *string            `json:"id_not,omitempty"`
+	IDIn             []string           `json:"id_in,omitempty"`
+	IDNotIn          []string           `json:"id_not_in,omitempty"`
+	IDLt             *string            `json:"id_lt,omitempty"`
+	IDLte            *string            `json:"id_lte,omitempty"`
+	IDGt             *string            `json:"id_gt,omitempty"`
+	IDGte            *string            `json:"id_gte,omitempty"`
+	IDContains       *string            `json:"id_contains,omitempty"`
+	IDNotContains    *string            `json:"id_not_contains,omitempty"`
+	IDStartsWith     *string            `json:"id_starts_with,omitempty"`
+	IDNotStartsWith  *string            `json:"id_not_starts_with,omitempty"`
+	IDEndsWith       *string            `json:"id_ends_with,omitempty"`
+	IDNotEndsWith    *string            `json:"id_not_ends_with,omitempty"`
+	CreatedAt        *string            `json:"createdAt,omitempty"`
+	CreatedAtNot     *string            `json:"createdAt_not,omitempty"`
+	CreatedAtIn      []string           `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn   []string           `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt      *string            `json:"createdAt_lt,omitempty"`
+	CreatedAtLte     *string            `json:"createdAt_lte,omitempty"`
+	CreatedAtGt      *string            `json:"createdAt_gt,omitempty"`
+	CreatedAtGte     *string            `json:"createdAt_gte,omitempty"`
+	Key              *string            `json:"key,omitempty"`
+	KeyNot           *string            `json:"key_not,omitempty"`
+	KeyIn            []string           `json:"key_in,omitempty"`
+	KeyNotIn         []string           `json:"key_not_in,omitempty"`
+	KeyLt            *string            `json:"key_lt,omitempty"`
+	KeyLte           *string            `json:"key_lte,omitempty"`
+	KeyGt            *string            `json:"key_gt,omitempty"`
+	KeyGte           *string            `json:"key_gte,omitempty"`
+	KeyContains      *string            `json:"key_contains,omitempty"`
+	KeyNotContains   *string            `json:"key_not_contains,omitempty"`
+	KeyStartsWith    *string            `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith *string            `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith      *string            `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith   *string            `json:"key_not_ends_with,omitempty"`
+	Grade            *int32             `json:"grade,omitempty"`
+	GradeNot         *int32             `json:"grade_not,omitempty"`
+	GradeIn          []int32            `json:"grade_in,omitempty"`
+	GradeNotIn       []int32            `json:"grade_not_in,omitempty"`
+	GradeLt          *int32             `json:"grade_lt,omitempty"`
+	GradeLte         *int32             `json:"grade_lte,omitempty"`
+	GradeGt          *int32             `json:"grade_gt,omitempty"`
+	GradeGte         *int32             `json:"grade_gte,omitempty"`
+	Ease             *float64           `json:"ease,omitempty"`
+	EaseNot          *float64           `json:"ease_not,omitempty"`
+	EaseIn           []float64          `json:"ease_in,omitempty"`
+	EaseNotIn        []float64          `json:"ease_not_in,omitempty"`
+	EaseLt           *float64           `json:"ease_lt,omitempty"`
+	EaseLte          *float64           `json:"ease_lte,omitempty"`
+	EaseGt           *float64           `json:"ease_gt,omitempty"`
+	EaseGte          *float64           `json:"ease_gte,omitempty"`
+	Interval         *int32             `json:"interval,omitempty"`
+	IntervalNot      *int32             `json:"interval_not,omitempty"`
+	IntervalIn       []int32            `json:"interval_in,omitempty"`
+	IntervalNotIn    []int32            `json:"interval_not_in,omitempty"`
+	IntervalLt       *int32             `json:"interval_lt,omitempty"`
+	IntervalLte      *int32             `json:"interval_lte,omitempty"`
+	IntervalGt       *int32             `json:"interval_gt,omitempty"`
+	IntervalGte      *int32             `json:"interval_gte,omitempty"`
+	Due              *string            `json:"due,omitempty"`
+	DueNot           *string            `json:"due_not,omitempty"`
+	DueIn            []string           `json:"due_in,omitempty"`
+	DueNotIn         []string           `json:"due_not_in,omitempty"`
+	DueLt            *string            `json:"due_lt,omitempty"`
+	DueLte           *string            `json:"due_lte,omitempty"`
+	DueGt            *string            `json:"due_gt,omitempty"`
+	DueGte           *string            `json:"due_gte,omitempty"`
+	Card             *CardWhereInput    `json:"card,omitempty"`
+	And              []ReviewWhereInput `json:"AND,omitempty"`
+	Or               []ReviewWhereInput `json:"OR,omitempty"`
+	Not              []ReviewWhereInput `json:"NOT,omitempty"`
+}
+
+type ReviewCreateInput struct {
+	ID       *string                          `json:"id,omitempty"`
+	Key      string                           `json:"key"`
+	Grade    int32                            `json:"grade"`
+	Ease     float64                          `json:"ease"`
+	Interval int32                            `json:"interval"`
+	Due      string                           `json:"due"`
+	Card     CardCreateOneWithoutReviewsInput `json:"card"`
+}
+
+type ReviewUpdateInput struct {
+	Key      *string                                   `json:"key,omitempty"`
+	Grade    *int32                                    `json:"grade,omitempty"`
+	Ease     *float64                                  `json:"ease,omitempty"`
+	Interval *int32                                    `json:"interval,omitempty"`
+	Due      *string                                   `json:"due,omitempty"`
+	Card     *CardUpdateOneRequiredWithoutReviewsInput `json:"card,omitempty"`
+}
+
+type ReviewUpdateManyMutationInput struct {
+	Key      *string  `json:"key,omitempty"`
+	Grade    *int32   `json:"grade,omitempty"`
+	Ease     *float64 `json:"ease,omitempty"`
+	Interval *int32   `json:"interval,omitempty"`
+	Due      *string  `json:"due,omitempty"`
+}
+
+type ReviewSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                 `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                        `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                       `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                       `json:"updatedFields_contains_some,omitempty"`
+	Node                       *ReviewWhereInput              `json:"node,omitempty"`
+	And                        []ReviewSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []ReviewSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []ReviewSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type CardCreateWithoutChapterInput struct {
+	ID             *string                           `json:"id,omitempty"`
+	Front          string                            `json:"front"`
+	Back           string                            `json:"back"`
+	Ease           *float64                          `json:"ease,omitempty"`
+	Interval       *int32                            `json:"interval,omitempty"`
+	Repetitions    *int32                            `json:"repetitions,omitempty"`
+	Lapses         *int32                            `json:"lapses,omitempty"`
+	ReviewCount    *int32                            `json:"reviewCount,omitempty"`
+	Due            string                            `json:"due"`
+	LastReviewedAt *string                           `json:"lastReviewedAt,omitempty"`
+	Note           *NoteCreateOneWithoutCardsInput   `json:"note,omitempty"`
+	Reviews        *ReviewCreateManyWithoutCardInput `json:"reviews,omitempty"`
+}
+
+type CardCreateManyWithoutChapterInput struct {
+	Create  []CardCreateWithoutChapterInput `json:"create,omitempty"`
+	Connect []CardWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type CardUpdateWithoutChapterDataInput struct {
+	Front          *string                           `json:"front,omitempty"`
+	Back           *string                           `json:"back,omitempty"`
+	Ease           *float64                          `json:"ease,omitempty"`
+	Interval       *int32                            `json:"interval,omitempty"`
+	Repetitions    *int32                            `json:"repetitions,omitempty"`
+	Lapses         *int32                            `json:"lapses,omitempty"`
+	ReviewCount    *int32                            `json:"reviewCount,omitempty"`
+	Due            *string                           `json:"due,omitempty"`
+	LastReviewedAt *string                           `json:"lastReviewedAt,omitempty"`
+	Note           *NoteUpdateOneWithoutCardsInput   `json:"note,omitempty"`
+	Reviews        *ReviewUpdateManyWithoutCardInput `json:"reviews,omitempty"`
+}
+
+type CardUpdateManyWithoutChapterInput struct {
+	Create     []CardCreateWithoutChapterInput                `json:"create,omitempty"`
+	Delete     []CardWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []CardWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []CardWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []CardWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []CardUpdateWithWhereUniqueWithoutChapterInput `json:"update,omitempty"`
+	Upsert     []CardUpsertWithWhereUniqueWithoutChapterInput `json:"upsert,omitempty"`
+	DeleteMany []CardScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []CardUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type CardUpdateWithWhereUniqueWithoutChapterInput struct {
+	Where CardWhereUniqueInput              `json:"where"`
+	Data  CardUpdateWithoutChapterDataInput `json:"data"`
+}
+
+type CardUpsertWithWhereUniqueWithoutChapterInput struct {
+	Where  CardWhereUniqueInput              `json:"where"`
+	Update CardUpdateWithoutChapterDataInput `json:"update"`
+	Create CardCreateWithoutChapterInput     `json:"create"`
+}
+
+type CardScalarWhereInput struct {
+	ID                  *string                `json:"id,omitempty"`
+	IDNot               *string                `json:"id_not,omitempty"`
+	IDIn                []string               `json:"id_in,omitempty"`
+	IDNotIn             []string               `json:"id_not_in,omitempty"`
+	IDLt                *string                `json:"id_lt,omitempty"`
+	IDLte               *string                `json:"id_lte,omitempty"`
+	IDGt                *string                `json:"id_gt,omitempty"`
+	IDGte               *string                `json:"id_gte,omitempty"`
+	IDContains          *string                `json:"id_contains,omitempty"`
+	IDNotContains       *string                `json:"id_not_contains,omitempty"`
+	IDStartsWith        *string                `json:"id_starts_with,omitempty"`
+	IDNotStartsWith     *string                `json:"id_not_starts_with,omitempty"`
+	IDEndsWith          *string                `json:"id_ends_with,omitempty"`
+	IDNotEndsWith       *string                `json:"id_not_ends_with,omitempty"`
+	CreatedAt           *string                `json:"createdAt,omitempty"`
+	CreatedAtNot        *string                `json:"createdAt_not,omitempty"`
+	CreatedAtIn         []string               `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn      []string               `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt         *string                `json:"createdAt_lt,omitempty"`
+	CreatedAtLte        *string                `json:"createdAt_lte,omitempty"`
+	CreatedAtGt         *string                `json:"createdAt_gt,omitempty"`
+	CreatedAtGte        *string                `json:"createdAt_gte,omitempty"`
+	UpdatedAt           *string                `json:"updatedAt,omitempty"`
+	UpdatedAtNot        *string                `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn         []string               `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn      []string               `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt         *string                `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte        *string                `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt         *string                `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte        *string                `json:"updatedAt_gte,omitempty"`
+	Front               *string                `json:"front,omitempty"`
+	FrontNot            *string                `json:"front_not,omitempty"`
+	FrontIn             []string               `json:"front_in,omitempty"`
+	FrontNotIn          []string               `json:"front_not_in,omitempty"`
+	FrontLt             *string                `json:"front_lt,omitempty"`
+	FrontLte            *string                `json:"front_lte,omitempty"`
+	FrontGt             *string                `json:"front_gt,omitempty"`
+	FrontGte            *string                `json:"front_gte,omitempty"`
+	FrontContains       *string                `json:"front_contains,omitempty"`
+	FrontNotContains    *string                `json:"front_not_contains,omitempty"`
+	FrontStartsWith     *string                `json:"front_starts_with,omitempty"`
+	FrontNotStartsWith  *string                `json:"front_not_starts_with,omitempty"`
+	FrontEndsWith       *string                `json:"front_ends_with,omitempty"`
+	FrontNotEndsWith    *string                `json:"front_not_ends_with,omitempty"`
+	Back                *string                `json:"back,omitempty"`
+	BackNot             *string                `json:"back_not,omitempty"`
+	BackIn              []string               `json:"back_in,omitempty"`
+	BackNotIn           []string               `json:"back_not_in,omitempty"`
+	BackLt              *string                `json:"back_lt,omitempty"`
+	BackLte             *string                `json:"back_lte,omitempty"`
+	BackGt              *string                `json:"back_gt,omitempty"`
+	BackGte             *string                `json:"back_gte,omitempty"`
+	BackContains        *string                `json:"back_contains,omitempty"`
+	BackNotContains     *string                `json:"back_not_contains,omitempty"`
+	BackStartsWith      *string                `json:"back_starts_with,omitempty"`
+	BackNotStartsWith   *string                `json:"back_not_starts_with,omitempty"`
+	BackEndsWith        *string                `json:"back_ends_with,omitempty"`
+	BackNotEndsWith     *string                `json:"back_not_ends_with,omitempty"`
+	Ease                *float64               `json:"ease,omitempty"`
+	EaseNot             *float64               `json:"ease_not,omitempty"`
+	EaseIn              []float64              `json:"ease_in,omitempty"`
+	EaseNotIn           []float64              `json:"ease_not_in,omitempty"`
+	EaseLt              *float64               `json:"ease_lt,omitempty"`
+	EaseLte             *float64               `json:"ease_lte,omitempty"`
+	EaseGt              *float64               `json:"ease_gt,omitempty"`
+	EaseGte             *float64               `json:"ease_gte,omitempty"`
+	Interval            *int32                 `json:"interval,omitempty"`
+	IntervalNot         *int32                 `json:"interval_not,omitempty"`
+	IntervalIn          []int32                `json:"interval_in,omitempty"`
+	IntervalNotIn       []int32                `json:"interval_not_in,omitempty"`
+	IntervalLt          *int32                 `json:"interval_lt,omitempty"`
+	IntervalLte         *int32                 `json:"interval_lte,omitempty"`
+	IntervalGt          *int32                 `json:"interval_gt,omitempty"`
+	IntervalGte         *int32                 `json:"interval_gte,omitempty"`
+	Repetitions         *int32                 `json:"repetitions,omitempty"`
+	RepetitionsNot      *int32                 `json:"repetitions_not,omitempty"`
+	RepetitionsIn       []int32                `json:"repetitions_in,omitempty"`
+	RepetitionsNotIn    []int32                `json:"repetitions_not_in,omitempty"`
+	RepetitionsLt       *int32                 `json:"repetitions_lt,omitempty"`
+	RepetitionsLte      *int32                 `json:"repetitions_lte,omitempty"`
+	RepetitionsGt       *int32                 `json:"repetitions_gt,omitempty"`
+	RepetitionsGte      *int32                 `json:"repetitions_gte,omitempty"`
+	Lapses              *int32                 `json:"lapses,omitempty"`
+	LapsesNot           *int32                 `json:"lapses_not,omitempty"`
+	LapsesIn            []int32                `json:"lapses_in,omitempty"`
+	LapsesNotIn         []int32                `json:"lapses_not_in,omitempty"`
+	LapsesLt            *int32                 `json:"lapses_lt,omitempty"`
+	LapsesLte           *int32                 `json:"lapses_lte,omitempty"`
+	LapsesGt            *int32                 `json:"lapses_gt,omitempty"`
+	LapsesGte           *int32                 `json:"lapses_gte,omitempty"`
+	ReviewCount         *int32                 `json:"reviewCount,omitempty"`
+	ReviewCountNot      *int32                 `json:"reviewCount_not,omitempty"`
+	ReviewCountIn       []int32                `json:"reviewCount_in,omitempty"`
+	ReviewCountNotIn    []int32                `json:"reviewCount_not_in,omitempty"`
+	ReviewCountLt       *int32                 `json:"reviewCount_lt,omitempty"`
+	ReviewCountLte      *int32                 `json:"reviewCount_lte,omitempty"`
+	ReviewCountGt       *int32                 `json:"reviewCount_gt,omitempty"`
+	ReviewCountGte      *int32                 `json:"reviewCount_gte,omitempty"`
+	Due                 *string                `json:"due,omitempty"`
+	DueNot              *string                `json:"due_not,omitempty"`
+	DueIn               []string               `json:"due_in,omitempty"`
+	DueNotIn            []string               `json:"due_not_in,omitempty"`
+	DueLt               *string                `json:"due_lt,omitempty"`
+	DueLte              *string                `json:"due_lte,omitempty"`
+	DueGt               *string                `json:"due_gt,omitempty"`
+	DueGte              *string                `json:"due_gte,omitempty"`
+	LastReviewedAt      *string                `json:"lastReviewedAt,omitempty"`
+	LastReviewedAtNot   *string                `json:"lastReviewedAt_not,omitempty"`
+	LastReviewedAtIn    []string               `json:"lastReviewedAt_in,omitempty"`
+	LastReviewedAtNotIn []string               `json:"lastReviewedAt_not_in,omitempty"`
+	LastReviewedAtLt    *string                `json:"lastReviewedAt_lt,omitempty"`
+	LastReviewedAtLte   *string                `json:"lastReviewedAt_lte,omitempty"`
+	LastReviewedAtGt    *string                `json:"lastReviewedAt_gt,omitempty"`
+	LastReviewedAtGte   *string                `json:"lastReviewedAt_gte,omitempty"`
+	And                 []CardScalarWhereInput `json:"AND,omitempty"`
+	Or                  []CardScalarWhereInput `json:"OR,omitempty"`
+	Not                 []CardScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type CardUpdateManyWithWhereNestedInput struct {
+	Where CardScalarWhereInput    `json:"where"`
+	Data  CardUpdateManyDataInput `json:"data"`
+}
+
+type CardUpdateManyDataInput struct {
+	Front          *string  `json:"front,omitempty"`
+	Back           *string  `json:"back,omitempty"`
+	Ease           *float64 `json:"ease,omitempty"`
+	Interval       *int32   `json:"interval,omitempty"`
+	Repetitions    *int32   `json:"repetitions,omitempty"`
+	Lapses         *int32   `json:"lapses,omitempty"`
+	ReviewCount    *int32   `json:"reviewCount,omitempty"`
+	Due            *string  `json:"due,omitempty"`
+	LastReviewedAt *string  `json:"lastReviewedAt,omitempty"`
+}
+
+type CardCreateWithoutNoteInput struct {
+	ID             *string                           `json:"id,omitempty"`
+	Front          string                            `json:"front"`
+	Back           string                            `json:"back"`
+	Ease           *float64                          `json:"ease,omitempty"`
+	Interval       *int32                            `json:"interval,omitempty"`
+	Repetitions    *int32                            `json:"repetitions,omitempty"`
+	Lapses         *int32                            `json:"lapses,omitempty"`
+	ReviewCount    *int32                            `json:"reviewCount,omitempty"`
+	Due            string                            `json:"due"`
+	LastReviewedAt *string                           `json:"lastReviewedAt,omitempty"`
+	Chapter        ChapterCreateOneWithoutCardsInput `json:"chapter"`
+	Reviews        *ReviewCreateManyWithoutCardInput `json:"reviews,omitempty"`
+}
+
+type CardCreateManyWithoutNoteInput struct {
+	Create  []CardCreateWithoutNoteInput `json:"create,omitempty"`
+	Connect []CardWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type CardUpdateWithoutNoteDataInput struct {
+	Front          *string                                    `json:"front,omitempty"`
+	Back           *string                                    `json:"back,omitempty"`
+	Ease           *float64                                   `json:"ease,omitempty"`
+	Interval       *int32                                     `json:"interval,omitempty"`
+	Repetitions    *int32                                     `json:"repetitions,omitempty"`
+	Lapses         *int32                                     `json:"lapses,omitempty"`
+	ReviewCount    *int32                                     `json:"reviewCount,omitempty"`
+	Due            *string                                    `json:"due,omitempty"`
+	LastReviewedAt *string                                    `json:"lastReviewedAt,omitempty"`
+	Chapter        *ChapterUpdateOneRequiredWithoutCardsInput `json:"chapter,omitempty"`
+	Reviews        *ReviewUpdateManyWithoutCardInput          `json:"reviews,omitempty"`
+}
+
+type CardUpdateManyWithoutNoteInput struct {
+	Create     []CardCreateWithoutNoteInput                `json:"create,omitempty"`
+	Delete     []CardWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []CardWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []CardWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []CardWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []CardUpdateWithWhereUniqueWithoutNoteInput `json:"update,omitempty"`
+	Upsert     []CardUpsertWithWhereUniqueWithoutNoteInput `json:"upsert,omitempty"`
+	DeleteMany []CardScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []CardUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type CardUpdateWithWhereUniqueWithoutNoteInput struct {
+	Where CardWhereUniqueInput           `json:"where"`
+	Data  CardUpdateWithoutNoteDataInput `json:"data"`
+}
+
+type CardUpsertWithWhereUniqueWithoutNoteInput struct {
+	Where  CardWhereUniqueInput           `json:"where"`
+	Update CardUpdateWithoutNoteDataInput `json:"update"`
+	Create CardCreateWithoutNoteInput     `json:"create"`
+}
+
+type ChapterCreateWithoutCardsInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput             `json:"book"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterCreateOneWithoutCardsInput struct {
+	Create  *ChapterCreateWithoutCardsInput `json:"create,omitempty"`
+	Connect *ChapterWhereUniqueInput        `json:"connect,omitempty"`
+}
+
+type ChapterUpdateWithoutCardsDataInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput    `json:"book,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateOneRequiredWithoutCardsInput struct {
+	Create  *ChapterCreateWithoutCardsInput     `json:"create,omitempty"`
+	Update  *ChapterUpdateWithoutCardsDataInput `json:"update,omitempty"`
+	Upsert  *ChapterUpsertWithoutCardsInput     `json:"upsert,omitempty"`
+	Connect *ChapterWhereUniqueInput            `json:"connect,omitempty"`
+}
+
+type ChapterUpsertWithoutCardsInput struct {
+	Update ChapterUpdateWithoutCardsDataInput `json:"update"`
+	Create ChapterCreateWithoutCardsInput     `json:"create"`
+}
+
+type NoteCreateWithoutCardsInput struct {
+	ID       *string                           `json:"id,omitempty"`
+	Quote    string                            `json:"quote"`
+	Text     string                            `json:"text"`
+	Location string                            `json:"location"`
+	Color    *NoteColor                        `json:"color,omitempty"`
+	Chapter  ChapterCreateOneWithoutNotesInput `json:"chapter"`
+}
+
+type NoteCreateOneWithoutCardsInput struct {
+	Create  *NoteCreateWithoutCardsInput `json:"create,omitempty"`
+	Connect *NoteWhereUniqueInput        `json:"connect,omitempty"`
+}
+
+type NoteUpdateWithoutCardsDataInput struct {
+	Quote    *string                                    `json:"quote,omitempty"`
+	Text     *string                                    `json:"text,omitempty"`
+	Location *string                                    `json:"location,omitempty"`
+	Color    *NoteColor                                 `json:"color,omitempty"`
+	Chapter  *ChapterUpdateOneRequiredWithoutNotesInput `json:"chapter,omitempty"`
+}
+
+type NoteUpdateOneWithoutCardsInput struct {
+	Create     *NoteCreateWithoutCardsInput     `json:"create,omitempty"`
+	Update     *NoteUpdateWithoutCardsDataInput `json:"update,omitempty"`
+	Upsert     *NoteUpsertWithoutCardsInput     `json:"upsert,omitempty"`
+	Delete     *bool                            `json:"delete,omitempty"`
+	Disconnect *bool                            `json:"disconnect,omitempty"`
+	Connect    *NoteWhereUniqueInput            `json:"connect,omitempty"`
+}
+
+type NoteUpsertWithoutCardsInput struct {
+	Update NoteUpdateWithoutCardsDataInput `json:"update"`
+	Create NoteCreateWithoutCardsInput     `json:"create"`
+}
+
+type ReviewCreateWithoutCardInput struct {
+	ID       *string `json:"id,omitempty"`
+	Key      string  `json:"key"`
+	Grade    int32   `json:"grade"`
+	Ease     float64 `json:"ease"`
+	Interval int32   `json:"interval"`
+	Due      string  `json:"due"`
+}
+
+type ReviewCreateManyWithoutCardInput struct {
+	Create  []ReviewCreateWithoutCardInput `json:"create,omitempty"`
+	Connect []ReviewWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type ReviewUpdateWithoutCardDataInput struct {
+	Key      *string  `json:"key,omitempty"`
+	Grade    *int32   `json:"grade,omitempty"`
+	Ease     *float64 `json:"ease,omitempty"`
+	Interval *int32   `json:"interval,omitempty"`
+	Due      *string  `json:"due,omitempty"`
+}
+
+type ReviewUpdateManyWithoutCardInput struct {
+	Create     []ReviewCreateWithoutCardInput                `json:"create,omitempty"`
+	Delete     []ReviewWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []ReviewWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []ReviewWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []ReviewWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []ReviewUpdateWithWhereUniqueWithoutCardInput `json:"update,omitempty"`
+	Upsert     []ReviewUpsertWithWhereUniqueWithoutCardInput `json:"upsert,omitempty"`
+	DeleteMany []ReviewScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []ReviewUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type ReviewUpdateWithWhereUniqueWithoutCardInput struct {
+	Where ReviewWhereUniqueInput           `json:"where"`
+	Data  ReviewUpdateWithoutCardDataInput `json:"data"`
+}
+
+type ReviewUpsertWithWhereUniqueWithoutCardInput struct {
+	Where  ReviewWhereUniqueInput           `json:"where"`
+	Update ReviewUpdateWithoutCardDataInput `json:"update"`
+	Create ReviewCreateWithoutCardInput     `json:"create"`
+}
+
+type ReviewScalarWhereInput struct {
+	ID               *string                  `json:"id,omitempty"`
+	IDNot            *string                  `json:"id_not,omitempty"`
+	IDIn             []string                 `json:"id_in,omitempty"`
+	IDNotIn          []string                 `json:"id_not_in,omitempty"`
+	IDLt             *string                  `json:"id_lt,omitempty"`
+	IDLte            *string                  `json:"id_lte,omitempty"`
+	IDGt             *string                  `json:"id_gt,omitempty"`
+	IDGte            *string                  `json:"id_gte,omitempty"`
+	IDContains       *string                  `json:"id_contains,omitempty"`
+	IDNotContains    *string                  `json:"id_not_contains,omitempty"`
+	IDStartsWith     *string                  `json:"id_starts_with,omitempty"`
+	IDNotStartsWith  *string                  `json:"id_not_starts_with,omitempty"`
+	IDEndsWith       *string                  `json:"id_ends_with,omitempty"`
+	IDNotEndsWith    *string                  `json:"id_not_ends_with,omitempty"`
+	CreatedAt        *string                  `json:"createdAt,omitempty"`
+	CreatedAtNot     *string                  `json:"createdAt_not,omitempty"`
+	CreatedAtIn      []string                 `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn   []string                 `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt      *string                  `json:"createdAt_lt,omitempty"`
+	CreatedAtLte     *string                  `json:"createdAt_lte,omitempty"`
+	CreatedAtGt      *string                  `json:"createdAt_gt,omitempty"`
+	CreatedAtGte     *string                  `json:"createdAt_gte,omitempty"`
+	Key              *string                  `json:"key,omitempty"`
+	KeyNot           *string                  `json:"key_not,omitempty"`
+	KeyIn            []string                 `json:"key_in,omitempty"`
+	KeyNotIn         []string                 `json:"key_not_in,omitempty"`
+	KeyLt            *string                  `json:"key_lt,omitempty"`
+	KeyLte           *string                  `json:"key_lte,omitempty"`
+	KeyGt            *string                  `json:"key_gt,omitempty"`
+	KeyGte           *string                  `json:"key_gte,omitempty"`
+	KeyContains      *string                  `json:"key_contains,omitempty"`
+	KeyNotContains   *string                  `json:"key_not_contains,omitempty"`
+	KeyStartsWith    *string                  `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith *string                  `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith      *string                  `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith   *string                  `json:"key_not_ends_with,omitempty"`
+	Grade            *int32                   `json:"grade,omitempty"`
+	GradeNot         *int32                   `json:"grade_not,omitempty"`
+	GradeIn          []int32                  `json:"grade_in,omitempty"`
+	GradeNotIn       []int32                  `json:"grade_not_in,omitempty"`
+	GradeLt          *int32                   `json:"grade_lt,omitempty"`
+	GradeLte         *int32                   `json:"grade_lte,omitempty"`
+	GradeGt          *int32                   `json:"grade_gt,omitempty"`
+	GradeGte         *int32                   `json:"grade_gte,omitempty"`
+	Ease             *float64                 `json:"ease,omitempty"`
+	EaseNot          *float64                 `json:"ease_not,omitempty"`
+	EaseIn           []float64                `json:"ease_in,omitempty"`
+	EaseNotIn        []float64                `json:"ease_not_in,omitempty"`
+	EaseLt           *float64                 `json:"ease_lt,omitempty"`
+	EaseLte          *float64                 `json:"ease_lte,omitempty"`
+	EaseGt           *float64                 `json:"ease_gt,omitempty"`
+	EaseGte          *float64                 `json:"ease_gte,omitempty"`
+	Interval         *int32                   `json:"interval,omitempty"`
+	IntervalNot      *int32                   `json:"interval_not,omitempty"`
+	IntervalIn       []int32                  `json:"interval_in,omitempty"`
+	IntervalNotIn    []int32                  `json:"interval_not_in,omitempty"`
+	IntervalLt       *int32                   `json:"interval_lt,omitempty"`
+	IntervalLte      *int32                   `json:"interval_lte,omitempty"`
+	IntervalGt       *int32                   `json:"interval_gt,omitempty"`
+	IntervalGte      *int32                   `json:"interval_gte,omitempty"`
+	Due              *string                  `json:"due,omitempty"`
+	DueNot           *string                  `json:"due_not,omitempty"`
+	DueIn            []string                 `json:"due_in,omitempty"`
+	DueNotIn         []string                 `json:"due_not_in,omitempty"`
+	DueLt            *string                  `json:"due_lt,omitempty"`
+	DueLte           *string                  `json:"due_lte,omitempty"`
+	DueGt            *string                  `json:"due_gt,omitempty"`
+	DueGte           *string                  `json:"due_gte,omitempty"`
+	And              []ReviewScalarWhereInput `json:"AND,omitempty"`
+	Or               []ReviewScalarWhereInput `json:"OR,omitempty"`
+	Not              []ReviewScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type ReviewUpdateManyWithWhereNestedInput struct {
+	Where ReviewScalarWhereInput    `json:"where"`
+	Data  ReviewUpdateManyDataInput `json:"data"`
+}
+
+type ReviewUpdateManyDataInput struct {
+	Key      *string  `json:"key,omitempty"`
+	Grade    *int32   `json:"grade,omitempty"`
+	Ease     *float64 `json:"ease,omitempty"`
+	Interval *int32   `json:"interval,omitempty"`
+	Due      *string  `json:"due,omitempty"`
+}
+
+type CardCreateWithoutReviewsInput struct {
+	ID             *string                           `json:"id,omitempty"`
+	Front          string                            `json:"front"`
+	Back           string                            `json:"back"`
+	Ease           *float64                          `json:"ease,omitempty"`
+	Interval       *int32                            `json:"interval,omitempty"`
+	Repetitions    *int32                            `json:"repetitions,omitempty"`
+	Lapses         *int32                            `json:"lapses,omitempty"`
+	ReviewCount    *int32                            `json:"reviewCount,omitempty"`
+	Due            string                            `json:"due"`
+	LastReviewedAt *string                           `json:"lastReviewedAt,omitempty"`
+	Chapter        ChapterCreateOneWithoutCardsInput `json:"chapter"`
+	Note           *NoteCreateOneWithoutCardsInput   `json:"note,omitempty"`
+}
+
+type CardCreateOneWithoutReviewsInput struct {
+	Create  *CardCreateWithoutReviewsInput `json:"create,omitempty"`
+	Connect *CardWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type CardUpdateWithoutReviewsDataInput struct {
+	Front          *string                                    `json:"front,omitempty"`
+	Back           *string                                    `json:"back,omitempty"`
+	Ease           *float64                                   `json:"ease,omitempty"`
+	Interval       *int32                                     `json:"interval,omitempty"`
+	Repetitions    *int32                                     `json:"repetitions,omitempty"`
+	Lapses         *int32                                     `json:"lapses,omitempty"`
+	ReviewCount    *int32                                     `json:"reviewCount,omitempty"`
+	Due            *string                                    `json:"due,omitempty"`
+	LastReviewedAt *string                                    `json:"lastReviewedAt,omitempty"`
+	Chapter        *ChapterUpdateOneRequiredWithoutCardsInput `json:"chapter,omitempty"`
+	Note           *NoteUpdateOneWithoutCardsInput            `json:"note,omitempty"`
+}
+
+type CardUpdateOneRequiredWithoutReviewsInput struct {
+	Create  *CardCreateWithoutReviewsInput     `json:"create,omitempty"`
+	Update  *CardUpdateWithoutReviewsDataInput `json:"update,omitempty"`
+	Upsert  *CardUpsertWithoutReviewsInput     `json:"upsert,omitempty"`
+	Connect *CardWhereUniqueInput              `json:"connect,omitempty"`
+}
+
+type CardUpsertWithoutReviewsInput struct {
+	Update CardUpdateWithoutReviewsDataInput `json:"update"`
+	Create CardCreateWithoutReviewsInput     `json:"create"`
+}
+
+type ReadingProgressWhereUniqueInput struct {
+	ID  *string `json:"id,omitempty"`
+	Key *string `json:"key,omitempty"`
+}
+
+type ReadingProgressWhereInput struct {
+	ID                *string                     `json:"id,omitempty"`
+	IDNot             *string                     `json:"id_not,omitempty"`
+	IDIn              []string                    `json:"id_in,omitempty"`
+	IDNotIn           []string                    `json:"id_not_in,omitempty"`
+	IDLt              *string                     `json:"id_lt,omitempty"`
+	IDLte             *string                     `json:"id_lte,omitempty"`
+	IDGt              *string                     `json:"id_gt,omitempty"`
+	IDGte             *string                     `json:"id_gte,omitempty"`
+	IDContains        *string                     `json:"id_contains,omitempty"`
+	IDNotContains     *string                     `json:"id_not_contains,omitempty"`
+	IDStartsWith      *string                     `json:"id_starts_with,omitempty"`
+	IDNotStartsWith   *string                     `json:"id_not_starts_with,omitempty"`
+	IDEndsWith        *string                     `json:"id_ends_with,omitempty"`
+	IDNotEndsWith     *string                     `json:"id_not_ends_with,omitempty"`
+	CreatedAt         *string                     `json:"createdAt,omitempty"`
+	CreatedAtNot      *string                     `json:"createdAt_not,omitempty"`
+	CreatedAtIn       []string                    `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn    []string                    `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt       *string                     `json:"createdAt_lt,omitempty"`
+	CreatedAtLte      *string                     `json:"createdAt_lte,omitempty"`
+	CreatedAtGt       *string                     `json:"createdAt_gt,omitempty"`
+	CreatedAtGte      *string                     `json:"createdAt_gte,omitempty"`
+	UpdatedAt         *string                     `json:"updatedAt,omitempty"`
+	UpdatedAtNot      *string                     `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn       []string                    `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn    []string                    `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt       *string                     `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte      *string                     `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt       *string                     `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte      *string                     `json:"updatedAt_gte,omitempty"`
+	Key               *string                     `json:"key,omitempty"`
+	KeyNot            *string                     `json:"key_not,omitempty"`
+	KeyIn             []string                    `json:"key_in,omitempty"`
+	KeyNotIn          []string                    `json:"key_not_in,omitempty"`
+	KeyLt             *string                     `json:"key_lt,omitempty"`
+	KeyLte            *string                     `json:"key_lte,omitempty"`
+	KeyGt             *string                     `json:"key_gt,omitempty"`
+	KeyGte            *string                     `json:"key_gte,omitempty"`
+	KeyContains       *string                     `json:"key_contains,omitempty"`
+	KeyNotContains    *string                     `json:"key_not_contains,omitempty"`
+	KeyStartsWith     *string                     `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith  *string                     `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith       *string                     `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith    *string                     `json:"key_not_ends_with,omitempty"`
+	User              *string                     `json:"user,omitempty"`
+	UserNot           *string                     `json:"user_not,omitempty"`
+	UserIn            []string                    `json:"user_in,omitempty"`
+	UserNotIn         []string                    `json:"user_not_in,omitempty"`
+	UserLt            *string                     `json:"user_lt,omitempty"`
+	UserLte           *string                     `json:"user_lte,omitempty"`
+	UserGt            *string                     `json:"user_gt,omitempty"`
+	UserGte           *string                     `json:"user_gte,omitempty"`
+	UserContains      *string                     `json:"user_contains,omitempty"`
+	UserNotContains   *string                     `json:"user_not_contains,omitempty"`
+	UserStartsWith    *string                     `json:"user_starts_with,omitempty"`
+	UserNotStartsWith *string                     `json:"user_not_starts_with,omitempty"`
+	UserEndsWith      *string                     `json:"user_ends_with,omitempty"`
+	UserNotEndsWith   *string                     `json:"user_not_ends_with,omitempty"`
+	Status            *ReadingStatus              `json:"status,omitempty"`
+	StatusNot         *ReadingStatus              `json:"status_not,omitempty"`
+	StatusIn          []ReadingStatus             `json:"status_in,omitempty"`
+	StatusNotIn       []ReadingStatus             `json:"status_not_in,omitempty"`
+	StartedAt         *string                     `json:"startedAt,omitempty"`
+	StartedAtNot      *string                     `json:"startedAt_not,omitempty"`
+	StartedAtIn       []string                    `json:"startedAt_in,omitempty"`
+	StartedAtNotIn    []string                    `json:"startedAt_not_in,omitempty"`
+	StartedAtLt       *string                     `json:"startedAt_lt,omitempty"`
+	StartedAtLte      *string                     `json:"startedAt_lte,omitempty"`
+	StartedAtGt       *string                     `json:"startedAt_gt,omitempty"`
+	StartedAtGte      *string                     `json:"startedAt_gte,omitempty"`
+	FinishedAt        *string                     `json:"finishedAt,omitempty"`
+	FinishedAtNot     *string                     `json:"finishedAt_not,omitempty"`
+	FinishedAtIn      []string                    `json:"finishedAt_in,omitempty"`
+	FinishedAtNotIn   []string                    `json:"finishedAt_not_in,omitempty"`
+	FinishedAtLt      *string                     `json:"finishedAt_lt,omitempty"`
+	FinishedAtLte     *string                     `json:"finishedAt_lte,omitempty"`
+	FinishedAtGt      *string                     `json:"finishedAt_gt,omitempty"`
+	FinishedAtGte     *string                     `json:"finishedAt_gte,omitempty"`
+	Chapter           *ChapterWhereInput          `json:"chapter,omitempty"`
+	And               []ReadingProgressWhereInput `json:"AND,omitempty"`
+	Or                []ReadingProgressWhereInput `json:"OR,omitempty"`
+	Not               []ReadingProgressWhereInput `json:"NOT,omitempty"`
+}
+
+type ReadingProgressCreateInput struct {
+	ID         *string                              `json:"id,omitempty"`
+	Key        string                               `json:"key"`
+	User       string                               `json:"user"`
+	Status     *ReadingStatus                       `json:"status,omitempty"`
+	StartedAt  *string                              `json:"startedAt,omitempty"`
+	FinishedAt *string                              `json:"finishedAt,omitempty"`
+	Chapter    ChapterCreateOneWithoutProgressInput `json:"chapter"`
+}
+
+type ReadingProgressUpdateInput struct {
+	Key        *string                                       `json:"key,omitempty"`
+	User       *string                                       `json:"user,omitempty"`
+	Status     *ReadingStatus                                `json:"status,omitempty"`
+	StartedAt  *string                                       `json:"startedAt,omitempty"`
+	FinishedAt *string                                       `json:"finishedAt,omitempty"`
+	Chapter    *ChapterUpdateOneRequiredWithoutProgressInput `json:"chapter,omitempty"`
+}
+
+type ReadingProgressUpdateManyMutationInput struct {
+	Key        *string        `json:"key,omitempty"`
+	User       *string        `json:"user,omitempty"`
+	Status     *ReadingStatus `json:"status,omitempty"`
+	StartedAt  *string        `json:"startedAt,omitempty"`
+	FinishedAt *string        `json:"finishedAt,omitempty"`
+}
+
+type ReadingProgressSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                          `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                                 `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                                `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                                `json:"updatedFields_contains_some,omitempty"`
+	Node                       *ReadingProgressWhereInput              `json:"node,omitempty"`
+	And                        []ReadingProgressSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []ReadingProgressSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []ReadingProgressSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type ReadingProgressCreateWithoutChapterInput struct {
+	ID         *string        `json:"id,omitempty"`
+	Key        string         `json:"key"`
+	User       string         `json:"user"`
+	Status     *ReadingStatus `json:"status,omitempty"`
+	StartedAt  *string        `json:"startedAt,omitempty"`
+	FinishedAt *string        `json:"finishedAt,omitempty"`
+}
+
+type ReadingProgressCreateManyWithoutChapterInput struct {
+	Create  []ReadingProgressCreateWithoutChapterInput `json:"create,omitempty"`
+	Connect []ReadingProgressWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type ReadingProgressUpdateWithoutChapterDataInput struct {
+	Key        *string        `json:"key,omitempty"`
+	User       *string        `json:"user,omitempty"`
+	Status     *ReadingStatus `json:"status,omitempty"`
+	StartedAt  *string        `json:"startedAt,omitempty"`
+	FinishedAt *string        `json:"finishedAt,omitempty"`
+}
+
+type ReadingProgressUpdateManyWithoutChapterInput struct {
+	Create     []ReadingProgressCreateWithoutChapterInput                `json:"create,omitempty"`
+	Delete     []ReadingProgressWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []ReadingProgressWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []ReadingProgressWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []ReadingProgressWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []ReadingProgressUpdateWithWhereUniqueWithoutChapterInput `json:"update,omitempty"`
+	Upsert     []ReadingProgressUpsertWithWhereUniqueWithoutChapterInput `json:"upsert,omitempty"`
+	DeleteMany []ReadingProgressScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []ReadingProgressUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type ReadingProgressUpdateWithWhereUniqueWithoutChapterInput struct {
+	Where ReadingProgressWhereUniqueInput              `json:"where"`
+	Data  ReadingProgressUpdateWithoutChapterDataInput `json:"data"`
+}
+
+type ReadingProgressUpsertWithWhereUniqueWithoutChapterInput struct {
+	Where  ReadingProgressWhereUniqueInput              `json:"where"`
+	Update ReadingProgressUpdateWithoutChapterDataInput `json:"update"`
+	Create ReadingProgressCreateWithoutChapterInput     `json:"create"`
+}
+
+type ReadingProgressScalarWhereInput struct {
+	ID                *string                           `json:"id,omitempty"`
+	IDNot             *string                           `json:"id_not,omitempty"`
+	IDIn              []string                          `json:"id_in,omitempty"`
+	IDNotIn           []string                          `json:"id_not_in,omitempty"`
+	IDLt              *string                           `json:"id_lt,omitempty"`
+	IDLte             *string                           `json:"id_lte,omitempty"`
+	IDGt              *string                           `json:"id_gt,omitempty"`
+	IDGte             *string                           `json:"id_gte,omitempty"`
+	IDContains        *string                           `json:"id_contains,omitempty"`
+	IDNotContains     *string                           `json:"id_not_contains,omitempty"`
+	IDStartsWith      *string                           `json:"id_starts_with,omitempty"`
+	IDNotStartsWith   *string                           `json:"id_not_starts_with,omitempty"`
+	IDEndsWith        *string                           `json:"id_ends_with,omitempty"`
+	IDNotEndsWith     *string                           `json:"id_not_ends_with,omitempty"`
+	CreatedAt         *string                           `json:"createdAt,omitempty"`
+	CreatedAtNot      *string                           `json:"createdAt_not,omitempty"`
+	CreatedAtIn       []string                          `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn    []string                          `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt       *string                           `json:"createdAt_lt,omitempty"`
+	CreatedAtLte      *string                           `json:"createdAt_lte,omitempty"`
+	CreatedAtGt       *string                           `json:"createdAt_gt,omitempty"`
+	CreatedAtGte      *string                           `json:"createdAt_gte,omitempty"`
+	UpdatedAt         *string                           `json:"updatedAt,omitempty"`
+	UpdatedAtNot      *string                           `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn       []string                          `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn    []string                          `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt       *string                           `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte      *string                           `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt       *string                           `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte      *string                           `json:"updatedAt_gte,omitempty"`
+	Key               *string                           `json:"key,omitempty"`
+	KeyNot            *string                           `json:"key_not,omitempty"`
+	KeyIn             []string                          `json:"key_in,omitempty"`
+	KeyNotIn          []string                          `json:"key_not_in,omitempty"`
+	KeyLt             *string                           `json:"key_lt,omitempty"`
+	KeyLte            *string                           `json:"key_lte,omitempty"`
+	KeyGt             *string                           `json:"key_gt,omitempty"`
+	KeyGte            *string                           `json:"key_gte,omitempty"`
+	KeyContains       *string                           `json:"key_contains,omitempty"`
+	KeyNotContains    *string                           `json:"key_not_contains,omitempty"`
+	KeyStartsWith     *string                           `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith  *string                           `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith       *string                           `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith    *string                           `json:"key_not_ends_with,omitempty"`
+	User              *string                           `json:"user,omitempty"`
+	UserNot           *string                           `json:"user_not,omitempty"`
+	UserIn            []string                          `json:"user_in,omitempty"`
+	UserNotIn         []string                          `json:"user_not_in,omitempty"`
+	UserLt            *string                           `json:"user_lt,omitempty"`
+	UserLte           *string                           `json:"user_lte,omitempty"`
+	UserGt            *string                           `json:"user_gt,omitempty"`
+	UserGte           *string                           `json:"user_gte,omitempty"`
+	UserContains      *string                           `json:"user_contains,omitempty"`
+	UserNotContains   *string                           `json:"user_not_contains,omitempty"`
+	UserStartsWith    *string                           `json:"user_starts_with,omitempty"`
+	UserNotStartsWith *string                           `json:"user_not_starts_with,omitempty"`
+	UserEndsWith      *string                           `json:"user_ends_with,omitempty"`
+	UserNotEndsWith   *string                           `json:"user_not_ends_with,omitempty"`
+	Status            *ReadingStatus                    `json:"status,omitempty"`
+	StatusNot         *ReadingStatus                    `json:"status_not,omitempty"`
+	StatusIn          []ReadingStatus                   `json:"status_in,omitempty"`
+	StatusNotIn       []ReadingStatus                   `json:"status_not_in,omitempty"`
+	StartedAt         *string                           `json:"startedAt,omitempty"`
+	StartedAtNot      *string                           `json:"startedAt_not,omitempty"`
+	StartedAtIn       []string                          `json:"startedAt_in,omitempty"`
+	StartedAtNotIn    []string                          `json:"startedAt_not_in,omitempty"`
+	StartedAtLt       *string                           `json:"startedAt_lt,omitempty"`
+	StartedAtLte      *string                           `json:"startedAt_lte,omitempty"`
+	StartedAtGt       *string                           `json:"startedAt_gt,omitempty"`
+	StartedAtGte      *string                           `json:"startedAt_gte,omitempty"`
+	FinishedAt        *string                           `json:"finishedAt,omitempty"`
+	FinishedAtNot     *string                           `json:"finishedAt_not,omitempty"`
+	FinishedAtIn      []string                          `json:"finishedAt_in,omitempty"`
+	FinishedAtNotIn   []string                          `json:"finishedAt_not_in,omitempty"`
+	FinishedAtLt      *string                           `json:"finishedAt_lt,omitempty"`
+	FinishedAtLte     *string                           `json:"finishedAt_lte,omitempty"`
+	FinishedAtGt      *string                           `json:"finishedAt_gt,omitempty"`
+	FinishedAtGte     *string                           `json:"finishedAt_gte,omitempty"`
+	And               []ReadingProgressScalarWhereInput `json:"AND,omitempty"`
+	Or                []ReadingProgressScalarWhereInput `json:"OR,omitempty"`
+	Not               []ReadingProgressScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type ReadingProgressUpdateManyWithWhereNestedInput struct {
+	Where ReadingProgressScalarWhereInput    `json:"where"`
+	Data  ReadingProgressUpdateManyDataInput `json:"data"`
+}
+
+type ReadingProgressUpdateManyDataInput struct {
+	Key        *string        `json:"key,omitempty"`
+	User       *string        `json:"user,omitempty"`
+	Status     *ReadingStatus `json:"status,omitempty"`
+	StartedAt  *string        `json:"startedAt,omitempty"`
+	FinishedAt *string        `json:"finishedAt,omitempty"`
+}
+
+type ChapterCreateWithoutProgressInput struct {
+	ID          *string                                      `json:"id,omitempty"`
+	Name        string                                       `json:"name"`
+	Description string                                       `json:"description"`
+	Position    *float64                                     `json:"position,omitempty"`
+	Deleted     *bool                                        `json:"deleted,omitempty"`
+	DeletedAt   *string                                      `json:"deletedAt,omitempty"`
+	Revision    *int32                                       `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput           `json:"tags,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput           `json:"notes,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput           `json:"cards,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput `json:"sessions,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput       `json:"reminders,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput       `json:"revisions,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput            `json:"book"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput    `json:"outbox,omitempty"`
+}
+
+type ChapterCreateOneWithoutProgressInput struct {
+	Create  *ChapterCreateWithoutProgressInput `json:"create,omitempty"`
+	Connect *ChapterWhereUniqueInput           `json:"connect,omitempty"`
+}
+
+type ChapterUpdateWithoutProgressDataInput struct {
+	Name        *string                                      `json:"name,omitempty"`
+	Description *string                                      `json:"description,omitempty"`
+	Position    *float64                                     `json:"position,omitempty"`
+	Deleted     *bool                                        `json:"deleted,omitempty"`
+	DeletedAt   *string                                      `json:"deletedAt,omitempty"`
+	Revision    *int32                                       `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput           `json:"tags,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput           `json:"notes,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput           `json:"cards,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput `json:"sessions,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput       `json:"reminders,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput       `json:"revisions,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput   `json:"book,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput    `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateOneRequiredWithoutProgressInput struct {
+	Create  *ChapterCreateWithoutProgressInput     `json:"create,omitempty"`
+	Update  *ChapterUpdateWithoutProgressDataInput `json:"update,omitempty"`
+	Upsert  *ChapterUpsertWithoutProgressInput     `json:"upsert,omitempty"`
+	Connect *ChapterWhereUniqueInput               `json:"connect,omitempty"`
+}
+
+type ChapterUpsertWithoutProgressInput struct {
+	Update ChapterUpdateWithoutProgressDataInput `json:"update"`
+	Create ChapterCreateWithoutProgressInput     `json:"create"`
+}
+
+type ReadingSessionWhereUniqueInput struct {
+	ID  *string `json:"id,omitempty"`
+	Key *string `json:"key,omitempty"`
+}
+
+type ReadingSessionWhereInput struct {
+	ID                *string                    `json:"id,omitempty"`
+	IDNot             *string                    `json:"id_not,omitempty"`
+	IDIn              []string                   `json:"id_in,omitempty"`
+	IDNotIn           []string                   `json:"id_not_in,omitempty"`
+	IDLt              *string                    `json:"id_lt,omitempty"`
+	IDLte             *string                    `json:"id_lte,omitempty"`
+	IDGt              *string                    `json:"id_gt,omitempty"`
+	IDGte             *string                    `json:"id_gte,omitempty"`
+	IDContains        *string                    `json:"id_contains,omitempty"`
+	IDNotContains     *string                    `json:"id_not_contains,omitempty"`
+	IDStartsWith      *string                    `json:"id_starts_with,omitempty"`
+	IDNotStartsWith   *string                    `json:"id_not_starts_with,omitempty"`
+	IDEndsWith        *string                    `json:"id_ends_with,omitempty"`
+	IDNotEndsWith     *string                    `json:"id_not_ends_with,omitempty"`
+	CreatedAt         *string                    `json:"createdAt,omitempty"`
+	CreatedAtNot      *string                    `json:"createdAt_not,omitempty"`
+	CreatedAtIn       []string                   `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn    []string                   `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt       *string                    `json:"createdAt_lt,omitempty"`
+	CreatedAtLte      *string                    `json:"createdAt_lte,omitempty"`
+	CreatedAtGt       *string                    `json:"createdAt_gt,omitempty"`
+	CreatedAtGte      *string                    `json:"createdAt_gte,omitempty"`
+	UpdatedAt         *string                    `json:"updatedAt,omitempty"`
+	UpdatedAtNot      *string                    `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn       []string                   `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn    []string                   `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt       *string                    `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte      *string                    `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt       *string                    `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte      *string                    `json:"updatedAt_gte,omitempty"`
+	Key               *string                    `json:"key,omitempty"`
+	KeyNot            *string                    `json:"key_not,omitempty"`
+	KeyIn             []string                   `json:"key_in,omitempty"`
+	KeyNotIn          []string                   `json:"key_not_in,omitempty"`
+	KeyLt             *string                    `json:"key_lt,omitempty"`
+	KeyLte            *string                    `json:"key_lte,omitempty"`
+	KeyGt             *string                    `json:"key_gt,omitempty"`
+	KeyGte            *string                    `json:"key_gte,omitempty"`
+	KeyContains       *string                    `json:"key_contains,omitempty"`
+	KeyNotContains    *string                    `json:"key_not_contains,omitempty"`
+	KeyStartsWith     *string                    `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith  *string                    `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith       *string                    `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith    *string                    `json:"key_not_ends_with,omitempty"`
+	User              *string                    `json:"user,omitempty"`
+	UserNot           *string                    `json:"user_not,omitempty"`
+	UserIn            []string                   `json:"user_in,omitempty"`
+	UserNotIn         []string                   `json:"user_not_in,omitempty"`
+	UserLt            *string                    `json:"user_lt,omitempty"`
+	UserLte           *string                    `json:"user_lte,omitempty"`
+	UserGt            *string                    `json:"user_gt,omitempty"`
+	UserGte           *string                    `json:"user_gte,omitempty"`
+	UserContains      *string                    `json:"user_contains,omitempty"`
+	UserNotContains   *string                    `json:"user_not_contains,omitempty"`
+	UserStartsWith    *string                    `json:"user_starts_with,omitempty"`
+	UserNotStartsWith *string                    `json:"user_not_starts_with,omitempty"`
+	UserEndsWith      *string                    `json:"user_ends_with,omitempty"`
+	UserNotEndsWith   *string                    `json:"user_not_ends_with,omitempty"`
+	Open              *bool                      `json:"open,omitempty"`
+	OpenNot           *bool                      `json:"open_not,omitempty"`
+	StartedAt         *string                    `json:"startedAt,omitempty"`
+	StartedAtNot      *string                    `json:"startedAt_not,omitempty"`
+	StartedAtIn       []string                   `json:"startedAt_in,omitempty"`
+	StartedAtNotIn    []string                   `json:"startedAt_not_in,omitempty"`
+	StartedAtLt       *string                    `json:"startedAt_lt,omitempty"`
+	StartedAtLte      *string                    `json:"startedAt_lte,omitempty"`
+	StartedAtGt       *string                    `json:"startedAt_gt,omitempty"`
+	StartedAtGte      *string                    `json:"startedAt_gte,omitempty"`
+	EndedAt           *string                    `json:"endedAt,omitempty"`
+	EndedAtNot        *string                    `json:"endedAt_not,omitempty"`
+	EndedAtIn         []string                   `json:"endedAt_in,omitempty"`
+	EndedAtNotIn      []string                   `json:"endedAt_not_in,omitempty"`
+	EndedAtLt         *string                    `json:"endedAt_lt,omitempty"`
+	EndedAtLte        *string                    `json:"endedAt_lte,omitempty"`
+	EndedAtGt         *string                    `json:"endedAt_gt,omitempty"`
+	EndedAtGte        *string                    `json:"endedAt_gte,omitempty"`
+	Duration          *int32                     `json:"duration,omitempty"`
+	DurationNot       *int32                     `json:"duration_not,omitempty"`
+	DurationIn        []int32                    `json:"duration_in,omitempty"`
+	DurationNotIn     []int32                    `json:"duration_not_in,omitempty"`
+	DurationLt        *int32                     `json:"duration_lt,omitempty"`
+	DurationLte       *int32                     `json:"duration_lte,omitempty"`
+	DurationGt        *int32                     `json:"duration_gt,omitempty"`
+	DurationGte       *int32                     `json:"duration_gte,omitempty"`
+	Pages             *int32                     `json:"pages,omitempty"`
+	PagesNot          *int32                     `json:"pages_not,omitempty"`
+	PagesIn           []int32                    `json:"pages_in,omitempty"`
+	PagesNotIn        []int32                    `json:"pages_not_in,omitempty"`
+	PagesLt           *int32                     `json:"pages_lt,omitempty"`
+	PagesLte          *int32                     `json:"pages_lte,omitempty"`
+	PagesGt           *int32                     `json:"pages_gt,omitempty"`
+	PagesGte          *int32                     `json:"pages_gte,omitempty"`
+	Book              *BookWhereInput            `json:"book,omitempty"`
+	Chapter           *ChapterWhereInput         `json:"chapter,omitempty"`
+	And               []ReadingSessionWhereInput `json:"AND,omitempty"`
+	Or                []ReadingSessionWhereInput `json:"OR,omitempty"`
+	Not               []ReadingSessionWhereInput `json:"NOT,omitempty"`
+}
+
+type ReadingSessionCreateInput struct {
+	ID        *string                               `json:"id,omitempty"`
+	Key       string                                `json:"key"`
+	User      string                                `json:"user"`
+	Open      *bool                                 `json:"open,omitempty"`
+	StartedAt string                                `json:"startedAt"`
+	EndedAt   *string                               `json:"endedAt,omitempty"`
+	Duration  *int32                                `json:"duration,omitempty"`
+	Pages     *int32                                `json:"pages,omitempty"`
+	Book      BookCreateOneWithoutSessionsInput     `json:"book"`
+	Chapter   *ChapterCreateOneWithoutSessionsInput `json:"chapter,omitempty"`
+}
+
+type ReadingSessionUpdateInput struct {
+	Key       *string                                    `json:"key,omitempty"`
+	User      *string                                    `json:"user,omitempty"`
+	Open      *bool                                      `json:"open,omitempty"`
+	StartedAt *string                                    `json:"startedAt,omitempty"`
+	EndedAt   *string                                    `json:"endedAt,omitempty"`
+	Duration  *int32                                     `json:"duration,omitempty"`
+	Pages     *int32                                     `json:"pages,omitempty"`
+	Book      *BookUpdateOneRequiredWithoutSessionsInput `json:"book,omitempty"`
+	Chapter   *ChapterUpdateOneWithoutSessionsInput      `json:"chapter,omitempty"`
+}
+
+type ReadingSessionUpdateManyMutationInput struct {
+	Key       *string `json:"key,omitempty"`
+	User      *string `json:"user,omitempty"`
+	Open      *bool   `json:"open,omitempty"`
+	StartedAt *string `json:"startedAt,omitempty"`
+	EndedAt   *string `json:"endedAt,omitempty"`
+	Duration  *int32  `json:"duration,omitempty"`
+	Pages     *int32  `json:"pages,omitempty"`
+}
+
+type ReadingSessionSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                         `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                                `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                               `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                               `json:"updatedFields_contains_some,omitempty"`
+	Node                       *ReadingSessionWhereInput              `json:"node,omitempty"`
+	And                        []ReadingSessionSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []ReadingSessionSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []ReadingSessionSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type ReadingSessionCreateWithoutBookInput struct {
+	ID        *string                               `json:"id,omitempty"`
+	Key       string                                `json:"key"`
+	User      string                                `json:"user"`
+	Open      *bool                                 `json:"open,omitempty"`
+	StartedAt string                                `json:"startedAt"`
+	EndedAt   *string                               `json:"endedAt,omitempty"`
+	Duration  *int32                                `json:"duration,omitempty"`
+	Pages     *int32                                `json:"pages,omitempty"`
+	Chapter   *ChapterCreateOneWithoutSessionsInput `json:"chapter,omitempty"`
+}
+
+type ReadingSessionCreateManyWithoutBookInput struct {
+	Create  []ReadingSessionCreateWithoutBookInput `json:"create,omitempty"`
+	Connect []ReadingSessionWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type ReadingSessionUpdateWithoutBookDataInput struct {
+	Key       *string                               `json:"key,omitempty"`
+	User      *string                               `json:"user,omitempty"`
+	Open      *bool                                 `json:"open,omitempty"`
+	StartedAt *string                               `json:"startedAt,omitempty"`
+	EndedAt   *string                               `json:"endedAt,omitempty"`
+	Duration  *int32                                `json:"duration,omitempty"`
+	Pages     *int32                                `json:"pages,omitempty"`
+	Chapter   *ChapterUpdateOneWithoutSessionsInput `json:"chapter,omitempty"`
+}
+
+type ReadingSessionUpdateManyWithoutBookInput struct {
+	Create     []ReadingSessionCreateWithoutBookInput                `json:"create,omitempty"`
+	Delete     []ReadingSessionWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []ReadingSessionWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []ReadingSessionWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []ReadingSessionWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []ReadingSessionUpdateWithWhereUniqueWithoutBookInput `json:"update,omitempty"`
+	Upsert     []ReadingSessionUpsertWithWhereUniqueWithoutBookInput `json:"upsert,omitempty"`
+	DeleteMany []ReadingSessionScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []ReadingSessionUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type ReadingSessionUpdateWithWhereUniqueWithoutBookInput struct {
+	Where ReadingSessionWhereUniqueInput           `json:"where"`
+	Data  ReadingSessionUpdateWithoutBookDataInput `json:"data"`
+}
+
+type ReadingSessionUpsertWithWhereUniqueWithoutBookInput struct {
+	Where  ReadingSessionWhereUniqueInput           `json:"where"`
+	Update ReadingSessionUpdateWithoutBookDataInput `json:"update"`
+	Create ReadingSessionCreateWithoutBookInput     `json:"create"`
+}
+
+type ReadingSessionScalarWhereInput struct {
+	ID                *string                          `json:"id,omitempty"`
+	IDNot             *string                          `json:"id_not,omitempty"`
+	IDIn              []string                         `json:"id_in,omitempty"`
+	IDNotIn           []string                         `json:"id_not_in,omitempty"`
+	IDLt              *string                          `json:"id_lt,omitempty"`
+	IDLte             *string                          `json:"id_lte,omitempty"`
+	IDGt              *string                          `json:"id_gt,omitempty"`
+	IDGte             *string                          `json:"id_gte,omitempty"`
+	IDContains        *string                          `json:"id_contains,omitempty"`
+	IDNotContains     *string                          `json:"id_not_contains,omitempty"`
+	IDStartsWith      *string                          `json:"id_starts_with,omitempty"`
+	IDNotStartsWith   *string                          `json:"id_not_starts_with,omitempty"`
+	IDEndsWith        *string                          `json:"id_ends_with,omitempty"`
+	IDNotEndsWith     *string                          `json:"id_not_ends_with,omitempty"`
+	CreatedAt         *string                          `json:"createdAt,omitempty"`
+	CreatedAtNot      *string                          `json:"createdAt_not,omitempty"`
+	CreatedAtIn       []string                         `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn    []string                         `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt       *string                          `json:"createdAt_lt,omitempty"`
+	CreatedAtLte      *string                          `json:"createdAt_lte,omitempty"`
+	CreatedAtGt       *string                          `json:"createdAt_gt,omitempty"`
+	CreatedAtGte      *string                          `json:"createdAt_gte,omitempty"`
+	UpdatedAt         *string                          `json:"updatedAt,omitempty"`
+	UpdatedAtNot      *string                          `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn       []string                         `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn    []string                         `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt       *string                          `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte      *string                          `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt       *string                          `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte      *string                          `json:"updatedAt_gte,omitempty"`
+	Key               *string                          `json:"key,omitempty"`
+	KeyNot            *string                          `json:"key_not,omitempty"`
+	KeyIn             []string                         `json:"key_in,omitempty"`
+	KeyNotIn          []string                         `json:"key_not_in,omitempty"`
+	KeyLt             *string                          `json:"key_lt,omitempty"`
+	KeyLte            *string                          `json:"key_lte,omitempty"`
+	KeyGt             *string                          `json:"key_gt,omitempty"`
+	KeyGte            *string                          `json:"key_gte,omitempty"`
+	KeyContains       *string                          `json:"key_contains,omitempty"`
+	KeyNotContains    *string                          `json:"key_not_contains,omitempty"`
+	KeyStartsWith     *string                          `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith  *string                          `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith       *string                          `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith    *string                          `json:"key_not_ends_with,omitempty"`
+	User              *string                          `json:"user,omitempty"`
+	UserNot           *string                          `json:"user_not,omitempty"`
+	UserIn            []string                         `json:"user_in,omitempty"`
+	UserNotIn         []string                         `json:"user_not_in,omitempty"`
+	UserLt            *string                          `json:"user_lt,omitempty"`
+	UserLte           *string                          `json:"user_lte,omitempty"`
+	UserGt            *string                          `json:"user_gt,omitempty"`
+	UserGte           *string                          `json:"user_gte,omitempty"`
+	UserContains      *string                          `json:"user_contains,omitempty"`
+	UserNotContains   *string                          `json:"user_not_contains,omitempty"`
+	UserStartsWith    *string                          `json:"user_starts_with,omitempty"`
+	UserNotStartsWith *string                          `json:"user_not_starts_with,omitempty"`
+	UserEndsWith      *string                          `json:"user_ends_with,omitempty"`
+	UserNotEndsWith   *string                          `json:"user_not_ends_with,omitempty"`
+	Open              *bool                            `json:"open,omitempty"`
+	OpenNot           *bool                            `json:"open_not,omitempty"`
+	StartedAt         *string                          `json:"startedAt,omitempty"`
+	StartedAtNot      *string                          `json:"startedAt_not,omitempty"`
+	StartedAtIn       []string                         `json:"startedAt_in,omitempty"`
+	StartedAtNotIn    []string                         `json:"startedAt_not_in,omitempty"`
+	StartedAtLt       *string                          `json:"startedAt_lt,omitempty"`
+	StartedAtLte      *string                          `json:"startedAt_lte,omitempty"`
+	StartedAtGt       *string                          `json:"startedAt_gt,omitempty"`
+	StartedAtGte      *string                          `json:"startedAt_gte,omitempty"`
+	EndedAt           *string                          `json:"endedAt,omitempty"`
+	EndedAtNot        *string                          `json:"endedAt_not,omitempty"`
+	EndedAtIn         []string                         `json:"endedAt_in,omitempty"`
+	EndedAtNotIn      []string                         `json:"endedAt_not_in,omitempty"`
+	EndedAtLt         *string                          `json:"endedAt_lt,omitempty"`
+	EndedAtLte        *string                          `json:"endedAt_lte,omitempty"`
+	EndedAtGt         *string                          `json:"endedAt_gt,omitempty"`
+	EndedAtGte        *string                          `json:"endedAt_gte,omitempty"`
+	Duration          *int32                           `json:"duration,omitempty"`
+	DurationNot       *int32                           `json:"duration_not,omitempty"`
+	DurationIn        []int32                          `json:"duration_in,omitempty"`
+	DurationNotIn     []int32                          `json:"duration_not_in,omitempty"`
+	DurationLt        *int32                           `json:"duration_lt,omitempty"`
+	DurationLte       *int32                           `json:"duration_lte,omitempty"`
+	DurationGt        *int32                           `json:"duration_gt,omitempty"`
+	DurationGte       *int32                           `json:"duration_gte,omitempty"`
+	Pages             *int32                           `json:"pages,omitempty"`
+	PagesNot          *int32                           `json:"pages_not,omitempty"`
+	PagesIn           []int32                          `json:"pages_in,omitempty"`
+	PagesNotIn        []int32                          `json:"pages_not_in,omitempty"`
+	PagesLt           *int32                           `json:"pages_lt,omitempty"`
+	PagesLte          *int32                           `json:"pages_lte,omitempty"`
+	PagesGt           *int32                           `json:"pages_gt,omitempty"`
+	PagesGte          *int32                           `json:"pages_gte,omitempty"`
+	And               []ReadingSessionScalarWhereInput `json:"AND,omitempty"`
+	Or                []ReadingSessionScalarWhereInput `json:"OR,omitempty"`
+	Not               []ReadingSessionScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type ReadingSessionUpdateManyWithWhereNestedInput struct {
+	Where ReadingSessionScalarWhereInput    `json:"where"`
+	Data  ReadingSessionUpdateManyDataInput `json:"data"`
+}
+
+type ReadingSessionUpdateManyDataInput struct {
+	Key       *string `json:"key,omitempty"`
+	User      *string `json:"user,omitempty"`
+	Open      *bool   `json:"open,omitempty"`
+	StartedAt *string `json:"startedAt,omitempty"`
+	EndedAt   *string `json:"endedAt,omitempty"`
+	Duration  *int32  `json:"duration,omitempty"`
+	Pages     *int32  `json:"pages,omitempty"`
+}
+
+type ReadingSessionCreateWithoutChapterInput struct {
+	ID        *string                           `json:"id,omitempty"`
+	Key       string                            `json:"key"`
+	User      string                            `json:"user"`
+	Open      *bool                             `json:"open,omitempty"`
+	StartedAt string                            `json:"startedAt"`
+	EndedAt   *string                           `json:"endedAt,omitempty"`
+	Duration  *int32                            `json:"duration,omitempty"`
+	Pages     *int32                            `json:"pages,omitempty"`
+	Book      BookCreateOneWithoutSessionsInput `json:"book"`
+}
+
+type ReadingSessionCreateManyWithoutChapterInput struct {
+	Create  []ReadingSessionCreateWithoutChapterInput `json:"create,omitempty"`
+	Connect []ReadingSessionWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type ReadingSessionUpdateWithoutChapterDataInput struct {
+	Key       *string                                    `json:"key,omitempty"`
+	User      *string                                    `json:"user,omitempty"`
+	Open      *bool                                      `json:"open,omitempty"`
+	StartedAt *string                                    `json:"startedAt,omitempty"`
+	EndedAt   *string                                    `json:"endedAt,omitempty"`
+	Duration  *int32                                     `json:"duration,omitempty"`
+	Pages     *int32                                     `json:"pages,omitempty"`
+	Book      *BookUpdateOneRequiredWithoutSessionsInput `json:"book,omitempty"`
+}
+
+type ReadingSessionUpdateManyWithoutChapterInput struct {
+	Create     []ReadingSessionCreateWithoutChapterInput                `json:"create,omitempty"`
+	Delete     []ReadingSessionWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []ReadingSessionWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []ReadingSessionWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []ReadingSessionWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []ReadingSessionUpdateWithWhereUniqueWithoutChapterInput `json:"update,omitempty"`
+	Upsert     []ReadingSessionUpsertWithWhereUniqueWithoutChapterInput `json:"upsert,omitempty"`
+	DeleteMany []ReadingSessionScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []ReadingSessionUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type ReadingSessionUpdateWithWhereUniqueWithoutChapterInput struct {
+	Where ReadingSessionWhereUniqueInput              `json:"where"`
+	Data  ReadingSessionUpdateWithoutChapterDataInput `json:"data"`
+}
+
+type ReadingSessionUpsertWithWhereUniqueWithoutChapterInput struct {
+	Where  ReadingSessionWhereUniqueInput              `json:"where"`
+	Update ReadingSessionUpdateWithoutChapterDataInput `json:"update"`
+	Create ReadingSessionCreateWithoutChapterInput     `json:"create"`
+}
+
+type BookCreateWithoutSessionsInput struct {
+	ID          *string                                `json:"id,omitempty"`
+	Name        string                                 `json:"name"`
+	Description string                                 `json:"description"`
+	Isbn        *string                                `json:"isbn,omitempty"`
+	Publisher   *string                                `json:"publisher,omitempty"`
+	Year        *int32                                 `json:"year,omitempty"`
+	Language    *string                                `json:"language,omitempty"`
+	Deleted     *bool                                  `json:"deleted,omitempty"`
+	DeletedAt   *string                                `json:"deletedAt,omitempty"`
+	Revision    *int32                                 `json:"revision,omitempty"`
+	Authors     *AuthorCreateManyWithoutBooksInput     `json:"authors,omitempty"`
+	Tags        *TagCreateManyWithoutBooksInput        `json:"tags,omitempty"`
+	Collections *CollectionCreateManyWithoutBooksInput `json:"collections,omitempty"`
+	Revisions   *RevisionCreateManyWithoutBookInput    `json:"revisions,omitempty"`
+	Chapters    *ChapterCreateManyWithoutBookInput     `json:"chapters,omitempty"`
+	Reminders   *ReminderCreateManyWithoutBookInput    `json:"reminders,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutBookInput `json:"outbox,omitempty"`
+}
+
+type BookCreateOneWithoutSessionsInput struct {
+	Create  *BookCreateWithoutSessionsInput `json:"create,omitempty"`
+	Connect *BookWhereUniqueInput           `json:"connect,omitempty"`
+}
+
+type BookUpdateWithoutSessionsDataInput struct {
+	Name        *string                                `json:"name,omitempty"`
+	Description *string                                `json:"description,omitempty"`
+	Isbn        *string                                `json:"isbn,omitempty"`
+	Publisher   *string                                `json:"publisher,omitempty"`
+	Year        *int32                                 `json:"year,omitempty"`
+	Language    *string                                `json:"language,omitempty"`
+	Deleted     *bool                                  `json:"deleted,omitempty"`
+	DeletedAt   *string                                `json:"deletedAt,omitempty"`
+	Revision    *int32                                 `json:"revision,omitempty"`
+	Authors     *AuthorUpdateManyWithoutBooksInput     `json:"authors,omitempty"`
+	Tags        *TagUpdateManyWithoutBooksInput        `json:"tags,omitempty"`
+	Collections *CollectionUpdateManyWithoutBooksInput `json:"collections,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutBookInput    `json:"revisions,omitempty"`
+	Chapters    *ChapterUpdateManyWithoutBookInput     `json:"chapters,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutBookInput    `json:"reminders,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutBookInput `json:"outbox,omitempty"`
+}
+
+type BookUpdateOneRequiredWithoutSessionsInput struct {
+	Create  *BookCreateWithoutSessionsInput     `json:"create,omitempty"`
+	Update  *BookUpdateWithoutSessionsDataInput `json:"update,omitempty"`
+	Upsert  *BookUpsertWithoutSessionsInput     `json:"upsert,omitempty"`
+	Connect *BookWhereUniqueInput               `json:"connect,omitempty"`
+}
+
+type BookUpsertWithoutSessionsInput struct {
+	Update BookUpdateWithoutSessionsDataInput `json:"update"`
+	Create BookCreateWithoutSessionsInput     `json:"create"`
+}
+
+type ChapterCreateWithoutSessionsInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Reminders   *ReminderCreateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput             `json:"book"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterCreateOneWithoutSessionsInput struct {
+	Create  *ChapterCreateWithoutSessionsInput `json:"create,omitempty"`
+	Connect *ChapterWhereUniqueInput           `json:"connect,omitempty"`
+}
+
+type ChapterUpdateWithoutSessionsDataInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Reminders   *ReminderUpdateManyWithoutChapterInput        `json:"reminders,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput    `json:"book,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateOneWithoutSessionsInput struct {
+	Create     *ChapterCreateWithoutSessionsInput     `json:"create,omitempty"`
+	Update     *ChapterUpdateWithoutSessionsDataInput `json:"update,omitempty"`
+	Upsert     *ChapterUpsertWithoutSessionsInput     `json:"upsert,omitempty"`
+	Delete     *bool                                  `json:"delete,omitempty"`
+	Disconnect *bool                                  `json:"disconnect,omitempty"`
+	Connect    *ChapterWhereUniqueInput               `json:"connect,omitempty"`
+}
+
+type ChapterUpsertWithoutSessionsInput struct {
+	Update ChapterUpdateWithoutSessionsDataInput `json:"update"`
+	Create ChapterCreateWithoutSessionsInput     `json:"create"`
+}
+
+type ReminderWhereUniqueInput struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type ReminderWhereInput struct {
+	ID                    *string                   `json:"id,omitempty"`
+	IDNot                 *string                   `json:"id_not,omitempty"`
+	IDIn                  []string                  `json:"id_in,omitempty"`
+	IDNotIn               []string                  `json:"id_not_in,omitempty"`
+	IDLt                  *string                   `json:"id_lt,omitempty"`
+	IDLte                 *string                   `json:"id_lte,omitempty"`
+	IDGt                  *string                   `json:"id_gt,omitempty"`
+	IDGte                 *string                   `json:"id_gte,omitempty"`
+	IDContains            *string                   `json:"id_contains,omitempty"`
+	IDNotContains         *string                   `json:"id_not_contains,omitempty"`
+	IDStartsWith          *string                   `json:"id_starts_with,omitempty"`
+	IDNotStartsWith       *string                   `json:"id_not_starts_with,omitempty"`
+	IDEndsWith            *string                   `json:"id_ends_with,omitempty"`
+	IDNotEndsWith         *string                   `json:"id_not_ends_with,omitempty"`
+	CreatedAt             *string                   `json:"createdAt,omitempty"`
+	CreatedAtNot          *string                   `json:"createdAt_not,omitempty"`
+	CreatedAtIn           []string                  `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn        []string                  `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt           *string                   `json:"createdAt_lt,omitempty"`
+	CreatedAtLte          *string                   `json:"createdAt_lte,omitempty"`
+	CreatedAtGt           *string                   `json:"createdAt_gt,omitempty"`
+	CreatedAtGte          *string                   `json:"createdAt_gte,omitempty"`
+	UpdatedAt             *string                   `json:"updatedAt,omitempty"`
+	UpdatedAtNot          *string                   `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn           []string                  `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn        []string                  `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt           *string                   `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte          *string                   `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt           *string                   `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte          *string                   `json:"updatedAt_gte,omitempty"`
+	User                  *string                   `json:"user,omitempty"`
+	UserNot               *string                   `json:"user_not,omitempty"`
+	UserIn                []string                  `json:"user_in,omitempty"`
+	UserNotIn             []string                  `json:"user_not_in,omitempty"`
+	UserLt                *string                   `json:"user_lt,omitempty"`
+	UserLte               *string                   `json:"user_lte,omitempty"`
+	UserGt                *string                   `json:"user_gt,omitempty"`
+	UserGte               *string                   `json:"user_gte,omitempty"`
+	UserContains          *string                   `json:"user_contains,omitempty"`
+	UserNotContains       *string                   `json:"user_not_contains,omitempty"`
+	UserStartsWith        *string                   `json:"user_starts_with,omitempty"`
+	UserNotStartsWith     *string                   `json:"user_not_starts_with,omitempty"`
+	UserEndsWith          *string                   `json:"user_ends_with,omitempty"`
+	UserNotEndsWith       *string                   `json:"user_not_ends_with,omitempty"`
+	Message               *string                   `json:"message,omitempty"`
+	MessageNot            *string                   `json:"message_not,omitempty"`
+	MessageIn             []string                  `json:"message_in,omitempty"`
+	MessageNotIn          []string                  `json:"message_not_in,omitempty"`
+	MessageLt             *string                   `json:"message_lt,omitempty"`
+	MessageLte            *string                   `json:"message_lte,omitempty"`
+	MessageGt             *string                   `json:"message_gt,omitempty"`
+	MessageGte            *string                   `json:"message_gte,omitempty"`
+	MessageContains       *string                   `json:"message_contains,omitempty"`
+	MessageNotContains    *string                   `json:"message_not_contains,omitempty"`
+	MessageStartsWith     *string                   `json:"message_starts_with,omitempty"`
+	MessageNotStartsWith  *string                   `json:"message_not_starts_with,omitempty"`
+	MessageEndsWith       *string                   `json:"message_ends_with,omitempty"`
+	MessageNotEndsWith    *string                   `json:"message_not_ends_with,omitempty"`
+	Rule                  *string                   `json:"rule,omitempty"`
+	RuleNot               *string                   `json:"rule_not,omitempty"`
+	RuleIn                []string                  `json:"rule_in,omitempty"`
+	RuleNotIn             []string                  `json:"rule_not_in,omitempty"`
+	RuleLt                *string                   `json:"rule_lt,omitempty"`
+	RuleLte               *string                   `json:"rule_lte,omitempty"`
+	RuleGt                *string                   `json:"rule_gt,omitempty"`
+	RuleGte               *string                   `json:"rule_gte,omitempty"`
+	RuleContains          *string                   `json:"rule_contains,omitempty"`
+	RuleNotContains       *string                   `json:"rule_not_contains,omitempty"`
+	RuleStartsWith        *string                   `json:"rule_starts_with,omitempty"`
+	RuleNotStartsWith     *string                   `json:"rule_not_starts_with,omitempty"`
+	RuleEndsWith          *string                   `json:"rule_ends_with,omitempty"`
+	RuleNotEndsWith       *string                   `json:"rule_not_ends_with,omitempty"`
+	Timezone              *string                   `json:"timezone,omitempty"`
+	TimezoneNot           *string                   `json:"timezone_not,omitempty"`
+	TimezoneIn            []string                  `json:"timezone_in,omitempty"`
+	TimezoneNotIn         []string                  `json:"timezone_not_in,omitempty"`
+	TimezoneLt            *string                   `json:"timezone_lt,omitempty"`
+	TimezoneLte           *string                   `json:"timezone_lte,omitempty"`
+	TimezoneGt            *string                   `json:"timezone_gt,omitempty"`
+	TimezoneGte           *string                   `json:"timezone_gte,omitempty"`
+	TimezoneContains      *string                   `json:"timezone_contains,omitempty"`
+	TimezoneNotContains   *string                   `json:"timezone_not_contains,omitempty"`
+	TimezoneStartsWith    *string                   `json:"timezone_starts_with,omitempty"`
+	TimezoneNotStartsWith *string                   `json:"timezone_not_starts_with,omitempty"`
+	TimezoneEndsWith      *string                   `json:"timezone_ends_with,omitempty"`
+	TimezoneNotEndsWith   *string                   `json:"timezone_not_ends_with,omitempty"`
+	Notifier              *string                   `json:"notifier,omitempty"`
+	NotifierNot           *string                   `json:"notifier_not,omitempty"`
+	NotifierIn            []string                  `json:"notifier_in,omitempty"`
+	NotifierNotIn         []string                  `json:"notifier_not_in,omitempty"`
+	NotifierLt            *string                   `json:"notifier_lt,omitempty"`
+	NotifierLte           *string                   `json:"notifier_lte,omitempty"`
+	NotifierGt            *string                   `json:"notifier_gt,omitempty"`
+	NotifierGte           *string                   `json:"notifier_gte,omitempty"`
+	NotifierContains      *string                   `json:"notifier_contains,omitempty"`
+	NotifierNotContains   *string                   `json:"notifier_not_contains,omitempty"`
+	NotifierStartsWith    *string                   `json:"notifier_starts_with,omitempty"`
+	NotifierNotStartsWith *string                   `json:"notifier_not_starts_with,omitempty"`
+	NotifierEndsWith      *string                   `json:"notifier_ends_with,omitempty"`
+	NotifierNotEndsWith   *string                   `json:"notifier_not_ends_with,omitempty"`
+	Target                *string                   `json:"target,omitempty"`
+	TargetNot             *string                   `json:"target_not,omitempty"`
+	TargetIn              []string                  `json:"target_in,omitempty"`
+	TargetNotIn           []string                  `json:"target_not_in,omitempty"`
+	TargetLt              *string                   `json:"target_lt,omitempty"`
+	TargetLte             *string                   `json:"target_lte,omitempty"`
+	TargetGt              *string                   `json:"target_gt,omitempty"`
+	TargetGte             *string                   `json:"target_gte,omitempty"`
+	TargetContains        *string                   `json:"target_contains,omitempty"`
+	TargetNotContains     *string                   `json:"target_not_contains,omitempty"`
+	TargetStartsWith      *string                   `json:"target_starts_with,omitempty"`
+	TargetNotStartsWith   *string                   `json:"target_not_starts_with,omitempty"`
+	TargetEndsWith        *string                   `json:"target_ends_with,omitempty"`
+	TargetNotEndsWith     *string                   `json:"target_not_ends_with,omitempty"`
+	Active                *bool                     `json:"active,omitempty"`
+	ActiveNot             *bool                     `json:"active_not,omitempty"`
+	NextFireAt            *string                   `json:"nextFireAt,omitempty"`
+	NextFireAtNot         *string                   `json:"nextFireAt_not,omitempty"`
+	NextFireAtIn          []string                  `json:"nextFireAt_in,omitempty"`
+	NextFireAtNotIn       []string                  `json:"nextFireAt_not_in,omitempty"`
+	NextFireAtLt          *string                   `json:"nextFireAt_lt,omitempty"`
+	NextFireAtLte         *string                   `json:"nextFireAt_lte,omitempty"`
+	NextFireAtGt          *string                   `json:"nextFireAt_gt,omitempty"`
+	NextFireAtGte         *string                   `json:"nextFireAt_gte,omitempty"`
+	LastFiredAt           *string                   `json:"lastFiredAt,omitempty"`
+	LastFiredAtNot        *string                   `json:"lastFiredAt_not,omitempty"`
+	LastFiredAtIn         []string                  `json:"lastFiredAt_in,omitempty"`
+	LastFiredAtNotIn      []string                  `json:"lastFiredAt_not_in,omitempty"`
+	LastFiredAtLt         *string                   `json:"lastFiredAt_lt,omitempty"`
+	LastFiredAtLte        *string                   `json:"lastFiredAt_lte,omitempty"`
+	LastFiredAtGt         *string                   `json:"lastFiredAt_gt,omitempty"`
+	LastFiredAtGte        *string                   `json:"lastFiredAt_gte,omitempty"`
+	Book                  *BookWhereInput           `json:"book,omitempty"`
+	Chapter               *ChapterWhereInput        `json:"chapter,omitempty"`
+	FiringsEvery          *ReminderFiringWhereInput `json:"firings_every,omitempty"`
+	FiringsSome           *ReminderFiringWhereInput `json:"firings_some,omitempty"`
+	FiringsNone           *ReminderFiringWhereInput `json:"firings_none,omitempty"`
+	And                   []ReminderWhereInput      `json:"AND,omitempty"`
+	Or                    []ReminderWhereInput      `json:"OR,omitempty"`
+	Not                   []ReminderWhereInput      `json:"NOT,omitempty"`
+}
+
+type ReminderCreateInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	User        string                                        `json:"user"`
+	Message     string                                        `json:"message"`
+	Rule        string                                        `json:"rule"`
+	Timezone    string                                        `json:"timezone"`
+	Notifier    string                                        `json:"notifier"`
+	Target      string                                        `json:"target"`
+	Active      *bool                                         `json:"active,omitempty"`
+	NextFireAt  string                                        `json:"nextFireAt"`
+	LastFiredAt *string                                       `json:"lastFiredAt,omitempty"`
+	Book        BookCreateOneWithoutRemindersInput            `json:"book"`
+	Chapter     *ChapterCreateOneWithoutRemindersInput        `json:"chapter,omitempty"`
+	Firings     *ReminderFiringCreateManyWithoutReminderInput `json:"firings,omitempty"`
+}
+
+type ReminderUpdateInput struct {
+	User        *string                                       `json:"user,omitempty"`
+	Message     *string                                       `json:"message,omitempty"`
+	Rule        *string                                       `json:"rule,omitempty"`
+	Timezone    *string                                       `json:"timezone,omitempty"`
+	Notifier    *string                                       `json:"notifier,omitempty"`
+	Target      *string                                       `json:"target,omitempty"`
+	Active      *bool                                         `json:"active,omitempty"`
+	NextFireAt  *string                                       `json:"nextFireAt,omitempty"`
+	LastFiredAt *string                                       `json:"lastFiredAt,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutRemindersInput   `json:"book,omitempty"`
+	Chapter     *ChapterUpdateOneWithoutRemindersInput        `json:"chapter,omitempty"`
+	Firings     *ReminderFiringUpdateManyWithoutReminderInput `json:"firings,omitempty"`
+}
+
+type ReminderUpdateManyMutationInput struct {
+	User        *string `json:"user,omitempty"`
+	Message     *string `json:"message,omitempty"`
+	Rule        *string `json:"rule,omitempty"`
+	Timezone    *string `json:"timezone,omitempty"`
+	Notifier    *string `json:"notifier,omitempty"`
+	Target      *string `json:"target,omitempty"`
+	Active      *bool   `json:"active,omitempty"`
+	NextFireAt  *string `json:"nextFireAt,omitempty"`
+	LastFiredAt *string `json:"lastFiredAt,omitempty"`
+}
+
+type ReminderSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                   `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                          `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                         `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                         `json:"updatedFields_contains_some,omitempty"`
+	Node                       *ReminderWhereInput              `json:"node,omitempty"`
+	And                        []ReminderSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []ReminderSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []ReminderSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type ReminderFiringWhereUniqueInput struct {
+	ID  *string `json:"id,omitempty"`
+	Key *string `json:"key,omitempty"`
+}
+
+type ReminderFiringWhereInput struct {
+	ID                     *string                    `json:"id,omitempty"`
+	IDNot                  *string                    `json:"id_not,omitempty"`
+	IDIn                   []string                   `json:"id_in,omitempty"`
+	IDNotIn                []string                   `json:"id_not_in,omitempty"`
+	IDLt                   *string                    `json:"id_lt,omitempty"`
+	IDLte                  *string                    `json:"id_lte,omitempty"`
+	IDGt                   *string                    `json:"id_gt,omitempty"`
+	IDGte                  *string                    `json:"id_gte,omitempty"`
+	IDContains             *string                    `json:"id_contains,omitempty"`
+	IDNotContains          *string                    `json:"id_not_contains,omitempty"`
+	IDStartsWith           *string                    `json:"id_starts_with,omitempty"`
+	IDNotStartsWith        *string                    `json:"id_not_starts_with,omitempty"`
+	IDEndsWith             *string                    `json:"id_ends_with,omitempty"`
+	IDNotEndsWith          *string                    `json:"id_not_ends_with,omitempty"`
+	CreatedAt              *string                    `json:"createdAt,omitempty"`
+	CreatedAtNot           *string                    `json:"createdAt_not,omitempty"`
+	CreatedAtIn            []string                   `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn         []string                   `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt            *string                    `json:"createdAt_lt,omitempty"`
+	CreatedAtLte           *string                    `json:"createdAt_lte,omitempty"`
+	CreatedAtGt            *string                    `json:"createdAt_gt,omitempty"`
+	CreatedAtGte           *string                    `json:"createdAt_gte,omitempty"`
+	UpdatedAt              *string                    `json:"updatedAt,omitempty"`
+	UpdatedAtNot           *string                    `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn            []string                   `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn         []string                   `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt            *string                    `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte           *string                    `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt            *string                    `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte           *string                    `json:"updatedAt_gte,omitempty"`
+	Key                    *string                    `json:"key,omitempty"`
+	KeyNot                 *string                    `json:"key_not,omitempty"`
+	KeyIn                  []string                   `json:"key_in,omitempty"`
+	KeyNotIn               []string                   `json:"key_not_in,omitempty"`
+	KeyLt                  *string                    `json:"key_lt,omitempty"`
+	KeyLte                 *string                    `json:"key_lte,omitempty"`
+	KeyGt                  *string                    `json:"key_gt,omitempty"`
+	KeyGte                 *string                    `json:"key_gte,omitempty"`
+	KeyContains            *string                    `json:"key_contains,omitempty"`
+	KeyNotContains         *string                    `json:"key_not_contains,omitempty"`
+	KeyStartsWith          *string                    `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith       *string                    `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith            *string                    `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith         *string                    `json:"key_not_ends_with,omitempty"`
+	ScheduledAt            *string                    `json:"scheduledAt,omitempty"`
+	ScheduledAtNot         *string                    `json:"scheduledAt_not,omitempty"`
+	ScheduledAtIn          []string                   `json:"scheduledAt_in,omitempty"`
+	ScheduledAtNotIn       []string                   `json:"scheduledAt_not_in,omitempty"`
+	ScheduledAtLt          *string                    `json:"scheduledAt_lt,omitempty"`
+	ScheduledAtLte         *string                    `json:"scheduledAt_lte,omitempty"`
+	ScheduledAtGt          *string                    `json:"scheduledAt_gt,omitempty"`
+	ScheduledAtGte         *string                    `json:"scheduledAt_gte,omitempty"`
+	Status                 *FiringStatus              `json:"status,omitempty"`
+	StatusNot              *FiringStatus              `json:"status_not,omitempty"`
+	StatusIn               []FiringStatus             `json:"status_in,omitempty"`
+	StatusNotIn            []FiringStatus             `json:"status_not_in,omitempty"`
+	Attempts               *int32                     `json:"attempts,omitempty"`
+	AttemptsNot            *int32                     `json:"attempts_not,omitempty"`
+	AttemptsIn             []int32                    `json:"attempts_in,omitempty"`
+	AttemptsNotIn          []int32                    `json:"attempts_not_in,omitempty"`
+	AttemptsLt             *int32                     `json:"attempts_lt,omitempty"`
+	AttemptsLte            *int32                     `json:"attempts_lte,omitempty"`
+	AttemptsGt             *int32                     `json:"attempts_gt,omitempty"`
+	AttemptsGte            *int32                     `json:"attempts_gte,omitempty"`
+	LastError              *string                    `json:"lastError,omitempty"`
+	LastErrorNot           *string                    `json:"lastError_not,omitempty"`
+	LastErrorIn            []string                   `json:"lastError_in,omitempty"`
+	LastErrorNotIn         []string                   `json:"lastError_not_in,omitempty"`
+	LastErrorLt            *string                    `json:"lastError_lt,omitempty"`
+	LastErrorLte           *string                    `json:"lastError_lte,omitempty"`
+	LastErrorGt            *string                    `json:"lastError_gt,omitempty"`
+	LastErrorGte           *string                    `json:"lastError_gte,omitempty"`
+	LastErrorContains      *string                    `json:"lastError_contains,omitempty"`
+	LastErrorNotContains   *string                    `json:"lastError_not_contains,omitempty"`
+	LastErrorStartsWith    *string                    `json:"lastError_starts_with,omitempty"`
+	LastErrorNotStartsWith *string                    `json:"lastError_not_starts_with,omitempty"`
+	LastErrorEndsWith      *string                    `json:"lastError_ends_with,omitempty"`
+	LastErrorNotEndsWith   *string                    `json:"lastError_not_ends_with,omitempty"`
+	Reminder               *ReminderWhereInput        `json:"reminder,omitempty"`
+	And                    []ReminderFiringWhereInput `json:"AND,omitempty"`
+	Or                     []ReminderFiringWhereInput `json:"OR,omitempty"`
+	Not                    []ReminderFiringWhereInput `json:"NOT,omitempty"`
+}
+
+type ReminderFiringCreateInput struct {
+	ID          *string                              `json:"id,omitempty"`
+	Key         string                               `json:"key"`
+	ScheduledAt string                               `json:"scheduledAt"`
+	Status      *FiringStatus                        `json:"status,omitempty"`
+	Attempts    *int32                               `json:"attempts,omitempty"`
+	LastError   *string                              `json:"lastError,omitempty"`
+	Reminder    ReminderCreateOneWithoutFiringsInput `json:"reminder"`
+}
+
+type ReminderFiringUpdateInput struct {
+	Key         *string                                       `json:"key,omitempty"`
+	ScheduledAt *string                                       `json:"scheduledAt,omitempty"`
+	Status      *FiringStatus                                 `json:"status,omitempty"`
+	Attempts    *int32                                        `json:"attempts,omitempty"`
+	LastError   *string                                       `json:"lastError,omitempty"`
+	Reminder    *ReminderUpdateOneRequiredWithoutFiringsInput `json:"reminder,omitempty"`
+}
+
+type ReminderFiringUpdateManyMutationInput struct {
+	Key         *string       `json:"key,omitempty"`
+	ScheduledAt *string       `json:"scheduledAt,omitempty"`
+	Status      *FiringStatus `json:"status,omitempty"`
+	Attempts    *int32        `json:"attempts,omitempty"`
+	LastError   *string       `json:"lastError,omitempty"`
+}
+
+type ReminderFiringSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                         `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                                `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                               `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                               `json:"updatedFields_contains_some,omitempty"`
+	Node                       *ReminderFiringWhereInput              `json:"node,omitempty"`
+	And                        []ReminderFiringSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []ReminderFiringSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []ReminderFiringSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type ReminderCreateWithoutBookInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	User        string                                        `json:"user"`
+	Message     string                                        `json:"message"`
+	Rule        string                                        `json:"rule"`
+	Timezone    string                                        `json:"timezone"`
+	Notifier    string                                        `json:"notifier"`
+	Target      string                                        `json:"target"`
+	Active      *bool                                         `json:"active,omitempty"`
+	NextFireAt  string                                        `json:"nextFireAt"`
+	LastFiredAt *string                                       `json:"lastFiredAt,omitempty"`
+	Chapter     *ChapterCreateOneWithoutRemindersInput        `json:"chapter,omitempty"`
+	Firings     *ReminderFiringCreateManyWithoutReminderInput `json:"firings,omitempty"`
+}
+
+type ReminderCreateManyWithoutBookInput struct {
+	Create  []ReminderCreateWithoutBookInput `json:"create,omitempty"`
+	Connect []ReminderWhereUniqueInput       `json:"connect,omitempty"`
+}
+
+type ReminderUpdateWithoutBookDataInput struct {
+	User        *string                                       `json:"user,omitempty"`
+	Message     *string                                       `json:"message,omitempty"`
+	Rule        *string                                       `json:"rule,omitempty"`
+	Timezone    *string                                       `json:"timezone,omitempty"`
+	Notifier    *string                                       `json:"notifier,omitempty"`
+	Target      *string                                       `json:"target,omitempty"`
+	Active      *bool                                         `json:"active,omitempty"`
+	NextFireAt  *string                                       `json:"nextFireAt,omitempty"`
+	LastFiredAt *string                                       `json:"lastFiredAt,omitempty"`
+	Chapter     *ChapterUpdateOneWithoutRemindersInput        `json:"chapter,omitempty"`
+	Firings     *ReminderFiringUpdateManyWithoutReminderInput `json:"firings,omitempty"`
+}
+
+type ReminderUpdateManyWithoutBookInput struct {
+	Create     []ReminderCreateWithoutBookInput                `json:"create,omitempty"`
+	Delete     []ReminderWhereUniqueInput                      `json:"delete,omitempty"`
+	Connect    []ReminderWhereUniqueInput                      `json:"connect,omitempty"`
+	Set        []ReminderWhereUniqueInput                      `json:"set,omitempty"`
+	Disconnect []ReminderWhereUniqueInput                      `json:"disconnect,omitempty"`
+	Update     []ReminderUpdateWithWhereUniqueWithoutBookInput `json:"update,omitempty"`
+	Upsert     []ReminderUpsertWithWhereUniqueWithoutBookInput `json:"upsert,omitempty"`
+	DeleteMany []ReminderScalarWhereInput                      `json:"deleteMany,omitempty"`
+	UpdateMany []ReminderUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+}
+
+type ReminderUpdateWithWhereUniqueWithoutBookInput struct {
+	Where ReminderWhereUniqueInput           `json:"where"`
+	Data  ReminderUpdateWithoutBookDataInput `json:"data"`
+}
+
+type ReminderUpsertWithWhereUniqueWithoutBookInput struct {
+	Where  ReminderWhereUniqueInput           `json:"where"`
+	Update ReminderUpdateWithoutBookDataInput `json:"update"`
+	Create ReminderCreateWithoutBookInput     `json:"create"`
+}
+
+type ReminderScalarWhereInput struct {
+	ID                    *string                    `json:"id,omitempty"`
+	IDNot                 *string                    `json:"id_not,omitempty"`
+	IDIn                  []string                   `json:"id_in,omitempty"`
+	IDNotIn               []string                   `json:"id_not_in,omitempty"`
+	IDLt                  *string                    `json:"id_lt,omitempty"`
+	IDLte                 *string                    `json:"id_lte,omitempty"`
+	IDGt                  *string                    `json:"id_gt,omitempty"`
+	IDGte                 *string                    `json:"id_gte,omitempty"`
+	IDContains            *string                    `json:"id_contains,omitempty"`
+	IDNotContains         *string                    `json:"id_not_contains,omitempty"`
+	IDStartsWith          *string                    `json:"id_starts_with,omitempty"`
+	IDNotStartsWith       *string                    `json:"id_not_starts_with,omitempty"`
+	IDEndsWith            *string                    `json:"id_ends_with,omitempty"`
+	IDNotEndsWith         *string                    `json:"id_not_ends_with,omitempty"`
+	CreatedAt             *string                    `json:"createdAt,omitempty"`
+	CreatedAtNot          *string                    `json:"createdAt_not,omitempty"`
+	CreatedAtIn           []string                   `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn        []string                   `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt           *string                    `json:"createdAt_lt,omitempty"`
+	CreatedAtLte          *string                    `json:"createdAt_lte,omitempty"`
+	CreatedAtGt           *string                    `json:"createdAt_gt,omitempty"`
+	CreatedAtGte          *string                    `json:"createdAt_gte,omitempty"`
+	UpdatedAt             *string                    `json:"updatedAt,omitempty"`
+	UpdatedAtNot          *string                    `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn           []string                   `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn        []string                   `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt           *string                    `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte          *string                    `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt           *string                    `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte          *string                    `json:"updatedAt_gte,omitempty"`
+	User                  *string                    `json:"user,omitempty"`
+	UserNot               *string                    `json:"user_not,omitempty"`
+	UserIn                []string                   `json:"user_in,omitempty"`
+	UserNotIn             []string                   `json:"user_not_in,omitempty"`
+	UserLt                *string                    `json:"user_lt,omitempty"`
+	UserLte               *string                    `json:"user_lte,omitempty"`
+	UserGt                *string                    `json:"user_gt,omitempty"`
+	UserGte               *string                    `json:"user_gte,omitempty"`
+	UserContains          *string                    `json:"user_contains,omitempty"`
+	UserNotContains       *string                    `json:"user_not_contains,omitempty"`
+	UserStartsWith        *string                    `json:"user_starts_with,omitempty"`
+	UserNotStartsWith     *string                    `json:"user_not_starts_with,omitempty"`
+	UserEndsWith          *string                    `json:"user_ends_with,omitempty"`
+	UserNotEndsWith       *string                    `json:"user_not_ends_with,omitempty"`
+	Message               *string                    `json:"message,omitempty"`
+	MessageNot            *string                    `json:"message_not,omitempty"`
+	MessageIn             []string                   `json:"message_in,omitempty"`
+	MessageNotIn          []string                   `json:"message_not_in,omitempty"`
+	MessageLt             *string                    `json:"message_lt,omitempty"`
+	MessageLte            *string                    `json:"message_lte,omitempty"`
+	MessageGt             *string                    `json:"message_gt,omitempty"`
+	MessageGte            *string                    `json:"message_gte,omitempty"`
+	MessageContains       *string                    `json:"message_contains,omitempty"`
+	MessageNotContains    *string                    `json:"message_not_contains,omitempty"`
+	MessageStartsWith     *string                    `json:"message_starts_with,omitempty"`
+	MessageNotStartsWith  *string                    `json:"message_not_starts_with,omitempty"`
+	MessageEndsWith       *string                    `json:"message_ends_with,omitempty"`
+	MessageNotEndsWith    *string                    `json:"message_not_ends_with,omitempty"`
+	Rule                  *string                    `json:"rule,omitempty"`
+	RuleNot               *string                    `json:"rule_not,omitempty"`
+	RuleIn                []string                   `json:"rule_in,omitempty"`
+	RuleNotIn             []string                   `json:"rule_not_in,omitempty"`
+	RuleLt                *string                    `json:"rule_lt,omitempty"`
+	RuleLte               *string                    `json:"rule_lte,omitempty"`
+	RuleGt                *string                    `json:"rule_gt,omitempty"`
+	RuleGte               *string                    `json:"rule_gte,omitempty"`
+	RuleContains          *string                    `json:"rule_contains,omitempty"`
+	RuleNotContains       *string                    `json:"rule_not_contains,omitempty"`
+	RuleStartsWith        *string                    `json:"rule_starts_with,omitempty"`
+	RuleNotStartsWith     *string                    `json:"rule_not_starts_with,omitempty"`
+	RuleEndsWith          *string                    `json:"rule_ends_with,omitempty"`
+	RuleNotEndsWith       *string                    `json:"rule_not_ends_with,omitempty"`
+	Timezone              *string                    `json:"timezone,omitempty"`
+	TimezoneNot           *string                    `json:"timezone_not,omitempty"`
+	TimezoneIn            []string                   `json:"timezone_in,omitempty"`
+	TimezoneNotIn         []string                   `json:"timezone_not_in,omitempty"`
+	TimezoneLt            *string                    `json:"timezone_lt,omitempty"`
+	TimezoneLte           *string                    `json:"timezone_lte,omitempty"`
+	TimezoneGt            *string                    `json:"timezone_gt,omitempty"`
+	TimezoneGte           *string                    `json:"timezone_gte,omitempty"`
+	TimezoneContains      *string                    `json:"timezone_contains,omitempty"`
+	TimezoneNotContains   *string                    `json:"timezone_not_contains,omitempty"`
+	TimezoneStartsWith    *string                    `json:"timezone_starts_with,omitempty"`
+	TimezoneNotStartsWith *string                    `json:"timezone_not_starts_with,omitempty"`
+	TimezoneEndsWith      *string                    `json:"timezone_ends_with,omitempty"`
+	TimezoneNotEndsWith   *string                    `json:"timezone_not_ends_with,omitempty"`
+	Notifier              *string                    `json:"notifier,omitempty"`
+	NotifierNot           *string                    `json:"notifier_not,omitempty"`
+	NotifierIn            []string                   `json:"notifier_in,omitempty"`
+	NotifierNotIn         []string                   `json:"notifier_not_in,omitempty"`
+	NotifierLt            *string                    `json:"notifier_lt,omitempty"`
+	NotifierLte           *string                    `json:"notifier_lte,omitempty"`
+	NotifierGt            *string                    `json:"notifier_gt,omitempty"`
+	NotifierGte           *string                    `json:"notifier_gte,omitempty"`
+	NotifierContains      *string                    `json:"notifier_contains,omitempty"`
+	NotifierNotContains   *string                    `json:"notifier_not_contains,omitempty"`
+	NotifierStartsWith    *string                    `json:"notifier_starts_with,omitempty"`
+	NotifierNotStartsWith *string                    `json:"notifier_not_starts_with,omitempty"`
+	NotifierEndsWith      *string                    `json:"notifier_ends_with,omitempty"`
+	NotifierNotEndsWith   *string                    `json:"notifier_not_ends_with,omitempty"`
+	Target                *string                    `json:"target,omitempty"`
+	TargetNot             *string                    `json:"target_not,omitempty"`
+	TargetIn              []string                   `json:"target_in,omitempty"`
+	TargetNotIn           []string                   `json:"target_not_in,omitempty"`
+	TargetLt              *string                    `json:"target_lt,omitempty"`
+	TargetLte             *string                    `json:"target_lte,omitempty"`
+	TargetGt              *string                    `json:"target_gt,omitempty"`
+	TargetGte             *string                    `json:"target_gte,omitempty"`
+	TargetContains        *string                    `json:"target_contains,omitempty"`
+	TargetNotContains     *string                    `json:"target_not_contains,omitempty"`
+	TargetStartsWith      *string                    `json:"target_starts_with,omitempty"`
+	TargetNotStartsWith   *string                    `json:"target_not_starts_with,omitempty"`
+	TargetEndsWith        *string                    `json:"target_ends_with,omitempty"`
+	TargetNotEndsWith     *string                    `json:"target_not_ends_with,omitempty"`
+	Active                *bool                      `json:"active,omitempty"`
+	ActiveNot             *bool                      `json:"active_not,omitempty"`
+	NextFireAt            *string                    `json:"nextFireAt,omitempty"`
+	NextFireAtNot         *string                    `json:"nextFireAt_not,omitempty"`
+	NextFireAtIn          []string                   `json:"nextFireAt_in,omitempty"`
+	NextFireAtNotIn       []string                   `json:"nextFireAt_not_in,omitempty"`
+	NextFireAtLt          *string                    `json:"nextFireAt_lt,omitempty"`
+	NextFireAtLte         *string                    `json:"nextFireAt_lte,omitempty"`
+	NextFireAtGt          *string                    `json:"nextFireAt_gt,omitempty"`
+	NextFireAtGte         *string                    `json:"nextFireAt_gte,omitempty"`
+	LastFiredAt           *string                    `json:"lastFiredAt,omitempty"`
+	LastFiredAtNot        *string                    `json:"lastFiredAt_not,omitempty"`
+	LastFiredAtIn         []string                   `json:"lastFiredAt_in,omitempty"`
+	LastFiredAtNotIn      []string                   `json:"lastFiredAt_not_in,omitempty"`
+	LastFiredAtLt         *string                    `json:"lastFiredAt_lt,omitempty"`
+	LastFiredAtLte        *string                    `json:"lastFiredAt_lte,omitempty"`
+	LastFiredAtGt         *string                    `json:"lastFiredAt_gt,omitempty"`
+	LastFiredAtGte        *string                    `json:"lastFiredAt_gte,omitempty"`
+	And                   []ReminderScalarWhereInput `json:"AND,omitempty"`
+	Or                    []ReminderScalarWhereInput `json:"OR,omitempty"`
+	Not                   []ReminderScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type ReminderUpdateManyWithWhereNestedInput struct {
+	Where ReminderScalarWhereInput    `json:"where"`
+	Data  ReminderUpdateManyDataInput `json:"data"`
+}
+
+type ReminderUpdateManyDataInput struct {
+	User        *string `json:"user,omitempty"`
+	Message     *string `json:"message,omitempty"`
+	Rule        *string `json:"rule,omitempty"`
+	Timezone    *string `json:"timezone,omitempty"`
+	Notifier    *string `json:"notifier,omitempty"`
+	Target      *string `json:"target,omitempty"`
+	Active      *bool   `json:"active,omitempty"`
+	NextFireAt  *string `json:"nextFireAt,omitempty"`
+	LastFiredAt *string `json:"lastFiredAt,omitempty"`
+}
+
+type ReminderCreateWithoutChapterInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	User        string                                        `json:"user"`
+	Message     string                                        `json:"message"`
+	Rule        string                                        `json:"rule"`
+	Timezone    string                                        `json:"timezone"`
+	Notifier    string                                        `json:"notifier"`
+	Target      string                                        `json:"target"`
+	Active      *bool                                         `json:"active,omitempty"`
+	NextFireAt  string                                        `json:"nextFireAt"`
+	LastFiredAt *string                                       `json:"lastFiredAt,omitempty"`
+	Book        BookCreateOneWithoutRemindersInput            `json:"book"`
+	Firings     *ReminderFiringCreateManyWithoutReminderInput `json:"firings,omitempty"`
+}
+
+type ReminderCreateManyWithoutChapterInput struct {
+	Create  []ReminderCreateWithoutChapterInput `json:"create,omitempty"`
+	Connect []ReminderWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type ReminderUpdateWithoutChapterDataInput struct {
+	User        *string                                       `json:"user,omitempty"`
+	Message     *string                                       `json:"message,omitempty"`
+	Rule        *string                                       `json:"rule,omitempty"`
+	Timezone    *string                                       `json:"timezone,omitempty"`
+	Notifier    *string                                       `json:"notifier,omitempty"`
+	Target      *string                                       `json:"target,omitempty"`
+	Active      *bool                                         `json:"active,omitempty"`
+	NextFireAt  *string                                       `json:"nextFireAt,omitempty"`
+	LastFiredAt *string                                       `json:"lastFiredAt,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutRemindersInput   `json:"book,omitempty"`
+	Firings     *ReminderFiringUpdateManyWithoutReminderInput `json:"firings,omitempty"`
+}
+
+type ReminderUpdateManyWithoutChapterInput struct {
+	Create     []ReminderCreateWithoutChapterInput                `json:"create,omitempty"`
+	Delete     []ReminderWhereUniqueInput                         `json:"delete,omitempty"`
+	Connect    []ReminderWhereUniqueInput                         `json:"connect,omitempty"`
+	Set        []ReminderWhereUniqueInput                         `json:"set,omitempty"`
+	Disconnect []ReminderWhereUniqueInput                         `json:"disconnect,omitempty"`
+	Update     []ReminderUpdateWithWhereUniqueWithoutChapterInput `json:"update,omitempty"`
+	Upsert     []ReminderUpsertWithWhereUniqueWithoutChapterInput `json:"upsert,omitempty"`
+	DeleteMany []ReminderScalarWhereInput                         `json:"deleteMany,omitempty"`
+	UpdateMany []ReminderUpdateManyWithWhereNestedInput           `json:"updateMany,omitempty"`
+}
+
+type ReminderUpdateWithWhereUniqueWithoutChapterInput struct {
+	Where ReminderWhereUniqueInput              `json:"where"`
+	Data  ReminderUpdateWithoutChapterDataInput `json:"data"`
+}
+
+type ReminderUpsertWithWhereUniqueWithoutChapterInput struct {
+	Where  ReminderWhereUniqueInput              `json:"where"`
+	Update ReminderUpdateWithoutChapterDataInput `json:"update"`
+	Create ReminderCreateWithoutChapterInput     `json:"create"`
+}
+
+type BookCreateWithoutRemindersInput struct {
+	ID          *string                                   `json:"id,omitempty"`
+	Name        string                                    `json:"name"`
+	Description string                                    `json:"description"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorCreateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagCreateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionCreateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionCreateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterCreateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutBookInput `json:"sessions,omitempty"`
+	Outbox      *OutboxEventCreateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookCreateOneWithoutRemindersInput struct {
+	Create  *BookCreateWithoutRemindersInput `json:"create,omitempty"`
+	Connect *BookWhereUniqueInput            `json:"connect,omitempty"`
+}
+
+type BookUpdateWithoutRemindersDataInput struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Isbn        *string                                   `json:"isbn,omitempty"`
+	Publisher   *string                                   `json:"publisher,omitempty"`
+	Year        *int32                                    `json:"year,omitempty"`
+	Language    *string                                   `json:"language,omitempty"`
+	Deleted     *bool                                     `json:"deleted,omitempty"`
+	DeletedAt   *string                                   `json:"deletedAt,omitempty"`
+	Revision    *int32                                    `json:"revision,omitempty"`
+	Authors     *AuthorUpdateManyWithoutBooksInput        `json:"authors,omitempty"`
+	Tags        *TagUpdateManyWithoutBooksInput           `json:"tags,omitempty"`
+	Collections *CollectionUpdateManyWithoutBooksInput    `json:"collections,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutBookInput       `json:"revisions,omitempty"`
+	Chapters    *ChapterUpdateManyWithoutBookInput        `json:"chapters,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutBookInput `json:"sessions,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutBookInput    `json:"outbox,omitempty"`
+}
+
+type BookUpdateOneRequiredWithoutRemindersInput struct {
+	Create  *BookCreateWithoutRemindersInput     `json:"create,omitempty"`
+	Update  *BookUpdateWithoutRemindersDataInput `json:"update,omitempty"`
+	Upsert  *BookUpsertWithoutRemindersInput     `json:"upsert,omitempty"`
+	Connect *BookWhereUniqueInput                `json:"connect,omitempty"`
+}
+
+type BookUpsertWithoutRemindersInput struct {
+	Update BookUpdateWithoutRemindersDataInput `json:"update"`
+	Create BookCreateWithoutRemindersInput     `json:"create"`
+}
+
+type ChapterCreateWithoutRemindersInput struct {
+	ID          *string                                       `json:"id,omitempty"`
+	Name        string                                        `json:"name"`
+	Description string                                        `json:"description"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagCreateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteCreateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardCreateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressCreateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionCreateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Revisions   *RevisionCreateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        BookCreateOneWithoutChaptersInput             `json:"book"`
+	Outbox      *OutboxEventCreateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterCreateOneWithoutRemindersInput struct {
+	Create  *ChapterCreateWithoutRemindersInput `json:"create,omitempty"`
+	Connect *ChapterWhereUniqueInput            `json:"connect,omitempty"`
+}
+
+type ChapterUpdateWithoutRemindersDataInput struct {
+	Name        *string                                       `json:"name,omitempty"`
+	Description *string                                       `json:"description,omitempty"`
+	Position    *float64                                      `json:"position,omitempty"`
+	Deleted     *bool                                         `json:"deleted,omitempty"`
+	DeletedAt   *string                                       `json:"deletedAt,omitempty"`
+	Revision    *int32                                        `json:"revision,omitempty"`
+	Tags        *TagUpdateManyWithoutChaptersInput            `json:"tags,omitempty"`
+	Notes       *NoteUpdateManyWithoutChapterInput            `json:"notes,omitempty"`
+	Cards       *CardUpdateManyWithoutChapterInput            `json:"cards,omitempty"`
+	Progress    *ReadingProgressUpdateManyWithoutChapterInput `json:"progress,omitempty"`
+	Sessions    *ReadingSessionUpdateManyWithoutChapterInput  `json:"sessions,omitempty"`
+	Revisions   *RevisionUpdateManyWithoutChapterInput        `json:"revisions,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutChaptersInput    `json:"book,omitempty"`
+	Outbox      *OutboxEventUpdateManyWithoutChapterInput     `json:"outbox,omitempty"`
+}
+
+type ChapterUpdateOneWithoutRemindersInput struct {
+	Create     *ChapterCreateWithoutRemindersInput     `json:"create,omitempty"`
+	Update     *ChapterUpdateWithoutRemindersDataInput `json:"update,omitempty"`
+	Upsert     *ChapterUpsertWithoutRemindersInput     `json:"upsert,omitempty"`
+	Delete     *bool                                   `json:"delete,omitempty"`
+	Disconnect *bool                                   `json:"disconnect,omitempty"`
+	Connect    *ChapterWhereUniqueInput                `json:"connect,omitempty"`
+}
+
+type ChapterUpsertWithoutRemindersInput struct {
+	Update ChapterUpdateWithoutRemindersDataInput `json:"update"`
+	Create ChapterCreateWithoutRemindersInput     `json:"create"`
+}
+
+type ReminderFiringCreateWithoutReminderInput struct {
+	ID          *string       `json:"id,omitempty"`
+	Key         string        `json:"key"`
+	ScheduledAt string        `json:"scheduledAt"`
+	Status      *FiringStatus `json:"status,omitempty"`
+	Attempts    *int32        `json:"attempts,omitempty"`
+	LastError   *string       `json:"lastError,omitempty"`
+}
+
+type ReminderFiringCreateManyWithoutReminderInput struct {
+	Create  []ReminderFiringCreateWithoutReminderInput `json:"create,omitempty"`
+	Connect []ReminderFiringWhereUniqueInput           `json:"connect,omitempty"`
+}
+
+type ReminderFiringUpdateWithoutReminderDataInput struct {
+	Key         *string       `json:"key,omitempty"`
+	ScheduledAt *string       `json:"scheduledAt,omitempty"`
+	Status      *FiringStatus `json:"status,omitempty"`
+	Attempts    *int32        `json:"attempts,omitempty"`
+	LastError   *string       `json:"lastError,omitempty"`
+}
+
+type ReminderFiringUpdateManyWithoutReminderInput struct {
+	Create     []ReminderFiringCreateWithoutReminderInput                `json:"create,omitempty"`
+	Delete     []ReminderFiringWhereUniqueInput                          `json:"delete,omitempty"`
+	Connect    []ReminderFiringWhereUniqueInput                          `json:"connect,omitempty"`
+	Set        []ReminderFiringWhereUniqueInput                          `json:"set,omitempty"`
+	Disconnect []ReminderFiringWhereUniqueInput                          `json:"disconnect,omitempty"`
+	Update     []ReminderFiringUpdateWithWhereUniqueWithoutReminderInput `json:"update,omitempty"`
+	Upsert     []ReminderFiringUpsertWithWhereUniqueWithoutReminderInput `json:"upsert,omitempty"`
+	DeleteMany []ReminderFiringScalarWhereInput                          `json:"deleteMany,omitempty"`
+	UpdateMany []ReminderFiringUpdateManyWithWhereNestedInput            `json:"updateMany,omitempty"`
+}
+
+type ReminderFiringUpdateWithWhereUniqueWithoutReminderInput struct {
+	Where ReminderFiringWhereUniqueInput               `json:"where"`
+	Data  ReminderFiringUpdateWithoutReminderDataInput `json:"data"`
+}
+
+type ReminderFiringUpsertWithWhereUniqueWithoutReminderInput struct {
+	Where  ReminderFiringWhereUniqueInput               `json:"where"`
+	Update ReminderFiringUpdateWithoutReminderDataInput `json:"update"`
+	Create ReminderFiringCreateWithoutReminderInput     `json:"create"`
+}
+
+type ReminderFiringScalarWhereInput struct {
+	ID                     *string                          `json:"id,omitempty"`
+	IDNot                  *string                          `json:"id_not,omitempty"`
+	IDIn                   []string                         `json:"id_in,omitempty"`
+	IDNotIn                []string                         `json:"id_not_in,omitempty"`
+	IDLt                   *string                          `json:"id_lt,omitempty"`
+	IDLte                  *string                          `json:"id_lte,omitempty"`
+	IDGt                   *string                          `json:"id_gt,omitempty"`
+	IDGte                  *string                          `json:"id_gte,omitempty"`
+	IDContains             *string                          `json:"id_contains,omitempty"`
+	IDNotContains          *string                          `json:"id_not_contains,omitempty"`
+	IDStartsWith           *string                          `json:"id_starts_with,omitempty"`
+	IDNotStartsWith        *string                          `json:"id_not_starts_with,omitempty"`
+	IDEndsWith             *string                          `json:"id_ends_with,omitempty"`
+	IDNotEndsWith          *string                          `json:"id_not_ends_with,omitempty"`
+	CreatedAt              *string                          `json:"createdAt,omitempty"`
+	CreatedAtNot           *string                          `json:"createdAt_not,omitempty"`
+	CreatedAtIn            []string                         `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn         []string                         `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt            *string                          `json:"createdAt_lt,omitempty"`
+	CreatedAtLte           *string                          `json:"createdAt_lte,omitempty"`
+	CreatedAtGt            *string                          `json:"createdAt_gt,omitempty"`
+	CreatedAtGte           *string                          `json:"createdAt_gte,omitempty"`
+	UpdatedAt              *string                          `json:"updatedAt,omitempty"`
+	UpdatedAtNot           *string                          `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn            []string                         `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn         []string                         `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt            *string                          `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte           *string                          `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt            *string                          `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte           *string                          `json:"updatedAt_gte,omitempty"`
+	Key                    *string                          `json:"key,omitempty"`
+	KeyNot                 *string                          `json:"key_not,omitempty"`
+	KeyIn                  []string                         `json:"key_in,omitempty"`
+	KeyNotIn               []string                         `json:"key_not_in,omitempty"`
+	KeyLt                  *string                          `json:"key_lt,omitempty"`
+	KeyLte                 *string                          `json:"key_lte,omitempty"`
+	KeyGt                  *string                          `json:"key_gt,omitempty"`
+	KeyGte                 *string                          `json:"key_gte,omitempty"`
+	KeyContains            *string                          `json:"key_contains,omitempty"`
+	KeyNotContains         *string                          `json:"key_not_contains,omitempty"`
+	KeyStartsWith          *string                          `json:"key_starts_with,omitempty"`
+	KeyNotStartsWith       *string                          `json:"key_not_starts_with,omitempty"`
+	KeyEndsWith            *string                          `json:"key_ends_with,omitempty"`
+	KeyNotEndsWith         *string                          `json:"key_not_ends_with,omitempty"`
+	ScheduledAt            *string                          `json:"scheduledAt,omitempty"`
+	ScheduledAtNot         *string                          `json:"scheduledAt_not,omitempty"`
+	ScheduledAtIn          []string                         `json:"scheduledAt_in,omitempty"`
+	ScheduledAtNotIn       []string                         `json:"scheduledAt_not_in,omitempty"`
+	ScheduledAtLt          *string                          `json:"scheduledAt_lt,omitempty"`
+	ScheduledAtLte         *string                          `json:"scheduledAt_lte,omitempty"`
+	ScheduledAtGt          *string                          `json:"scheduledAt_gt,omitempty"`
+	ScheduledAtGte         *string                          `json:"scheduledAt_gte,omitempty"`
+	Status                 *FiringStatus                    `json:"status,omitempty"`
+	StatusNot              *FiringStatus                    `json:"status_not,omitempty"`
+	StatusIn               []FiringStatus                   `json:"status_in,omitempty"`
+	StatusNotIn            []FiringStatus                   `json:"status_not_in,omitempty"`
+	Attempts               *int32                           `json:"attempts,omitempty"`
+	AttemptsNot            *int32                           `json:"attempts_not,omitempty"`
+	AttemptsIn             []int32                          `json:"attempts_in,omitempty"`
+	AttemptsNotIn          []int32                          `json:"attempts_not_in,omitempty"`
+	AttemptsLt             *int32                           `json:"attempts_lt,omitempty"`
+	AttemptsLte            *int32                           `json:"attempts_lte,omitempty"`
+	AttemptsGt             *int32                           `json:"attempts_gt,omitempty"`
+	AttemptsGte            *int32                           `json:"attempts_gte,omitempty"`
+	LastError              *string                          `json:"lastError,omitempty"`
+	LastErrorNot           *string                          `json:"lastError_not,omitempty"`
+	LastErrorIn            []string                         `json:"lastError_in,omitempty"`
+	LastErrorNotIn         []string                         `json:"lastError_not_in,omitempty"`
+	LastErrorLt            *string                          `json:"lastError_lt,omitempty"`
+	LastErrorLte           *string                          `json:"lastError_lte,omitempty"`
+	LastErrorGt            *string                          `json:"lastError_gt,omitempty"`
+	LastErrorGte           *string                          `json:"lastError_gte,omitempty"`
+	LastErrorContains      *string                          `json:"lastError_contains,omitempty"`
+	LastErrorNotContains   *string                          `json:"lastError_not_contains,omitempty"`
+	LastErrorStartsWith    *string                          `json:"lastError_starts_with,omitempty"`
+	LastErrorNotStartsWith *string                          `json:"lastError_not_starts_with,omitempty"`
+	LastErrorEndsWith      *string                          `json:"lastError_ends_with,omitempty"`
+	LastErrorNotEndsWith   *string                          `json:"lastError_not_ends_with,omitempty"`
+	And                    []ReminderFiringScalarWhereInput `json:"AND,omitempty"`
+	Or                     []ReminderFiringScalarWhereInput `json:"OR,omitempty"`
+	Not                    []ReminderFiringScalarWhereInput `json:"NOT,omitempty"`
+}
+
+type ReminderFiringUpdateManyWithWhereNestedInput struct {
+	Where ReminderFiringScalarWhereInput    `json:"where"`
+	Data  ReminderFiringUpdateManyDataInput `json:"data"`
+}
+
+type ReminderFiringUpdateManyDataInput struct {
+	Key         *string       `json:"key,omitempty"`
+	ScheduledAt *string       `json:"scheduledAt,omitempty"`
+	Status      *FiringStatus `json:"status,omitempty"`
+	Attempts    *int32        `json:"attempts,omitempty"`
+	LastError   *string       `json:"lastError,omitempty"`
+}
+
+type ReminderCreateWithoutFiringsInput struct {
+	ID          *string                                `json:"id,omitempty"`
+	User        string                                 `json:"user"`
+	Message     string                                 `json:"message"`
+	Rule        string                                 `json:"rule"`
+	Timezone    string                                 `json:"timezone"`
+	Notifier    string                                 `json:"notifier"`
+	Target      string                                 `json:"target"`
+	Active      *bool                                  `json:"active,omitempty"`
+	NextFireAt  string                                 `json:"nextFireAt"`
+	LastFiredAt *string                                `json:"lastFiredAt,omitempty"`
+	Book        BookCreateOneWithoutRemindersInput     `json:"book"`
+	Chapter     *ChapterCreateOneWithoutRemindersInput `json:"chapter,omitempty"`
+}
+
+type ReminderCreateOneWithoutFiringsInput struct {
+	Create  *ReminderCreateWithoutFiringsInput `json:"create,omitempty"`
+	Connect *ReminderWhereUniqueInput          `json:"connect,omitempty"`
+}
+
+type ReminderUpdateWithoutFiringsDataInput struct {
+	User        *string                                     `json:"user,omitempty"`
+	Message     *string                                     `json:"message,omitempty"`
+	Rule        *string                                     `json:"rule,omitempty"`
+	Timezone    *string                                     `json:"timezone,omitempty"`
+	Notifier    *string                                     `json:"notifier,omitempty"`
+	Target      *string                                     `json:"target,omitempty"`
+	Active      *bool                                       `json:"active,omitempty"`
+	NextFireAt  *string                                     `json:"nextFireAt,omitempty"`
+	LastFiredAt *string                                     `json:"lastFiredAt,omitempty"`
+	Book        *BookUpdateOneRequiredWithoutRemindersInput `json:"book,omitempty"`
+	Chapter     *ChapterUpdateOneWithoutRemindersInput      `json:"chapter,omitempty"`
+}
+
+type ReminderUpdateOneRequiredWithoutFiringsInput struct {
+	Create  *ReminderCreateWithoutFiringsInput     `json:"create,omitempty"`
+	Update  *ReminderUpdateWithoutFiringsDataInput `json:"update,omitempty"`
+	Upsert  *ReminderUpsertWithoutFiringsInput     `json:"upsert,omitempty"`
+	Connect *ReminderWhereUniqueInput              `json:"connect,omitempty"`
+}
+
+type ReminderUpsertWithoutFiringsInput struct {
+	Update ReminderUpdateWithoutFiringsDataInput `json:"update"`
+	Create ReminderCreateWithoutFiringsInput     `json:"create"`
+}
+
+type CalendarFeedWhereUniqueInput struct {
+	ID        *string `json:"id,omitempty"`
+	User      *string `json:"user,omitempty"`
+	TokenHash *string `json:"tokenHash,omitempty"`
+}
+
+type CalendarFeedWhereInput struct {
+	ID                     *string                  `json:"id,omitempty"`
+	IDNot                  *string                  `json:"id_not,omitempty"`
+	IDIn                   []string                 `json:"id_in,omitempty"`
+	IDNotIn                []string                 `json:"id_not_in,omitempty"`
+	IDLt                   *string                  `json:"id_lt,omitempty"`
+	IDLte                  *string                  `json:"id_lte,omitempty"`
+	IDGt                   *string                  `json:"id_gt,omitempty"`
+	IDGte                  *string                  `json:"id_gte,omitempty"`
+	IDContains             *string                  `json:"id_contains,omitempty"`
+	IDNotContains          *string                  `json:"id_not_contains,omitempty"`
+	IDStartsWith           *string                  `json:"id_starts_with,omitempty"`
+	IDNotStartsWith        *string                  `json:"id_not_starts_with,omitempty"`
+	IDEndsWith             *string                  `json:"id_ends_with,omitempty"`
+	IDNotEndsWith          *string                  `json:"id_not_ends_with,omitempty"`
+	CreatedAt              *string                  `json:"createdAt,omitempty"`
+	CreatedAtNot           *string                  `json:"createdAt_not,omitempty"`
+	CreatedAtIn            []string                 `json:"createdAt_in,omitempty"`
+	CreatedAtNotIn         []string                 `json:"createdAt_not_in,omitempty"`
+	CreatedAtLt            *string                  `json:"createdAt_lt,omitempty"`
+	CreatedAtLte           *string                  `json:"createdAt_lte,omitempty"`
+	CreatedAtGt            *string                  `json:"createdAt_gt,omitempty"`
+	CreatedAtGte           *string                  `json:"createdAt_gte,omitempty"`
+	UpdatedAt              *string                  `json:"updatedAt,omitempty"`
+	UpdatedAtNot           *string                  `json:"updatedAt_not,omitempty"`
+	UpdatedAtIn            []string                 `json:"updatedAt_in,omitempty"`
+	UpdatedAtNotIn         []string                 `json:"updatedAt_not_in,omitempty"`
+	UpdatedAtLt            *string                  `json:"updatedAt_lt,omitempty"`
+	UpdatedAtLte           *string                  `json:"updatedAt_lte,omitempty"`
+	UpdatedAtGt            *string                  `json:"updatedAt_gt,omitempty"`
+	UpdatedAtGte           *string                  `json:"updatedAt_gte,omitempty"`
+	User                   *string                  `json:"user,omitempty"`
+	UserNot                *string                  `json:"user_not,omitempty"`
+	UserIn                 []string                 `json:"user_in,omitempty"`
+	UserNotIn              []string                 `json:"user_not_in,omitempty"`
+	UserLt                 *string                  `json:"user_lt,omitempty"`
+	UserLte                *string                  `json:"user_lte,omitempty"`
+	UserGt                 *string                  `json:"user_gt,omitempty"`
+	UserGte                *string                  `json:"user_gte,omitempty"`
+	UserContains           *string                  `json:"user_contains,omitempty"`
+	UserNotContains        *string                  `json:"user_not_contains,omitempty"`
+	UserStartsWith         *string                  `json:"user_starts_with,omitempty"`
+	UserNotStartsWith      *string                  `json:"user_not_starts_with,omitempty"`
+	UserEndsWith           *string                  `json:"user_ends_with,omitempty"`
+	UserNotEndsWith        *string                  `json:"user_not_ends_with,omitempty"`
+	TokenHash              *string                  `json:"tokenHash,omitempty"`
+	TokenHashNot           *string                  `json:"tokenHash_not,omitempty"`
+	TokenHashIn            []string                 `json:"tokenHash_in,omitempty"`
+	TokenHashNotIn         []string                 `json:"tokenHash_not_in,omitempty"`
+	TokenHashLt            *string                  `json:"tokenHash_lt,omitempty"`
+	TokenHashLte           *string                  `json:"tokenHash_lte,omitempty"`
+	TokenHashGt            *string                  `json:"tokenHash_gt,omitempty"`
+	TokenHashGte           *string                  `json:"tokenHash_gte,omitempty"`
+	TokenHashContains      *string                  `json:"tokenHash_contains,omitempty"`
+	TokenHashNotContains   *string                  `json:"tokenHash_not_contains,omitempty"`
+	TokenHashStartsWith    *string                  `json:"tokenHash_starts_with,omitempty"`
+	TokenHashNotStartsWith *string                  `json:"tokenHash_not_starts_with,omitempty"`
+	TokenHashEndsWith      *string                  `json:"tokenHash_ends_with,omitempty"`
+	TokenHashNotEndsWith   *string                  `json:"tokenHash_not_ends_with,omitempty"`
+	And                    []CalendarFeedWhereInput `json:"AND,omitempty"`
+	Or                     []CalendarFeedWhereInput `json:"OR,omitempty"`
+	Not                    []CalendarFeedWhereInput `json:"NOT,omitempty"`
+}
+
+type CalendarFeedCreateInput struct {
+	ID        *string `json:"id,omitempty"`
+	User      string  `json:"user"`
+	TokenHash string  `json:"tokenHash"`
+}
+
+type CalendarFeedUpdateInput struct {
+	User      *string `json:"user,omitempty"`
+	TokenHash *string `json:"tokenHash,omitempty"`
+}
+
+type CalendarFeedUpdateManyMutationInput struct {
+	User      *string `json:"user,omitempty"`
+	TokenHash *string `json:"tokenHash,omitempty"`
+}
+
+type CalendarFeedSubscriptionWhereInput struct {
+	MutationIn                 []MutationType                       `json:"mutation_in,omitempty"`
+	UpdatedFieldsContains      *string                              `json:"updatedFields_contains,omitempty"`
+	UpdatedFieldsContainsEvery []string                             `json:"updatedFields_contains_every,omitempty"`
+	UpdatedFieldsContainsSome  []string                             `json:"updatedFields_contains_some,omitempty"`
+	Node                       *CalendarFeedWhereInput              `json:"node,omitempty"`
+	And                        []CalendarFeedSubscriptionWhereInput `json:"AND,omitempty"`
+	Or                         []CalendarFeedSubscriptionWhereInput `json:"OR,omitempty"`
+	Not                        []CalendarFeedSubscriptionWhereInput `json:"NOT,omitempty"`
+}
+
+type ChapterPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance ChapterPreviousValuesExec) Exec(ctx context.Context) (*ChapterPreviousValues, error) {
+	var v ChapterPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ChapterPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ChapterPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ChapterPreviousValuesExecArray) Exec(ctx context.Context) ([]ChapterPreviousValues, error) {
+	var v []ChapterPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ChapterPreviousValues struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Position    float64 `json:"position"`
+	Deleted     bool    `json:"deleted"`
+	DeletedAt   *string `json:"deletedAt,omitempty"`
+	Revision    int32   `json:"revision"`
+}
+
+type BookEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *BookEdgeExec) Node() *BookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Book"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+func (instance BookEdgeExec) Exec(ctx context.Context) (*BookEdge, error) {
+	var v BookEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance BookEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type BookEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance BookEdgeExecArray) Exec(ctx context.Context) ([]BookEdge, error) {
+	var v []BookEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type BookEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type BookSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *BookSubscriptionPayloadExec) Node() *BookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Book"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+func (instance *BookSubscriptionPayloadExec) PreviousValues() *BookPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "BookPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookPreviousValuesExec{ret}
+}
+
+func (instance BookSubscriptionPayloadExec) Exec(ctx context.Context) (*BookSubscriptionPayload, error) {
+	var v BookSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance BookSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type BookSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance BookSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]BookSubscriptionPayload, error) {
+	var v []BookSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type BookSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type ChapterExec struct {
+	exec *prisma.Exec
+}
+
+type TagsParamsExec struct {
+	Where   *TagWhereInput
+	OrderBy *TagOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *ChapterExec) Tags(params *TagsParamsExec) *TagExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"TagWhereInput", "TagOrderByInput", "Tag"},
+		"tags",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExecArray{ret}
+}
+
+type NotesParamsExec struct {
+	Where   *NoteWhereInput
+	OrderBy *NoteOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *ChapterExec) Notes(params *NotesParamsExec) *NoteExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"NoteWhereInput", "NoteOrderByInput", "Note"},
+		"notes",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExecArray{ret}
+}
+
+type CardsParamsExec struct {
+	Where   *CardWhereInput
+	OrderBy *CardOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *ChapterExec) Cards(params *CardsParamsExec) *CardExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"CardWhereInput", "CardOrderByInput", "Card"},
+		"cards",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExecArray{ret}
+}
+
+type ProgressParamsExec struct {
+	Where   *ReadingProgressWhereInput
+	OrderBy *ReadingProgressOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *ChapterExec) Progress(params *ProgressParamsExec) *ReadingProgressExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ReadingProgressWhereInput", "ReadingProgressOrderByInput", "ReadingProgress"},
+		"progress",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExecArray{ret}
+}
+
+type SessionsParamsExec struct {
+	Where   *ReadingSessionWhereInput
+	OrderBy *ReadingSessionOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *ChapterExec) Sessions(params *SessionsParamsExec) *ReadingSessionExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ReadingSessionWhereInput", "ReadingSessionOrderByInput", "ReadingSession"},
+		"sessions",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExecArray{ret}
+}
+
+type RemindersParamsExec struct {
+	Where   *ReminderWhereInput
+	OrderBy *ReminderOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *ChapterExec) Reminders(params *RemindersParamsExec) *ReminderExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ReminderWhereInput", "ReminderOrderByInput", "Reminder"},
+		"reminders",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExecArray{ret}
+}
+
+type RevisionsParamsExec struct {
+	Where   *RevisionWhereInput
+	OrderBy *RevisionOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *ChapterExec) Revisions(params *RevisionsParamsExec) *RevisionExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"RevisionWhereInput", "RevisionOrderByInput", "Revision"},
+		"revisions",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExecArray{ret}
+}
+
+func (instance *ChapterExec) Book() *BookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Book"},
+		"book",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+type OutboxParamsExec struct {
+	Where   *OutboxEventWhereInput
+	OrderBy *OutboxEventOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *ChapterExec) Outbox(params *OutboxParamsExec) *OutboxEventExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"OutboxEventWhereInput", "OutboxEventOrderByInput", "OutboxEvent"},
+		"outbox",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExecArray{ret}
+}
+
+func (instance ChapterExec) Exec(ctx context.Context) (*Chapter, error) {
+	var v Chapter
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ChapterExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ChapterExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ChapterExecArray) Exec(ctx context.Context) ([]Chapter, error) {
+	var v []Chapter
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Chapter struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Position    float64 `json:"position"`
+	Deleted     bool    `json:"deleted"`
+	DeletedAt   *string `json:"deletedAt,omitempty"`
+	Revision    int32   `json:"revision"`
+}
+
+type ChapterSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ChapterSubscriptionPayloadExec) Node() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (instance *ChapterSubscriptionPayloadExec) PreviousValues() *ChapterPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ChapterPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterPreviousValuesExec{ret}
+}
+
+func (instance ChapterSubscriptionPayloadExec) Exec(ctx context.Context) (*ChapterSubscriptionPayload, error) {
+	var v ChapterSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ChapterSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ChapterSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ChapterSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]ChapterSubscriptionPayload, error) {
+	var v []ChapterSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ChapterSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type BookPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance BookPreviousValuesExec) Exec(ctx context.Context) (*BookPreviousValues, error) {
+	var v BookPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance BookPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type BookPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance BookPreviousValuesExecArray) Exec(ctx context.Context) ([]BookPreviousValues, error) {
+	var v []BookPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type BookPreviousValues struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Isbn        *string `json:"isbn,omitempty"`
+	Publisher   *string `json:"publisher,omitempty"`
+	Year        *int32  `json:"year,omitempty"`
+	Language    *string `json:"language,omitempty"`
+	Deleted     bool    `json:"deleted"`
+	DeletedAt   *string `json:"deletedAt,omitempty"`
+	Revision    int32   `json:"revision"`
+}
+
+type BookExec struct {
+	exec *prisma.Exec
+}
+
+type AuthorsParamsExec struct {
+	Where   *AuthorWhereInput
+	OrderBy *AuthorOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *BookExec) Authors(params *AuthorsParamsExec) *AuthorExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"AuthorWhereInput", "AuthorOrderByInput", "Author"},
+		"authors",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExecArray{ret}
+}
+
+func (instance *BookExec) Tags(params *TagsParamsExec) *TagExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"TagWhereInput", "TagOrderByInput", "Tag"},
+		"tags",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExecArray{ret}
+}
+
+type CollectionsParamsExec struct {
+	Where   *CollectionWhereInput
+	OrderBy *CollectionOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *BookExec) Collections(params *CollectionsParamsExec) *CollectionExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"CollectionWhereInput", "CollectionOrderByInput", "Collection"},
+		"collections",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExecArray{ret}
+}
+
+func (instance *BookExec) Revisions(params *RevisionsParamsExec) *RevisionExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"RevisionWhereInput", "RevisionOrderByInput", "Revision"},
+		"revisions",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExecArray{ret}
+}
+
+type ChaptersParamsExec struct {
+	Where   *ChapterWhereInput
+	OrderBy *ChapterOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *BookExec) Chapters(params *ChaptersParamsExec) *ChapterExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ChapterWhereInput", "ChapterOrderByInput", "Chapter"},
+		"chapters",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExecArray{ret}
+}
+
+func (instance *BookExec) Sessions(params *SessionsParamsExec) *ReadingSessionExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ReadingSessionWhereInput", "ReadingSessionOrderByInput", "ReadingSession"},
+		"sessions",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExecArray{ret}
+}
+
+func (instance *BookExec) Reminders(params *RemindersParamsExec) *ReminderExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ReminderWhereInput", "ReminderOrderByInput", "Reminder"},
+		"reminders",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExecArray{ret}
+}
+
+func (instance *BookExec) Outbox(params *OutboxParamsExec) *OutboxEventExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"OutboxEventWhereInput", "OutboxEventOrderByInput", "OutboxEvent"},
+		"outbox",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExecArray{ret}
+}
+
+func (instance BookExec) Exec(ctx context.Context) (*Book, error) {
+	var v Book
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance BookExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type BookExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance BookExecArray) Exec(ctx context.Context) ([]Book, error) {
+	var v []Book
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Book struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Isbn        *string `json:"isbn,omitempty"`
+	Publisher   *string `json:"publisher,omitempty"`
+	Year        *int32  `json:"year,omitempty"`
+	Language    *string `json:"language,omitempty"`
+	Deleted     bool    `json:"deleted"`
+	DeletedAt   *string `json:"deletedAt,omitempty"`
+	Revision    int32   `json:"revision"`
+}
+
+type BookConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *BookConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *BookConnectionExec) Edges() *BookEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "BookEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &BookEdgeExec{ret}
+}
+
+func (instance *BookConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateBook"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance BookConnectionExec) Exec(ctx context.Context) (*BookConnection, error) {
+	var v BookConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance BookConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type BookConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance BookConnectionExecArray) Exec(ctx context.Context) ([]BookConnection, error) {
+	var v []BookConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type BookConnection struct {
+}
+
+type PageInfoExec struct {
+	exec *prisma.Exec
+}
+
+func (instance PageInfoExec) Exec(ctx context.Context) (*PageInfo, error) {
+	var v PageInfo
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance PageInfoExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type PageInfoExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance PageInfoExecArray) Exec(ctx context.Context) ([]PageInfo, error) {
+	var v []PageInfo
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
+type ChapterConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ChapterConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *ChapterConnectionExec) Edges() *ChapterEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ChapterEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &ChapterEdgeExec{ret}
+}
+
+func (instance *ChapterConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateChapter"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance ChapterConnectionExec) Exec(ctx context.Context) (*ChapterConnection, error) {
+	var v ChapterConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ChapterConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ChapterConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ChapterConnectionExecArray) Exec(ctx context.Context) ([]ChapterConnection, error) {
+	var v []ChapterConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ChapterConnection struct {
+}
+
+type ChapterEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ChapterEdgeExec) Node() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (instance ChapterEdgeExec) Exec(ctx context.Context) (*ChapterEdge, error) {
+	var v ChapterEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ChapterEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ChapterEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ChapterEdgeExecArray) Exec(ctx context.Context) ([]ChapterEdge, error) {
+	var v []ChapterEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ChapterEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type WebhookPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookPreviousValuesExec) Exec(ctx context.Context) (*WebhookPreviousValues, error) {
+	var v WebhookPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookPreviousValuesExecArray) Exec(ctx context.Context) ([]WebhookPreviousValues, error) {
+	var v []WebhookPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookPreviousValues struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	URL       string `json:"url"`
+	Secret    string `json:"secret"`
+	Events    string `json:"events"`
+}
+
+type WebhookEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *WebhookEdgeExec) Node() *WebhookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Webhook"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExec{ret}
+}
+
+func (instance WebhookEdgeExec) Exec(ctx context.Context) (*WebhookEdge, error) {
+	var v WebhookEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookEdgeExecArray) Exec(ctx context.Context) ([]WebhookEdge, error) {
+	var v []WebhookEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type WebhookSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *WebhookSubscriptionPayloadExec) Node() *WebhookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Webhook"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExec{ret}
+}
+
+func (instance *WebhookSubscriptionPayloadExec) PreviousValues() *WebhookPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "WebhookPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookPreviousValuesExec{ret}
+}
+
+func (instance WebhookSubscriptionPayloadExec) Exec(ctx context.Context) (*WebhookSubscriptionPayload, error) {
+	var v WebhookSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]WebhookSubscriptionPayload, error) {
+	var v []WebhookSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type WebhookExec struct {
+	exec *prisma.Exec
+}
+
+type DeliveriesParamsExec struct {
+	Where   *WebhookDeliveryWhereInput
+	OrderBy *WebhookDeliveryOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *WebhookExec) Deliveries(params *DeliveriesParamsExec) *WebhookDeliveryExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"WebhookDeliveryWhereInput", "WebhookDeliveryOrderByInput", "WebhookDelivery"},
+		"deliveries",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExecArray{ret}
+}
+
+func (instance WebhookExec) Exec(ctx context.Context) (*Webhook, error) {
+	var v Webhook
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookExecArray) Exec(ctx context.Context) ([]Webhook, error) {
+	var v []Webhook
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Webhook struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	URL       string `json:"url"`
+	Secret    string `json:"secret"`
+	Events    string `json:"events"`
+}
+
+type WebhookConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *WebhookConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *WebhookConnectionExec) Edges() *WebhookEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "WebhookEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &WebhookEdgeExec{ret}
+}
+
+func (instance *WebhookConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateWebhook"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance WebhookConnectionExec) Exec(ctx context.Context) (*WebhookConnection, error) {
+	var v WebhookConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookConnectionExecArray) Exec(ctx context.Context) ([]WebhookConnection, error) {
+	var v []WebhookConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookConnection struct {
+}
+
+type WebhookDeliveryPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookDeliveryPreviousValuesExec) Exec(ctx context.Context) (*WebhookDeliveryPreviousValues, error) {
+	var v WebhookDeliveryPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookDeliveryPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookDeliveryPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookDeliveryPreviousValuesExecArray) Exec(ctx context.Context) ([]WebhookDeliveryPreviousValues, error) {
+	var v []WebhookDeliveryPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookDeliveryPreviousValues struct {
+	ID             string         `json:"id"`
+	CreatedAt      string         `json:"createdAt"`
+	UpdatedAt      string         `json:"updatedAt"`
+	Event          string         `json:"event"`
+	Payload        string         `json:"payload"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int32          `json:"attempts"`
+	NextAttemptAt  *string        `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int32         `json:"lastStatusCode,omitempty"`
+	LastError      *string        `json:"lastError,omitempty"`
+}
+
+type WebhookDeliveryEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *WebhookDeliveryEdgeExec) Node() *WebhookDeliveryExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "WebhookDelivery"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExec{ret}
+}
+
+func (instance WebhookDeliveryEdgeExec) Exec(ctx context.Context) (*WebhookDeliveryEdge, error) {
+	var v WebhookDeliveryEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookDeliveryEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookDeliveryEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookDeliveryEdgeExecArray) Exec(ctx context.Context) ([]WebhookDeliveryEdge, error) {
+	var v []WebhookDeliveryEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookDeliveryEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type WebhookDeliverySubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *WebhookDeliverySubscriptionPayloadExec) Node() *WebhookDeliveryExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "WebhookDelivery"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryExec{ret}
+}
+
+func (instance *WebhookDeliverySubscriptionPayloadExec) PreviousValues() *WebhookDeliveryPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "WebhookDeliveryPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "event", "payload", "status", "attempts", "nextAttemptAt", "lastStatusCode", "lastError"})
+
+	return &WebhookDeliveryPreviousValuesExec{ret}
+}
+
+func (instance WebhookDeliverySubscriptionPayloadExec) Exec(ctx context.Context) (*WebhookDeliverySubscriptionPayload, error) {
+	var v WebhookDeliverySubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookDeliverySubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookDeliverySubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookDeliverySubscriptionPayloadExecArray) Exec(ctx context.Context) ([]WebhookDeliverySubscriptionPayload, error) {
+	var v []WebhookDeliverySubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookDeliverySubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type WebhookDeliveryExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *WebhookDeliveryExec) Webhook() *WebhookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Webhook"},
+		"webhook",
+		[]string{"id", "createdAt", "updatedAt", "url", "secret", "events"})
+
+	return &WebhookExec{ret}
+}
+
+func (instance WebhookDeliveryExec) Exec(ctx context.Context) (*WebhookDelivery, error) {
+	var v WebhookDelivery
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookDeliveryExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookDeliveryExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookDeliveryExecArray) Exec(ctx context.Context) ([]WebhookDelivery, error) {
+	var v []WebhookDelivery
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookDelivery struct {
+	ID             string         `json:"id"`
+	CreatedAt      string         `json:"createdAt"`
+	UpdatedAt      string         `json:"updatedAt"`
+	Event          string         `json:"event"`
+	Payload        string         `json:"payload"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int32          `json:"attempts"`
+	NextAttemptAt  *string        `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int32         `json:"lastStatusCode,omitempty"`
+	LastError      *string        `json:"lastError,omitempty"`
+}
+
+type WebhookDeliveryConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *WebhookDeliveryConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *WebhookDeliveryConnectionExec) Edges() *WebhookDeliveryEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "WebhookDeliveryEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &WebhookDeliveryEdgeExec{ret}
+}
+
+func (instance *WebhookDeliveryConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateWebhookDelivery"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance WebhookDeliveryConnectionExec) Exec(ctx context.Context) (*WebhookDeliveryConnection, error) {
+	var v WebhookDeliveryConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance WebhookDeliveryConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type WebhookDeliveryConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance WebhookDeliveryConnectionExecArray) Exec(ctx context.Context) ([]WebhookDeliveryConnection, error) {
+	var v []WebhookDeliveryConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type WebhookDeliveryConnection struct {
+}
+
+type OutboxEventPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance OutboxEventPreviousValuesExec) Exec(ctx context.Context) (*OutboxEventPreviousValues, error) {
+	var v OutboxEventPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance OutboxEventPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type OutboxEventPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance OutboxEventPreviousValuesExecArray) Exec(ctx context.Context) ([]OutboxEventPreviousValues, error) {
+	var v []OutboxEventPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type OutboxEventPreviousValues struct {
+	ID          string       `json:"id"`
+	CreatedAt   string       `json:"createdAt"`
+	UpdatedAt   string       `json:"updatedAt"`
+	Type        string       `json:"type"`
+	Aggregate   string       `json:"aggregate"`
+	AggregateID string       `json:"aggregateId"`
+	Payload     string       `json:"payload"`
+	Status      OutboxStatus `json:"status"`
+	PublishedAt *string      `json:"publishedAt,omitempty"`
+	Sequence    string       `json:"sequence"`
+}
+
+type OutboxEventEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *OutboxEventEdgeExec) Node() *OutboxEventExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "OutboxEvent"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExec{ret}
+}
+
+func (instance OutboxEventEdgeExec) Exec(ctx context.Context) (*OutboxEventEdge, error) {
+	var v OutboxEventEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance OutboxEventEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type OutboxEventEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance OutboxEventEdgeExecArray) Exec(ctx context.Context) ([]OutboxEventEdge, error) {
+	var v []OutboxEventEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type OutboxEventEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type OutboxEventSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *OutboxEventSubscriptionPayloadExec) Node() *OutboxEventExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "OutboxEvent"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventExec{ret}
+}
+
+func (instance *OutboxEventSubscriptionPayloadExec) PreviousValues() *OutboxEventPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "OutboxEventPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "type", "aggregate", "aggregateId", "payload", "status", "publishedAt", "sequence"})
+
+	return &OutboxEventPreviousValuesExec{ret}
+}
+
+func (instance OutboxEventSubscriptionPayloadExec) Exec(ctx context.Context) (*OutboxEventSubscriptionPayload, error) {
+	var v OutboxEventSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance OutboxEventSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type OutboxEventSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance OutboxEventSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]OutboxEventSubscriptionPayload, error) {
+	var v []OutboxEventSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type OutboxEventSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type OutboxEventExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *OutboxEventExec) Book() *BookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Book"},
+		"book",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+func (instance *OutboxEventExec) Chapter() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"chapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (instance OutboxEventExec) Exec(ctx context.Context) (*OutboxEvent, error) {
+	var v OutboxEvent
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance OutboxEventExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type OutboxEventExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance OutboxEventExecArray) Exec(ctx context.Context) ([]OutboxEvent, error) {
+	var v []OutboxEvent
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type OutboxEvent struct {
+	ID          string       `json:"id"`
+	CreatedAt   string       `json:"createdAt"`
+	UpdatedAt   string       `json:"updatedAt"`
+	Type        string       `json:"type"`
+	Aggregate   string       `json:"aggregate"`
+	AggregateID string       `json:"aggregateId"`
+	Payload     string       `json:"payload"`
+	Status      OutboxStatus `json:"status"`
+	PublishedAt *string      `json:"publishedAt,omitempty"`
+	Sequence    string       `json:"sequence"`
+}
+
+type OutboxEventConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *OutboxEventConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *OutboxEventConnectionExec) Edges() *OutboxEventEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "OutboxEventEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &OutboxEventEdgeExec{ret}
+}
+
+func (instance *OutboxEventConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateOutboxEvent"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance OutboxEventConnectionExec) Exec(ctx context.Context) (*OutboxEventConnection, error) {
+	var v OutboxEventConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance OutboxEventConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type OutboxEventConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance OutboxEventConnectionExecArray) Exec(ctx context.Context) ([]OutboxEventConnection, error) {
+	var v []OutboxEventConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type OutboxEventConnection struct {
+}
+
+type AuditEntryPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance AuditEntryPreviousValuesExec) Exec(ctx context.Context) (*AuditEntryPreviousValues, error) {
+	var v AuditEntryPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuditEntryPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuditEntryPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuditEntryPreviousValuesExecArray) Exec(ctx context.Context) ([]AuditEntryPreviousValues, error) {
+	var v []AuditEntryPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuditEntryPreviousValues struct {
+	ID        string  `json:"id"`
+	CreatedAt string  `json:"createdAt"`
+	Actor     string  `json:"actor"`
+	Method    string  `json:"method"`
+	RequestID string  `json:"requestId"`
+	BookID    *string `json:"bookId,omitempty"`
+	ChapterID *string `json:"chapterId,omitempty"`
+	Before    *string `json:"before,omitempty"`
+	After     *string `json:"after,omitempty"`
+}
+
+type AuditEntryEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *AuditEntryEdgeExec) Node() *AuditEntryExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AuditEntry"},
+		"node",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryExec{ret}
+}
+
+func (instance AuditEntryEdgeExec) Exec(ctx context.Context) (*AuditEntryEdge, error) {
+	var v AuditEntryEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuditEntryEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuditEntryEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuditEntryEdgeExecArray) Exec(ctx context.Context) ([]AuditEntryEdge, error) {
+	var v []AuditEntryEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuditEntryEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type AuditEntrySubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *AuditEntrySubscriptionPayloadExec) Node() *AuditEntryExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AuditEntry"},
+		"node",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryExec{ret}
+}
+
+func (instance *AuditEntrySubscriptionPayloadExec) PreviousValues() *AuditEntryPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AuditEntryPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "actor", "method", "requestId", "bookId", "chapterId", "before", "after"})
+
+	return &AuditEntryPreviousValuesExec{ret}
+}
+
+func (instance AuditEntrySubscriptionPayloadExec) Exec(ctx context.Context) (*AuditEntrySubscriptionPayload, error) {
+	var v AuditEntrySubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuditEntrySubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuditEntrySubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuditEntrySubscriptionPayloadExecArray) Exec(ctx context.Context) ([]AuditEntrySubscriptionPayload, error) {
+	var v []AuditEntrySubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuditEntrySubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type AuditEntryExec struct {
+	exec *prisma.Exec
+}
+
+func (instance AuditEntryExec) Exec(ctx context.Context) (*AuditEntry, error) {
+	var v AuditEntry
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuditEntryExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuditEntryExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuditEntryExecArray) Exec(ctx context.Context) ([]AuditEntry, error) {
+	var v []AuditEntry
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuditEntry struct {
+	ID        string  `json:"id"`
+	CreatedAt string  `json:"createdAt"`
+	Actor     string  `json:"actor"`
+	Method    string  `json:"method"`
+	RequestID string  `json:"requestId"`
+	BookID    *string `json:"bookId,omitempty"`
+	ChapterID *string `json:"chapterId,omitempty"`
+	Before    *string `json:"before,omitempty"`
+	After     *string `json:"after,omitempty"`
+}
+
+type AuditEntryConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *AuditEntryConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *AuditEntryConnectionExec) Edges() *AuditEntryEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AuditEntryEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &AuditEntryEdgeExec{ret}
+}
+
+func (instance *AuditEntryConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateAuditEntry"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance AuditEntryConnectionExec) Exec(ctx context.Context) (*AuditEntryConnection, error) {
+	var v AuditEntryConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuditEntryConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuditEntryConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuditEntryConnectionExecArray) Exec(ctx context.Context) ([]AuditEntryConnection, error) {
+	var v []AuditEntryConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuditEntryConnection struct {
+}
+
+type RevisionPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance RevisionPreviousValuesExec) Exec(ctx context.Context) (*RevisionPreviousValues, error) {
+	var v RevisionPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance RevisionPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type RevisionPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance RevisionPreviousValuesExecArray) Exec(ctx context.Context) ([]RevisionPreviousValues, error) {
+	var v []RevisionPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type RevisionPreviousValues struct {
+	ID          string `json:"id"`
+	CreatedAt   string `json:"createdAt"`
+	Key         string `json:"key"`
+	Number      int32  `json:"number"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type RevisionEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *RevisionEdgeExec) Node() *RevisionExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Revision"},
+		"node",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExec{ret}
+}
+
+func (instance RevisionEdgeExec) Exec(ctx context.Context) (*RevisionEdge, error) {
+	var v RevisionEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance RevisionEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type RevisionEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance RevisionEdgeExecArray) Exec(ctx context.Context) ([]RevisionEdge, error) {
+	var v []RevisionEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type RevisionEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type RevisionSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *RevisionSubscriptionPayloadExec) Node() *RevisionExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Revision"},
+		"node",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionExec{ret}
+}
+
+func (instance *RevisionSubscriptionPayloadExec) PreviousValues() *RevisionPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "RevisionPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "key", "number", "name", "description"})
+
+	return &RevisionPreviousValuesExec{ret}
+}
+
+func (instance RevisionSubscriptionPayloadExec) Exec(ctx context.Context) (*RevisionSubscriptionPayload, error) {
+	var v RevisionSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance RevisionSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type RevisionSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance RevisionSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]RevisionSubscriptionPayload, error) {
+	var v []RevisionSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type RevisionSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type RevisionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *RevisionExec) Book() *BookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Book"},
+		"book",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+func (instance *RevisionExec) Chapter() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"chapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (instance RevisionExec) Exec(ctx context.Context) (*Revision, error) {
+	var v Revision
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance RevisionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type RevisionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance RevisionExecArray) Exec(ctx context.Context) ([]Revision, error) {
+	var v []Revision
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Revision struct {
+	ID          string `json:"id"`
+	CreatedAt   string `json:"createdAt"`
+	Key         string `json:"key"`
+	Number      int32  `json:"number"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type RevisionConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *RevisionConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *RevisionConnectionExec) Edges() *RevisionEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "RevisionEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &RevisionEdgeExec{ret}
+}
+
+func (instance *RevisionConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateRevision"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance RevisionConnectionExec) Exec(ctx context.Context) (*RevisionConnection, error) {
+	var v RevisionConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance RevisionConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type RevisionConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance RevisionConnectionExecArray) Exec(ctx context.Context) ([]RevisionConnection, error) {
+	var v []RevisionConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type RevisionConnection struct {
+}
+
+type IdempotencyKeyPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance IdempotencyKeyPreviousValuesExec) Exec(ctx context.Context) (*IdempotencyKeyPreviousValues, error) {
+	var v IdempotencyKeyPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance IdempotencyKeyPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type IdempotencyKeyPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance IdempotencyKeyPreviousValuesExecArray) Exec(ctx context.Context) ([]IdempotencyKeyPreviousValues, error) {
+	var v []IdempotencyKeyPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type IdempotencyKeyPreviousValues struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	Key         string  `json:"key"`
+	RequestHash string  `json:"requestHash"`
+	StatusCode  *int32  `json:"statusCode,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+	Etag        *string `json:"etag,omitempty"`
+	Body        *string `json:"body,omitempty"`
+	ExpiresAt   string  `json:"expiresAt"`
+}
+
+type IdempotencyKeyEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *IdempotencyKeyEdgeExec) Node() *IdempotencyKeyExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "IdempotencyKey"},
+		"node",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyExec{ret}
+}
+
+func (instance IdempotencyKeyEdgeExec) Exec(ctx context.Context) (*IdempotencyKeyEdge, error) {
+	var v IdempotencyKeyEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance IdempotencyKeyEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type IdempotencyKeyEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance IdempotencyKeyEdgeExecArray) Exec(ctx context.Context) ([]IdempotencyKeyEdge, error) {
+	var v []IdempotencyKeyEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type IdempotencyKeyEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type IdempotencyKeySubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *IdempotencyKeySubscriptionPayloadExec) Node() *IdempotencyKeyExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "IdempotencyKey"},
+		"node",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyExec{ret}
+}
+
+func (instance *IdempotencyKeySubscriptionPayloadExec) PreviousValues() *IdempotencyKeyPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "IdempotencyKeyPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "key", "requestHash", "statusCode", "contentType", "etag", "body", "expiresAt"})
+
+	return &IdempotencyKeyPreviousValuesExec{ret}
+}
+
+func (instance IdempotencyKeySubscriptionPayloadExec) Exec(ctx context.Context) (*IdempotencyKeySubscriptionPayload, error) {
+	var v IdempotencyKeySubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance IdempotencyKeySubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type IdempotencyKeySubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance IdempotencyKeySubscriptionPayloadExecArray) Exec(ctx context.Context) ([]IdempotencyKeySubscriptionPayload, error) {
+	var v []IdempotencyKeySubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type IdempotencyKeySubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type IdempotencyKeyExec struct {
+	exec *prisma.Exec
+}
+
+func (instance IdempotencyKeyExec) Exec(ctx context.Context) (*IdempotencyKey, error) {
+	var v IdempotencyKey
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance IdempotencyKeyExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type IdempotencyKeyExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance IdempotencyKeyExecArray) Exec(ctx context.Context) ([]IdempotencyKey, error) {
+	var v []IdempotencyKey
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type IdempotencyKey struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	Key         string  `json:"key"`
+	RequestHash string  `json:"requestHash"`
+	StatusCode  *int32  `json:"statusCode,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+	Etag        *string `json:"etag,omitempty"`
+	Body        *string `json:"body,omitempty"`
+	ExpiresAt   string  `json:"expiresAt"`
+}
+
+type IdempotencyKeyConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *IdempotencyKeyConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *IdempotencyKeyConnectionExec) Edges() *IdempotencyKeyEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "IdempotencyKeyEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &IdempotencyKeyEdgeExec{ret}
+}
+
+func (instance *IdempotencyKeyConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateIdempotencyKey"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance IdempotencyKeyConnectionExec) Exec(ctx context.Context) (*IdempotencyKeyConnection, error) {
+	var v IdempotencyKeyConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance IdempotencyKeyConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type IdempotencyKeyConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance IdempotencyKeyConnectionExecArray) Exec(ctx context.Context) ([]IdempotencyKeyConnection, error) {
+	var v []IdempotencyKeyConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type IdempotencyKeyConnection struct {
+}
+
+type AuthorPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance AuthorPreviousValuesExec) Exec(ctx context.Context) (*AuthorPreviousValues, error) {
+	var v AuthorPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuthorPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuthorPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuthorPreviousValuesExecArray) Exec(ctx context.Context) ([]AuthorPreviousValues, error) {
+	var v []AuthorPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuthorPreviousValues struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	Name      string `json:"name"`
+}
+
+type AuthorEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *AuthorEdgeExec) Node() *AuthorExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Author"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExec{ret}
+}
+
+func (instance AuthorEdgeExec) Exec(ctx context.Context) (*AuthorEdge, error) {
+	var v AuthorEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuthorEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuthorEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuthorEdgeExecArray) Exec(ctx context.Context) ([]AuthorEdge, error) {
+	var v []AuthorEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuthorEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type AuthorSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *AuthorSubscriptionPayloadExec) Node() *AuthorExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Author"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorExec{ret}
+}
+
+func (instance *AuthorSubscriptionPayloadExec) PreviousValues() *AuthorPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AuthorPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &AuthorPreviousValuesExec{ret}
+}
+
+func (instance AuthorSubscriptionPayloadExec) Exec(ctx context.Context) (*AuthorSubscriptionPayload, error) {
+	var v AuthorSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuthorSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuthorSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuthorSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]AuthorSubscriptionPayload, error) {
+	var v []AuthorSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuthorSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type AuthorExec struct {
+	exec *prisma.Exec
+}
+
+type BooksParamsExec struct {
+	Where   *BookWhereInput
+	OrderBy *BookOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *AuthorExec) Books(params *BooksParamsExec) *BookExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"BookWhereInput", "BookOrderByInput", "Book"},
+		"books",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExecArray{ret}
+}
+
+func (instance AuthorExec) Exec(ctx context.Context) (*Author, error) {
+	var v Author
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuthorExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuthorExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuthorExecArray) Exec(ctx context.Context) ([]Author, error) {
+	var v []Author
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Author struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	Name      string `json:"name"`
+}
+
+type AuthorConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *AuthorConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *AuthorConnectionExec) Edges() *AuthorEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AuthorEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &AuthorEdgeExec{ret}
+}
+
+func (instance *AuthorConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateAuthor"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance AuthorConnectionExec) Exec(ctx context.Context) (*AuthorConnection, error) {
+	var v AuthorConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance AuthorConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type AuthorConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance AuthorConnectionExecArray) Exec(ctx context.Context) ([]AuthorConnection, error) {
+	var v []AuthorConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type AuthorConnection struct {
+}
+
+type TagPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance TagPreviousValuesExec) Exec(ctx context.Context) (*TagPreviousValues, error) {
+	var v TagPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance TagPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type TagPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance TagPreviousValuesExecArray) Exec(ctx context.Context) ([]TagPreviousValues, error) {
+	var v []TagPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type TagPreviousValues struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	Name      string `json:"name"`
+}
+
+type TagEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *TagEdgeExec) Node() *TagExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Tag"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExec{ret}
+}
+
+func (instance TagEdgeExec) Exec(ctx context.Context) (*TagEdge, error) {
+	var v TagEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance TagEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type TagEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance TagEdgeExecArray) Exec(ctx context.Context) ([]TagEdge, error) {
+	var v []TagEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type TagEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type TagSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *TagSubscriptionPayloadExec) Node() *TagExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Tag"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagExec{ret}
+}
+
+func (instance *TagSubscriptionPayloadExec) PreviousValues() *TagPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "TagPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "name"})
+
+	return &TagPreviousValuesExec{ret}
+}
+
+func (instance TagSubscriptionPayloadExec) Exec(ctx context.Context) (*TagSubscriptionPayload, error) {
+	var v TagSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance TagSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type TagSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance TagSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]TagSubscriptionPayload, error) {
+	var v []TagSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type TagSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type TagExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *TagExec) Books(params *BooksParamsExec) *BookExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"BookWhereInput", "BookOrderByInput", "Book"},
+		"books",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExecArray{ret}
+}
+
+func (instance *TagExec) Chapters(params *ChaptersParamsExec) *ChapterExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ChapterWhereInput", "ChapterOrderByInput", "Chapter"},
+		"chapters",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExecArray{ret}
+}
+
+func (instance TagExec) Exec(ctx context.Context) (*Tag, error) {
+	var v Tag
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance TagExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type TagExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance TagExecArray) Exec(ctx context.Context) ([]Tag, error) {
+	var v []Tag
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Tag struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	Name      string `json:"name"`
+}
+
+type TagConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *TagConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *TagConnectionExec) Edges() *TagEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "TagEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &TagEdgeExec{ret}
+}
+
+func (instance *TagConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateTag"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance TagConnectionExec) Exec(ctx context.Context) (*TagConnection, error) {
+	var v TagConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance TagConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type TagConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance TagConnectionExecArray) Exec(ctx context.Context) ([]TagConnection, error) {
+	var v []TagConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type TagConnection struct {
+}
+
+type CollectionPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance CollectionPreviousValuesExec) Exec(ctx context.Context) (*CollectionPreviousValues, error) {
+	var v CollectionPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CollectionPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CollectionPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CollectionPreviousValuesExecArray) Exec(ctx context.Context) ([]CollectionPreviousValues, error) {
+	var v []CollectionPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type CollectionPreviousValues struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+type CollectionEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *CollectionEdgeExec) Node() *CollectionExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Collection"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExec{ret}
+}
+
+func (instance CollectionEdgeExec) Exec(ctx context.Context) (*CollectionEdge, error) {
+	var v CollectionEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CollectionEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CollectionEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CollectionEdgeExecArray) Exec(ctx context.Context) ([]CollectionEdge, error) {
+	var v []CollectionEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type CollectionEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type CollectionSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *CollectionSubscriptionPayloadExec) Node() *CollectionExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Collection"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionExec{ret}
+}
+
+func (instance *CollectionSubscriptionPayloadExec) PreviousValues() *CollectionPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "CollectionPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+
+	return &CollectionPreviousValuesExec{ret}
+}
+
+func (instance CollectionSubscriptionPayloadExec) Exec(ctx context.Context) (*CollectionSubscriptionPayload, error) {
+	var v CollectionSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CollectionSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CollectionSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CollectionSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]CollectionSubscriptionPayload, error) {
+	var v []CollectionSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type CollectionSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type CollectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *CollectionExec) Books(params *BooksParamsExec) *BookExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"BookWhereInput", "BookOrderByInput", "Book"},
+		"books",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExecArray{ret}
+}
+
+func (instance CollectionExec) Exec(ctx context.Context) (*Collection, error) {
+	var v Collection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CollectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CollectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CollectionExecArray) Exec(ctx context.Context) ([]Collection, error) {
+	var v []Collection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Collection struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+type CollectionConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *CollectionConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *CollectionConnectionExec) Edges() *CollectionEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "CollectionEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &CollectionEdgeExec{ret}
+}
+
+func (instance *CollectionConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateCollection"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance CollectionConnectionExec) Exec(ctx context.Context) (*CollectionConnection, error) {
+	var v CollectionConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CollectionConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CollectionConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CollectionConnectionExecArray) Exec(ctx context.Context) ([]CollectionConnection, error) {
+	var v []CollectionConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type CollectionConnection struct {
+}
+
+type NotePreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance NotePreviousValuesExec) Exec(ctx context.Context) (*NotePreviousValues, error) {
+	var v NotePreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance NotePreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type NotePreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance NotePreviousValuesExecArray) Exec(ctx context.Context) ([]NotePreviousValues, error) {
+	var v []NotePreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type NotePreviousValues struct {
+	ID        string    `json:"id"`
+	CreatedAt string    `json:"createdAt"`
+	UpdatedAt string    `json:"updatedAt"`
+	Quote     string    `json:"quote"`
+	Text      string    `json:"text"`
+	Location  string    `json:"location"`
+	Color     NoteColor `json:"color"`
+}
+
+type NoteEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *NoteEdgeExec) Node() *NoteExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Note"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExec{ret}
+}
+
+func (instance NoteEdgeExec) Exec(ctx context.Context) (*NoteEdge, error) {
+	var v NoteEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance NoteEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type NoteEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance NoteEdgeExecArray) Exec(ctx context.Context) ([]NoteEdge, error) {
+	var v []NoteEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type NoteEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type NoteSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *NoteSubscriptionPayloadExec) Node() *NoteExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Note"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExec{ret}
+}
+
+func (instance *NoteSubscriptionPayloadExec) PreviousValues() *NotePreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "NotePreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NotePreviousValuesExec{ret}
+}
+
+func (instance NoteSubscriptionPayloadExec) Exec(ctx context.Context) (*NoteSubscriptionPayload, error) {
+	var v NoteSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance NoteSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type NoteSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance NoteSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]NoteSubscriptionPayload, error) {
+	var v []NoteSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type NoteSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type NoteExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *NoteExec) Chapter() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"chapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (instance *NoteExec) Cards(params *CardsParamsExec) *CardExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"CardWhereInput", "CardOrderByInput", "Card"},
+		"cards",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExecArray{ret}
+}
+
+func (instance NoteExec) Exec(ctx context.Context) (*Note, error) {
+	var v Note
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance NoteExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type NoteExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance NoteExecArray) Exec(ctx context.Context) ([]Note, error) {
+	var v []Note
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Note struct {
+	ID        string    `json:"id"`
+	CreatedAt string    `json:"createdAt"`
+	UpdatedAt string    `json:"updatedAt"`
+	Quote     string    `json:"quote"`
+	Text      string    `json:"text"`
+	Location  string    `json:"location"`
+	Color     NoteColor `json:"color"`
+}
+
+type NoteConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *NoteConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *NoteConnectionExec) Edges() *NoteEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "NoteEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &NoteEdgeExec{ret}
+}
+
+func (instance *NoteConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateNote"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance NoteConnectionExec) Exec(ctx context.Context) (*NoteConnection, error) {
+	var v NoteConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance NoteConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type NoteConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance NoteConnectionExecArray) Exec(ctx context.Context) ([]NoteConnection, error) {
+	var v []NoteConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type NoteConnection struct {
+}
+
+type CardPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance CardPreviousValuesExec) Exec(ctx context.Context) (*CardPreviousValues, error) {
+	var v CardPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CardPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CardPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CardPreviousValuesExecArray) Exec(ctx context.Context) ([]CardPreviousValues, error) {
+	var v []CardPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type CardPreviousValues struct {
+	ID             string  `json:"id"`
+	CreatedAt      string  `json:"createdAt"`
+	UpdatedAt      string  `json:"updatedAt"`
+	Front          string  `json:"front"`
+	Back           string  `json:"back"`
+	Ease           float64 `json:"ease"`
+	Interval       int32   `json:"interval"`
+	Repetitions    int32   `json:"repetitions"`
+	Lapses         int32   `json:"lapses"`
+	ReviewCount    int32   `json:"reviewCount"`
+	Due            string  `json:"due"`
+	LastReviewedAt *string `json:"lastReviewedAt,omitempty"`
+}
+
+type CardEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *CardEdgeExec) Node() *CardExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Card"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExec{ret}
+}
+
+func (instance CardEdgeExec) Exec(ctx context.Context) (*CardEdge, error) {
+	var v CardEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CardEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CardEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CardEdgeExecArray) Exec(ctx context.Context) ([]CardEdge, error) {
+	var v []CardEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type CardEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type CardSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *CardSubscriptionPayloadExec) Node() *CardExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Card"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExec{ret}
+}
+
+func (instance *CardSubscriptionPayloadExec) PreviousValues() *CardPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "CardPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardPreviousValuesExec{ret}
+}
+
+func (instance CardSubscriptionPayloadExec) Exec(ctx context.Context) (*CardSubscriptionPayload, error) {
+	var v CardSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CardSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CardSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CardSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]CardSubscriptionPayload, error) {
+	var v []CardSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type CardSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type CardExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *CardExec) Chapter() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"chapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (instance *CardExec) Note() *NoteExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Note"},
+		"note",
+		[]string{"id", "createdAt", "updatedAt", "quote", "text", "location", "color"})
+
+	return &NoteExec{ret}
+}
+
+type ReviewsParamsExec struct {
+	Where   *ReviewWhereInput
+	OrderBy *ReviewOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
+}
+
+func (instance *CardExec) Reviews(params *ReviewsParamsExec) *ReviewExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ReviewWhereInput", "ReviewOrderByInput", "Review"},
+		"reviews",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewExecArray{ret}
+}
+
+func (instance CardExec) Exec(ctx context.Context) (*Card, error) {
+	var v Card
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CardExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CardExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CardExecArray) Exec(ctx context.Context) ([]Card, error) {
+	var v []Card
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Card struct {
+	ID             string  `json:"id"`
+	CreatedAt      string  `json:"createdAt"`
+	UpdatedAt      string  `json:"updatedAt"`
+	Front          string  `json:"front"`
+	Back           string  `json:"back"`
+	Ease           float64 `json:"ease"`
+	Interval       int32   `json:"interval"`
+	Repetitions    int32   `json:"repetitions"`
+	Lapses         int32   `json:"lapses"`
+	ReviewCount    int32   `json:"reviewCount"`
+	Due            string  `json:"due"`
+	LastReviewedAt *string `json:"lastReviewedAt,omitempty"`
+}
+
+type CardConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *CardConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *CardConnectionExec) Edges() *CardEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "CardEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &CardEdgeExec{ret}
+}
+
+func (instance *CardConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateCard"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance CardConnectionExec) Exec(ctx context.Context) (*CardConnection, error) {
+	var v CardConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance CardConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type CardConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance CardConnectionExecArray) Exec(ctx context.Context) ([]CardConnection, error) {
+	var v []CardConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type CardConnection struct {
+}
+
+type ReviewPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance ReviewPreviousValuesExec) Exec(ctx context.Context) (*ReviewPreviousValues, error) {
+	var v ReviewPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReviewPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReviewPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReviewPreviousValuesExecArray) Exec(ctx context.Context) ([]ReviewPreviousValues, error) {
+	var v []ReviewPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReviewPreviousValues struct {
+	ID        string  `json:"id"`
+	CreatedAt string  `json:"createdAt"`
+	Key       string  `json:"key"`
+	Grade     int32   `json:"grade"`
+	Ease      float64 `json:"ease"`
+	Interval  int32   `json:"interval"`
+	Due       string  `json:"due"`
+}
+
+type ReviewEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReviewEdgeExec) Node() *ReviewExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Review"},
+		"node",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewExec{ret}
+}
+
+func (instance ReviewEdgeExec) Exec(ctx context.Context) (*ReviewEdge, error) {
+	var v ReviewEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReviewEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReviewEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReviewEdgeExecArray) Exec(ctx context.Context) ([]ReviewEdge, error) {
+	var v []ReviewEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReviewEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type ReviewSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReviewSubscriptionPayloadExec) Node() *ReviewExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Review"},
+		"node",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewExec{ret}
+}
+
+func (instance *ReviewSubscriptionPayloadExec) PreviousValues() *ReviewPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReviewPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "key", "grade", "ease", "interval", "due"})
+
+	return &ReviewPreviousValuesExec{ret}
+}
+
+func (instance ReviewSubscriptionPayloadExec) Exec(ctx context.Context) (*ReviewSubscriptionPayload, error) {
+	var v ReviewSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReviewSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReviewSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReviewSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]ReviewSubscriptionPayload, error) {
+	var v []ReviewSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReviewSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type ReviewExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReviewExec) Card() *CardExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Card"},
+		"card",
+		[]string{"id", "createdAt", "updatedAt", "front", "back", "ease", "interval", "repetitions", "lapses", "reviewCount", "due", "lastReviewedAt"})
+
+	return &CardExec{ret}
+}
+
+func (instance ReviewExec) Exec(ctx context.Context) (*Review, error) {
+	var v Review
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReviewExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReviewExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReviewExecArray) Exec(ctx context.Context) ([]Review, error) {
+	var v []Review
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type Review struct {
+	ID        string  `json:"id"`
+	CreatedAt string  `json:"createdAt"`
+	Key       string  `json:"key"`
+	Grade     int32   `json:"grade"`
+	Ease      float64 `json:"ease"`
+	Interval  int32   `json:"interval"`
+	Due       string  `json:"due"`
+}
+
+type ReviewConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReviewConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *ReviewConnectionExec) Edges() *ReviewEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReviewEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &ReviewEdgeExec{ret}
+}
+
+func (instance *ReviewConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateReview"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance ReviewConnectionExec) Exec(ctx context.Context) (*ReviewConnection, error) {
+	var v ReviewConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReviewConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReviewConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReviewConnectionExecArray) Exec(ctx context.Context) ([]ReviewConnection, error) {
+	var v []ReviewConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReviewConnection struct {
+}
+
+type ReadingProgressPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingProgressPreviousValuesExec) Exec(ctx context.Context) (*ReadingProgressPreviousValues, error) {
+	var v ReadingProgressPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingProgressPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingProgressPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingProgressPreviousValuesExecArray) Exec(ctx context.Context) ([]ReadingProgressPreviousValues, error) {
+	var v []ReadingProgressPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingProgressPreviousValues struct {
+	ID         string        `json:"id"`
+	CreatedAt  string        `json:"createdAt"`
+	UpdatedAt  string        `json:"updatedAt"`
+	Key        string        `json:"key"`
+	User       string        `json:"user"`
+	Status     ReadingStatus `json:"status"`
+	StartedAt  *string       `json:"startedAt,omitempty"`
+	FinishedAt *string       `json:"finishedAt,omitempty"`
+}
+
+type ReadingProgressEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReadingProgressEdgeExec) Node() *ReadingProgressExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReadingProgress"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExec{ret}
+}
+
+func (instance ReadingProgressEdgeExec) Exec(ctx context.Context) (*ReadingProgressEdge, error) {
+	var v ReadingProgressEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingProgressEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingProgressEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingProgressEdgeExecArray) Exec(ctx context.Context) ([]ReadingProgressEdge, error) {
+	var v []ReadingProgressEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingProgressEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type ReadingProgressSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReadingProgressSubscriptionPayloadExec) Node() *ReadingProgressExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReadingProgress"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressExec{ret}
+}
+
+func (instance *ReadingProgressSubscriptionPayloadExec) PreviousValues() *ReadingProgressPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReadingProgressPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "status", "startedAt", "finishedAt"})
+
+	return &ReadingProgressPreviousValuesExec{ret}
+}
+
+func (instance ReadingProgressSubscriptionPayloadExec) Exec(ctx context.Context) (*ReadingProgressSubscriptionPayload, error) {
+	var v ReadingProgressSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingProgressSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingProgressSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingProgressSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]ReadingProgressSubscriptionPayload, error) {
+	var v []ReadingProgressSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingProgressSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type ReadingProgressExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReadingProgressExec) Chapter() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"chapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (instance ReadingProgressExec) Exec(ctx context.Context) (*ReadingProgress, error) {
+	var v ReadingProgress
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingProgressExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingProgressExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingProgressExecArray) Exec(ctx context.Context) ([]ReadingProgress, error) {
+	var v []ReadingProgress
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingProgress struct {
+	ID         string        `json:"id"`
+	CreatedAt  string        `json:"createdAt"`
+	UpdatedAt  string        `json:"updatedAt"`
+	Key        string        `json:"key"`
+	User       string        `json:"user"`
+	Status     ReadingStatus `json:"status"`
+	StartedAt  *string       `json:"startedAt,omitempty"`
+	FinishedAt *string       `json:"finishedAt,omitempty"`
+}
+
+type ReadingProgressConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReadingProgressConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *ReadingProgressConnectionExec) Edges() *ReadingProgressEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReadingProgressEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &ReadingProgressEdgeExec{ret}
+}
+
+func (instance *ReadingProgressConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateReadingProgress"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance ReadingProgressConnectionExec) Exec(ctx context.Context) (*ReadingProgressConnection, error) {
+	var v ReadingProgressConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingProgressConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingProgressConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingProgressConnectionExecArray) Exec(ctx context.Context) ([]ReadingProgressConnection, error) {
+	var v []ReadingProgressConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingProgressConnection struct {
+}
+
+type ReadingSessionPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingSessionPreviousValuesExec) Exec(ctx context.Context) (*ReadingSessionPreviousValues, error) {
+	var v ReadingSessionPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingSessionPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingSessionPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingSessionPreviousValuesExecArray) Exec(ctx context.Context) ([]ReadingSessionPreviousValues, error) {
+	var v []ReadingSessionPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingSessionPreviousValues struct {
+	ID        string  `json:"id"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+	Key       string  `json:"key"`
+	User      string  `json:"user"`
+	Open      bool    `json:"open"`
+	StartedAt string  `json:"startedAt"`
+	EndedAt   *string `json:"endedAt,omitempty"`
+	Duration  int32   `json:"duration"`
+	Pages     int32   `json:"pages"`
+}
+
+type ReadingSessionEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReadingSessionEdgeExec) Node() *ReadingSessionExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReadingSession"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExec{ret}
+}
 
-type BookOrderByInput string
+func (instance ReadingSessionEdgeExec) Exec(ctx context.Context) (*ReadingSessionEdge, error) {
+	var v ReadingSessionEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
 
-const (
-	BookOrderByInputIDAsc           BookOrderByInput = "id_ASC"
-	BookOrderByInputIDDesc          BookOrderByInput = "id_DESC"
-	BookOrderByInputCreatedAtAsc    BookOrderByInput = "createdAt_ASC"
-	BookOrderByInputCreatedAtDesc   BookOrderByInput = "createdAt_DESC"
-	BookOrderByInputUpdatedAtAsc    BookOrderByInput = "updatedAt_ASC"
-	BookOrderByInputUpdatedAtDesc   BookOrderByInput = "updatedAt_DESC"
-	BookOrderByInputNameAsc         BookOrderByInput = "name_ASC"
-	BookOrderByInputNameDesc        BookOrderByInput = "name_DESC"
-	BookOrderByInputDescriptionAsc  BookOrderByInput = "description_ASC"
-	BookOrderByInputDescriptionDesc BookOrderByInput = "description_DESC"
-)
+func (instance ReadingSessionEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
 
-type MutationType string
+type ReadingSessionEdgeExecArray struct {
+	exec *prisma.Exec
+}
 
-const (
-	MutationTypeCreated MutationType = "CREATED"
-	MutationTypeUpdated MutationType = "UPDATED"
-	MutationTypeDeleted MutationType = "DELETED"
-)
+func (instance ReadingSessionEdgeExecArray) Exec(ctx context.Context) ([]ReadingSessionEdge, error) {
+	var v []ReadingSessionEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingSessionEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type ReadingSessionSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReadingSessionSubscriptionPayloadExec) Node() *ReadingSessionExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReadingSession"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionExec{ret}
+}
+
+func (instance *ReadingSessionSubscriptionPayloadExec) PreviousValues() *ReadingSessionPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReadingSessionPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "key", "user", "open", "startedAt", "endedAt", "duration", "pages"})
+
+	return &ReadingSessionPreviousValuesExec{ret}
+}
+
+func (instance ReadingSessionSubscriptionPayloadExec) Exec(ctx context.Context) (*ReadingSessionSubscriptionPayload, error) {
+	var v ReadingSessionSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingSessionSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingSessionSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingSessionSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]ReadingSessionSubscriptionPayload, error) {
+	var v []ReadingSessionSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingSessionSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
+}
+
+type ReadingSessionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReadingSessionExec) Book() *BookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Book"},
+		"book",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
+}
+
+func (instance *ReadingSessionExec) Chapter() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"chapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
+}
+
+func (instance ReadingSessionExec) Exec(ctx context.Context) (*ReadingSession, error) {
+	var v ReadingSession
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingSessionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingSessionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingSessionExecArray) Exec(ctx context.Context) ([]ReadingSession, error) {
+	var v []ReadingSession
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingSession struct {
+	ID        string  `json:"id"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+	Key       string  `json:"key"`
+	User      string  `json:"user"`
+	Open      bool    `json:"open"`
+	StartedAt string  `json:"startedAt"`
+	EndedAt   *string `json:"endedAt,omitempty"`
+	Duration  int32   `json:"duration"`
+	Pages     int32   `json:"pages"`
+}
+
+type ReadingSessionConnectionExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReadingSessionConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
+}
+
+func (instance *ReadingSessionConnectionExec) Edges() *ReadingSessionEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReadingSessionEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &ReadingSessionEdgeExec{ret}
+}
+
+func (instance *ReadingSessionConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateReadingSession"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance ReadingSessionConnectionExec) Exec(ctx context.Context) (*ReadingSessionConnection, error) {
+	var v ReadingSessionConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReadingSessionConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReadingSessionConnectionExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReadingSessionConnectionExecArray) Exec(ctx context.Context) ([]ReadingSessionConnection, error) {
+	var v []ReadingSessionConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReadingSessionConnection struct {
+}
+
+type ReminderPreviousValuesExec struct {
+	exec *prisma.Exec
+}
+
+func (instance ReminderPreviousValuesExec) Exec(ctx context.Context) (*ReminderPreviousValues, error) {
+	var v ReminderPreviousValues
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReminderPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReminderPreviousValuesExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReminderPreviousValuesExecArray) Exec(ctx context.Context) ([]ReminderPreviousValues, error) {
+	var v []ReminderPreviousValues
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReminderPreviousValues struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	User        string  `json:"user"`
+	Message     string  `json:"message"`
+	Rule        string  `json:"rule"`
+	Timezone    string  `json:"timezone"`
+	Notifier    string  `json:"notifier"`
+	Target      string  `json:"target"`
+	Active      bool    `json:"active"`
+	NextFireAt  string  `json:"nextFireAt"`
+	LastFiredAt *string `json:"lastFiredAt,omitempty"`
+}
+
+type ReminderEdgeExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReminderEdgeExec) Node() *ReminderExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Reminder"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderExec{ret}
+}
+
+func (instance ReminderEdgeExec) Exec(ctx context.Context) (*ReminderEdge, error) {
+	var v ReminderEdge
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
+}
+
+func (instance ReminderEdgeExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
+}
+
+type ReminderEdgeExecArray struct {
+	exec *prisma.Exec
+}
+
+func (instance ReminderEdgeExecArray) Exec(ctx context.Context) ([]ReminderEdge, error) {
+	var v []ReminderEdge
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
+}
+
+type ReminderEdge struct {
+	Cursor string `json:"cursor"`
+}
+
+type ReminderSubscriptionPayloadExec struct {
+	exec *prisma.Exec
+}
+
+func (instance *ReminderSubscriptionPayloadExec) Node() *ReminderExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Reminder"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
 
-type ChapterUpdateManyWithoutBookInput struct {
-	Create     []ChapterCreateWithoutBookInput                `json:"create,omitempty"`
-	Delete     []ChapterWhereUniqueInput                      `json:"delete,omitempty"`
-	Connect    []ChapterWhereUniqueInput                      `json:"connect,omitempty"`
-	Set        []ChapterWhereUniqueInput                      `json:"set,omitempty"`
-	Disconnect []ChapterWhereUniqueInput                      `json:"disconnect,omitempty"`
-	Update     []ChapterUpdateWithWhereUniqueWithoutBookInput `json:"update,omitempty"`
-	Upsert     []ChapterUpsertWithWhereUniqueWithoutBookInput `json:"upsert,omitempty"`
-	DeleteMany []ChapterScalarWhereInput                      `json:"deleteMany,omitempty"`
-	UpdateMany []ChapterUpdateManyWithWhereNestedInput        `json:"updateMany,omitempty"`
+	return &ReminderExec{ret}
 }
 
-type BookWhereUniqueInput struct {
-	ID   *string `json:"id,omitempty"`
-	Name *string `json:"name,omitempty"`
+func (instance *ReminderSubscriptionPayloadExec) PreviousValues() *ReminderPreviousValuesExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReminderPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
+
+	return &ReminderPreviousValuesExec{ret}
 }
 
-type ChapterUpdateWithoutBookDataInput struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
+func (instance ReminderSubscriptionPayloadExec) Exec(ctx context.Context) (*ReminderSubscriptionPayload, error) {
+	var v ReminderSubscriptionPayload
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
 }
 
-type ChapterWhereInput struct {
-	ID                       *string             `json:"id,omitempty"`
-	IDNot                    *string             `json:"id_not,omitempty"`
-	IDIn                     []string            `json:"id_in,omitempty"`
-	IDNotIn                  []string            `json:"id_not_in,omitempty"`
-	IDLt                     *string             `json:"id_lt,omitempty"`
-	IDLte                    *string             `json:"id_lte,omitempty"`
-	IDGt                     *string             `json:"id_gt,omitempty"`
-	IDGte                    *string             `json:"id_gte,omitempty"`
-	IDContains               *string             `json:"id_contains,omitempty"`
-	IDNotContains            *string             `json:"id_not_contains,omitempty"`
-	IDStartsWith             *string             `json:"id_starts_with,omitempty"`
-	IDNotStartsWith          *string             `json:"id_not_starts_with,omitempty"`
-	IDEndsWith               *string             `json:"id_ends_with,omitempty"`
-	IDNotEndsWith            *string             `json:"id_not_ends_with,omitempty"`
-	CreatedAt                *string             `json:"createdAt,omitempty"`
-	CreatedAtNot             *string             `json:"createdAt_not,omitempty"`
-	CreatedAtIn              []string            `json:"createdAt_in,omitempty"`
-	CreatedAtNotIn           []string            `json:"createdAt_not_in,omitempty"`
-	CreatedAtLt              *string             `json:"createdAt_lt,omitempty"`
-	CreatedAtLte             *string             `json:"createdAt_lte,omitempty"`
-	CreatedAtGt              *string             `json:"createdAt_gt,omitempty"`
-	CreatedAtGte             *string             `json:"createdAt_gte,omitempty"`
-	UpdatedAt                *string             `json:"updatedAt,omitempty"`
-	UpdatedAtNot             *string             `json:"updatedAt_not,omitempty"`
-	UpdatedAtIn              []string            `json:"updatedAt_in,omitempty"`
-	UpdatedAtNotIn           []string            `json:"updatedAt_not_in,omitempty"`
-	UpdatedAtLt              *string             `json:"updatedAt_lt,omitempty"`
-	UpdatedAtLte             *string             `json:"updatedAt_lte,omitempty"`
-	UpdatedAtGt              *string             `json:"updatedAt_gt,omitempty"`
-	UpdatedAtGte             *string             `json:"updatedAt_gte,omitempty"`
-	Name                     *string             `json:"name,omitempty"`
-	NameNot                  *string             `json:"name_not,omitempty"`
-	NameIn                   []string            `json:"name_in,omitempty"`
-	NameNotIn                []string            `json:"name_not_in,omitempty"`
-	NameLt                   *string             `json:"name_lt,omitempty"`
-	NameLte                  *string             `json:"name_lte,omitempty"`
-	NameGt                   *string             `json:"name_gt,omitempty"`
-	NameGte                  *string             `json:"name_gte,omitempty"`
-	NameContains             *string             `json:"name_contains,omitempty"`
-	NameNotContains          *string             `json:"name_not_contains,omitempty"`
-	NameStartsWith           *string             `json:"name_starts_with,omitempty"`
-	NameNotStartsWith        *string             `json:"name_not_starts_with,omitempty"`
-	NameEndsWith             *string             `json:"name_ends_with,omitempty"`
-	NameNotEndsWith          *string             `json:"name_not_ends_with,omitempty"`
-	Description              *string             `json:"description,omitempty"`
-	DescriptionNot           *string             `json:"description_not,omitempty"`
-	DescriptionIn            []string            `json:"description_in,omitempty"`
-	DescriptionNotIn         []string            `json:"description_not_in,omitempty"`
-	DescriptionLt            *string             `json:"description_lt,omitempty"`
-	DescriptionLte           *string             `json:"description_lte,omitempty"`
-	DescriptionGt            *string             `json:"description_gt,omitempty"`
-	DescriptionGte           *string             `json:"description_gte,omitempty"`
-	DescriptionContains      *string             `json:"description_contains,omitempty"`
-	DescriptionNotContains   *string             `json:"description_not_contains,omitempty"`
-	DescriptionStartsWith    *string             `json:"description_starts_with,omitempty"`
-	DescriptionNotStartsWith *string             `json:"description_not_starts_with,omitempty"`
-	DescriptionEndsWith      *string             `json:"description_ends_with,omitempty"`
-	DescriptionNotEndsWith   *string             `json:"description_not_ends_with,omitempty"`
-	Book                     *BookWhereInput     `json:"book,omitempty"`
-	And                      []ChapterWhereInput `json:"AND,omitempty"`
-	Or                       []ChapterWhereInput `json:"OR,omitempty"`
-	Not                      []ChapterWhereInput `json:"NOT,omitempty"`
+func (instance ReminderSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
 }
 
-type ChapterCreateInput struct {
-	ID          *string                           `json:"id,omitempty"`
-	Name        string                            `json:"name"`
-	Description string                            `json:"description"`
-	Book        BookCreateOneWithoutChaptersInput `json:"book"`
+type ReminderSubscriptionPayloadExecArray struct {
+	exec *prisma.Exec
 }
 
-type ChapterUpdateManyWithWhereNestedInput struct {
-	Where ChapterScalarWhereInput    `json:"where"`
-	Data  ChapterUpdateManyDataInput `json:"data"`
+func (instance ReminderSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]ReminderSubscriptionPayload, error) {
+	var v []ReminderSubscriptionPayload
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
 }
 
-type ChapterUpsertWithWhereUniqueWithoutBookInput struct {
-	Where  ChapterWhereUniqueInput           `json:"where"`
-	Update ChapterUpdateWithoutBookDataInput `json:"update"`
-	Create ChapterCreateWithoutBookInput     `json:"create"`
+type ReminderSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
 }
 
-type BookSubscriptionWhereInput struct {
-	MutationIn                 []MutationType               `json:"mutation_in,omitempty"`
-	UpdatedFieldsContains      *string                      `json:"updatedFields_contains,omitempty"`
-	UpdatedFieldsContainsEvery []string                     `json:"updatedFields_contains_every,omitempty"`
-	UpdatedFieldsContainsSome  []string                     `json:"updatedFields_contains_some,omitempty"`
-	Node                       *BookWhereInput              `json:"node,omitempty"`
-	And                        []BookSubscriptionWhereInput `json:"AND,omitempty"`
-	Or                         []BookSubscriptionWhereInput `json:"OR,omitempty"`
-	Not                        []BookSubscriptionWhereInput `json:"NOT,omitempty"`
+type ReminderExec struct {
+	exec *prisma.Exec
 }
 
-type BookCreateInput struct {
-	ID          *string                            `json:"id,omitempty"`
-	Name        string                             `json:"name"`
-	Description string                             `json:"description"`
-	Chapters    *ChapterCreateManyWithoutBookInput `json:"chapters,omitempty"`
+func (instance *ReminderExec) Book() *BookExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Book"},
+		"book",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "isbn", "publisher", "year", "language", "deleted", "deletedAt", "revision"})
+
+	return &BookExec{ret}
 }
 
-type BookUpsertWithoutChaptersInput struct {
-	Update BookUpdateWithoutChaptersDataInput `json:"update"`
-	Create BookCreateWithoutChaptersInput     `json:"create"`
+func (instance *ReminderExec) Chapter() *ChapterExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "Chapter"},
+		"chapter",
+		[]string{"id", "createdAt", "updatedAt", "name", "description", "position", "deleted", "deletedAt", "revision"})
+
+	return &ChapterExec{ret}
 }
 
-type ChapterCreateManyWithoutBookInput struct {
-	Create  []ChapterCreateWithoutBookInput `json:"create,omitempty"`
-	Connect []ChapterWhereUniqueInput       `json:"connect,omitempty"`
+type FiringsParamsExec struct {
+	Where   *ReminderFiringWhereInput
+	OrderBy *ReminderFiringOrderByInput
+	Skip    *int32
+	After   *string
+	Before  *string
+	First   *int32
+	Last    *int32
 }
 
-type BookUpdateOneRequiredWithoutChaptersInput struct {
-	Create  *BookCreateWithoutChaptersInput     `json:"create,omitempty"`
-	Update  *BookUpdateWithoutChaptersDataInput `json:"update,omitempty"`
-	Upsert  *BookUpsertWithoutChaptersInput     `json:"upsert,omitempty"`
-	Connect *BookWhereUniqueInput               `json:"connect,omitempty"`
+func (instance *ReminderExec) Firings(params *FiringsParamsExec) *ReminderFiringExecArray {
+	var wparams *prisma.WhereParams
+	if params != nil {
+		wparams = &prisma.WhereParams{
+			Where:   params.Where,
+			OrderBy: (*string)(params.OrderBy),
+			Skip:    params.Skip,
+			After:   params.After,
+			Before:  params.Before,
+			First:   params.First,
+			Last:    params.Last,
+		}
+	}
+
+	ret := instance.exec.Client.GetMany(
+		instance.exec,
+		wparams,
+		[3]string{"ReminderFiringWhereInput", "ReminderFiringOrderByInput", "ReminderFiring"},
+		"firings",
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
+
+	return &ReminderFiringExecArray{ret}
 }
 
-type ChapterCreateWithoutBookInput struct {
-	ID          *string `json:"id,omitempty"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
+func (instance ReminderExec) Exec(ctx context.Context) (*Reminder, error) {
+	var v Reminder
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
 }
 
-type ChapterWhereUniqueInput struct {
-	ID *string `json:"id,omitempty"`
+func (instance ReminderExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
 }
 
-type BookUpdateInput struct {
-	Name        *string                            `json:"name,omitempty"`
-	Description *string                            `json:"description,omitempty"`
-	Chapters    *ChapterUpdateManyWithoutBookInput `json:"chapters,omitempty"`
+type ReminderExecArray struct {
+	exec *prisma.Exec
 }
 
-type BookCreateOneWithoutChaptersInput struct {
-	Create  *BookCreateWithoutChaptersInput `json:"create,omitempty"`
-	Connect *BookWhereUniqueInput           `json:"connect,omitempty"`
+func (instance ReminderExecArray) Exec(ctx context.Context) ([]Reminder, error) {
+	var v []Reminder
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
 }
 
-type BookUpdateManyMutationInput struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
+type Reminder struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+	User        string  `json:"user"`
+	Message     string  `json:"message"`
+	Rule        string  `json:"rule"`
+	Timezone    string  `json:"timezone"`
+	Notifier    string  `json:"notifier"`
+	Target      string  `json:"target"`
+	Active      bool    `json:"active"`
+	NextFireAt  string  `json:"nextFireAt"`
+	LastFiredAt *string `json:"lastFiredAt,omitempty"`
 }
 
-type ChapterUpdateManyMutationInput struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
+type ReminderConnectionExec struct {
+	exec *prisma.Exec
 }
 
-type ChapterScalarWhereInput struct {
-	ID                       *string                   `json:"id,omitempty"`
-	IDNot                    *string                   `json:"id_not,omitempty"`
-	IDIn                     []string                  `json:"id_in,omitempty"`
-	IDNotIn                  []string                  `json:"id_not_in,omitempty"`
-	IDLt                     *string                   `json:"id_lt,omitempty"`
-	IDLte                    *string                   `json:"id_lte,omitempty"`
-	IDGt                     *string                   `json:"id_gt,omitempty"`
-	IDGte                    *string                   `json:"id_gte,omitempty"`
-	IDContains               *string                   `json:"id_contains,omitempty"`
-	IDNotContains            *string                   `json:"id_not_contains,omitempty"`
-	IDStartsWith             *string                   `json:"id_starts_with,omitempty"`
-	IDNotStartsWith          *string                   `json:"id_not_starts_with,omitempty"`
-	IDEndsWith               *string                   `json:"id_ends_with,omitempty"`
-	IDNotEndsWith            *string                   `json:"id_not_ends_with,omitempty"`
-	CreatedAt                *string                   `json:"createdAt,omitempty"`
-	CreatedAtNot             *string                   `json:"createdAt_not,omitempty"`
-	CreatedAtIn              []string                  `json:"createdAt_in,omitempty"`
-	CreatedAtNotIn           []string                  `json:"createdAt_not_in,omitempty"`
-	CreatedAtLt              *string                   `json:"createdAt_lt,omitempty"`
-	CreatedAtLte             *string                   `json:"createdAt_lte,omitempty"`
-	CreatedAtGt              *string                   `json:"createdAt_gt,omitempty"`
-	CreatedAtGte             *string                   `json:"createdAt_gte,omitempty"`
-	UpdatedAt                *string                   `json:"updatedAt,omitempty"`
-	UpdatedAtNot             *string                   `json:"updatedAt_not,omitempty"`
-	UpdatedAtIn              []string                  `json:"updatedAt_in,omitempty"`
-	UpdatedAtNotIn           []string                  `json:"updatedAt_not_in,omitempty"`
-	UpdatedAtLt              *string                   `json:"updatedAt_lt,omitempty"`
-	UpdatedAtLte             *string                   `json:"updatedAt_lte,omitempty"`
-	UpdatedAtGt              *string                   `json:"updatedAt_gt,omitempty"`
-	UpdatedAtGte             *string                   `json:"updatedAt_gte,omitempty"`
-	Name                     *string                   `json:"name,omitempty"`
-	NameNot                  *string                   `json:"name_not,omitempty"`
-	NameIn                   []string                  `json:"name_in,omitempty"`
-	NameNotIn                []string                  `json:"name_not_in,omitempty"`
-	NameLt                   *string                   `json:"name_lt,omitempty"`
-	NameLte                  *string                   `json:"name_lte,omitempty"`
-	NameGt                   *string                   `json:"name_gt,omitempty"`
-	NameGte                  *string                   `json:"name_gte,omitempty"`
-	NameContains             *string                   `json:"name_contains,omitempty"`
-	NameNotContains          *string                   `json:"name_not_contains,omitempty"`
-	NameStartsWith           *string                   `json:"name_starts_with,omitempty"`
-	NameNotStartsWith        *string                   `json:"name_not_starts_with,omitempty"`
-	NameEndsWith             *string                   `json:"name_ends_with,omitempty"`
-	NameNotEndsWith          *string                   `json:"name_not_ends_with,omitempty"`
-	Description              *string                   `json:"description,omitempty"`
-	DescriptionNot           *string                   `json:"description_not,omitempty"`
-	DescriptionIn            []string                  `json:"description_in,omitempty"`
-	DescriptionNotIn         []string                  `json:"description_not_in,omitempty"`
-	DescriptionLt            *string                   `json:"description_lt,omitempty"`
-	DescriptionLte           *string                   `json:"description_lte,omitempty"`
-	DescriptionGt            *string                   `json:"description_gt,omitempty"`
-	DescriptionGte           *string                   `json:"description_gte,omitempty"`
-	DescriptionContains      *string                   `json:"description_contains,omitempty"`
-	DescriptionNotContains   *string                   `json:"description_not_contains,omitempty"`
-	DescriptionStartsWith    *string                   `json:"description_starts_with,omitempty"`
-	DescriptionNotStartsWith *string                   `json:"description_not_starts_with,omitempty"`
-	DescriptionEndsWith      *string                   `json:"description_ends_with,omitempty"`
-	DescriptionNotEndsWith   *string                   `json:"description_not_ends_with,omitempty"`
-	And                      []ChapterScalarWhereInput `json:"AND,omitempty"`
-	Or                       []ChapterScalarWhereInput `json:"OR,omitempty"`
-	Not                      []ChapterScalarWhereInput `json:"NOT,omitempty"`
+func (instance *ReminderConnectionExec) PageInfo() *PageInfoExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
+
+	return &PageInfoExec{ret}
 }
 
-type ChapterUpdateManyDataInput struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
+func (instance *ReminderConnectionExec) Edges() *ReminderEdgeExec {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "ReminderEdge"},
+		"edges",
+		[]string{"cursor"})
+
+	return &ReminderEdgeExec{ret}
 }
 
-type BookWhereInput struct {
-	ID                       *string            `json:"id,omitempty"`
-	IDNot                    *string            `json:"id_not,omitempty"`
-	IDIn                     []string           `json:"id_in,omitempty"`
-	IDNotIn                  []string           `json:"id_not_in,omitempty"`
-	IDLt                     *string            `json:"id_lt,omitempty"`
-	IDLte                    *string            `json:"id_lte,omitempty"`
-	IDGt                     *string            `json:"id_gt,omitempty"`
-	IDGte                    *string            `json:"id_gte,omitempty"`
-	IDContains               *string            `json:"id_contains,omitempty"`
-	IDNotContains            *string            `json:"id_not_contains,omitempty"`
-	IDStartsWith             *string            `json:"id_starts_with,omitempty"`
-	IDNotStartsWith          *string            `json:"id_not_starts_with,omitempty"`
-	IDEndsWith               *string            `json:"id_ends_with,omitempty"`
-	IDNotEndsWith            *string            `json:"id_not_ends_with,omitempty"`
-	CreatedAt                *string            `json:"createdAt,omitempty"`
-	CreatedAtNot             *string            `json:"createdAt_not,omitempty"`
-	CreatedAtIn              []string           `json:"createdAt_in,omitempty"`
-	CreatedAtNotIn           []string           `json:"createdAt_not_in,omitempty"`
-	CreatedAtLt              *string            `json:"createdAt_lt,omitempty"`
-	CreatedAtLte             *string            `json:"createdAt_lte,omitempty"`
-	CreatedAtGt              *string            `json:"createdAt_gt,omitempty"`
-	CreatedAtGte             *string            `json:"createdAt_gte,omitempty"`
-	UpdatedAt                *string            `json:"updatedAt,omitempty"`
-	UpdatedAtNot             *string            `json:"updatedAt_not,omitempty"`
-	UpdatedAtIn              []string           `json:"updatedAt_in,omitempty"`
-	UpdatedAtNotIn           []string           `json:"updatedAt_not_in,omitempty"`
-	UpdatedAtLt              *string            `json:"updatedAt_lt,omitempty"`
-	UpdatedAtLte             *string            `json:"updatedAt_lte,omitempty"`
-	UpdatedAtGt              *string            `json:"updatedAt_gt,omitempty"`
-	UpdatedAtGte             *string            `json:"updatedAt_gte,omitempty"`
-	Name                     *string            `json:"name,omitempty"`
-	NameNot                  *string            `json:"name_not,omitempty"`
-	NameIn                   []string           `json:"name_in,omitempty"`
-	NameNotIn                []string           `json:"name_not_in,omitempty"`
-	NameLt                   *string            `json:"name_lt,omitempty"`
-	NameLte                  *string            `json:"name_lte,omitempty"`
-	NameGt                   *string            `json:"name_gt,omitempty"`
-	NameGte                  *string            `json:"name_gte,omitempty"`
-	NameContains             *string            `json:"name_contains,omitempty"`
-	NameNotContains          *string            `json:"name_not_contains,omitempty"`
-	NameStartsWith           *string            `json:"name_starts_with,omitempty"`
-	NameNotStartsWith        *string            `json:"name_not_starts_with,omitempty"`
-	NameEndsWith             *string            `json:"name_ends_with,omitempty"`
-	NameNotEndsWith          *string            `json:"name_not_ends_with,omitempty"`
-	Description              *string            `json:"description,omitempty"`
-	DescriptionNot           *string            `json:"description_not,omitempty"`
-	DescriptionIn            []string           `json:"description_in,omitempty"`
-	DescriptionNotIn         []string           `json:"description_not_in,omitempty"`
-	DescriptionLt            *string            `json:"description_lt,omitempty"`
-	DescriptionLte           *string            `json:"description_lte,omitempty"`
-	DescriptionGt            *string            `json:"description_gt,omitempty"`
-	DescriptionGte           *string            `json:"description_gte,omitempty"`
-	DescriptionContains      *string            `json:"description_contains,omitempty"`
-	DescriptionNotContains   *string            `json:"description_not_contains,omitempty"`
-	DescriptionStartsWith    *string            `json:"description_starts_with,omitempty"`
-	DescriptionNotStartsWith *string            `json:"description_not_starts_with,omitempty"`
-	DescriptionEndsWith      *string            `json:"description_ends_with,omitempty"`
-	DescriptionNotEndsWith   *string            `json:"description_not_ends_with,omitempty"`
-	ChaptersEvery            *ChapterWhereInput `json:"chapters_every,omitempty"`
-	ChaptersSome             *ChapterWhereInput `json:"chapters_some,omitempty"`
-	ChaptersNone             *ChapterWhereInput `json:"chapters_none,omitempty"`
-	And                      []BookWhereInput   `json:"AND,omitempty"`
-	Or                       []BookWhereInput   `json:"OR,omitempty"`
-	Not                      []BookWhereInput   `json:"NOT,omitempty"`
+func (instance *ReminderConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateReminder"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
 }
 
-type ChapterUpdateWithWhereUniqueWithoutBookInput struct {
-	Where ChapterWhereUniqueInput           `json:"where"`
-	Data  ChapterUpdateWithoutBookDataInput `json:"data"`
+func (instance ReminderConnectionExec) Exec(ctx context.Context) (*ReminderConnection, error) {
+	var v ReminderConnection
+	ok, err := instance.exec.Exec(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoResult
+	}
+	return &v, nil
 }
 
-type BookUpdateWithoutChaptersDataInput struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
+func (instance ReminderConnectionExec) Exists(ctx context.Context) (bool, error) {
+	return instance.exec.Exists(ctx)
 }
 
-type ChapterSubscriptionWhereInput struct {
-	MutationIn                 []MutationType                  `json:"mutation_in,omitempty"`
-	UpdatedFieldsContains      *string                         `json:"updatedFields_contains,omitempty"`
-	UpdatedFieldsContainsEvery []string                        `json:"updatedFields_contains_every,omitempty"`
-	UpdatedFieldsContainsSome  []string                        `json:"updatedFields_contains_some,omitempty"`
-	Node                       *ChapterWhereInput              `json:"node,omitempty"`
-	And                        []ChapterSubscriptionWhereInput `json:"AND,omitempty"`
-	Or                         []ChapterSubscriptionWhereInput `json:"OR,omitempty"`
-	Not                        []ChapterSubscriptionWhereInput `json:"NOT,omitempty"`
+type ReminderConnectionExecArray struct {
+	exec *prisma.Exec
 }
 
-type BookCreateWithoutChaptersInput struct {
-	ID          *string `json:"id,omitempty"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
+func (instance ReminderConnectionExecArray) Exec(ctx context.Context) ([]ReminderConnection, error) {
+	var v []ReminderConnection
+	err := instance.exec.ExecArray(ctx, &v)
+	return v, err
 }
 
-type ChapterUpdateInput struct {
-	Name        *string                                    `json:"name,omitempty"`
-	Description *string                                    `json:"description,omitempty"`
-	Book        *BookUpdateOneRequiredWithoutChaptersInput `json:"book,omitempty"`
+type ReminderConnection struct {
 }
 
-type ChapterPreviousValuesExec struct {
+type ReminderFiringPreviousValuesExec struct {
 	exec *prisma.Exec
 }
-
-func (instance ChapterPreviousValuesExec) Exec(ctx context.Context) (*ChapterPreviousValues, error) {
-	var v ChapterPreviousValues
+
+func (instance ReminderFiringPreviousValuesExec) Exec(ctx context.Context) (*ReminderFiringPreviousValues, error) {
+	var v ReminderFiringPreviousValues
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -734,45 +14709,48 @@ func (instance ChapterPreviousValuesExec) Exec(ctx context.Context) (*ChapterPre
 	return &v, nil
 }
 
-func (instance ChapterPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+func (instance ReminderFiringPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type ChapterPreviousValuesExecArray struct {
+type ReminderFiringPreviousValuesExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance ChapterPreviousValuesExecArray) Exec(ctx context.Context) ([]ChapterPreviousValues, error) {
-	var v []ChapterPreviousValues
+func (instance ReminderFiringPreviousValuesExecArray) Exec(ctx context.Context) ([]ReminderFiringPreviousValues, error) {
+	var v []ReminderFiringPreviousValues
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type ChapterPreviousValues struct {
-	ID          string `json:"id"`
-	CreatedAt   string `json:"createdAt"`
-	UpdatedAt   string `json:"updatedAt"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+type ReminderFiringPreviousValues struct {
+	ID          string       `json:"id"`
+	CreatedAt   string       `json:"createdAt"`
+	UpdatedAt   string       `json:"updatedAt"`
+	Key         string       `json:"key"`
+	ScheduledAt string       `json:"scheduledAt"`
+	Status      FiringStatus `json:"status"`
+	Attempts    int32        `json:"attempts"`
+	LastError   *string      `json:"lastError,omitempty"`
 }
 
-type BookEdgeExec struct {
+type ReminderFiringEdgeExec struct {
 	exec *prisma.Exec
 }
 
-func (instance *BookEdgeExec) Node() *BookExec {
+func (instance *ReminderFiringEdgeExec) Node() *ReminderFiringExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "Book"},
+		[2]string{"", "ReminderFiring"},
 		"node",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
 
-	return &BookExec{ret}
+	return &ReminderFiringExec{ret}
 }
 
-func (instance BookEdgeExec) Exec(ctx context.Context) (*BookEdge, error) {
-	var v BookEdge
+func (instance ReminderFiringEdgeExec) Exec(ctx context.Context) (*ReminderFiringEdge, error) {
+	var v ReminderFiringEdge
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -783,52 +14761,52 @@ func (instance BookEdgeExec) Exec(ctx context.Context) (*BookEdge, error) {
 	return &v, nil
 }
 
-func (instance BookEdgeExec) Exists(ctx context.Context) (bool, error) {
+func (instance ReminderFiringEdgeExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type BookEdgeExecArray struct {
+type ReminderFiringEdgeExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance BookEdgeExecArray) Exec(ctx context.Context) ([]BookEdge, error) {
-	var v []BookEdge
+func (instance ReminderFiringEdgeExecArray) Exec(ctx context.Context) ([]ReminderFiringEdge, error) {
+	var v []ReminderFiringEdge
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type BookEdge struct {
+type ReminderFiringEdge struct {
 	Cursor string `json:"cursor"`
 }
 
-type BookSubscriptionPayloadExec struct {
+type ReminderFiringSubscriptionPayloadExec struct {
 	exec *prisma.Exec
 }
 
-func (instance *BookSubscriptionPayloadExec) Node() *BookExec {
+func (instance *ReminderFiringSubscriptionPayloadExec) Node() *ReminderFiringExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "Book"},
+		[2]string{"", "ReminderFiring"},
 		"node",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
 
-	return &BookExec{ret}
+	return &ReminderFiringExec{ret}
 }
 
-func (instance *BookSubscriptionPayloadExec) PreviousValues() *BookPreviousValuesExec {
+func (instance *ReminderFiringSubscriptionPayloadExec) PreviousValues() *ReminderFiringPreviousValuesExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "BookPreviousValues"},
+		[2]string{"", "ReminderFiringPreviousValues"},
 		"previousValues",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[]string{"id", "createdAt", "updatedAt", "key", "scheduledAt", "status", "attempts", "lastError"})
 
-	return &BookPreviousValuesExec{ret}
+	return &ReminderFiringPreviousValuesExec{ret}
 }
 
-func (instance BookSubscriptionPayloadExec) Exec(ctx context.Context) (*BookSubscriptionPayload, error) {
-	var v BookSubscriptionPayload
+func (instance ReminderFiringSubscriptionPayloadExec) Exec(ctx context.Context) (*ReminderFiringSubscriptionPayload, error) {
+	var v ReminderFiringSubscriptionPayload
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -839,42 +14817,42 @@ func (instance BookSubscriptionPayloadExec) Exec(ctx context.Context) (*BookSubs
 	return &v, nil
 }
 
-func (instance BookSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+func (instance ReminderFiringSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type BookSubscriptionPayloadExecArray struct {
+type ReminderFiringSubscriptionPayloadExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance BookSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]BookSubscriptionPayload, error) {
-	var v []BookSubscriptionPayload
+func (instance ReminderFiringSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]ReminderFiringSubscriptionPayload, error) {
+	var v []ReminderFiringSubscriptionPayload
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type BookSubscriptionPayload struct {
+type ReminderFiringSubscriptionPayload struct {
 	Mutation      MutationType `json:"mutation"`
 	UpdatedFields []string     `json:"updatedFields,omitempty"`
 }
 
-type ChapterExec struct {
+type ReminderFiringExec struct {
 	exec *prisma.Exec
 }
 
-func (instance *ChapterExec) Book() *BookExec {
+func (instance *ReminderFiringExec) Reminder() *ReminderExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "Book"},
-		"book",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[2]string{"", "Reminder"},
+		"reminder",
+		[]string{"id", "createdAt", "updatedAt", "user", "message", "rule", "timezone", "notifier", "target", "active", "nextFireAt", "lastFiredAt"})
 
-	return &BookExec{ret}
+	return &ReminderExec{ret}
 }
 
-func (instance ChapterExec) Exec(ctx context.Context) (*Chapter, error) {
-	var v Chapter
+func (instance ReminderFiringExec) Exec(ctx context.Context) (*ReminderFiring, error) {
+	var v ReminderFiring
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -885,56 +14863,72 @@ func (instance ChapterExec) Exec(ctx context.Context) (*Chapter, error) {
 	return &v, nil
 }
 
-func (instance ChapterExec) Exists(ctx context.Context) (bool, error) {
+func (instance ReminderFiringExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type ChapterExecArray struct {
+type ReminderFiringExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance ChapterExecArray) Exec(ctx context.Context) ([]Chapter, error) {
-	var v []Chapter
+func (instance ReminderFiringExecArray) Exec(ctx context.Context) ([]ReminderFiring, error) {
+	var v []ReminderFiring
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type Chapter struct {
-	ID          string `json:"id"`
-	CreatedAt   string `json:"createdAt"`
-	UpdatedAt   string `json:"updatedAt"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+type ReminderFiring struct {
+	ID          string       `json:"id"`
+	CreatedAt   string       `json:"createdAt"`
+	UpdatedAt   string       `json:"updatedAt"`
+	Key         string       `json:"key"`
+	ScheduledAt string       `json:"scheduledAt"`
+	Status      FiringStatus `json:"status"`
+	Attempts    int32        `json:"attempts"`
+	LastError   *string      `json:"lastError,omitempty"`
 }
 
-type ChapterSubscriptionPayloadExec struct {
+type ReminderFiringConnectionExec struct {
 	exec *prisma.Exec
 }
 
-func (instance *ChapterSubscriptionPayloadExec) Node() *ChapterExec {
+func (instance *ReminderFiringConnectionExec) PageInfo() *PageInfoExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "Chapter"},
-		"node",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[2]string{"", "PageInfo"},
+		"pageInfo",
+		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
 
-	return &ChapterExec{ret}
+	return &PageInfoExec{ret}
 }
 
-func (instance *ChapterSubscriptionPayloadExec) PreviousValues() *ChapterPreviousValuesExec {
+func (instance *ReminderFiringConnectionExec) Edges() *ReminderFiringEdgeExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "ChapterPreviousValues"},
-		"previousValues",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		[2]string{"", "ReminderFiringEdge"},
+		"edges",
+		[]string{"cursor"})
 
-	return &ChapterPreviousValuesExec{ret}
+	return &ReminderFiringEdgeExec{ret}
 }
 
-func (instance ChapterSubscriptionPayloadExec) Exec(ctx context.Context) (*ChapterSubscriptionPayload, error) {
-	var v ChapterSubscriptionPayload
+func (instance *ReminderFiringConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+	ret := instance.exec.Client.GetOne(
+		instance.exec,
+		nil,
+		[2]string{"", "AggregateReminderFiring"},
+		"aggregate",
+		[]string{"count"})
+
+	var v Aggregate
+	_, err := ret.Exec(ctx, &v)
+	return v, err
+}
+
+func (instance ReminderFiringConnectionExec) Exec(ctx context.Context) (*ReminderFiringConnection, error) {
+	var v ReminderFiringConnection
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -945,31 +14939,29 @@ func (instance ChapterSubscriptionPayloadExec) Exec(ctx context.Context) (*Chapt
 	return &v, nil
 }
 
-func (instance ChapterSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
+func (instance ReminderFiringConnectionExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type ChapterSubscriptionPayloadExecArray struct {
+type ReminderFiringConnectionExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance ChapterSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]ChapterSubscriptionPayload, error) {
-	var v []ChapterSubscriptionPayload
+func (instance ReminderFiringConnectionExecArray) Exec(ctx context.Context) ([]ReminderFiringConnection, error) {
+	var v []ReminderFiringConnection
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type ChapterSubscriptionPayload struct {
-	Mutation      MutationType `json:"mutation"`
-	UpdatedFields []string     `json:"updatedFields,omitempty"`
+type ReminderFiringConnection struct {
 }
 
-type BookPreviousValuesExec struct {
+type CalendarFeedPreviousValuesExec struct {
 	exec *prisma.Exec
 }
 
-func (instance BookPreviousValuesExec) Exec(ctx context.Context) (*BookPreviousValues, error) {
-	var v BookPreviousValues
+func (instance CalendarFeedPreviousValuesExec) Exec(ctx context.Context) (*CalendarFeedPreviousValues, error) {
+	var v CalendarFeedPreviousValues
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -980,68 +14972,45 @@ func (instance BookPreviousValuesExec) Exec(ctx context.Context) (*BookPreviousV
 	return &v, nil
 }
 
-func (instance BookPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
+func (instance CalendarFeedPreviousValuesExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type BookPreviousValuesExecArray struct {
+type CalendarFeedPreviousValuesExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance BookPreviousValuesExecArray) Exec(ctx context.Context) ([]BookPreviousValues, error) {
-	var v []BookPreviousValues
+func (instance CalendarFeedPreviousValuesExecArray) Exec(ctx context.Context) ([]CalendarFeedPreviousValues, error) {
+	var v []CalendarFeedPreviousValues
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type BookPreviousValues struct {
-	ID          string `json:"id"`
-	CreatedAt   string `json:"createdAt"`
-	UpdatedAt   string `json:"updatedAt"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+type CalendarFeedPreviousValues struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	User      string `json:"user"`
+	TokenHash string `json:"tokenHash"`
 }
 
-type BookExec struct {
+type CalendarFeedEdgeExec struct {
 	exec *prisma.Exec
 }
 
-type ChaptersParamsExec struct {
-	Where   *ChapterWhereInput
-	OrderBy *ChapterOrderByInput
-	Skip    *int32
-	After   *string
-	Before  *string
-	First   *int32
-	Last    *int32
-}
-
-func (instance *BookExec) Chapters(params *ChaptersParamsExec) *ChapterExecArray {
-	var wparams *prisma.WhereParams
-	if params != nil {
-		wparams = &prisma.WhereParams{
-			Where:   params.Where,
-			OrderBy: (*string)(params.OrderBy),
-			Skip:    params.Skip,
-			After:   params.After,
-			Before:  params.Before,
-			First:   params.First,
-			Last:    params.Last,
-		}
-	}
-
-	ret := instance.exec.Client.GetMany(
+func (instance *CalendarFeedEdgeExec) Node() *CalendarFeedExec {
+	ret := instance.exec.Client.GetOne(
 		instance.exec,
-		wparams,
-		[3]string{"ChapterWhereInput", "ChapterOrderByInput", "Chapter"},
-		"chapters",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
+		nil,
+		[2]string{"", "CalendarFeed"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
 
-	return &ChapterExecArray{ret}
+	return &CalendarFeedExec{ret}
 }
 
-func (instance BookExec) Exec(ctx context.Context) (*Book, error) {
-	var v Book
+func (instance CalendarFeedEdgeExec) Exec(ctx context.Context) (*CalendarFeedEdge, error) {
+	var v CalendarFeedEdge
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -1052,69 +15021,52 @@ func (instance BookExec) Exec(ctx context.Context) (*Book, error) {
 	return &v, nil
 }
 
-func (instance BookExec) Exists(ctx context.Context) (bool, error) {
+func (instance CalendarFeedEdgeExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type BookExecArray struct {
+type CalendarFeedEdgeExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance BookExecArray) Exec(ctx context.Context) ([]Book, error) {
-	var v []Book
+func (instance CalendarFeedEdgeExecArray) Exec(ctx context.Context) ([]CalendarFeedEdge, error) {
+	var v []CalendarFeedEdge
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type Book struct {
-	ID          string `json:"id"`
-	CreatedAt   string `json:"createdAt"`
-	UpdatedAt   string `json:"updatedAt"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+type CalendarFeedEdge struct {
+	Cursor string `json:"cursor"`
 }
 
-type BookConnectionExec struct {
+type CalendarFeedSubscriptionPayloadExec struct {
 	exec *prisma.Exec
 }
 
-func (instance *BookConnectionExec) PageInfo() *PageInfoExec {
-	ret := instance.exec.Client.GetOne(
-		instance.exec,
-		nil,
-		[2]string{"", "PageInfo"},
-		"pageInfo",
-		[]string{"hasNextPage", "hasPreviousPage", "startCursor", "endCursor"})
-
-	return &PageInfoExec{ret}
-}
-
-func (instance *BookConnectionExec) Edges() *BookEdgeExec {
+func (instance *CalendarFeedSubscriptionPayloadExec) Node() *CalendarFeedExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "BookEdge"},
-		"edges",
-		[]string{"cursor"})
+		[2]string{"", "CalendarFeed"},
+		"node",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
 
-	return &BookEdgeExec{ret}
+	return &CalendarFeedExec{ret}
 }
 
-func (instance *BookConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+func (instance *CalendarFeedSubscriptionPayloadExec) PreviousValues() *CalendarFeedPreviousValuesExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "AggregateBook"},
-		"aggregate",
-		[]string{"count"})
+		[2]string{"", "CalendarFeedPreviousValues"},
+		"previousValues",
+		[]string{"id", "createdAt", "updatedAt", "user", "tokenHash"})
 
-	var v Aggregate
-	_, err := ret.Exec(ctx, &v)
-	return v, err
+	return &CalendarFeedPreviousValuesExec{ret}
 }
 
-func (instance BookConnectionExec) Exec(ctx context.Context) (*BookConnection, error) {
-	var v BookConnection
+func (instance CalendarFeedSubscriptionPayloadExec) Exec(ctx context.Context) (*CalendarFeedSubscriptionPayload, error) {
+	var v CalendarFeedSubscriptionPayload
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -1125,29 +15077,31 @@ func (instance BookConnectionExec) Exec(ctx context.Context) (*BookConnection, e
 	return &v, nil
 }
 
-func (instance BookConnectionExec) Exists(ctx context.Context) (bool, error) {
+func (instance CalendarFeedSubscriptionPayloadExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type BookConnectionExecArray struct {
+type CalendarFeedSubscriptionPayloadExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance BookConnectionExecArray) Exec(ctx context.Context) ([]BookConnection, error) {
-	var v []BookConnection
+func (instance CalendarFeedSubscriptionPayloadExecArray) Exec(ctx context.Context) ([]CalendarFeedSubscriptionPayload, error) {
+	var v []CalendarFeedSubscriptionPayload
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type BookConnection struct {
+type CalendarFeedSubscriptionPayload struct {
+	Mutation      MutationType `json:"mutation"`
+	UpdatedFields []string     `json:"updatedFields,omitempty"`
 }
 
-type PageInfoExec struct {
+type CalendarFeedExec struct {
 	exec *prisma.Exec
 }
 
-func (instance PageInfoExec) Exec(ctx context.Context) (*PageInfo, error) {
-	var v PageInfo
+func (instance CalendarFeedExec) Exec(ctx context.Context) (*CalendarFeed, error) {
+	var v CalendarFeed
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -1158,32 +15112,33 @@ func (instance PageInfoExec) Exec(ctx context.Context) (*PageInfo, error) {
 	return &v, nil
 }
 
-func (instance PageInfoExec) Exists(ctx context.Context) (bool, error) {
+func (instance CalendarFeedExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type PageInfoExecArray struct {
+type CalendarFeedExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance PageInfoExecArray) Exec(ctx context.Context) ([]PageInfo, error) {
-	var v []PageInfo
+func (instance CalendarFeedExecArray) Exec(ctx context.Context) ([]CalendarFeed, error) {
+	var v []CalendarFeed
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type PageInfo struct {
-	HasNextPage     bool    `json:"hasNextPage"`
-	HasPreviousPage bool    `json:"hasPreviousPage"`
-	StartCursor     *string `json:"startCursor,omitempty"`
-	EndCursor       *string `json:"endCursor,omitempty"`
+type CalendarFeed struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	User      string `json:"user"`
+	TokenHash string `json:"tokenHash"`
 }
 
-type ChapterConnectionExec struct {
+type CalendarFeedConnectionExec struct {
 	exec *prisma.Exec
 }
 
-func (instance *ChapterConnectionExec) PageInfo() *PageInfoExec {
+func (instance *CalendarFeedConnectionExec) PageInfo() *PageInfoExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
@@ -1194,22 +15149,22 @@ func (instance *ChapterConnectionExec) PageInfo() *PageInfoExec {
 	return &PageInfoExec{ret}
 }
 
-func (instance *ChapterConnectionExec) Edges() *ChapterEdgeExec {
+func (instance *CalendarFeedConnectionExec) Edges() *CalendarFeedEdgeExec {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "ChapterEdge"},
+		[2]string{"", "CalendarFeedEdge"},
 		"edges",
 		[]string{"cursor"})
 
-	return &ChapterEdgeExec{ret}
+	return &CalendarFeedEdgeExec{ret}
 }
 
-func (instance *ChapterConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
+func (instance *CalendarFeedConnectionExec) Aggregate(ctx context.Context) (Aggregate, error) {
 	ret := instance.exec.Client.GetOne(
 		instance.exec,
 		nil,
-		[2]string{"", "AggregateChapter"},
+		[2]string{"", "AggregateCalendarFeed"},
 		"aggregate",
 		[]string{"count"})
 
@@ -1218,52 +15173,8 @@ func (instance *ChapterConnectionExec) Aggregate(ctx context.Context) (Aggregate
 	return v, err
 }
 
-func (instance ChapterConnectionExec) Exec(ctx context.Context) (*ChapterConnection, error) {
-	var v ChapterConnection
-	ok, err := instance.exec.Exec(ctx, &v)
-	if err != nil {
-		return nil, err
-	}
-	if !ok {
-		return nil, ErrNoResult
-	}
-	return &v, nil
-}
-
-func (instance ChapterConnectionExec) Exists(ctx context.Context) (bool, error) {
-	return instance.exec.Exists(ctx)
-}
-
-type ChapterConnectionExecArray struct {
-	exec *prisma.Exec
-}
-
-func (instance ChapterConnectionExecArray) Exec(ctx context.Context) ([]ChapterConnection, error) {
-	var v []ChapterConnection
-	err := instance.exec.ExecArray(ctx, &v)
-	return v, err
-}
-
-type ChapterConnection struct {
-}
-
-type ChapterEdgeExec struct {
-	exec *prisma.Exec
-}
-
-func (instance *ChapterEdgeExec) Node() *ChapterExec {
-	ret := instance.exec.Client.GetOne(
-		instance.exec,
-		nil,
-		[2]string{"", "Chapter"},
-		"node",
-		[]string{"id", "createdAt", "updatedAt", "name", "description"})
-
-	return &ChapterExec{ret}
-}
-
-func (instance ChapterEdgeExec) Exec(ctx context.Context) (*ChapterEdge, error) {
-	var v ChapterEdge
+func (instance CalendarFeedConnectionExec) Exec(ctx context.Context) (*CalendarFeedConnection, error) {
+	var v CalendarFeedConnection
 	ok, err := instance.exec.Exec(ctx, &v)
 	if err != nil {
 		return nil, err
@@ -1274,20 +15185,19 @@ func (instance ChapterEdgeExec) Exec(ctx context.Context) (*ChapterEdge, error)
 	return &v, nil
 }
 
-func (instance ChapterEdgeExec) Exists(ctx context.Context) (bool, error) {
+func (instance CalendarFeedConnectionExec) Exists(ctx context.Context) (bool, error) {
 	return instance.exec.Exists(ctx)
 }
 
-type ChapterEdgeExecArray struct {
+type CalendarFeedConnectionExecArray struct {
 	exec *prisma.Exec
 }
 
-func (instance ChapterEdgeExecArray) Exec(ctx context.Context) ([]ChapterEdge, error) {
-	var v []ChapterEdge
+func (instance CalendarFeedConnectionExecArray) Exec(ctx context.Context) ([]CalendarFeedConnection, error) {
+	var v []CalendarFeedConnection
 	err := instance.exec.ExecArray(ctx, &v)
 	return v, err
 }
 
-type ChapterEdge struct {
-	Cursor string `json:"cursor"`
+type CalendarFeedConnection struct {
 }
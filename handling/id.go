@@ -0,0 +1,59 @@
+package handling
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// newID returns a collision-resistant ID in the cuid format Prisma uses for
+// ID fields. IDs are generated up front when the ID has to be known before
+// the entity is written, e.g. to reference it from an outbox event created
+// in the same mutation.
+func newID() string {
+	var b strings.Builder
+	b.Grow(25)
+	b.WriteByte('c')
+	b.WriteString(pad(strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 36), 8))
+	b.WriteString(pad(strconv.FormatUint(uint64(atomic.AddUint32(&idCounter, 1)%idBlock), 36), 4))
+	b.WriteString(idFingerprint)
+	b.WriteString(pad(strconv.FormatUint(randomUint64()%idBlock, 36), 4))
+	b.WriteString(pad(strconv.FormatUint(randomUint64()%idBlock, 36), 4))
+	return b.String()
+}
+
+// idBlock is the number of values a block of four base 36 digits holds.
+const idBlock = 36 * 36 * 36 * 36
+
+var (
+	idCounter     uint32
+	idFingerprint = fingerprint()
+)
+
+func fingerprint() string {
+	host, _ := os.Hostname()
+	h := fnv.New32a()
+	h.Write([]byte(host))
+	pid := strconv.FormatInt(int64(os.Getpid())%(36*36), 36)
+	return pad(pid, 2) + pad(strconv.FormatUint(uint64(h.Sum32()%(36*36)), 36), 2)
+}
+
+func randomUint64() uint64 {
+	var buf [8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return uint64(time.Now().UnixNano())
+	}
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+func pad(s string, n int) string {
+	if len(s) >= n {
+		return s[len(s)-n:]
+	}
+	return strings.Repeat("0", n-len(s)) + s
+}
@@ -0,0 +1,188 @@
+package handling
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// HeaderIdempotencyKey is the header clients set to make a create request
+// safe to retry.
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+const (
+	// idempotencyTTL is how long a response is kept for replay.
+	idempotencyTTL          = 24 * time.Hour
+	idempotencyStoreTimeout = 10 * time.Second
+
+	// maxIdempotentBody is the largest body read to be compared with the
+	// one of the first request. No create request takes a larger body
+	// than a Markdown import.
+	maxIdempotentBody = MaxImportSize
+)
+
+var (
+	errIdempotencyKeyReused = errors.New("idempotency key reused with a different request")
+	errIdempotencyKeyInUse  = errors.New("request with the same idempotency key in progress")
+	errRequestTooLarge      = errors.New("request body too large")
+)
+
+// idempotent makes h honor the Idempotency-Key header. The first response
+// to a key is stored and replayed for retries with the same key and body.
+// Keys are scoped to the actor, method and path. A retry with a different
+// body fails with 422, and a retry while the first request is still in
+// progress with 409. Server errors are not stored, so they can be retried.
+// Bodies larger than maxIdempotentBody are rejected with 413.
+func idempotent(h http.Handler, logger kitlog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(HeaderIdempotencyKey)
+		if key == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxIdempotentBody+1))
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+		if len(body) > maxIdempotentBody {
+			encodeError(ctx, errRequestTooLarge, w)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		scoped := idempotencyHash(r.Header.Get(HeaderActor), r.Method, r.URL.Path, key)
+		requestHash := idempotencyHash(string(body))
+
+		record, err := client.IdempotencyKey(prisma.IdempotencyKeyWhereUniqueInput{
+			Key: &scoped,
+		}).Exec(ctx)
+
+		if err == nil && record.ExpiresAt < formatTime(time.Now()) {
+			client.DeleteIdempotencyKey(prisma.IdempotencyKeyWhereUniqueInput{Key: &scoped}).Exec(ctx)
+			err = prisma.ErrNoResult
+		}
+
+		switch {
+		case err == prisma.ErrNoResult:
+		case err != nil:
+			encodeError(ctx, err, w)
+			return
+		case record.RequestHash != requestHash:
+			encodeError(ctx, errIdempotencyKeyReused, w)
+			return
+		case record.StatusCode == nil:
+			encodeError(ctx, errIdempotencyKeyInUse, w)
+			return
+		default:
+			replay(w, *record)
+			return
+		}
+
+		// The key is unique, so of concurrent first requests only one
+		// gets to claim it.
+		_, err = client.CreateIdempotencyKey(prisma.IdempotencyKeyCreateInput{
+			Key:         scoped,
+			RequestHash: requestHash,
+			ExpiresAt:   formatTime(time.Now().Add(idempotencyTTL)),
+		}).Exec(ctx)
+
+		if err != nil {
+			if _, lerr := client.IdempotencyKey(prisma.IdempotencyKeyWhereUniqueInput{Key: &scoped}).Exec(ctx); lerr == nil {
+				err = errIdempotencyKeyInUse
+			}
+			encodeError(ctx, err, w)
+			return
+		}
+
+		rec := &responseRecorder{header: http.Header{}, statusCode: http.StatusOK}
+		h.ServeHTTP(rec, r)
+		rec.writeTo(w)
+
+		// The outcome is stored even if the client has gone away, as it is
+		// the one most likely to retry.
+		ctx, cancel := context.WithTimeout(context.Background(), idempotencyStoreTimeout)
+		defer cancel()
+
+		if rec.statusCode >= http.StatusInternalServerError {
+			_, err = client.DeleteIdempotencyKey(prisma.IdempotencyKeyWhereUniqueInput{Key: &scoped}).Exec(ctx)
+		} else {
+			statusCode := int32(rec.statusCode)
+			_, err = client.UpdateIdempotencyKey(prisma.IdempotencyKeyUpdateParams{
+				Where: prisma.IdempotencyKeyWhereUniqueInput{Key: &scoped},
+				Data: prisma.IdempotencyKeyUpdateInput{
+					StatusCode:  &statusCode,
+					ContentType: prisma.Str(rec.header.Get("Content-Type")),
+					Etag:        prisma.Str(rec.header.Get("ETag")),
+					Body:        prisma.Str(rec.body.String()),
+				},
+			}).Exec(ctx)
+		}
+
+		if err != nil {
+			logger.Log("idempotency_key", key, "err", err)
+		}
+
+		// Expired keys are swept whenever a new one is stored.
+		client.DeleteManyIdempotencyKeys(&prisma.IdempotencyKeyWhereInput{
+			ExpiresAtLt: prisma.Str(formatTime(time.Now())),
+		}).Exec(ctx)
+	})
+}
+
+func replay(w http.ResponseWriter, record prisma.IdempotencyKey) {
+	if record.ContentType != nil && *record.ContentType != "" {
+		w.Header().Set("Content-Type", *record.ContentType)
+	}
+	if record.Etag != nil && *record.Etag != "" {
+		w.Header().Set("ETag", *record.Etag)
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(int(*record.StatusCode))
+	if record.Body != nil {
+		w.Write([]byte(*record.Body))
+	}
+}
+
+func idempotencyHash(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder buffers a response so that it can be stored before it
+// is written out.
+type responseRecorder struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header { return r.header }
+
+func (r *responseRecorder) Write(b []byte) (int, error) { return r.body.Write(b) }
+
+func (r *responseRecorder) WriteHeader(statusCode int) { r.statusCode = statusCode }
+
+func (r *responseRecorder) writeTo(w http.ResponseWriter) {
+	for k, v := range r.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(r.statusCode)
+	w.Write(r.body.Bytes())
+}
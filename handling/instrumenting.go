@@ -23,13 +23,13 @@ func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram,
 	}
 }
 
-func (s *instrumentingService) AddBook(ctx context.Context, name string, description string) (prisma.Book, error) {
+func (s *instrumentingService) AddBook(ctx context.Context, name string, description string, chapters []NewChapter) (prisma.Book, []prisma.Chapter, error) {
 	defer func(begin time.Time) {
 		s.requestCount.With("method", "add_book").Add(1)
 		s.requestLatency.With("method", "add_book").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return s.Service.AddBook(ctx, name, description)
+	return s.Service.AddBook(ctx, name, description, chapters)
 }
 
 func (s *instrumentingService) GetBook(ctx context.Context, id string) (prisma.Book, error) {
@@ -41,22 +41,22 @@ func (s *instrumentingService) GetBook(ctx context.Context, id string) (prisma.B
 	return s.Service.GetBook(ctx, id)
 }
 
-func (s *instrumentingService) DeleteBook(ctx context.Context, id string) (prisma.Book, error) {
+func (s *instrumentingService) DeleteBook(ctx context.Context, id string, version int32) (prisma.Book, error) {
 	defer func(begin time.Time) {
 		s.requestCount.With("method", "delete_book").Add(1)
 		s.requestLatency.With("method", "delete_book").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return s.Service.DeleteBook(ctx, id)
+	return s.Service.DeleteBook(ctx, id, version)
 }
 
-func (s *instrumentingService) Books(ctx context.Context) ([]prisma.Book, error) {
+func (s *instrumentingService) Books(ctx context.Context, filter BookFilter) ([]prisma.Book, error) {
 	defer func(begin time.Time) {
 		s.requestCount.With("method", "list_books").Add(1)
 		s.requestLatency.With("method", "list_books").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return s.Service.Books(ctx)
+	return s.Service.Books(ctx, filter)
 }
 
 func (s *instrumentingService) AddChapter(ctx context.Context, name string, description string, bookID string) (prisma.Chapter, error) {
@@ -77,16 +77,16 @@ func (s *instrumentingService) GetChapter(ctx context.Context, id string) (prism
 	return s.Service.GetChapter(ctx, id)
 }
 
-func (s *instrumentingService) DeleteChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+func (s *instrumentingService) DeleteChapter(ctx context.Context, id string, version int32) (prisma.Chapter, error) {
 	defer func(begin time.Time) {
 		s.requestCount.With("method", "delete_chapter").Add(1)
 		s.requestLatency.With("method", "delete_chapter").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return s.Service.DeleteChapter(ctx, id)
+	return s.Service.DeleteChapter(ctx, id, version)
 }
 
-func (s *instrumentingService) Chapters(ctx context.Context, bookID string) ([]prisma.Chapter, error) {
+func (s *instrumentingService) Chapters(ctx context.Context, bookID string) ([]ReadingChapter, error) {
 	defer func(begin time.Time) {
 		s.requestCount.With("method", "list_chapters").Add(1)
 		s.requestLatency.With("method", "list_chapters").Observe(time.Since(begin).Seconds())
@@ -94,3 +94,604 @@ func (s *instrumentingService) Chapters(ctx context.Context, bookID string) ([]p
 
 	return s.Service.Chapters(ctx, bookID)
 }
+
+func (s *instrumentingService) ChapterBook(ctx context.Context, id string) (prisma.Book, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "chapter_book").Add(1)
+		s.requestLatency.With("method", "chapter_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ChapterBook(ctx, id)
+}
+
+func (s *instrumentingService) AuditLog(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "audit_log").Add(1)
+		s.requestLatency.With("method", "audit_log").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.AuditLog(ctx, filter)
+}
+
+func (s *instrumentingService) Trash(ctx context.Context) (Trash, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "trash").Add(1)
+		s.requestLatency.With("method", "trash").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Trash(ctx)
+}
+
+func (s *instrumentingService) RestoreBook(ctx context.Context, id string) (prisma.Book, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "restore_book").Add(1)
+		s.requestLatency.With("method", "restore_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RestoreBook(ctx, id)
+}
+
+func (s *instrumentingService) RestoreChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "restore_chapter").Add(1)
+		s.requestLatency.With("method", "restore_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RestoreChapter(ctx, id)
+}
+
+func (s *instrumentingService) UpdateBook(ctx context.Context, id string, name string, description string, version int32) (prisma.Book, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "update_book").Add(1)
+		s.requestLatency.With("method", "update_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.UpdateBook(ctx, id, name, description, version)
+}
+
+func (s *instrumentingService) UpdateChapter(ctx context.Context, id string, name string, description string, version int32) (prisma.Chapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "update_chapter").Add(1)
+		s.requestLatency.With("method", "update_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.UpdateChapter(ctx, id, name, description, version)
+}
+
+func (s *instrumentingService) BookRevisions(ctx context.Context, id string) ([]prisma.Revision, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "book_revisions").Add(1)
+		s.requestLatency.With("method", "book_revisions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.BookRevisions(ctx, id)
+}
+
+func (s *instrumentingService) DiffBookRevisions(ctx context.Context, id string, from, to int32) (RevisionDiff, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "diff_book_revisions").Add(1)
+		s.requestLatency.With("method", "diff_book_revisions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DiffBookRevisions(ctx, id, from, to)
+}
+
+func (s *instrumentingService) BookAt(ctx context.Context, id string, at time.Time) (HistoricalBook, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "book_at").Add(1)
+		s.requestLatency.With("method", "book_at").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.BookAt(ctx, id, at)
+}
+
+func (s *instrumentingService) RevertBook(ctx context.Context, id string, number int32, version int32) (prisma.Book, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "revert_book").Add(1)
+		s.requestLatency.With("method", "revert_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RevertBook(ctx, id, number, version)
+}
+
+func (s *instrumentingService) ChapterRevisions(ctx context.Context, id string) ([]prisma.Revision, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "chapter_revisions").Add(1)
+		s.requestLatency.With("method", "chapter_revisions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ChapterRevisions(ctx, id)
+}
+
+func (s *instrumentingService) DiffChapterRevisions(ctx context.Context, id string, from, to int32) (RevisionDiff, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "diff_chapter_revisions").Add(1)
+		s.requestLatency.With("method", "diff_chapter_revisions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DiffChapterRevisions(ctx, id, from, to)
+}
+
+func (s *instrumentingService) RevertChapter(ctx context.Context, id string, number int32, version int32) (prisma.Chapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "revert_chapter").Add(1)
+		s.requestLatency.With("method", "revert_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RevertChapter(ctx, id, number, version)
+}
+
+func (s *instrumentingService) MoveChapter(ctx context.Context, id string, bookID string, position int32, version int32) (prisma.Chapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "move_chapter").Add(1)
+		s.requestLatency.With("method", "move_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.MoveChapter(ctx, id, bookID, position, version)
+}
+
+func (s *instrumentingService) CopyChapter(ctx context.Context, id string, bookID string, position int32) (prisma.Chapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "copy_chapter").Add(1)
+		s.requestLatency.With("method", "copy_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.CopyChapter(ctx, id, bookID, position)
+}
+
+func (s *instrumentingService) MergeBooks(ctx context.Context, sourceID string, targetID string, strategy MergeStrategy, archive bool, version int32) (prisma.Book, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "merge_books").Add(1)
+		s.requestLatency.With("method", "merge_books").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.MergeBooks(ctx, sourceID, targetID, strategy, archive, version)
+}
+
+func (s *instrumentingService) DuplicateBook(ctx context.Context, id string, name string) (prisma.Book, []prisma.Chapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "duplicate_book").Add(1)
+		s.requestLatency.With("method", "duplicate_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DuplicateBook(ctx, id, name)
+}
+
+func (s *instrumentingService) BookMetadata(ctx context.Context, id string) (Metadata, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "get_book_metadata").Add(1)
+		s.requestLatency.With("method", "get_book_metadata").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.BookMetadata(ctx, id)
+}
+
+func (s *instrumentingService) UpdateBookMetadata(ctx context.Context, id string, m Metadata, version int32) (prisma.Book, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "update_book_metadata").Add(1)
+		s.requestLatency.With("method", "update_book_metadata").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.UpdateBookMetadata(ctx, id, m, version)
+}
+
+func (s *instrumentingService) BookByISBN(ctx context.Context, isbn string) (prisma.Book, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "get_book_by_isbn").Add(1)
+		s.requestLatency.With("method", "get_book_by_isbn").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.BookByISBN(ctx, isbn)
+}
+
+func (s *instrumentingService) Authors(ctx context.Context) ([]prisma.Author, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_authors").Add(1)
+		s.requestLatency.With("method", "list_authors").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Authors(ctx)
+}
+
+func (s *instrumentingService) BookTags(ctx context.Context, id string) ([]string, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_book_tags").Add(1)
+		s.requestLatency.With("method", "list_book_tags").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.BookTags(ctx, id)
+}
+
+func (s *instrumentingService) TagBook(ctx context.Context, id string, tag string) ([]string, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "tag_book").Add(1)
+		s.requestLatency.With("method", "tag_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.TagBook(ctx, id, tag)
+}
+
+func (s *instrumentingService) UntagBook(ctx context.Context, id string, tag string) ([]string, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "untag_book").Add(1)
+		s.requestLatency.With("method", "untag_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.UntagBook(ctx, id, tag)
+}
+
+func (s *instrumentingService) ChapterTags(ctx context.Context, id string) ([]string, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_chapter_tags").Add(1)
+		s.requestLatency.With("method", "list_chapter_tags").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ChapterTags(ctx, id)
+}
+
+func (s *instrumentingService) TagChapter(ctx context.Context, id string, tag string) ([]string, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "tag_chapter").Add(1)
+		s.requestLatency.With("method", "tag_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.TagChapter(ctx, id, tag)
+}
+
+func (s *instrumentingService) UntagChapter(ctx context.Context, id string, tag string) ([]string, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "untag_chapter").Add(1)
+		s.requestLatency.With("method", "untag_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.UntagChapter(ctx, id, tag)
+}
+
+func (s *instrumentingService) Tags(ctx context.Context) ([]TagCount, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_tags").Add(1)
+		s.requestLatency.With("method", "list_tags").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Tags(ctx)
+}
+
+func (s *instrumentingService) CreateCollection(ctx context.Context, name string, description string) (prisma.Collection, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "create_collection").Add(1)
+		s.requestLatency.With("method", "create_collection").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.CreateCollection(ctx, name, description)
+}
+
+func (s *instrumentingService) Collections(ctx context.Context) ([]prisma.Collection, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_collections").Add(1)
+		s.requestLatency.With("method", "list_collections").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Collections(ctx)
+}
+
+func (s *instrumentingService) DeleteCollection(ctx context.Context, id string) (prisma.Collection, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "delete_collection").Add(1)
+		s.requestLatency.With("method", "delete_collection").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DeleteCollection(ctx, id)
+}
+
+func (s *instrumentingService) AddToCollection(ctx context.Context, id string, bookID string) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "add_to_collection").Add(1)
+		s.requestLatency.With("method", "add_to_collection").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.AddToCollection(ctx, id, bookID)
+}
+
+func (s *instrumentingService) RemoveFromCollection(ctx context.Context, id string, bookID string) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "remove_from_collection").Add(1)
+		s.requestLatency.With("method", "remove_from_collection").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RemoveFromCollection(ctx, id, bookID)
+}
+
+func (s *instrumentingService) AddNote(ctx context.Context, chapterID string, n NoteInput) (prisma.Note, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "add_note").Add(1)
+		s.requestLatency.With("method", "add_note").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.AddNote(ctx, chapterID, n)
+}
+
+func (s *instrumentingService) GetNote(ctx context.Context, id string) (prisma.Note, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "get_note").Add(1)
+		s.requestLatency.With("method", "get_note").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.GetNote(ctx, id)
+}
+
+func (s *instrumentingService) UpdateNote(ctx context.Context, id string, n NoteInput) (prisma.Note, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "update_note").Add(1)
+		s.requestLatency.With("method", "update_note").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.UpdateNote(ctx, id, n)
+}
+
+func (s *instrumentingService) DeleteNote(ctx context.Context, id string) (prisma.Note, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "delete_note").Add(1)
+		s.requestLatency.With("method", "delete_note").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DeleteNote(ctx, id)
+}
+
+func (s *instrumentingService) Notes(ctx context.Context, chapterID string) ([]prisma.Note, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_notes").Add(1)
+		s.requestLatency.With("method", "list_notes").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Notes(ctx, chapterID)
+}
+
+func (s *instrumentingService) NoteChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "get_note_chapter").Add(1)
+		s.requestLatency.With("method", "get_note_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.NoteChapter(ctx, id)
+}
+
+func (s *instrumentingService) AddCard(ctx context.Context, chapterID string, c CardInput) (prisma.Card, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "add_card").Add(1)
+		s.requestLatency.With("method", "add_card").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.AddCard(ctx, chapterID, c)
+}
+
+func (s *instrumentingService) GetCard(ctx context.Context, id string) (prisma.Card, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "get_card").Add(1)
+		s.requestLatency.With("method", "get_card").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.GetCard(ctx, id)
+}
+
+func (s *instrumentingService) DeleteCard(ctx context.Context, id string) (prisma.Card, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "delete_card").Add(1)
+		s.requestLatency.With("method", "delete_card").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DeleteCard(ctx, id)
+}
+
+func (s *instrumentingService) Cards(ctx context.Context, chapterID string) ([]prisma.Card, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_cards").Add(1)
+		s.requestLatency.With("method", "list_cards").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Cards(ctx, chapterID)
+}
+
+func (s *instrumentingService) CardChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "get_card_chapter").Add(1)
+		s.requestLatency.With("method", "get_card_chapter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.CardChapter(ctx, id)
+}
+
+func (s *instrumentingService) DueCards(ctx context.Context, bookID string, limit int32) ([]prisma.Card, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "due_cards").Add(1)
+		s.requestLatency.With("method", "due_cards").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DueCards(ctx, bookID, limit)
+}
+
+func (s *instrumentingService) GradeCard(ctx context.Context, id string, grade int32) (prisma.Card, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "grade_card").Add(1)
+		s.requestLatency.With("method", "grade_card").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.GradeCard(ctx, id, grade)
+}
+
+func (s *instrumentingService) ReviewStats(ctx context.Context, bookID string) (ReviewStats, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "review_stats").Add(1)
+		s.requestLatency.With("method", "review_stats").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ReviewStats(ctx, bookID)
+}
+
+func (s *instrumentingService) SetChapterStatus(ctx context.Context, id string, status prisma.ReadingStatus) (ReadingChapter, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "set_chapter_status").Add(1)
+		s.requestLatency.With("method", "set_chapter_status").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.SetChapterStatus(ctx, id, status)
+}
+
+func (s *instrumentingService) BookProgress(ctx context.Context, id string) (BookProgress, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "book_progress").Add(1)
+		s.requestLatency.With("method", "book_progress").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.BookProgress(ctx, id)
+}
+
+func (s *instrumentingService) CurrentlyReading(ctx context.Context) ([]BookProgress, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "currently_reading").Add(1)
+		s.requestLatency.With("method", "currently_reading").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.CurrentlyReading(ctx)
+}
+
+func (s *instrumentingService) FinishedBooks(ctx context.Context, from time.Time, to time.Time) ([]BookProgress, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "finished_books").Add(1)
+		s.requestLatency.With("method", "finished_books").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.FinishedBooks(ctx, from, to)
+}
+
+func (s *instrumentingService) StartSession(ctx context.Context, bookID string, chapterID string) (Session, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "start_session").Add(1)
+		s.requestLatency.With("method", "start_session").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.StartSession(ctx, bookID, chapterID)
+}
+
+func (s *instrumentingService) StopSession(ctx context.Context, pages int32) (Session, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "stop_session").Add(1)
+		s.requestLatency.With("method", "stop_session").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.StopSession(ctx, pages)
+}
+
+func (s *instrumentingService) CurrentSession(ctx context.Context) (Session, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "current_session").Add(1)
+		s.requestLatency.With("method", "current_session").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.CurrentSession(ctx)
+}
+
+func (s *instrumentingService) Sessions(ctx context.Context, bookID string) ([]Session, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_sessions").Add(1)
+		s.requestLatency.With("method", "list_sessions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Sessions(ctx, bookID)
+}
+
+func (s *instrumentingService) ReadingStats(ctx context.Context, f SessionFilter) (ReadingStats, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "reading_stats").Add(1)
+		s.requestLatency.With("method", "reading_stats").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ReadingStats(ctx, f)
+}
+
+func (s *instrumentingService) LookupBook(ctx context.Context, name string, limit int32) ([]BookMatch, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "lookup_book").Add(1)
+		s.requestLatency.With("method", "lookup_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.LookupBook(ctx, name, limit)
+}
+
+func (s *instrumentingService) ExportBook(ctx context.Context, id string) (BookExport, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "export_book").Add(1)
+		s.requestLatency.With("method", "export_book").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ExportBook(ctx, id)
+}
+
+type instrumentingBulkService struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	BulkService
+}
+
+// NewInstrumentingBulkService returns an instance of an instrumenting
+// BulkService.
+func NewInstrumentingBulkService(counter metrics.Counter, latency metrics.Histogram, s BulkService) BulkService {
+	return &instrumentingBulkService{
+		requestCount:   counter,
+		requestLatency: latency,
+		BulkService:    s,
+	}
+}
+
+func (s *instrumentingBulkService) DeleteBooks(ctx context.Context, sel Selection, dryRun bool) (BulkResult, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "delete_books").Add(1)
+		s.requestLatency.With("method", "delete_books").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.BulkService.DeleteBooks(ctx, sel, dryRun)
+}
+
+func (s *instrumentingBulkService) UpdateBooks(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (BulkResult, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "update_books").Add(1)
+		s.requestLatency.With("method", "update_books").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.BulkService.UpdateBooks(ctx, sel, set, dryRun)
+}
+
+func (s *instrumentingBulkService) DeleteChapters(ctx context.Context, sel Selection, dryRun bool) (BulkResult, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "delete_chapters").Add(1)
+		s.requestLatency.With("method", "delete_chapters").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.BulkService.DeleteChapters(ctx, sel, dryRun)
+}
+
+func (s *instrumentingBulkService) UpdateChapters(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (BulkResult, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "update_chapters").Add(1)
+		s.requestLatency.With("method", "update_chapters").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.BulkService.UpdateChapters(ctx, sel, set, dryRun)
+}
+
+func (s *instrumentingBulkService) MoveChapters(ctx context.Context, sel Selection, bookID string, dryRun bool) (BulkResult, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "move_chapters").Add(1)
+		s.requestLatency.With("method", "move_chapters").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.BulkService.MoveChapters(ctx, sel, bookID, dryRun)
+}
+
+func (s *instrumentingBulkService) ImportMarkdown(ctx context.Context, doc string, dryRun bool) (ImportResult, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "import_markdown").Add(1)
+		s.requestLatency.With("method", "import_markdown").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.BulkService.ImportMarkdown(ctx, doc, dryRun)
+}
@@ -18,17 +18,18 @@ func NewLoggingService(logger log.Logger, s Service) Service {
 	return &loggingService{logger, s}
 }
 
-func (s *loggingService) AddBook(ctx context.Context, name string, description string) (book prisma.Book, err error) {
+func (s *loggingService) AddBook(ctx context.Context, name string, description string, chapters []NewChapter) (book prisma.Book, created []prisma.Chapter, err error) {
 	defer func(begin time.Time) {
 		s.logger.Log(
 			"method", "add_book",
 			"name", name,
 			"description", description,
+			"chapters", len(chapters),
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.AddBook(ctx, name, description)
+	return s.Service.AddBook(ctx, name, description, chapters)
 }
 
 func (s *loggingService) GetBook(ctx context.Context, id string) (book prisma.Book, err error) {
@@ -43,27 +44,31 @@ func (s *loggingService) GetBook(ctx context.Context, id string) (book prisma.Bo
 	return s.Service.GetBook(ctx, id)
 }
 
-func (s *loggingService) DeleteBook(ctx context.Context, id string) (book prisma.Book, err error) {
+func (s *loggingService) DeleteBook(ctx context.Context, id string, version int32) (book prisma.Book, err error) {
 	defer func(begin time.Time) {
 		s.logger.Log(
 			"method", "delete_book",
 			"id", id,
+			"version", version,
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.DeleteBook(ctx, id)
+	return s.Service.DeleteBook(ctx, id, version)
 }
 
-func (s *loggingService) Books(ctx context.Context) (books []prisma.Book, err error) {
+func (s *loggingService) Books(ctx context.Context, filter BookFilter) (books []prisma.Book, err error) {
 	defer func(begin time.Time) {
 		s.logger.Log(
 			"method", "list_books",
+			"author", filter.Author,
+			"tag", filter.Tag,
+			"collection", filter.Collection,
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.Books(ctx)
+	return s.Service.Books(ctx, filter)
 }
 
 func (s *loggingService) AddChapter(ctx context.Context, name string, description string, bookID string) (chapter prisma.Chapter, err error) {
@@ -92,19 +97,20 @@ func (s *loggingService) GetChapter(ctx context.Context, id string) (chapter pri
 	return s.Service.GetChapter(ctx, id)
 }
 
-func (s *loggingService) DeleteChapter(ctx context.Context, id string) (chapter prisma.Chapter, err error) {
+func (s *loggingService) DeleteChapter(ctx context.Context, id string, version int32) (chapter prisma.Chapter, err error) {
 	defer func(begin time.Time) {
 		s.logger.Log(
 			"method", "delete_chapter",
 			"id", id,
+			"version", version,
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.DeleteChapter(ctx, id)
+	return s.Service.DeleteChapter(ctx, id, version)
 }
 
-func (s *loggingService) Chapters(ctx context.Context, bookID string) (chapters []prisma.Chapter, err error) {
+func (s *loggingService) Chapters(ctx context.Context, bookID string) (chapters []ReadingChapter, err error) {
 	defer func(begin time.Time) {
 		s.logger.Log(
 			"method", "list_chapters",
@@ -115,3 +121,864 @@ func (s *loggingService) Chapters(ctx context.Context, bookID string) (chapters
 	}(time.Now())
 	return s.Service.Chapters(ctx, bookID)
 }
+
+func (s *loggingService) ChapterBook(ctx context.Context, id string) (book prisma.Book, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "chapter_book",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ChapterBook(ctx, id)
+}
+
+func (s *loggingService) AuditLog(ctx context.Context, filter AuditFilter) (entries []AuditEntry, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "audit_log",
+			"bookID", filter.BookID,
+			"actor", filter.Actor,
+			"since", filter.Since,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AuditLog(ctx, filter)
+}
+
+func (s *loggingService) Trash(ctx context.Context) (trash Trash, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "trash",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Trash(ctx)
+}
+
+func (s *loggingService) RestoreBook(ctx context.Context, id string) (book prisma.Book, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "restore_book",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RestoreBook(ctx, id)
+}
+
+func (s *loggingService) RestoreChapter(ctx context.Context, id string) (chapter prisma.Chapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "restore_chapter",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RestoreChapter(ctx, id)
+}
+
+func (s *loggingService) UpdateBook(ctx context.Context, id string, name string, description string, version int32) (book prisma.Book, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "update_book",
+			"id", id,
+			"name", name,
+			"description", description,
+			"version", version,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateBook(ctx, id, name, description, version)
+}
+
+func (s *loggingService) UpdateChapter(ctx context.Context, id string, name string, description string, version int32) (chapter prisma.Chapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "update_chapter",
+			"id", id,
+			"name", name,
+			"description", description,
+			"version", version,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateChapter(ctx, id, name, description, version)
+}
+
+func (s *loggingService) BookRevisions(ctx context.Context, id string) (revisions []prisma.Revision, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "book_revisions",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BookRevisions(ctx, id)
+}
+
+func (s *loggingService) DiffBookRevisions(ctx context.Context, id string, from, to int32) (diff RevisionDiff, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "diff_book_revisions",
+			"id", id,
+			"from", from,
+			"to", to,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DiffBookRevisions(ctx, id, from, to)
+}
+
+func (s *loggingService) BookAt(ctx context.Context, id string, at time.Time) (book HistoricalBook, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "book_at",
+			"id", id,
+			"at", at,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BookAt(ctx, id, at)
+}
+
+func (s *loggingService) RevertBook(ctx context.Context, id string, number int32, version int32) (book prisma.Book, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "revert_book",
+			"id", id,
+			"number", number,
+			"version", version,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RevertBook(ctx, id, number, version)
+}
+
+func (s *loggingService) ChapterRevisions(ctx context.Context, id string) (revisions []prisma.Revision, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "chapter_revisions",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ChapterRevisions(ctx, id)
+}
+
+func (s *loggingService) DiffChapterRevisions(ctx context.Context, id string, from, to int32) (diff RevisionDiff, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "diff_chapter_revisions",
+			"id", id,
+			"from", from,
+			"to", to,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DiffChapterRevisions(ctx, id, from, to)
+}
+
+func (s *loggingService) RevertChapter(ctx context.Context, id string, number int32, version int32) (chapter prisma.Chapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "revert_chapter",
+			"id", id,
+			"number", number,
+			"version", version,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RevertChapter(ctx, id, number, version)
+}
+
+func (s *loggingService) MoveChapter(ctx context.Context, id string, bookID string, position int32, version int32) (chapter prisma.Chapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "move_chapter",
+			"id", id,
+			"book_id", bookID,
+			"position", position,
+			"version", version,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.MoveChapter(ctx, id, bookID, position, version)
+}
+
+func (s *loggingService) CopyChapter(ctx context.Context, id string, bookID string, position int32) (chapter prisma.Chapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "copy_chapter",
+			"id", id,
+			"book_id", bookID,
+			"position", position,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CopyChapter(ctx, id, bookID, position)
+}
+
+func (s *loggingService) MergeBooks(ctx context.Context, sourceID string, targetID string, strategy MergeStrategy, archive bool, version int32) (book prisma.Book, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "merge_books",
+			"source_id", sourceID,
+			"target_id", targetID,
+			"strategy", strategy,
+			"archive", archive,
+			"version", version,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.MergeBooks(ctx, sourceID, targetID, strategy, archive, version)
+}
+
+func (s *loggingService) DuplicateBook(ctx context.Context, id string, name string) (book prisma.Book, chapters []prisma.Chapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "duplicate_book",
+			"id", id,
+			"name", name,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DuplicateBook(ctx, id, name)
+}
+
+func (s *loggingService) BookMetadata(ctx context.Context, id string) (m Metadata, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "get_book_metadata",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BookMetadata(ctx, id)
+}
+
+func (s *loggingService) UpdateBookMetadata(ctx context.Context, id string, m Metadata, version int32) (book prisma.Book, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "update_book_metadata",
+			"id", id,
+			"isbn", m.ISBN,
+			"version", version,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateBookMetadata(ctx, id, m, version)
+}
+
+func (s *loggingService) BookByISBN(ctx context.Context, isbn string) (book prisma.Book, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "get_book_by_isbn",
+			"isbn", isbn,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BookByISBN(ctx, isbn)
+}
+
+func (s *loggingService) Authors(ctx context.Context) (authors []prisma.Author, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_authors",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Authors(ctx)
+}
+
+func (s *loggingService) BookTags(ctx context.Context, id string) (tags []string, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_book_tags",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BookTags(ctx, id)
+}
+
+func (s *loggingService) TagBook(ctx context.Context, id string, tag string) (tags []string, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "tag_book",
+			"id", id,
+			"tag", tag,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.TagBook(ctx, id, tag)
+}
+
+func (s *loggingService) UntagBook(ctx context.Context, id string, tag string) (tags []string, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "untag_book",
+			"id", id,
+			"tag", tag,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UntagBook(ctx, id, tag)
+}
+
+func (s *loggingService) ChapterTags(ctx context.Context, id string) (tags []string, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_chapter_tags",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ChapterTags(ctx, id)
+}
+
+func (s *loggingService) TagChapter(ctx context.Context, id string, tag string) (tags []string, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "tag_chapter",
+			"id", id,
+			"tag", tag,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.TagChapter(ctx, id, tag)
+}
+
+func (s *loggingService) UntagChapter(ctx context.Context, id string, tag string) (tags []string, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "untag_chapter",
+			"id", id,
+			"tag", tag,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UntagChapter(ctx, id, tag)
+}
+
+func (s *loggingService) Tags(ctx context.Context) (tags []TagCount, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_tags",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Tags(ctx)
+}
+
+func (s *loggingService) CreateCollection(ctx context.Context, name string, description string) (collection prisma.Collection, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "create_collection",
+			"name", name,
+			"description", description,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CreateCollection(ctx, name, description)
+}
+
+func (s *loggingService) Collections(ctx context.Context) (collections []prisma.Collection, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_collections",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Collections(ctx)
+}
+
+func (s *loggingService) DeleteCollection(ctx context.Context, id string) (collection prisma.Collection, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "delete_collection",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeleteCollection(ctx, id)
+}
+
+func (s *loggingService) AddToCollection(ctx context.Context, id string, bookID string) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "add_to_collection",
+			"id", id,
+			"book_id", bookID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AddToCollection(ctx, id, bookID)
+}
+
+func (s *loggingService) RemoveFromCollection(ctx context.Context, id string, bookID string) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "remove_from_collection",
+			"id", id,
+			"book_id", bookID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RemoveFromCollection(ctx, id, bookID)
+}
+
+func (s *loggingService) AddNote(ctx context.Context, chapterID string, n NoteInput) (note prisma.Note, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "add_note",
+			"chapter_id", chapterID,
+			"color", n.Color,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AddNote(ctx, chapterID, n)
+}
+
+func (s *loggingService) GetNote(ctx context.Context, id string) (note prisma.Note, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "get_note",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.GetNote(ctx, id)
+}
+
+func (s *loggingService) UpdateNote(ctx context.Context, id string, n NoteInput) (note prisma.Note, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "update_note",
+			"id", id,
+			"color", n.Color,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateNote(ctx, id, n)
+}
+
+func (s *loggingService) DeleteNote(ctx context.Context, id string) (note prisma.Note, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "delete_note",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeleteNote(ctx, id)
+}
+
+func (s *loggingService) Notes(ctx context.Context, chapterID string) (notes []prisma.Note, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_notes",
+			"chapter_id", chapterID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Notes(ctx, chapterID)
+}
+
+func (s *loggingService) NoteChapter(ctx context.Context, id string) (chapter prisma.Chapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "get_note_chapter",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.NoteChapter(ctx, id)
+}
+
+func (s *loggingService) AddCard(ctx context.Context, chapterID string, c CardInput) (card prisma.Card, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "add_card",
+			"chapter_id", chapterID,
+			"note_id", c.NoteID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AddCard(ctx, chapterID, c)
+}
+
+func (s *loggingService) GetCard(ctx context.Context, id string) (card prisma.Card, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "get_card",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.GetCard(ctx, id)
+}
+
+func (s *loggingService) DeleteCard(ctx context.Context, id string) (card prisma.Card, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "delete_card",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeleteCard(ctx, id)
+}
+
+func (s *loggingService) Cards(ctx context.Context, chapterID string) (cards []prisma.Card, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_cards",
+			"chapter_id", chapterID,
+			"cards", len(cards),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Cards(ctx, chapterID)
+}
+
+func (s *loggingService) CardChapter(ctx context.Context, id string) (chapter prisma.Chapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "get_card_chapter",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CardChapter(ctx, id)
+}
+
+func (s *loggingService) DueCards(ctx context.Context, bookID string, limit int32) (cards []prisma.Card, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "due_cards",
+			"book_id", bookID,
+			"limit", limit,
+			"cards", len(cards),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DueCards(ctx, bookID, limit)
+}
+
+func (s *loggingService) GradeCard(ctx context.Context, id string, grade int32) (card prisma.Card, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "grade_card",
+			"id", id,
+			"grade", grade,
+			"interval", card.Interval,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.GradeCard(ctx, id, grade)
+}
+
+func (s *loggingService) ReviewStats(ctx context.Context, bookID string) (stats ReviewStats, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "review_stats",
+			"book_id", bookID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ReviewStats(ctx, bookID)
+}
+
+func (s *loggingService) SetChapterStatus(ctx context.Context, id string, status prisma.ReadingStatus) (chapter ReadingChapter, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "set_chapter_status",
+			"id", id,
+			"status", status,
+			"user", Actor(ctx),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.SetChapterStatus(ctx, id, status)
+}
+
+func (s *loggingService) BookProgress(ctx context.Context, id string) (progress BookProgress, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "book_progress",
+			"id", id,
+			"user", Actor(ctx),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.BookProgress(ctx, id)
+}
+
+func (s *loggingService) CurrentlyReading(ctx context.Context) (books []BookProgress, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "currently_reading",
+			"user", Actor(ctx),
+			"books", len(books),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CurrentlyReading(ctx)
+}
+
+func (s *loggingService) FinishedBooks(ctx context.Context, from time.Time, to time.Time) (books []BookProgress, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "finished_books",
+			"user", Actor(ctx),
+			"from", from,
+			"to", to,
+			"books", len(books),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.FinishedBooks(ctx, from, to)
+}
+
+func (s *loggingService) StartSession(ctx context.Context, bookID string, chapterID string) (session Session, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "start_session",
+			"book_id", bookID,
+			"chapter_id", chapterID,
+			"user", Actor(ctx),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.StartSession(ctx, bookID, chapterID)
+}
+
+func (s *loggingService) StopSession(ctx context.Context, pages int32) (session Session, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "stop_session",
+			"pages", pages,
+			"user", Actor(ctx),
+			"duration", session.Duration,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.StopSession(ctx, pages)
+}
+
+func (s *loggingService) CurrentSession(ctx context.Context) (session Session, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "current_session",
+			"user", Actor(ctx),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CurrentSession(ctx)
+}
+
+func (s *loggingService) Sessions(ctx context.Context, bookID string) (sessions []Session, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_sessions",
+			"book_id", bookID,
+			"user", Actor(ctx),
+			"sessions", len(sessions),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Sessions(ctx, bookID)
+}
+
+func (s *loggingService) ReadingStats(ctx context.Context, f SessionFilter) (stats ReadingStats, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "reading_stats",
+			"book_id", f.BookID,
+			"since", f.Since,
+			"user", Actor(ctx),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ReadingStats(ctx, f)
+}
+
+func (s *loggingService) LookupBook(ctx context.Context, name string, limit int32) (matches []BookMatch, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "lookup_book",
+			"name", name,
+			"limit", limit,
+			"matches", len(matches),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.LookupBook(ctx, name, limit)
+}
+
+func (s *loggingService) ExportBook(ctx context.Context, id string) (export BookExport, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "export_book",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ExportBook(ctx, id)
+}
+
+type loggingBulkService struct {
+	logger log.Logger
+	BulkService
+}
+
+// NewLoggingBulkService returns a new instance of a logging BulkService.
+func NewLoggingBulkService(logger log.Logger, s BulkService) BulkService {
+	return &loggingBulkService{logger, s}
+}
+
+func (s *loggingBulkService) DeleteBooks(ctx context.Context, sel Selection, dryRun bool) (result BulkResult, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "delete_books",
+			"dry_run", dryRun,
+			"matched", result.Matched,
+			"failed", result.Failed,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.BulkService.DeleteBooks(ctx, sel, dryRun)
+}
+
+func (s *loggingBulkService) UpdateBooks(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (result BulkResult, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "update_books",
+			"dry_run", dryRun,
+			"matched", result.Matched,
+			"failed", result.Failed,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.BulkService.UpdateBooks(ctx, sel, set, dryRun)
+}
+
+func (s *loggingBulkService) DeleteChapters(ctx context.Context, sel Selection, dryRun bool) (result BulkResult, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "delete_chapters",
+			"dry_run", dryRun,
+			"matched", result.Matched,
+			"failed", result.Failed,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.BulkService.DeleteChapters(ctx, sel, dryRun)
+}
+
+func (s *loggingBulkService) UpdateChapters(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (result BulkResult, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "update_chapters",
+			"dry_run", dryRun,
+			"matched", result.Matched,
+			"failed", result.Failed,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.BulkService.UpdateChapters(ctx, sel, set, dryRun)
+}
+
+func (s *loggingBulkService) MoveChapters(ctx context.Context, sel Selection, bookID string, dryRun bool) (result BulkResult, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "move_chapters",
+			"book_id", bookID,
+			"dry_run", dryRun,
+			"matched", result.Matched,
+			"failed", result.Failed,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.BulkService.MoveChapters(ctx, sel, bookID, dryRun)
+}
+
+func (s *loggingBulkService) ImportMarkdown(ctx context.Context, doc string, dryRun bool) (result ImportResult, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "import_markdown",
+			"dry_run", dryRun,
+			"size", len(doc),
+			"succeeded", result.Succeeded,
+			"failed", result.Failed,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.BulkService.ImportMarkdown(ctx, doc, dryRun)
+}
@@ -0,0 +1,238 @@
+package handling
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Limits of the matches returned by a lookup.
+const (
+	defaultLookupLimit = 5
+	maxLookupLimit     = 20
+)
+
+// minSimilarity is the trigram similarity a book name needs to be a near
+// match, unless it is within a few edits of the name looked up.
+const minSimilarity = 0.3
+
+// BookMatch is a book matching a name looked up. Names are compared folded:
+// in lower case, without diacritics and punctuation. Exact is set if the
+// folded names are equal. Similarity is the trigram similarity of the
+// names, from 0 to 1, and Distance their edit distance.
+type BookMatch struct {
+	Book       prisma.Book `json:"book"`
+	Exact      bool        `json:"exact"`
+	Similarity float64     `json:"similarity"`
+	Distance   int         `json:"distance"`
+}
+
+func (s *service) LookupBook(ctx context.Context, name string, limit int32) ([]BookMatch, error) {
+	folded := foldName(name)
+	if folded == "" || limit < 0 || limit > maxLookupLimit {
+		return nil, ErrInvalidArgument
+	}
+	if limit == 0 {
+		limit = defaultLookupLimit
+	}
+
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			Deleted: prisma.Bool(false),
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return matchBooks(books, folded, int(limit)), nil
+}
+
+// matchBooks returns the books which names match the folded name: the
+// exact matches if there are any, and the near matches best first if not.
+func matchBooks(books []prisma.Book, folded string, limit int) []BookMatch {
+	grams := trigrams(folded)
+	maxDistance := len([]rune(folded)) / 4
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	var exact, near []BookMatch
+	for _, book := range books {
+		other := foldName(book.Name)
+		if other == folded {
+			exact = append(exact, BookMatch{Book: book, Exact: true, Similarity: 1})
+			continue
+		}
+
+		m := BookMatch{
+			Book:       book,
+			Similarity: round2(similarity(grams, trigrams(other))),
+			Distance:   editDistance(folded, other),
+		}
+		if m.Similarity >= minSimilarity || m.Distance <= maxDistance {
+			near = append(near, m)
+		}
+	}
+
+	matches := exact
+	if len(matches) == 0 {
+		matches = near
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch {
+		case a.Similarity != b.Similarity:
+			return a.Similarity > b.Similarity
+		case a.Distance != b.Distance:
+			return a.Distance < b.Distance
+		}
+		return a.Book.Name < b.Book.Name
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// foldName returns name in lower case, with the diacritics of Latin
+// letters and ё removed, and runs of anything but letters and digits
+// turned into single spaces.
+func foldName(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(name) {
+		if f, ok := foldedLetters[r]; ok {
+			r = f
+		}
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// A combining mark, as left by decomposed input.
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			if s, ok := expandedLetters[r]; ok {
+				b.WriteString(s)
+			} else {
+				b.WriteRune(r)
+			}
+		default:
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// foldedLetters maps lower case letters with diacritics to their base
+// letter.
+var foldedLetters = func() map[rune]rune {
+	m := make(map[rune]rune)
+	for base, letters := range map[rune]string{
+		'a': "àáâãäåāăąǎ",
+		'c': "çćĉċč",
+		'd': "ďđ",
+		'e': "èéêëēĕėęěё",
+		'g': "ĝğġģ",
+		'h': "ĥħ",
+		'i': "ìíîïĩīĭįı",
+		'j': "ĵ",
+		'k': "ķ",
+		'l': "ĺļľŀł",
+		'n': "ñńņňŉ",
+		'o': "òóôõöøōŏőǒ",
+		'r': "ŕŗř",
+		's': "śŝşšș",
+		't': "ţťŧț",
+		'u': "ùúûüũūŭůűųǔ",
+		'w': "ŵ",
+		'y': "ýÿŷ",
+		'z': "źżž",
+	} {
+		for _, r := range letters {
+			m[r] = base
+		}
+	}
+	m['ё'] = 'е'
+	m['й'] = 'и'
+	return m
+}()
+
+// expandedLetters maps ligatures and letters without a single base letter
+// to their spelling.
+var expandedLetters = map[rune]string{
+	'ß': "ss",
+	'æ': "ae",
+	'œ': "oe",
+	'þ': "th",
+	'ð': "d",
+}
+
+// trigrams returns the trigrams of the words of folded, each word padded
+// as in PostgreSQL's pg_trgm: two spaces before and one after.
+func trigrams(folded string) map[string]bool {
+	grams := make(map[string]bool)
+	for _, word := range strings.Fields(folded) {
+		r := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(r); i++ {
+			grams[string(r[i:i+3])] = true
+		}
+	}
+	return grams
+}
+
+// similarity returns the number of trigrams a and b share over the number
+// of trigrams in either.
+func similarity(a, b map[string]bool) float64 {
+	shared := 0
+	for g := range a {
+		if b[g] {
+			shared++
+		}
+	}
+	all := len(a) + len(b) - shared
+	if all == 0 {
+		return 0
+	}
+	return float64(shared) / float64(all)
+}
+
+// editDistance returns the Levenshtein distance of a and b in runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,74 @@
+package handling
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFoldName(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"", ""},
+		{"War and Peace", "war and peace"},
+		{"  War -- and Peace! ", "war and peace"},
+		{"Crème Brûlée", "creme brulee"},
+		{"Crème", "creme"},
+		{"Straße", "strasse"},
+		{"Ёлка", "елка"},
+		{"Catch-22", "catch 22"},
+	}
+
+	for _, tt := range tests {
+		if got := foldName(tt.name); got != tt.want {
+			t.Errorf("foldName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTrigrams(t *testing.T) {
+	tests := []struct {
+		folded string
+		want   []string
+	}{
+		{"", nil},
+		{"a", []string{"  a", " a "}},
+		{"cat", []string{"  c", " ca", "cat", "at "}},
+		{"ab cd", []string{"  a", " ab", "ab ", "  c", " cd", "cd "}},
+		{"aa aa", []string{"  a", " aa", "aa "}},
+		{"еж", []string{"  е", " еж", "еж "}},
+	}
+
+	for _, tt := range tests {
+		want := make(map[string]bool, len(tt.want))
+		for _, g := range tt.want {
+			want[g] = true
+		}
+		if got := trigrams(tt.folded); !reflect.DeepEqual(got, want) {
+			t.Errorf("trigrams(%q) = %v, want %v", tt.folded, got, want)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"book", "book", 0},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"abc", "cab", 2},
+		{"ёж", "еж", 1},
+		{"война", "воина", 1},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
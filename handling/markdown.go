@@ -0,0 +1,249 @@
+package handling
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// MaxImportSize is the largest Markdown document which can be imported.
+const MaxImportSize = 1 << 20
+
+// ImportResult reports the outcome of an import. In a dry run nothing is
+// created and the books are the ones which would be.
+type ImportResult struct {
+	DryRun    bool           `json:"dryRun"`
+	Succeeded int            `json:"succeeded"`
+	Failed    int            `json:"failed"`
+	Books     []ImportedBook `json:"books"`
+}
+
+// ImportedBook is a book of an imported document, with the ID it was
+// created with or the reason it was not.
+type ImportedBook struct {
+	ID          string       `json:"id,omitempty"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Chapters    []NewChapter `json:"chapters"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// ImportMarkdown creates the books of a Markdown document. Every level one
+// heading starts a book named by it, and every level two heading a chapter
+// of that book; the text below a heading, down to the next of these, is
+// the description. Headings of other levels are part of the text, as is
+// anything in fenced code blocks. A heading or fence escaped with a
+// backslash, as exports escape them, is text too and is taken without the
+// backslash. Text before the first book is ignored.
+//
+// Books are created one by one, through the Service, and a failing book
+// does not stop the rest. A book is not created if there already is one by
+// the same name, as LookupBook finds it.
+func (b *bulkService) ImportMarkdown(ctx context.Context, doc string, dryRun bool) (ImportResult, error) {
+	if len(doc) > MaxImportSize {
+		return ImportResult{}, ErrInvalidArgument
+	}
+
+	books, err := parseMarkdown(doc)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	result := ImportResult{
+		DryRun: dryRun,
+		Books:  make([]ImportedBook, 0, len(books)),
+	}
+
+	seen := make(map[string]bool, len(books))
+	for _, book := range books {
+		err := b.checkImport(ctx, book, seen)
+		if err == nil && !dryRun {
+			var created prisma.Book
+			created, _, err = b.s.AddBook(ctx, book.Name, book.Description, book.Chapters)
+			book.ID = created.ID
+		}
+
+		if err != nil {
+			book.Error = err.Error()
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+		result.Books = append(result.Books, book)
+	}
+
+	return result, nil
+}
+
+// checkImport returns why book cannot be created, if it cannot. seen holds
+// the folded names of the books before it in the document.
+func (b *bulkService) checkImport(ctx context.Context, book ImportedBook, seen map[string]bool) error {
+	folded := foldName(book.Name)
+	switch {
+	case folded == "":
+		return errors.New("book has no name")
+	case book.Description == "":
+		return errors.New("book has no description")
+	case seen[folded]:
+		return errors.New("book is in the document twice")
+	}
+	seen[folded] = true
+
+	for _, c := range book.Chapters {
+		switch {
+		case c.Name == "":
+			return errors.New("chapter has no name")
+		case c.Description == "":
+			return fmt.Errorf("chapter %q has no description", c.Name)
+		}
+	}
+
+	matches, err := b.s.LookupBook(ctx, book.Name, 1)
+	if err != nil {
+		return err
+	}
+	if len(matches) > 0 && matches[0].Exact {
+		return fmt.Errorf("book already exists as %q (%s)", matches[0].Book.Name, matches[0].Book.ID)
+	}
+	return nil
+}
+
+// parseMarkdown returns the books of a Markdown document. It returns
+// ErrInvalidArgument if there is no book, or a chapter before the first.
+func parseMarkdown(doc string) ([]ImportedBook, error) {
+	var (
+		books []ImportedBook
+		text  []string
+		fence string
+	)
+
+	// flush sets the text collected to the description of the last book or
+	// chapter.
+	flush := func() {
+		description := strings.TrimSpace(strings.Join(text, "\n"))
+		text = text[:0]
+
+		if len(books) == 0 {
+			return
+		}
+		book := &books[len(books)-1]
+		if n := len(book.Chapters); n > 0 {
+			book.Chapters[n-1].Description = description
+		} else {
+			book.Description = description
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(doc))
+	scanner.Buffer(make([]byte, 64*1024), MaxImportSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+
+		if marker := codeFence(line); marker != "" {
+			switch {
+			case fence == "":
+				fence = marker
+			case strings.HasPrefix(marker, fence) && strings.TrimLeft(strings.TrimSpace(line), marker[:1]) == "":
+				fence = ""
+			}
+		}
+		if fence != "" {
+			text = append(text, line)
+			continue
+		}
+
+		level, title := heading(line)
+		if level == 0 {
+			line = unescapeMarkdown(line)
+		}
+		switch level {
+		case 1:
+			flush()
+			books = append(books, ImportedBook{
+				Name:     title,
+				Chapters: []NewChapter{},
+			})
+		case 2:
+			if len(books) == 0 {
+				return nil, ErrInvalidArgument
+			}
+			flush()
+			book := &books[len(books)-1]
+			book.Chapters = append(book.Chapters, NewChapter{Name: title})
+		default:
+			text = append(text, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	flush()
+
+	if len(books) == 0 {
+		return nil, ErrInvalidArgument
+	}
+	return books, nil
+}
+
+// unescapeMarkdown returns line without the first of the backslashes
+// escaping it, if it is an escaped heading or code fence.
+func unescapeMarkdown(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if !strings.HasPrefix(trimmed, "\\") || !escapable(line) {
+		return line
+	}
+	return line[:len(line)-len(trimmed)] + trimmed[1:]
+}
+
+// escapable reports whether line is a heading or a code fence, or would be
+// one without the backslashes it starts with.
+func escapable(line string) bool {
+	trimmed := strings.TrimLeft(line, " ")
+	unescaped := line[:len(line)-len(trimmed)] + strings.TrimLeft(trimmed, "\\")
+	level, _ := heading(unescaped)
+	return level > 0 || codeFence(unescaped) != ""
+}
+
+// heading returns the level and the title of an ATX heading, like
+// "## Title ##", or 0 if line is none.
+func heading(line string) (int, string) {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return 0, ""
+	}
+
+	level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+	if level == 0 || level > 6 {
+		return 0, ""
+	}
+	rest := trimmed[level:]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		return 0, ""
+	}
+
+	title := strings.TrimSpace(rest)
+	if closed := strings.TrimRight(title, "#"); closed == "" || strings.HasSuffix(closed, " ") {
+		title = strings.TrimSpace(closed)
+	}
+	return level, title
+}
+
+// codeFence returns the fence of line if it opens or closes a fenced code
+// block, like ``` or ~~~.
+func codeFence(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return ""
+	}
+	for _, c := range []string{"`", "~"} {
+		n := len(trimmed) - len(strings.TrimLeft(trimmed, c))
+		if n >= 3 {
+			return strings.Repeat(c, n)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package handling
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want []ImportedBook
+		err  error
+	}{
+		{"empty", "", nil, ErrInvalidArgument},
+		{"no book", "Just text.\n", nil, ErrInvalidArgument},
+		{"chapter before book", "## One\nFirst.\n# Book\n", nil, ErrInvalidArgument},
+		{
+			"books and chapters",
+			"# Book\nAbout it.\n\n## One\nFirst.\n\nStill first.\n## Two\nSecond.\n# Other\nMore.\n",
+			[]ImportedBook{
+				{Name: "Book", Description: "About it.", Chapters: []NewChapter{
+					{Name: "One", Description: "First.\n\nStill first."},
+					{Name: "Two", Description: "Second."},
+				}},
+				{Name: "Other", Description: "More.", Chapters: []NewChapter{}},
+			},
+			nil,
+		},
+		{
+			"text before the first book",
+			"Preface.\n# Book\nAbout it.\n",
+			[]ImportedBook{{Name: "Book", Description: "About it.", Chapters: []NewChapter{}}},
+			nil,
+		},
+		{
+			"closing hashes",
+			"# Book #\nText.\n## C# ##\nText.\n",
+			[]ImportedBook{{Name: "Book", Description: "Text.", Chapters: []NewChapter{
+				{Name: "C#", Description: "Text."},
+			}}},
+			nil,
+		},
+		{
+			"no headings",
+			"# Book\n### Section\n#hashtag\n    # code\n\\# escaped\n",
+			[]ImportedBook{{Name: "Book", Description: "### Section\n#hashtag\n    # code\n# escaped", Chapters: []NewChapter{}}},
+			nil,
+		},
+		{
+			"fenced code",
+			"# Book\n```\n# not a book\n~~~\n## not a chapter\n```\n## One\n~~~~\n# open\n",
+			[]ImportedBook{{Name: "Book", Description: "```\n# not a book\n~~~\n## not a chapter\n```", Chapters: []NewChapter{
+				{Name: "One", Description: "~~~~\n# open"},
+			}}},
+			nil,
+		},
+		{
+			"line endings",
+			"# Book\r\nAbout it.  \r\n## One\r\nFirst.\r\n",
+			[]ImportedBook{{Name: "Book", Description: "About it.", Chapters: []NewChapter{
+				{Name: "One", Description: "First."},
+			}}},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMarkdown(tt.doc)
+			if err != tt.err {
+				t.Fatalf("parseMarkdown error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseMarkdown = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
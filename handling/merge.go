@@ -0,0 +1,556 @@
+package handling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// MergeStrategy decides the name and description of the book which two
+// books are merged into.
+type MergeStrategy string
+
+// Merge strategies.
+const (
+	// MergeKeepTarget keeps the name and description of the target.
+	MergeKeepTarget MergeStrategy = "keep_target"
+
+	// MergeKeepSource gives the target the name and description of the
+	// source.
+	MergeKeepSource MergeStrategy = "keep_source"
+
+	// MergeCombine keeps the name of the target and appends the
+	// description of the source to the one of the target.
+	MergeCombine MergeStrategy = "combine"
+)
+
+// maxNameAttempts is the number of suffixes tried to make a book name
+// unique.
+const maxNameAttempts = 100
+
+// MergeBooks moves the chapters of the source book to the end of the
+// target book, along with the reading sessions and reminders of the
+// source, and then moves the source to the trash. Unless archive is set,
+// the emptied source is deleted for good afterwards.
+//
+// The target gets the tags and collections of the source as well, and the
+// metadata fields it leaves unset are taken from the source. An ISBN taken
+// over is removed from the source, as it identifies a single book.
+//
+// The target takes the chapters over in a single update, which is the only
+// step bound to the version of the target: if it fails, nothing else has
+// changed. Only when the target takes the name of the source is the source
+// renamed before, to free the name, and renamed back should the update
+// fail. The source goes to the trash once the target has taken over.
+func (s *service) MergeBooks(ctx context.Context, sourceID, targetID string, strategy MergeStrategy, archive bool, version int32) (prisma.Book, error) {
+	if sourceID == "" || targetID == "" || sourceID == targetID {
+		return prisma.Book{}, ErrInvalidArgument
+	}
+
+	switch strategy {
+	case MergeKeepTarget, MergeKeepSource, MergeCombine:
+	default:
+		return prisma.Book{}, ErrInvalidArgument
+	}
+
+	source, err := activeBook(ctx, sourceID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	target, err := activeBook(ctx, targetID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	if err := checkVersion(target.Revision, version); err != nil {
+		return prisma.Book{}, err
+	}
+
+	chapters, err := orderedChapters(ctx, sourceID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	targetChapters, err := orderedChapters(ctx, targetID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	targetMetadata, err := bookMetadata(ctx, *target)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	sourceMetadata, err := bookMetadata(ctx, *source)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	m := mergeMetadata(targetMetadata, sourceMetadata)
+
+	merged := *target
+	if !sameMetadata(targetMetadata, m) {
+		merged.Isbn, merged.Publisher, merged.Year, merged.Language = &m.ISBN, &m.Publisher, &m.Year, &m.Language
+	}
+	switch strategy {
+	case MergeKeepSource:
+		merged.Name, merged.Description = source.Name, source.Description
+	case MergeCombine:
+		if source.Description != target.Description {
+			merged.Description = target.Description + "\n\n" + source.Description
+		}
+	}
+
+	data, err := mergeData(sourceID, *target, merged, targetMetadata, m, chapters, targetChapters)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+	if err := mergeLabels(ctx, sourceID, targetID, &data); err != nil {
+		return prisma.Book{}, err
+	}
+	if err := mergeActivity(ctx, sourceID, &data); err != nil {
+		return prisma.Book{}, err
+	}
+
+	renamed := *source
+	if merged.Name == source.Name {
+		name, err := uniqueBookName(ctx, source.Name, "merged")
+		if err != nil {
+			return prisma.Book{}, err
+		}
+		if renamed, err = updateBook(ctx, *source, name, source.Description); err != nil {
+			return prisma.Book{}, err
+		}
+	}
+
+	result, err := client.UpdateBook(prisma.BookUpdateParams{
+		Where: prisma.BookWhereUniqueInput{
+			ID: &targetID,
+		},
+		Data: data,
+	}).Exec(ctx)
+
+	if err != nil {
+		if renamed.Name != source.Name {
+			// The error of the merge is the one that matters; a source
+			// left renamed is put right by renaming it by hand.
+			updateBook(ctx, renamed, source.Name, source.Description)
+		}
+		if current, cerr := client.Book(prisma.BookWhereUniqueInput{ID: &targetID}).Exec(ctx); cerr == nil && current.Revision != target.Revision {
+			return prisma.Book{}, ErrVersionMismatch
+		}
+		return prisma.Book{}, err
+	}
+
+	movedISBN := m.ISBN != "" && m.ISBN != targetMetadata.ISBN
+	if err := trashMergedBook(ctx, renamed, movedISBN); err != nil {
+		return prisma.Book{}, err
+	}
+
+	if !archive {
+		if _, err := client.DeleteBook(prisma.BookWhereUniqueInput{ID: &sourceID}).Exec(ctx); err != nil {
+			return prisma.Book{}, err
+		}
+	}
+
+	return *result, nil
+}
+
+// mergeData returns the update of the target of a merge which takes over
+// the chapters of the source sourceID and gives the target the name and description
+// of merged, and the metadata m in place of current.
+//
+// The chapters of the source keep their positions, and those of the target
+// are put before them where needed: the chapters connected need no update,
+// and only chapters which belong to the target already are updated. Like
+// the renumbering which makes room for a moved chapter, this makes no new
+// revisions.
+//
+// Each chapter taken over is written to the outbox as deleted from the
+// source and created in the target, like a chapter moved between books.
+func mergeData(sourceID string, target, merged prisma.Book, current, m Metadata, chapters, targetChapters []prisma.Chapter) (prisma.BookUpdateInput, error) {
+	targetID := target.ID
+	var entries []prisma.OutboxEventCreateWithoutBookInput
+	data := prisma.BookUpdateInput{}
+
+	metadataChanged := !sameMetadata(current, m)
+	if metadataChanged {
+		data.Isbn, data.Publisher, data.Year, data.Language = &m.ISBN, &m.Publisher, &m.Year, &m.Language
+		data.Authors = &prisma.AuthorUpdateManyWithoutBooksInput{Set: authorsWhere(m.Authors)}
+	}
+
+	if metadataChanged || merged.Name != target.Name || merged.Description != target.Description {
+		merged.Revision = target.Revision + 1
+		data.Name, data.Description, data.Revision = &merged.Name, &merged.Description, &merged.Revision
+		data.Revisions = &prisma.RevisionUpdateManyWithoutBookInput{
+			Create: []prisma.RevisionCreateWithoutBookInput{{
+				Key:         revisionKey(targetID, merged.Revision),
+				Number:      merged.Revision,
+				Name:        merged.Name,
+				Description: merged.Description,
+			}},
+		}
+
+		entry, err := newOutboxEntry(NewEvent(EventTypeBook, prisma.MutationTypeUpdated, targetID, merged, target), targetID)
+		if err != nil {
+			return prisma.BookUpdateInput{}, err
+		}
+		entries = append(entries, entry.withoutBook())
+	}
+
+	if len(chapters) > 0 {
+		chapterData := &prisma.ChapterUpdateManyWithoutBookInput{
+			Connect: make([]prisma.ChapterWhereUniqueInput, len(chapters)),
+		}
+		for i := range chapters {
+			chapterData.Connect[i] = prisma.ChapterWhereUniqueInput{ID: &chapters[i].ID}
+
+			deleted, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeDeleted, sourceID, nil, chapters[i]), chapters[i].ID)
+			if err != nil {
+				return prisma.BookUpdateInput{}, err
+			}
+			created, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeCreated, targetID, chapters[i], nil), chapters[i].ID)
+			if err != nil {
+				return prisma.BookUpdateInput{}, err
+			}
+			entries = append(entries, deleted.withoutBook(), created.withoutBook())
+		}
+
+		n := len(targetChapters)
+		if first := chapters[0].Position; n > 0 && targetChapters[n-1].Position >= first {
+			for i := range targetChapters {
+				id, key := targetChapters[i].ID, first-float64(n-i)
+				chapterData.Update = append(chapterData.Update, prisma.ChapterUpdateWithWhereUniqueWithoutBookInput{
+					Where: prisma.ChapterWhereUniqueInput{
+						ID: &id,
+					},
+					Data: prisma.ChapterUpdateWithoutBookDataInput{
+						Position: &key,
+					},
+				})
+			}
+		}
+		data.Chapters = chapterData
+	}
+
+	if len(entries) > 0 {
+		data.Outbox = &prisma.OutboxEventUpdateManyWithoutBookInput{Create: entries}
+	}
+
+	return data, nil
+}
+
+// mergeMetadata returns the metadata of the target of a merge: the one of
+// the target, with the fields it leaves unset taken from the source.
+func mergeMetadata(target, source Metadata) Metadata {
+	m := target
+	if len(m.Authors) == 0 {
+		m.Authors = source.Authors
+	}
+	if m.ISBN == "" {
+		m.ISBN = source.ISBN
+	}
+	if m.Publisher == "" {
+		m.Publisher = source.Publisher
+	}
+	if m.Year == 0 {
+		m.Year = source.Year
+	}
+	if m.Language == "" {
+		m.Language = source.Language
+	}
+	return m
+}
+
+// mergeLabels adds to data, the update of the target of a merge, the tags
+// and collections of the source which the target lacks.
+func mergeLabels(ctx context.Context, sourceID, targetID string, data *prisma.BookUpdateInput) error {
+	sourceTags, err := bookTags(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	targetTags, err := bookTags(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	sourceCollections, err := bookCollections(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	targetCollections, err := bookCollections(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	if tags := missing(sourceTags, targetTags); len(tags) > 0 {
+		data.Tags = &prisma.TagUpdateManyWithoutBooksInput{Connect: tagsWhere(tags)}
+	}
+
+	if collections := missing(sourceCollections, targetCollections); len(collections) > 0 {
+		data.Collections = &prisma.CollectionUpdateManyWithoutBooksInput{Connect: collectionsWhere(collections)}
+	}
+
+	return nil
+}
+
+// missing returns the values which are in values but not in present.
+func missing(values, present []string) []string {
+	var result []string
+	for _, v := range values {
+		if !contains(present, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// mergeActivity adds to data, the update of the target of a merge, the
+// reading sessions and reminders of the source: they belong with the
+// chapters taken over, and would be deleted with the source otherwise.
+func mergeActivity(ctx context.Context, sourceID string, data *prisma.BookUpdateInput) error {
+	where := &prisma.BookWhereInput{
+		ID: &sourceID,
+	}
+
+	sessions, err := client.ReadingSessions(&prisma.ReadingSessionsParams{
+		Where: &prisma.ReadingSessionWhereInput{
+			Book: where,
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	reminders, err := client.Reminders(&prisma.RemindersParams{
+		Where: &prisma.ReminderWhereInput{
+			Book: where,
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if len(sessions) > 0 {
+		connect := make([]prisma.ReadingSessionWhereUniqueInput, len(sessions))
+		for i := range sessions {
+			connect[i] = prisma.ReadingSessionWhereUniqueInput{ID: &sessions[i].ID}
+		}
+		data.Sessions = &prisma.ReadingSessionUpdateManyWithoutBookInput{Connect: connect}
+	}
+
+	if len(reminders) > 0 {
+		connect := make([]prisma.ReminderWhereUniqueInput, len(reminders))
+		for i := range reminders {
+			connect[i] = prisma.ReminderWhereUniqueInput{ID: &reminders[i].ID}
+		}
+		data.Reminders = &prisma.ReminderUpdateManyWithoutBookInput{Connect: connect}
+	}
+
+	return nil
+}
+
+// trashMergedBook moves the emptied source of a merge to the trash, and
+// removes its ISBN if the target has taken it over.
+func trashMergedBook(ctx context.Context, source prisma.Book, movedISBN bool) error {
+	id := source.ID
+	entry, err := newOutboxEntry(NewEvent(EventTypeBook, prisma.MutationTypeDeleted, id, nil, source), id)
+	if err != nil {
+		return err
+	}
+
+	data := prisma.BookUpdateInput{
+		Deleted:   prisma.Bool(true),
+		DeletedAt: prisma.Str(formatTime(time.Now())),
+		Outbox: &prisma.OutboxEventUpdateManyWithoutBookInput{
+			Create: []prisma.OutboxEventCreateWithoutBookInput{entry.withoutBook()},
+		},
+	}
+	if movedISBN {
+		data.Isbn = prisma.Str("")
+	}
+
+	_, err = client.UpdateBook(prisma.BookUpdateParams{
+		Where: prisma.BookWhereUniqueInput{
+			ID: &id,
+		},
+		Data: data,
+	}).Exec(ctx)
+
+	return err
+}
+
+// DuplicateBook creates a copy of the book with the given id and all of its
+// chapters. Without a name, the copy is named after the original.
+//
+// The copy has the metadata, tags and collections of the original, and its
+// chapters have the tags and notes of theirs, all created in one mutation.
+// An ISBN identifies a single book, so the copy has none. Reading progress,
+// sessions, reminders and cards are the reader's, and stay with the
+// original.
+func (s *service) DuplicateBook(ctx context.Context, id, name string) (prisma.Book, []prisma.Chapter, error) {
+	if id == "" {
+		return prisma.Book{}, nil, ErrInvalidArgument
+	}
+
+	book, err := activeBook(ctx, id)
+	if err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	if name == "" {
+		if name, err = uniqueBookName(ctx, book.Name, "copy"); err != nil {
+			return prisma.Book{}, nil, err
+		}
+	}
+
+	m, err := bookMetadata(ctx, *book)
+	if err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	tags, err := bookTags(ctx, id)
+	if err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	collections, err := bookCollections(ctx, id)
+	if err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	chapters, err := orderedChapters(ctx, id)
+	if err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	copies := make([]NewChapter, len(chapters))
+	for i, c := range chapters {
+		copies[i] = NewChapter{Name: c.Name, Description: c.Description}
+	}
+
+	input, ids, err := bookCreateInput(prisma.Book{
+		Name:        name,
+		Description: book.Description,
+		Publisher:   book.Publisher,
+		Year:        book.Year,
+		Language:    book.Language,
+	}, copies)
+
+	if err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	if len(m.Authors) > 0 {
+		input.Authors = &prisma.AuthorCreateManyWithoutBooksInput{Connect: authorsWhere(m.Authors)}
+	}
+	if len(tags) > 0 {
+		input.Tags = &prisma.TagCreateManyWithoutBooksInput{Connect: tagsWhere(tags)}
+	}
+	if len(collections) > 0 {
+		input.Collections = &prisma.CollectionCreateManyWithoutBooksInput{Connect: collectionsWhere(collections)}
+	}
+
+	for i := range chapters {
+		if err := copyChapterContent(ctx, chapters[i].ID, &input.Chapters.Create[i]); err != nil {
+			return prisma.Book{}, nil, err
+		}
+	}
+
+	return createBook(ctx, input, ids)
+}
+
+// copyChapterContent adds to data, the creation of a copy of the chapter
+// with the given id, the tags and notes of the chapter.
+func copyChapterContent(ctx context.Context, id string, data *prisma.ChapterCreateWithoutBookInput) error {
+	tags, err := chapterTags(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	orderBy := prisma.NoteOrderByInputCreatedAtAsc
+	notes, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &id,
+	}).Notes(&prisma.NotesParamsExec{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if len(tags) > 0 {
+		data.Tags = &prisma.TagCreateManyWithoutChaptersInput{Connect: tagsWhere(tags)}
+	}
+
+	if len(notes) > 0 {
+		creates := make([]prisma.NoteCreateWithoutChapterInput, len(notes))
+		for i := range notes {
+			creates[i] = prisma.NoteCreateWithoutChapterInput{
+				Quote:    notes[i].Quote,
+				Text:     notes[i].Text,
+				Location: notes[i].Location,
+				Color:    &notes[i].Color,
+			}
+		}
+		data.Notes = &prisma.NoteCreateManyWithoutChapterInput{Create: creates}
+	}
+
+	return nil
+}
+
+func authorsWhere(names []string) []prisma.AuthorWhereUniqueInput {
+	where := make([]prisma.AuthorWhereUniqueInput, len(names))
+	for i := range names {
+		where[i] = prisma.AuthorWhereUniqueInput{Name: &names[i]}
+	}
+	return where
+}
+
+func tagsWhere(names []string) []prisma.TagWhereUniqueInput {
+	where := make([]prisma.TagWhereUniqueInput, len(names))
+	for i := range names {
+		where[i] = prisma.TagWhereUniqueInput{Name: &names[i]}
+	}
+	return where
+}
+
+func collectionsWhere(ids []string) []prisma.CollectionWhereUniqueInput {
+	where := make([]prisma.CollectionWhereUniqueInput, len(ids))
+	for i := range ids {
+		where[i] = prisma.CollectionWhereUniqueInput{ID: &ids[i]}
+	}
+	return where
+}
+
+// uniqueBookName returns a name no book has yet, made of name and a label,
+// e.g. "Name (copy)" or "Name (copy 2)". Books in the trash keep their
+// names taken.
+func uniqueBookName(ctx context.Context, name, label string) (string, error) {
+	for i := 1; i <= maxNameAttempts; i++ {
+		candidate := fmt.Sprintf("%s (%s)", name, label)
+		if i > 1 {
+			candidate = fmt.Sprintf("%s (%s %d)", name, label, i)
+		}
+
+		_, err := client.Book(prisma.BookWhereUniqueInput{
+			Name: &candidate,
+		}).Exec(ctx)
+
+		switch err {
+		case prisma.ErrNoResult:
+			return candidate, nil
+		case nil:
+		default:
+			return "", err
+		}
+	}
+	return "", ErrInvalidArgument
+}
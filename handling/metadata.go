@@ -0,0 +1,360 @@
+package handling
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// ErrDuplicateISBN is returned when an ISBN is given to a book while
+// another book already has it.
+var ErrDuplicateISBN = errors.New("isbn already in use")
+
+// Metadata is the bibliographic data of a book. Empty fields are unset.
+//
+// ISBNs are stored in their ISBN-13 form, so a book can be looked up by
+// either form. Revisions keep the name and description of a book only, but
+// a change of its metadata makes a new revision all the same.
+type Metadata struct {
+	Authors   []string `json:"authors"`
+	ISBN      string   `json:"isbn,omitempty"`
+	Publisher string   `json:"publisher,omitempty"`
+	Year      int32    `json:"year,omitempty"`
+	Language  string   `json:"language,omitempty"`
+}
+
+// BookFilter narrows down the books listed. Empty fields match every book.
+type BookFilter struct {
+	Author     string
+	Tag        string
+	Collection string
+}
+
+// languagePattern matches ISO 639 language codes.
+var languagePattern = regexp.MustCompile(`^[a-z]{2,3}$`)
+
+func (s *service) BookMetadata(ctx context.Context, id string) (Metadata, error) {
+	if id == "" {
+		return Metadata{}, ErrInvalidArgument
+	}
+
+	book, err := activeBook(ctx, id)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	return bookMetadata(ctx, *book)
+}
+
+func (s *service) UpdateBookMetadata(ctx context.Context, id string, m Metadata, version int32) (prisma.Book, error) {
+	if id == "" {
+		return prisma.Book{}, ErrInvalidArgument
+	}
+
+	m, err := normalizeMetadata(m)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	book, err := activeBook(ctx, id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	if err := checkVersion(book.Revision, version); err != nil {
+		return prisma.Book{}, err
+	}
+
+	current, err := bookMetadata(ctx, *book)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	if sameMetadata(current, m) {
+		return *book, nil
+	}
+
+	// The ISBN cannot be unique in storage, as there is no way to unset
+	// it again other than storing it empty.
+	if m.ISBN != "" && m.ISBN != current.ISBN {
+		books, err := client.Books(&prisma.BooksParams{
+			Where: &prisma.BookWhereInput{
+				Isbn: &m.ISBN,
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return prisma.Book{}, err
+		}
+
+		if len(books) > 0 {
+			return prisma.Book{}, ErrDuplicateISBN
+		}
+	}
+
+	// Authors are shared between books and created on first use.
+	authors := make([]prisma.AuthorWhereUniqueInput, len(m.Authors))
+	for i := range m.Authors {
+		name := m.Authors[i]
+		_, err := client.UpsertAuthor(prisma.AuthorUpsertParams{
+			Where:  prisma.AuthorWhereUniqueInput{Name: &name},
+			Create: prisma.AuthorCreateInput{Name: name},
+		}).Exec(ctx)
+
+		if err != nil {
+			return prisma.Book{}, err
+		}
+		authors[i] = prisma.AuthorWhereUniqueInput{Name: &name}
+	}
+
+	updated := *book
+	updated.Isbn, updated.Publisher, updated.Year, updated.Language = &m.ISBN, &m.Publisher, &m.Year, &m.Language
+	updated.Revision = book.Revision + 1
+
+	entry, err := newOutboxEntry(NewEvent(EventTypeBook, prisma.MutationTypeUpdated, id, updated, *book), id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	result, err := client.UpdateBook(prisma.BookUpdateParams{
+		Where: prisma.BookWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.BookUpdateInput{
+			Isbn:      updated.Isbn,
+			Publisher: updated.Publisher,
+			Year:      updated.Year,
+			Language:  updated.Language,
+			Authors: &prisma.AuthorUpdateManyWithoutBooksInput{
+				Set: authors,
+			},
+			Revision: &updated.Revision,
+			Revisions: &prisma.RevisionUpdateManyWithoutBookInput{
+				Create: []prisma.RevisionCreateWithoutBookInput{{
+					Key:         revisionKey(id, updated.Revision),
+					Number:      updated.Revision,
+					Name:        book.Name,
+					Description: book.Description,
+				}},
+			},
+			Outbox: &prisma.OutboxEventUpdateManyWithoutBookInput{
+				Create: []prisma.OutboxEventCreateWithoutBookInput{entry.withoutBook()},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		if current, cerr := client.Book(prisma.BookWhereUniqueInput{ID: &id}).Exec(ctx); cerr == nil && current.Revision != book.Revision {
+			return prisma.Book{}, ErrVersionMismatch
+		}
+		return prisma.Book{}, err
+	}
+
+	return *result, nil
+}
+
+func (s *service) BookByISBN(ctx context.Context, isbn string) (prisma.Book, error) {
+	isbn, err := normalizeISBN(isbn)
+	if err != nil || isbn == "" {
+		return prisma.Book{}, ErrInvalidArgument
+	}
+
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			Isbn:    &isbn,
+			Deleted: prisma.Bool(false),
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	if len(books) == 0 {
+		return prisma.Book{}, ErrNotFound
+	}
+
+	return books[0], nil
+}
+
+func (s *service) Authors(ctx context.Context) ([]prisma.Author, error) {
+	orderBy := prisma.AuthorOrderByInputNameAsc
+	authors, err := client.Authors(&prisma.AuthorsParams{
+		Where: &prisma.AuthorWhereInput{
+			BooksSome: &prisma.BookWhereInput{
+				Deleted: prisma.Bool(false),
+			},
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return authors, nil
+}
+
+// bookMetadata returns the metadata of book.
+func bookMetadata(ctx context.Context, book prisma.Book) (Metadata, error) {
+	id := book.ID
+	orderBy := prisma.AuthorOrderByInputNameAsc
+	authors, err := client.Book(prisma.BookWhereUniqueInput{
+		ID: &id,
+	}).Authors(&prisma.AuthorsParamsExec{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	m := Metadata{
+		Authors: make([]string, len(authors)),
+	}
+	for i, a := range authors {
+		m.Authors[i] = a.Name
+	}
+	if book.Isbn != nil {
+		m.ISBN = *book.Isbn
+	}
+	if book.Publisher != nil {
+		m.Publisher = *book.Publisher
+	}
+	if book.Year != nil {
+		m.Year = *book.Year
+	}
+	if book.Language != nil {
+		m.Language = *book.Language
+	}
+	return m, nil
+}
+
+// normalizeMetadata validates m and returns it in the form it is stored
+// in.
+func normalizeMetadata(m Metadata) (Metadata, error) {
+	isbn, err := normalizeISBN(m.ISBN)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	n := Metadata{
+		Authors:   make([]string, 0, len(m.Authors)),
+		ISBN:      isbn,
+		Publisher: strings.TrimSpace(m.Publisher),
+		Year:      m.Year,
+		Language:  strings.ToLower(strings.TrimSpace(m.Language)),
+	}
+
+	seen := make(map[string]bool, len(m.Authors))
+	for _, a := range m.Authors {
+		a = strings.Join(strings.Fields(a), " ")
+		if a == "" {
+			return Metadata{}, ErrInvalidArgument
+		}
+		if !seen[a] {
+			seen[a] = true
+			n.Authors = append(n.Authors, a)
+		}
+	}
+	sort.Strings(n.Authors)
+
+	if n.Year < 0 || n.Year > int32(time.Now().Year()+1) {
+		return Metadata{}, ErrInvalidArgument
+	}
+
+	if n.Language != "" && !languagePattern.MatchString(n.Language) {
+		return Metadata{}, ErrInvalidArgument
+	}
+
+	return n, nil
+}
+
+func sameMetadata(a, b Metadata) bool {
+	if len(a.Authors) != len(b.Authors) {
+		return false
+	}
+	for i := range a.Authors {
+		if a.Authors[i] != b.Authors[i] {
+			return false
+		}
+	}
+	return a.ISBN == b.ISBN && a.Publisher == b.Publisher && a.Year == b.Year && a.Language == b.Language
+}
+
+// normalizeISBN validates an ISBN-10 or ISBN-13 and returns it as an
+// ISBN-13 without separators. An empty ISBN is returned as is.
+func normalizeISBN(isbn string) (string, error) {
+	var digits []byte
+	for _, r := range strings.ToUpper(isbn) {
+		switch {
+		case r >= '0' && r <= '9', r == 'X':
+			digits = append(digits, byte(r))
+		case r == '-' || r == ' ':
+		default:
+			return "", ErrInvalidArgument
+		}
+	}
+
+	switch len(digits) {
+	case 0:
+		return "", nil
+	case 10:
+		if !validISBN10(digits) {
+			return "", ErrInvalidArgument
+		}
+		isbn13 := append([]byte("978"), digits[:9]...)
+		return string(append(isbn13, isbn13CheckDigit(isbn13))), nil
+	case 13:
+		if !validISBN13(digits) {
+			return "", ErrInvalidArgument
+		}
+		return string(digits), nil
+	}
+	return "", ErrInvalidArgument
+}
+
+// validISBN10 reports whether the weighted sum of the digits is divisible
+// by 11. Only the check digit may be X, standing for 10.
+func validISBN10(digits []byte) bool {
+	sum := 0
+	for i, d := range digits {
+		v := int(d - '0')
+		if d == 'X' {
+			if i != 9 {
+				return false
+			}
+			v = 10
+		}
+		sum += (10 - i) * v
+	}
+	return sum%11 == 0
+}
+
+func validISBN13(digits []byte) bool {
+	for _, d := range digits {
+		if d == 'X' {
+			return false
+		}
+	}
+	return isbn13CheckDigit(digits[:12]) == digits[12]
+}
+
+// isbn13CheckDigit returns the check digit for the first twelve digits of
+// an ISBN-13, which are weighted alternately by 1 and 3.
+func isbn13CheckDigit(digits []byte) byte {
+	sum := 0
+	for i, d := range digits[:12] {
+		w := 1
+		if i%2 == 1 {
+			w = 3
+		}
+		sum += w * int(d-'0')
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,36 @@
+package handling
+
+import "testing"
+
+func TestNormalizeISBN(t *testing.T) {
+	tests := []struct {
+		isbn string
+		want string
+		err  error
+	}{
+		{"", "", nil},
+		{"9780306406157", "9780306406157", nil},
+		{"978-0-306-40615-7", "9780306406157", nil},
+		{"0-306-40615-2", "9780306406157", nil},
+		{"0 306 40615 2", "9780306406157", nil},
+		{"080442957X", "9780804429573", nil},
+		{"080442957x", "9780804429573", nil},
+		{"0-306-40615-3", "", ErrInvalidArgument},
+		{"978-0-306-40615-8", "", ErrInvalidArgument},
+		{"X306406152", "", ErrInvalidArgument},
+		{"978030640615X", "", ErrInvalidArgument},
+		{"030640615", "", ErrInvalidArgument},
+		{"0-306-40615-2-1", "", ErrInvalidArgument},
+		{"ISBN 0306406152", "", ErrInvalidArgument},
+		{"0.306.40615.2", "", ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.isbn, func(t *testing.T) {
+			got, err := normalizeISBN(tt.isbn)
+			if got != tt.want || err != tt.err {
+				t.Errorf("normalizeISBN(%q) = %q, %v, want %q, %v", tt.isbn, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
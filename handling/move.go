@@ -0,0 +1,315 @@
+package handling
+
+import (
+	"context"
+	"sort"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// LastPosition places a chapter after all other chapters of its book.
+const LastPosition int32 = -1
+
+// Chapters are ordered within their book by their position, which is a
+// sort key rather than an index: a chapter is put between two others by
+// giving it a position between theirs, so that no other chapter has to be
+// changed. Only when two neighbours leave no room between them are the
+// chapters of the book renumbered. A position is not part of the content
+// of a chapter, but a move makes a new revision of the chapter all the
+// same, with its name and description unchanged, so that the version a
+// move is conditioned on by If-Match changes with it. The chapters which
+// are renumbered to make room, or put in order by a merge, keep their
+// revisions.
+
+func (s *service) MoveChapter(ctx context.Context, id, bookID string, position, version int32) (prisma.Chapter, error) {
+	if id == "" || bookID == "" {
+		return prisma.Chapter{}, ErrInvalidArgument
+	}
+
+	chapter, err := activeChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	if err := checkVersion(chapter.Revision, version); err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	source, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &id,
+	}).Book().Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	if _, err := activeBook(ctx, bookID); err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	if source.ID == bookID {
+		chapters, err := orderedChapters(ctx, bookID)
+		if err != nil {
+			return prisma.Chapter{}, err
+		}
+		if chapterIndex(chapters, id) == clampPosition(position, int32(len(chapters))-1) {
+			return *chapter, nil
+		}
+	}
+
+	key, err := placeChapter(ctx, bookID, id, position)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	moved := *chapter
+	moved.Position, moved.Revision = key, chapter.Revision+1
+
+	// A chapter moved to another book is gone for those following the
+	// source book and new to those following the target book.
+	var events []Event
+	if source.ID == bookID {
+		events = []Event{
+			NewEvent(EventTypeChapter, prisma.MutationTypeUpdated, bookID, moved, *chapter),
+		}
+	} else {
+		events = []Event{
+			NewEvent(EventTypeChapter, prisma.MutationTypeDeleted, source.ID, nil, *chapter),
+			NewEvent(EventTypeChapter, prisma.MutationTypeCreated, bookID, moved, nil),
+		}
+	}
+
+	entries := make([]prisma.OutboxEventCreateWithoutChapterInput, len(events))
+	for i, e := range events {
+		entry, err := newOutboxEntry(e, id)
+		if err != nil {
+			return prisma.Chapter{}, err
+		}
+		entries[i] = entry.withoutChapter()
+	}
+
+	data := prisma.ChapterUpdateInput{
+		Position: &moved.Position,
+		Revision: &moved.Revision,
+		Revisions: &prisma.RevisionUpdateManyWithoutChapterInput{
+			Create: []prisma.RevisionCreateWithoutChapterInput{{
+				Key:         revisionKey(id, moved.Revision),
+				Number:      moved.Revision,
+				Name:        moved.Name,
+				Description: moved.Description,
+			}},
+		},
+		Outbox: &prisma.OutboxEventUpdateManyWithoutChapterInput{
+			Create: entries,
+		},
+	}
+	if source.ID != bookID {
+		data.Book = &prisma.BookUpdateOneRequiredWithoutChaptersInput{
+			Connect: &prisma.BookWhereUniqueInput{
+				ID: &bookID,
+			},
+		}
+	}
+
+	result, err := client.UpdateChapter(prisma.ChapterUpdateParams{
+		Where: prisma.ChapterWhereUniqueInput{
+			ID: &id,
+		},
+		Data: data,
+	}).Exec(ctx)
+
+	if err != nil {
+		if current, cerr := client.Chapter(prisma.ChapterWhereUniqueInput{ID: &id}).Exec(ctx); cerr == nil && current.Revision != chapter.Revision {
+			return prisma.Chapter{}, ErrVersionMismatch
+		}
+		return prisma.Chapter{}, err
+	}
+
+	return *result, nil
+}
+
+func (s *service) CopyChapter(ctx context.Context, id, bookID string, position int32) (prisma.Chapter, error) {
+	if id == "" || bookID == "" {
+		return prisma.Chapter{}, ErrInvalidArgument
+	}
+
+	chapter, err := activeChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	return createChapter(ctx, chapter.Name, chapter.Description, bookID, position)
+}
+
+// createChapter creates a chapter at the given position of the active book
+// with the id bookID.
+func createChapter(ctx context.Context, name, description, bookID string, position int32) (prisma.Chapter, error) {
+	if _, err := activeBook(ctx, bookID); err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	key, err := placeChapter(ctx, bookID, "", position)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	id := newID()
+	entry, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeCreated, bookID, prisma.Chapter{
+		ID:          id,
+		Name:        name,
+		Description: description,
+		Position:    key,
+		Revision:    1,
+	}, nil), id)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	chapter, err := client.CreateChapter(prisma.ChapterCreateInput{
+		ID:          &id,
+		Name:        name,
+		Description: description,
+		Position:    &key,
+		Book: prisma.BookCreateOneWithoutChaptersInput{
+			Connect: &prisma.BookWhereUniqueInput{
+				ID: &bookID,
+			},
+		},
+		Revisions: &prisma.RevisionCreateManyWithoutChapterInput{
+			Create: []prisma.RevisionCreateWithoutChapterInput{{
+				Key:         revisionKey(id, 1),
+				Number:      1,
+				Name:        name,
+				Description: description,
+			}},
+		},
+		Outbox: &prisma.OutboxEventCreateManyWithoutChapterInput{
+			Create: []prisma.OutboxEventCreateWithoutChapterInput{entry.withoutChapter()},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	return *chapter, nil
+}
+
+// orderedChapters returns the active chapters of the book with the given
+// id in order. Chapters with the same position are ordered by creation.
+func orderedChapters(ctx context.Context, bookID string) ([]prisma.Chapter, error) {
+	orderBy := prisma.ChapterOrderByInputCreatedAtAsc
+	chapters, err := client.Chapters(&prisma.ChaptersParams{
+		Where: &prisma.ChapterWhereInput{
+			Deleted: prisma.Bool(false),
+			Book: &prisma.BookWhereInput{
+				ID: &bookID,
+			},
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(chapters, func(i, j int) bool {
+		return chapters[i].Position < chapters[j].Position
+	})
+
+	return chapters, nil
+}
+
+// placeChapter returns the position key which puts a chapter at the given
+// index among the chapters of a book, leaving out the chapter with the
+// id exclude. An index out of range places the chapter last.
+func placeChapter(ctx context.Context, bookID, exclude string, position int32) (float64, error) {
+	chapters, err := orderedChapters(ctx, bookID)
+	if err != nil {
+		return 0, err
+	}
+
+	others := chapters[:0]
+	for _, c := range chapters {
+		if c.ID != exclude {
+			others = append(others, c)
+		}
+	}
+
+	if key, ok := positionKey(others, position); ok {
+		return key, nil
+	}
+
+	if err := renumberChapters(ctx, others); err != nil {
+		return 0, err
+	}
+
+	key, _ := positionKey(others, position)
+	return key, nil
+}
+
+// positionKey returns the position key for the given index among the
+// ordered chapters, and whether there is room for it.
+func positionKey(chapters []prisma.Chapter, position int32) (float64, bool) {
+	n := int32(len(chapters))
+	position = clampPosition(position, n)
+
+	switch {
+	case n == 0:
+		return 1, true
+	case position == n:
+		return chapters[n-1].Position + 1, true
+	case position == 0:
+		return chapters[0].Position - 1, true
+	}
+
+	before, after := chapters[position-1].Position, chapters[position].Position
+	key := before + (after-before)/2
+	return key, before < key && key < after
+}
+
+// clampPosition places an index out of range [0, n] at n.
+func clampPosition(position, n int32) int32 {
+	if position < 0 || position > n {
+		return n
+	}
+	return position
+}
+
+// chapterIndex returns the index of the chapter with the given id.
+func chapterIndex(chapters []prisma.Chapter, id string) int32 {
+	for i, c := range chapters {
+		if c.ID == id {
+			return int32(i)
+		}
+	}
+	return -1
+}
+
+// renumberChapters gives the ordered chapters the positions 1, 2, ... and
+// updates their positions in place.
+func renumberChapters(ctx context.Context, chapters []prisma.Chapter) error {
+	for i := range chapters {
+		key := float64(i + 1)
+		if chapters[i].Position == key {
+			continue
+		}
+
+		id := chapters[i].ID
+		_, err := client.UpdateChapter(prisma.ChapterUpdateParams{
+			Where: prisma.ChapterWhereUniqueInput{
+				ID: &id,
+			},
+			Data: prisma.ChapterUpdateInput{
+				Position: &key,
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return err
+		}
+		chapters[i].Position = key
+	}
+	return nil
+}
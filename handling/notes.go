@@ -0,0 +1,199 @@
+package handling
+
+import (
+	"context"
+	"strings"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Notes are highlights of a chapter: a quote from it, a note of one's own
+// on it, or both. They belong to their chapter in the way chapters belong
+// to their book. Notes of a chapter in the trash are hidden along with it,
+// and they are deleted for good along with the chapter.
+
+// NoteInput holds the fields of a note set by the user. A note has to have
+// a quote or a text. An empty color is the default color.
+type NoteInput struct {
+	Quote    string           `json:"quote"`
+	Text     string           `json:"text"`
+	Location string           `json:"location"`
+	Color    prisma.NoteColor `json:"color"`
+}
+
+func (s *service) AddNote(ctx context.Context, chapterID string, n NoteInput) (prisma.Note, error) {
+	n, err := normalizeNote(n)
+	if err != nil || chapterID == "" {
+		return prisma.Note{}, ErrInvalidArgument
+	}
+
+	if _, err := activeChapter(ctx, chapterID); err != nil {
+		return prisma.Note{}, err
+	}
+
+	note, err := client.CreateNote(prisma.NoteCreateInput{
+		Quote:    n.Quote,
+		Text:     n.Text,
+		Location: n.Location,
+		Color:    &n.Color,
+		Chapter: prisma.ChapterCreateOneWithoutNotesInput{
+			Connect: &prisma.ChapterWhereUniqueInput{
+				ID: &chapterID,
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Note{}, err
+	}
+
+	return *note, nil
+}
+
+func (s *service) GetNote(ctx context.Context, id string) (prisma.Note, error) {
+	if id == "" {
+		return prisma.Note{}, ErrInvalidArgument
+	}
+
+	note, err := activeNote(ctx, id)
+	if err != nil {
+		return prisma.Note{}, err
+	}
+
+	return *note, nil
+}
+
+func (s *service) UpdateNote(ctx context.Context, id string, n NoteInput) (prisma.Note, error) {
+	n, err := normalizeNote(n)
+	if err != nil || id == "" {
+		return prisma.Note{}, ErrInvalidArgument
+	}
+
+	if _, err := activeNote(ctx, id); err != nil {
+		return prisma.Note{}, err
+	}
+
+	note, err := client.UpdateNote(prisma.NoteUpdateParams{
+		Where: prisma.NoteWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.NoteUpdateInput{
+			Quote:    &n.Quote,
+			Text:     &n.Text,
+			Location: &n.Location,
+			Color:    &n.Color,
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Note{}, err
+	}
+
+	return *note, nil
+}
+
+func (s *service) DeleteNote(ctx context.Context, id string) (prisma.Note, error) {
+	if id == "" {
+		return prisma.Note{}, ErrInvalidArgument
+	}
+
+	if _, err := activeNote(ctx, id); err != nil {
+		return prisma.Note{}, err
+	}
+
+	note, err := client.DeleteNote(prisma.NoteWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Note{}, err
+	}
+
+	return *note, nil
+}
+
+func (s *service) Notes(ctx context.Context, chapterID string) ([]prisma.Note, error) {
+	if chapterID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeChapter(ctx, chapterID); err != nil {
+		return nil, err
+	}
+
+	orderBy := prisma.NoteOrderByInputCreatedAtAsc
+	notes, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &chapterID,
+	}).Notes(&prisma.NotesParamsExec{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+func (s *service) NoteChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	if id == "" {
+		return prisma.Chapter{}, ErrInvalidArgument
+	}
+
+	chapter, err := client.Note(prisma.NoteWhereUniqueInput{
+		ID: &id,
+	}).Chapter().Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	return *chapter, nil
+}
+
+// activeNote returns the note with the given id unless its chapter is in
+// the trash.
+func activeNote(ctx context.Context, id string) (*prisma.Note, error) {
+	notes, err := client.Notes(&prisma.NotesParams{
+		Where: &prisma.NoteWhereInput{
+			ID: &id,
+			Chapter: &prisma.ChapterWhereInput{
+				Deleted: prisma.Bool(false),
+				Book: &prisma.BookWhereInput{
+					Deleted: prisma.Bool(false),
+				},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(notes) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return &notes[0], nil
+}
+
+func normalizeNote(n NoteInput) (NoteInput, error) {
+	n.Quote = strings.TrimSpace(n.Quote)
+	n.Text = strings.TrimSpace(n.Text)
+	n.Location = strings.TrimSpace(n.Location)
+	n.Color = prisma.NoteColor(strings.ToUpper(string(n.Color)))
+
+	if n.Quote == "" && n.Text == "" {
+		return NoteInput{}, ErrInvalidArgument
+	}
+
+	switch n.Color {
+	case "":
+		n.Color = prisma.NoteColorYellow
+	case prisma.NoteColorYellow, prisma.NoteColorGreen, prisma.NoteColorBlue, prisma.NoteColorPink, prisma.NoteColorOrange:
+	default:
+		return NoteInput{}, ErrInvalidArgument
+	}
+
+	return n, nil
+}
@@ -0,0 +1,81 @@
+package handling
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Events are recorded in an outbox in the same storage transaction as the
+// mutation that causes them, and published from there by a relay. Prisma
+// runs a mutation together with its nested writes in a single transaction,
+// so every mutation writes its event as a nested outbox entry.
+//
+// The entries of a mutation share their createdAt, so the relay orders them
+// by their sequence instead: the time the entry was made in nanoseconds,
+// zero padded and kept increasing within the process.
+
+type outboxEntry struct {
+	ID          string
+	Type        string
+	Aggregate   string
+	AggregateID string
+	Payload     string
+	Sequence    string
+}
+
+var lastSequence int64
+
+func newOutboxEntry(e Event, aggregateID string) (outboxEntry, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return outboxEntry{}, err
+	}
+
+	return outboxEntry{
+		ID:          e.ID,
+		Type:        e.Name(),
+		Aggregate:   string(e.Type),
+		AggregateID: aggregateID,
+		Payload:     string(payload),
+		Sequence:    nextSequence(),
+	}, nil
+}
+
+func nextSequence() string {
+	for {
+		last := atomic.LoadInt64(&lastSequence)
+		next := time.Now().UnixNano()
+		if next <= last {
+			next = last + 1
+		}
+		if atomic.CompareAndSwapInt64(&lastSequence, last, next) {
+			return fmt.Sprintf("%020d", next)
+		}
+	}
+}
+
+func (e outboxEntry) withoutBook() prisma.OutboxEventCreateWithoutBookInput {
+	return prisma.OutboxEventCreateWithoutBookInput{
+		ID:          &e.ID,
+		Type:        e.Type,
+		Aggregate:   e.Aggregate,
+		AggregateID: e.AggregateID,
+		Payload:     e.Payload,
+		Sequence:    &e.Sequence,
+	}
+}
+
+func (e outboxEntry) withoutChapter() prisma.OutboxEventCreateWithoutChapterInput {
+	return prisma.OutboxEventCreateWithoutChapterInput{
+		ID:          &e.ID,
+		Type:        e.Type,
+		Aggregate:   e.Aggregate,
+		AggregateID: e.AggregateID,
+		Payload:     e.Payload,
+		Sequence:    &e.Sequence,
+	}
+}
@@ -0,0 +1,41 @@
+package handling
+
+import (
+	"testing"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+func TestNextSequence(t *testing.T) {
+	last := nextSequence()
+	for i := 0; i < 1000; i++ {
+		next := nextSequence()
+		if len(next) != 20 {
+			t.Fatalf("got sequence %s, want 20 digits", next)
+		}
+		if next <= last {
+			t.Fatalf("got sequence %s after %s, want it to increase", next, last)
+		}
+		last = next
+	}
+}
+
+func TestOutboxEntryOrder(t *testing.T) {
+	// The events of a chapter moved to another book, written by one
+	// mutation.
+	deleted, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeDeleted, "b1", nil, prisma.Chapter{ID: "c1"}), "c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeCreated, "b2", prisma.Chapter{ID: "c1"}, nil), "c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deleted.Sequence >= created.Sequence {
+		t.Errorf("got sequences %s and %s, want the deletion first", deleted.Sequence, created.Sequence)
+	}
+	if s := created.withoutChapter().Sequence; s == nil || *s != created.Sequence {
+		t.Errorf("sequence %s not written with the entry", created.Sequence)
+	}
+}
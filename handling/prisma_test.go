@@ -0,0 +1,142 @@
+package handling
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// prismaOp answers a Prisma operation given its variables. It returns the
+// data of the operation, nil if there is none.
+type prismaOp func(vars map[string]interface{}) (interface{}, error)
+
+// prismaCall is an operation the fake Prisma server was asked for.
+type prismaCall struct {
+	Op   string
+	Vars map[string]interface{}
+}
+
+// fakePrisma is a Prisma server answering operations by name.
+type fakePrisma struct {
+	t   *testing.T
+	ops map[string]prismaOp
+
+	mu    sync.Mutex
+	calls []prismaCall
+}
+
+var operationName = regexp.MustCompile(`^(?:query|mutation) (\w+)`)
+
+// usePrisma makes the service talk to a fake Prisma server answering ops
+// and returns it with a function which restores the client.
+func usePrisma(t *testing.T, ops map[string]prismaOp) (*fakePrisma, func()) {
+	f := &fakePrisma{t: t, ops: ops}
+	srv := httptest.NewServer(f)
+
+	previous := client
+	client = prisma.New(&prisma.Options{Endpoint: srv.URL})
+
+	return f, func() {
+		client = previous
+		srv.Close()
+	}
+}
+
+func (f *fakePrisma) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		f.t.Errorf("decoding Prisma request: %v", err)
+		return
+	}
+
+	m := operationName.FindStringSubmatch(req.Query)
+	if m == nil {
+		f.t.Errorf("unexpected Prisma request %q", req.Query)
+		return
+	}
+	name := m[1]
+
+	f.mu.Lock()
+	f.calls = append(f.calls, prismaCall{Op: name, Vars: req.Variables})
+	op, ok := f.ops[name]
+	f.mu.Unlock()
+
+	var resp struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []map[string]string    `json:"errors,omitempty"`
+	}
+	if !ok {
+		f.t.Errorf("unexpected Prisma operation %s", name)
+		resp.Errors = []map[string]string{{"message": "unexpected operation"}}
+	} else if data, err := op(req.Variables); err != nil {
+		resp.Errors = []map[string]string{{"message": err.Error()}}
+	} else {
+		resp.Data = map[string]interface{}{name: data}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// called returns the calls of the operation with the given name.
+func (f *fakePrisma) called(name string) []prismaCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var calls []prismaCall
+	for _, c := range f.calls {
+		if c.Op == name {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// errUniqueKey is what Prisma answers when a unique field would be
+// duplicated.
+var errUniqueKey = errors.New("A unique constraint would be violated on Revision. Details: Field name = key")
+
+// returns is a prismaOp answering data, each time in turn if several are
+// given and the last one from then on.
+func returns(data ...interface{}) prismaOp {
+	var mu sync.Mutex
+	return func(map[string]interface{}) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		d := data[0]
+		if len(data) > 1 {
+			data = data[1:]
+		}
+		return d, nil
+	}
+}
+
+// fails is a prismaOp answering err.
+func fails(err error) prismaOp {
+	return func(map[string]interface{}) (interface{}, error) {
+		return nil, err
+	}
+}
+
+// lookup returns the value at the given path of keys in v, nil if there is
+// none.
+func lookup(v interface{}, path ...string) interface{} {
+	for _, key := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
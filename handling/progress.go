@@ -0,0 +1,364 @@
+package handling
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Reading progress is kept per user and chapter: a chapter is unread,
+// being read or finished. The progress of a book follows from the progress
+// of its chapters. As with deletedAt, the timestamps are left as they are
+// when a chapter is marked unread again; the status is authoritative and
+// the timestamps are only reported along with the status they belong to.
+
+// ReadingChapter is a chapter together with its reading status for the
+// calling user.
+type ReadingChapter struct {
+	prisma.Chapter
+	Status     prisma.ReadingStatus `json:"status"`
+	StartedAt  *string              `json:"startedAt,omitempty"`
+	FinishedAt *string              `json:"finishedAt,omitempty"`
+}
+
+// BookProgress is the reading progress of a book for the calling user.
+// A book is finished once all of its chapters are, and is being read once
+// any of its chapters is being read or finished. Completion is the share
+// of finished chapters in percent.
+type BookProgress struct {
+	Book       prisma.Book          `json:"book"`
+	Status     prisma.ReadingStatus `json:"status"`
+	Chapters   int                  `json:"chapters"`
+	Reading    int                  `json:"reading"`
+	Finished   int                  `json:"finished"`
+	Completion float64              `json:"completion"`
+	StartedAt  *string              `json:"startedAt,omitempty"`
+	FinishedAt *string              `json:"finishedAt,omitempty"`
+	LastReadAt *string              `json:"lastReadAt,omitempty"`
+}
+
+func (s *service) SetChapterStatus(ctx context.Context, id string, status prisma.ReadingStatus) (ReadingChapter, error) {
+	status, err := normalizeReadingStatus(status)
+	if err != nil || id == "" {
+		return ReadingChapter{}, ErrInvalidArgument
+	}
+
+	chapter, err := activeChapter(ctx, id)
+	if err != nil {
+		return ReadingChapter{}, err
+	}
+
+	user := Actor(ctx)
+	key := progressKey(id, user)
+
+	previous, err := client.ReadingProgress(prisma.ReadingProgressWhereUniqueInput{
+		Key: &key,
+	}).Exec(ctx)
+
+	switch err {
+	case nil:
+		if previous.Status == status {
+			return readingChapter(*chapter, previous), nil
+		}
+	case prisma.ErrNoResult:
+		previous = &prisma.ReadingProgress{Status: prisma.ReadingStatusUnread}
+	default:
+		return ReadingChapter{}, err
+	}
+
+	// A chapter is started anew when it is read again, and finished when
+	// it is marked so, having been started then if it was unread.
+	now := formatTime(time.Now())
+	data := prisma.ReadingProgressUpdateInput{
+		Status: &status,
+	}
+	if status == prisma.ReadingStatusReading || (status == prisma.ReadingStatusFinished && previous.Status == prisma.ReadingStatusUnread) {
+		data.StartedAt = &now
+	}
+	if status == prisma.ReadingStatusFinished {
+		data.FinishedAt = &now
+	}
+
+	progress, err := client.UpsertReadingProgress(prisma.ReadingProgressUpsertParams{
+		Where: prisma.ReadingProgressWhereUniqueInput{
+			Key: &key,
+		},
+		Create: prisma.ReadingProgressCreateInput{
+			Key:        key,
+			User:       user,
+			Status:     &status,
+			StartedAt:  data.StartedAt,
+			FinishedAt: data.FinishedAt,
+			Chapter: prisma.ChapterCreateOneWithoutProgressInput{
+				Connect: &prisma.ChapterWhereUniqueInput{
+					ID: &id,
+				},
+			},
+		},
+		Update: data,
+	}).Exec(ctx)
+
+	if err != nil {
+		return ReadingChapter{}, err
+	}
+
+	return readingChapter(*chapter, progress), nil
+}
+
+func (s *service) BookProgress(ctx context.Context, id string) (BookProgress, error) {
+	if id == "" {
+		return BookProgress{}, ErrInvalidArgument
+	}
+
+	book, err := activeBook(ctx, id)
+	if err != nil {
+		return BookProgress{}, err
+	}
+
+	chapters, err := readingChapters(ctx, id, Actor(ctx))
+	if err != nil {
+		return BookProgress{}, err
+	}
+
+	return bookProgress(*book, chapters), nil
+}
+
+// CurrentlyReading returns the books being read by the calling user, the
+// one read last first.
+func (s *service) CurrentlyReading(ctx context.Context) ([]BookProgress, error) {
+	books, err := progressBooks(ctx, &prisma.ReadingProgressWhereInput{
+		User:     prisma.Str(Actor(ctx)),
+		StatusIn: []prisma.ReadingStatus{prisma.ReadingStatusReading, prisma.ReadingStatusFinished},
+	}, prisma.ReadingStatusReading)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(books, func(i, j int) bool {
+		return deref(books[i].LastReadAt) > deref(books[j].LastReadAt)
+	})
+
+	return books, nil
+}
+
+// FinishedBooks returns the books the calling user finished between from
+// and to, the one finished last first.
+func (s *service) FinishedBooks(ctx context.Context, from, to time.Time) ([]BookProgress, error) {
+	if !from.Before(to) {
+		return nil, ErrInvalidArgument
+	}
+
+	start, end := formatTime(from), formatTime(to)
+	status := prisma.ReadingStatusFinished
+	books, err := progressBooks(ctx, &prisma.ReadingProgressWhereInput{
+		User:          prisma.Str(Actor(ctx)),
+		Status:        &status,
+		FinishedAtGte: &start,
+		FinishedAtLt:  &end,
+	}, status)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A book is finished when its last chapter is, which has to be in the
+	// period as well.
+	finished := books[:0]
+	for _, b := range books {
+		if at := deref(b.FinishedAt); at >= start && at < end {
+			finished = append(finished, b)
+		}
+	}
+
+	sort.SliceStable(finished, func(i, j int) bool {
+		return deref(finished[i].FinishedAt) > deref(finished[j].FinishedAt)
+	})
+
+	return finished, nil
+}
+
+// progressBooks returns the progress of the books with a chapter of which
+// the progress matches where, keeping those with the given status.
+func progressBooks(ctx context.Context, where *prisma.ReadingProgressWhereInput, status prisma.ReadingStatus) ([]BookProgress, error) {
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			Deleted: prisma.Bool(false),
+			ChaptersSome: &prisma.ChapterWhereInput{
+				Deleted:      prisma.Bool(false),
+				ProgressSome: where,
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result []BookProgress
+	for _, book := range books {
+		chapters, err := readingChapters(ctx, book.ID, *where.User)
+		if err != nil {
+			return nil, err
+		}
+
+		if p := bookProgress(book, chapters); p.Status == status {
+			result = append(result, p)
+		}
+	}
+
+	return result, nil
+}
+
+// readingChapters returns the chapters of the book with the given id in
+// order, each with its reading status for user.
+func readingChapters(ctx context.Context, bookID, user string) ([]ReadingChapter, error) {
+	chapters, err := orderedChapters(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := client.ReadingProgresses(&prisma.ReadingProgressesParams{
+		Where: &prisma.ReadingProgressWhereInput{
+			User: &user,
+			Chapter: &prisma.ChapterWhereInput{
+				Book: &prisma.BookWhereInput{
+					ID: &bookID,
+				},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	byChapter := make(map[string]*prisma.ReadingProgress, len(progress))
+	for i := range progress {
+		byChapter[progressChapter(progress[i].Key)] = &progress[i]
+	}
+
+	result := make([]ReadingChapter, len(chapters))
+	for i, c := range chapters {
+		result[i] = readingChapter(c, byChapter[c.ID])
+	}
+
+	return result, nil
+}
+
+// readingChapter returns chapter with its progress, which is nil for an
+// unread chapter.
+func readingChapter(chapter prisma.Chapter, progress *prisma.ReadingProgress) ReadingChapter {
+	rc := ReadingChapter{
+		Chapter: chapter,
+		Status:  prisma.ReadingStatusUnread,
+	}
+	if progress == nil {
+		return rc
+	}
+
+	rc.Status = progress.Status
+	switch progress.Status {
+	case prisma.ReadingStatusReading:
+		rc.StartedAt = progress.StartedAt
+	case prisma.ReadingStatusFinished:
+		rc.StartedAt, rc.FinishedAt = progress.StartedAt, progress.FinishedAt
+	}
+	return rc
+}
+
+func bookProgress(book prisma.Book, chapters []ReadingChapter) BookProgress {
+	p := BookProgress{
+		Book:     book,
+		Status:   prisma.ReadingStatusUnread,
+		Chapters: len(chapters),
+	}
+
+	var started, finished, last string
+	for _, c := range chapters {
+		switch c.Status {
+		case prisma.ReadingStatusReading:
+			p.Reading++
+		case prisma.ReadingStatusFinished:
+			p.Finished++
+		default:
+			continue
+		}
+
+		if at := deref(c.StartedAt); at != "" && (started == "" || at < started) {
+			started = at
+		}
+		if at := deref(c.FinishedAt); at > finished {
+			finished = at
+		}
+		if at := deref(c.StartedAt); at > last {
+			last = at
+		}
+		if finished > last {
+			last = finished
+		}
+	}
+
+	if len(chapters) > 0 {
+		p.Completion = round2(float64(p.Finished) * 100 / float64(len(chapters)))
+	}
+
+	switch {
+	case p.Chapters > 0 && p.Finished == p.Chapters:
+		p.Status = prisma.ReadingStatusFinished
+		p.FinishedAt = optional(finished)
+	case p.Reading > 0 || p.Finished > 0:
+		p.Status = prisma.ReadingStatusReading
+	}
+	if p.Status != prisma.ReadingStatusUnread {
+		p.StartedAt, p.LastReadAt = optional(started), optional(last)
+	}
+
+	return p
+}
+
+func normalizeReadingStatus(status prisma.ReadingStatus) (prisma.ReadingStatus, error) {
+	status = prisma.ReadingStatus(strings.ToUpper(string(status)))
+	switch status {
+	case prisma.ReadingStatusUnread, prisma.ReadingStatusReading, prisma.ReadingStatusFinished:
+		return status, nil
+	}
+	return "", ErrInvalidArgument
+}
+
+// progressKey returns the key of the progress of user in the chapter with
+// the given id. Chapter ids contain no colons, so the chapter id is the
+// part of the key up to the first one.
+func progressKey(chapterID, user string) string {
+	return chapterID + ":" + user
+}
+
+func progressChapter(key string) string {
+	return strings.SplitN(key, ":", 2)[0]
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// chapterList returns the chapters of reading chapters.
+func chapterList(chapters []ReadingChapter) []prisma.Chapter {
+	list := make([]prisma.Chapter, len(chapters))
+	for i, c := range chapters {
+		list[i] = c.Chapter
+	}
+	return list
+}
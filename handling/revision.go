@@ -0,0 +1,569 @@
+package handling
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Every change to the name or description of a book or a chapter is kept
+// as a numbered revision, written in the same mutation as the change.
+// Revision 1 is the state the entity was created with, and the revision
+// field of the entity is the number of its latest revision.
+//
+// Moving an entity to the trash is recorded as a revision too, with its
+// name and description unchanged.
+//
+// The revision is also the version of the entity. Revision keys are unique,
+// so of two concurrent updates from the same revision only one can write
+// the next one, and the other fails as a whole.
+
+// RevisionDiff lists the fields which differ between two revisions.
+type RevisionDiff struct {
+	From    int32       `json:"from"`
+	To      int32       `json:"to"`
+	Changes []FieldDiff `json:"changes"`
+}
+
+// FieldDiff is the change of a single field. Lines is a line by line diff
+// of the values.
+type FieldDiff struct {
+	Field string     `json:"field"`
+	From  string     `json:"from"`
+	To    string     `json:"to"`
+	Lines []LineDiff `json:"lines"`
+}
+
+// Line diff operations.
+const (
+	LineEqual  = " "
+	LineDelete = "-"
+	LineInsert = "+"
+)
+
+// LineDiff is a line which is kept, deleted or inserted.
+type LineDiff struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// HistoricalBook is a book and its chapters as they were at a point in
+// time.
+type HistoricalBook struct {
+	At       string           `json:"at"`
+	Book     prisma.Book      `json:"book"`
+	Chapters []prisma.Chapter `json:"chapters"`
+}
+
+func (s *service) BookRevisions(ctx context.Context, id string) ([]prisma.Revision, error) {
+	if id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	orderBy := prisma.RevisionOrderByInputNumberAsc
+	return client.Revisions(&prisma.RevisionsParams{
+		Where: &prisma.RevisionWhereInput{
+			Book: &prisma.BookWhereInput{ID: &id},
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+}
+
+func (s *service) DiffBookRevisions(ctx context.Context, id string, from, to int32) (RevisionDiff, error) {
+	if id == "" {
+		return RevisionDiff{}, ErrInvalidArgument
+	}
+
+	a, err := revision(ctx, prisma.RevisionWhereInput{Book: &prisma.BookWhereInput{ID: &id}}, from)
+	if err != nil {
+		return RevisionDiff{}, err
+	}
+
+	b, err := revision(ctx, prisma.RevisionWhereInput{Book: &prisma.BookWhereInput{ID: &id}}, to)
+	if err != nil {
+		return RevisionDiff{}, err
+	}
+
+	return diffRevisions(a, b), nil
+}
+
+func (s *service) BookAt(ctx context.Context, id string, at time.Time) (HistoricalBook, error) {
+	if id == "" || at.IsZero() {
+		return HistoricalBook{}, ErrInvalidArgument
+	}
+
+	book, err := client.Book(prisma.BookWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err != nil {
+		return HistoricalBook{}, err
+	}
+
+	ts := formatTime(at)
+	if deletedBefore(book.Deleted, book.DeletedAt, ts) {
+		return HistoricalBook{}, ErrNotFound
+	}
+
+	rev, err := revisionAt(ctx, prisma.RevisionWhereInput{Book: &prisma.BookWhereInput{ID: &id}}, book.CreatedAt, ts)
+	if err != nil {
+		return HistoricalBook{}, err
+	}
+
+	book.Name, book.Description, book.Revision, book.UpdatedAt = rev.Name, rev.Description, rev.Number, rev.CreatedAt
+	book.Deleted, book.DeletedAt = false, nil
+
+	chapters, err := client.Book(prisma.BookWhereUniqueInput{
+		ID: &id,
+	}).Chapters(&prisma.ChaptersParamsExec{
+		Where: &prisma.ChapterWhereInput{
+			CreatedAtLte: &ts,
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return HistoricalBook{}, err
+	}
+
+	historical := HistoricalBook{
+		At:       ts,
+		Book:     *book,
+		Chapters: make([]prisma.Chapter, 0, len(chapters)),
+	}
+
+	for _, chapter := range chapters {
+		if deletedBefore(chapter.Deleted, chapter.DeletedAt, ts) {
+			continue
+		}
+
+		chapterID := chapter.ID
+		rev, err := revisionAt(ctx, prisma.RevisionWhereInput{Chapter: &prisma.ChapterWhereInput{ID: &chapterID}}, chapter.CreatedAt, ts)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return HistoricalBook{}, err
+		}
+
+		chapter.Name, chapter.Description, chapter.Revision, chapter.UpdatedAt = rev.Name, rev.Description, rev.Number, rev.CreatedAt
+		chapter.Deleted, chapter.DeletedAt = false, nil
+		historical.Chapters = append(historical.Chapters, chapter)
+	}
+
+	return historical, nil
+}
+
+func (s *service) RevertBook(ctx context.Context, id string, number int32, version int32) (prisma.Book, error) {
+	if id == "" {
+		return prisma.Book{}, ErrInvalidArgument
+	}
+
+	book, err := activeBook(ctx, id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	if err := checkVersion(book.Revision, version); err != nil {
+		return prisma.Book{}, err
+	}
+
+	rev, err := revision(ctx, prisma.RevisionWhereInput{Book: &prisma.BookWhereInput{ID: &id}}, number)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	return updateBook(ctx, *book, rev.Name, rev.Description)
+}
+
+func (s *service) ChapterRevisions(ctx context.Context, id string) ([]prisma.Revision, error) {
+	if id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	orderBy := prisma.RevisionOrderByInputNumberAsc
+	return client.Revisions(&prisma.RevisionsParams{
+		Where: &prisma.RevisionWhereInput{
+			Chapter: &prisma.ChapterWhereInput{ID: &id},
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+}
+
+func (s *service) DiffChapterRevisions(ctx context.Context, id string, from, to int32) (RevisionDiff, error) {
+	if id == "" {
+		return RevisionDiff{}, ErrInvalidArgument
+	}
+
+	a, err := revision(ctx, prisma.RevisionWhereInput{Chapter: &prisma.ChapterWhereInput{ID: &id}}, from)
+	if err != nil {
+		return RevisionDiff{}, err
+	}
+
+	b, err := revision(ctx, prisma.RevisionWhereInput{Chapter: &prisma.ChapterWhereInput{ID: &id}}, to)
+	if err != nil {
+		return RevisionDiff{}, err
+	}
+
+	return diffRevisions(a, b), nil
+}
+
+func (s *service) RevertChapter(ctx context.Context, id string, number int32, version int32) (prisma.Chapter, error) {
+	if id == "" {
+		return prisma.Chapter{}, ErrInvalidArgument
+	}
+
+	chapter, err := activeChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	if err := checkVersion(chapter.Revision, version); err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	rev, err := revision(ctx, prisma.RevisionWhereInput{Chapter: &prisma.ChapterWhereInput{ID: &id}}, number)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	return updateChapter(ctx, *chapter, rev.Name, rev.Description)
+}
+
+// updateBook changes the name and description of book and records the
+// change as its next revision. Nothing is written if nothing changed.
+func updateBook(ctx context.Context, book prisma.Book, name, description string) (prisma.Book, error) {
+	if book.Name == name && book.Description == description {
+		return book, nil
+	}
+
+	if name != book.Name {
+		if err := checkBookName(ctx, name, book.ID); err != nil {
+			return prisma.Book{}, err
+		}
+	}
+
+	updated := book
+	updated.Name, updated.Description, updated.Revision = name, description, book.Revision+1
+
+	entry, err := newOutboxEntry(NewEvent(EventTypeBook, prisma.MutationTypeUpdated, book.ID, updated, book), book.ID)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	id := book.ID
+	result, err := client.UpdateBook(prisma.BookUpdateParams{
+		Where: prisma.BookWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.BookUpdateInput{
+			Name:        &name,
+			Description: &description,
+			Revision:    &updated.Revision,
+			Revisions: &prisma.RevisionUpdateManyWithoutBookInput{
+				Create: []prisma.RevisionCreateWithoutBookInput{{
+					Key:         revisionKey(id, updated.Revision),
+					Number:      updated.Revision,
+					Name:        name,
+					Description: description,
+				}},
+			},
+			Outbox: &prisma.OutboxEventUpdateManyWithoutBookInput{
+				Create: []prisma.OutboxEventCreateWithoutBookInput{entry.withoutBook()},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Book{}, bookWriteError(ctx, book, err)
+	}
+
+	return *result, nil
+}
+
+// updateChapter changes the name and description of chapter and records the
+// change as its next revision. Nothing is written if nothing changed.
+func updateChapter(ctx context.Context, chapter prisma.Chapter, name, description string) (prisma.Chapter, error) {
+	if chapter.Name == name && chapter.Description == description {
+		return chapter, nil
+	}
+
+	id := chapter.ID
+	book, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &id,
+	}).Book().Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	updated := chapter
+	updated.Name, updated.Description, updated.Revision = name, description, chapter.Revision+1
+
+	entry, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeUpdated, book.ID, updated, chapter), id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	result, err := client.UpdateChapter(prisma.ChapterUpdateParams{
+		Where: prisma.ChapterWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.ChapterUpdateInput{
+			Name:        &name,
+			Description: &description,
+			Revision:    &updated.Revision,
+			Revisions: &prisma.RevisionUpdateManyWithoutChapterInput{
+				Create: []prisma.RevisionCreateWithoutChapterInput{{
+					Key:         revisionKey(id, updated.Revision),
+					Number:      updated.Revision,
+					Name:        name,
+					Description: description,
+				}},
+			},
+			Outbox: &prisma.OutboxEventUpdateManyWithoutChapterInput{
+				Create: []prisma.OutboxEventCreateWithoutChapterInput{entry.withoutChapter()},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, chapterWriteError(ctx, chapter, err)
+	}
+
+	return *result, nil
+}
+
+// bookWriteError returns the error of a failed write of the next revision
+// of book. It is ErrVersionMismatch if another write made that revision
+// first, and err otherwise.
+func bookWriteError(ctx context.Context, book prisma.Book, err error) error {
+	id := book.ID
+	if current, cerr := client.Book(prisma.BookWhereUniqueInput{ID: &id}).Exec(ctx); cerr == nil && current.Revision != book.Revision {
+		return ErrVersionMismatch
+	}
+	return err
+}
+
+// chapterWriteError is bookWriteError for a chapter.
+func chapterWriteError(ctx context.Context, chapter prisma.Chapter, err error) error {
+	id := chapter.ID
+	if current, cerr := client.Chapter(prisma.ChapterWhereUniqueInput{ID: &id}).Exec(ctx); cerr == nil && current.Revision != chapter.Revision {
+		return ErrVersionMismatch
+	}
+	return err
+}
+
+// revision returns the revision with the given number among those matched
+// by where.
+func revision(ctx context.Context, where prisma.RevisionWhereInput, number int32) (prisma.Revision, error) {
+	where.Number = &number
+	revisions, err := client.Revisions(&prisma.RevisionsParams{
+		Where: &where,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Revision{}, err
+	}
+
+	if len(revisions) == 0 {
+		return prisma.Revision{}, ErrNotFound
+	}
+
+	return revisions[0], nil
+}
+
+// revisionAt returns the latest revision among those matched by where
+// which was made at or before ts. The entity they belong to was created at
+// createdAt, and from then on revision 1 is its state even if it was
+// recorded later, as it is for entities which predate revisions.
+func revisionAt(ctx context.Context, where prisma.RevisionWhereInput, createdAt, ts string) (prisma.Revision, error) {
+	if createdAt > ts {
+		return prisma.Revision{}, ErrNotFound
+	}
+
+	at := where
+	at.CreatedAtLte = &ts
+	orderBy := prisma.RevisionOrderByInputNumberDesc
+	revisions, err := client.Revisions(&prisma.RevisionsParams{
+		Where:   &at,
+		OrderBy: &orderBy,
+		First:   prisma.Int32(1),
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Revision{}, err
+	}
+
+	if len(revisions) == 0 {
+		return revision(ctx, where, 1)
+	}
+
+	return revisions[0], nil
+}
+
+// BackfillRevisions records revision 1 of the books and chapters which were
+// created before revisions were kept, and returns how many there were. They
+// are still at revision 1, so it is their current name and description.
+// It has to run before they can be changed, or their revision 1 is lost.
+func BackfillRevisions(ctx context.Context) (int, error) {
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			Revision:      prisma.Int32(1),
+			RevisionsNone: &prisma.RevisionWhereInput{},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for _, book := range books {
+		id := book.ID
+		_, err := client.UpdateBook(prisma.BookUpdateParams{
+			Where: prisma.BookWhereUniqueInput{
+				ID: &id,
+			},
+			Data: prisma.BookUpdateInput{
+				Revisions: &prisma.RevisionUpdateManyWithoutBookInput{
+					Create: []prisma.RevisionCreateWithoutBookInput{{
+						Key:         revisionKey(id, 1),
+						Number:      1,
+						Name:        book.Name,
+						Description: book.Description,
+					}},
+				},
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	chapters, err := client.Chapters(&prisma.ChaptersParams{
+		Where: &prisma.ChapterWhereInput{
+			Revision:      prisma.Int32(1),
+			RevisionsNone: &prisma.RevisionWhereInput{},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for _, chapter := range chapters {
+		id := chapter.ID
+		_, err := client.UpdateChapter(prisma.ChapterUpdateParams{
+			Where: prisma.ChapterWhereUniqueInput{
+				ID: &id,
+			},
+			Data: prisma.ChapterUpdateInput{
+				Revisions: &prisma.RevisionUpdateManyWithoutChapterInput{
+					Create: []prisma.RevisionCreateWithoutChapterInput{{
+						Key:         revisionKey(id, 1),
+						Number:      1,
+						Name:        chapter.Name,
+						Description: chapter.Description,
+					}},
+				},
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(books) + len(chapters), nil
+}
+
+// revisionKey returns the unique key of a revision of the entity with the
+// given ID.
+func revisionKey(id string, number int32) string {
+	return id + ":" + strconv.FormatInt(int64(number), 10)
+}
+
+func deletedBefore(deleted bool, deletedAt *string, ts string) bool {
+	return deleted && deletedAt != nil && *deletedAt <= ts
+}
+
+func diffRevisions(a, b prisma.Revision) RevisionDiff {
+	diff := RevisionDiff{
+		From:    a.Number,
+		To:      b.Number,
+		Changes: []FieldDiff{},
+	}
+
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{"name", a.Name, b.Name},
+		{"description", a.Description, b.Description},
+	}
+
+	for _, f := range fields {
+		if f.from == f.to {
+			continue
+		}
+		diff.Changes = append(diff.Changes, FieldDiff{
+			Field: f.name,
+			From:  f.from,
+			To:    f.to,
+			Lines: diffLines(f.from, f.to),
+		})
+	}
+
+	return diff
+}
+
+// diffLines returns a line by line diff of a and b based on their longest
+// common subsequence of lines.
+func diffLines(a, b string) []LineDiff {
+	x, y := strings.Split(a, "\n"), strings.Split(b, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of x[i:]
+	// and y[j:].
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []LineDiff
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			lines = append(lines, LineDiff{Op: LineEqual, Text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, LineDiff{Op: LineDelete, Text: x[i]})
+			i++
+		default:
+			lines = append(lines, LineDiff{Op: LineInsert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		lines = append(lines, LineDiff{Op: LineDelete, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		lines = append(lines, LineDiff{Op: LineInsert, Text: y[j]})
+	}
+	return lines
+}
@@ -0,0 +1,95 @@
+package handling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+func TestRevisionAt(t *testing.T) {
+	// Revision 1 of the chapter was backfilled after revision 2 was made.
+	revisions := []map[string]interface{}{
+		{"number": 2, "name": "Second", "createdAt": "2026-10-12T00:00:00.000Z"},
+		{"number": 1, "name": "First", "createdAt": "2026-10-19T00:00:00.000Z"},
+	}
+
+	tests := []struct {
+		name string
+		ts   string
+		want string
+		err  error
+	}{
+		{"before creation", "2026-09-30T00:00:00Z", "", ErrNotFound},
+		{"before first recorded", "2026-10-05T00:00:00Z", "First", nil},
+		{"latest at", "2026-10-14T00:00:00Z", "Second", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, restore := usePrisma(t, map[string]prismaOp{
+				"revisions": func(vars map[string]interface{}) (interface{}, error) {
+					var found []interface{}
+					for _, r := range revisions {
+						if ts, ok := lookup(vars, "where", "createdAt_lte").(string); ok && r["createdAt"].(string) > ts {
+							continue
+						}
+						if n, ok := lookup(vars, "where", "number").(float64); ok && int(n) != r["number"] {
+							continue
+						}
+						found = append(found, r)
+					}
+					return found, nil
+				},
+			})
+			defer restore()
+
+			id := "c1"
+			where := prisma.RevisionWhereInput{Chapter: &prisma.ChapterWhereInput{ID: &id}}
+			got, err := revisionAt(context.Background(), where, "2026-10-01T00:00:00.000Z", tt.ts)
+			if err != tt.err || got.Name != tt.want {
+				t.Errorf("revisionAt(%s) = %q, %v, want %q, %v", tt.ts, got.Name, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestBackfillRevisions(t *testing.T) {
+	f, restore := usePrisma(t, map[string]prismaOp{
+		"books":         returns([]interface{}{map[string]interface{}{"id": "b1", "name": "Dune", "description": "Arrakis", "revision": 1}}),
+		"chapters":      returns([]interface{}{map[string]interface{}{"id": "c1", "name": "Prologue", "description": "The beginning", "revision": 1}}),
+		"updateBook":    returns(map[string]interface{}{"id": "b1"}),
+		"updateChapter": returns(map[string]interface{}{"id": "c1"}),
+	})
+	defer restore()
+
+	n, err := BackfillRevisions(context.Background())
+	if err != nil || n != 2 {
+		t.Fatalf("BackfillRevisions = %d, %v, want 2, nil", n, err)
+	}
+
+	for _, query := range []string{"books", "chapters"} {
+		where := f.called(query)[0].Vars["where"]
+		if lookup(where, "revision") != 1.0 || lookup(where, "revisions_none") == nil {
+			t.Errorf("%s backfilled where %v, want those at revision 1 without revisions", query, where)
+		}
+	}
+
+	tests := []struct {
+		mutation, key, name string
+	}{
+		{"updateBook", "b1:1", "Dune"},
+		{"updateChapter", "c1:1", "Prologue"},
+	}
+
+	for _, tt := range tests {
+		calls := f.called(tt.mutation)
+		if len(calls) != 1 {
+			t.Fatalf("got %d %s, want 1", len(calls), tt.mutation)
+		}
+		revs, _ := lookup(calls[0].Vars["data"], "revisions", "create").([]interface{})
+		if len(revs) != 1 || lookup(revs[0], "key") != tt.key || lookup(revs[0], "number") != 1.0 || lookup(revs[0], "name") != tt.name {
+			t.Errorf("%s created revisions %v, want %s named %s", tt.mutation, revs, tt.key, tt.name)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package handling
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/maxp36/rembook/handling/generated/prisma"
 )
@@ -10,17 +11,117 @@ import (
 // ErrInvalidArgument is returned when one or more arguments are invalid.
 var ErrInvalidArgument = errors.New("invalid argument")
 
+// ErrNotFound is returned when an entity does not exist or is in the trash.
+var ErrNotFound = errors.New("not found")
+
+// ErrVersionMismatch is returned when a mutation is conditioned on a version
+// of an entity other than its current one.
+var ErrVersionMismatch = errors.New("version mismatch")
+
+// ErrDuplicateName is returned when a book is given the name of another
+// book.
+var ErrDuplicateName = errors.New("name already in use")
+
+// TrashedNameError is returned when a book is given the name of a book in
+// the trash. Names stay taken in the trash, so that book has to be restored
+// and renamed, or purged, first.
+type TrashedNameError struct {
+	BookID string
+}
+
+func (e *TrashedNameError) Error() string {
+	return "name in use by book " + e.BookID + " in the trash"
+}
+
+// AnyVersion is passed as the version of a mutation which applies to
+// whatever the current version of the entity is. Otherwise the version is
+// the revision the caller expects the entity to be at.
+const AnyVersion int32 = 0
+
 // Service is the interface that provides handling methods.
 type Service interface {
-	AddBook(ctx context.Context, name string, description string) (prisma.Book, error)
+	AddBook(ctx context.Context, name string, description string, chapters []NewChapter) (prisma.Book, []prisma.Chapter, error)
 	GetBook(ctx context.Context, id string) (prisma.Book, error)
-	DeleteBook(ctx context.Context, id string) (prisma.Book, error)
-	Books(ctx context.Context) ([]prisma.Book, error)
+	UpdateBook(ctx context.Context, id string, name string, description string, version int32) (prisma.Book, error)
+	DeleteBook(ctx context.Context, id string, version int32) (prisma.Book, error)
+	Books(ctx context.Context, filter BookFilter) ([]prisma.Book, error)
+	MergeBooks(ctx context.Context, sourceID string, targetID string, strategy MergeStrategy, archive bool, version int32) (prisma.Book, error)
+	DuplicateBook(ctx context.Context, id string, name string) (prisma.Book, []prisma.Chapter, error)
+	BookMetadata(ctx context.Context, id string) (Metadata, error)
+	UpdateBookMetadata(ctx context.Context, id string, m Metadata, version int32) (prisma.Book, error)
+	BookByISBN(ctx context.Context, isbn string) (prisma.Book, error)
+	LookupBook(ctx context.Context, name string, limit int32) ([]BookMatch, error)
+	ExportBook(ctx context.Context, id string) (BookExport, error)
+	Authors(ctx context.Context) ([]prisma.Author, error)
+	BookTags(ctx context.Context, id string) ([]string, error)
+	TagBook(ctx context.Context, id string, tag string) ([]string, error)
+	UntagBook(ctx context.Context, id string, tag string) ([]string, error)
 
 	AddChapter(ctx context.Context, name string, description string, bookID string) (prisma.Chapter, error)
 	GetChapter(ctx context.Context, id string) (prisma.Chapter, error)
-	DeleteChapter(ctx context.Context, id string) (prisma.Chapter, error)
-	Chapters(ctx context.Context, bookID string) ([]prisma.Chapter, error)
+	UpdateChapter(ctx context.Context, id string, name string, description string, version int32) (prisma.Chapter, error)
+	DeleteChapter(ctx context.Context, id string, version int32) (prisma.Chapter, error)
+	Chapters(ctx context.Context, bookID string) ([]ReadingChapter, error)
+	ChapterBook(ctx context.Context, id string) (prisma.Book, error)
+	MoveChapter(ctx context.Context, id string, bookID string, position int32, version int32) (prisma.Chapter, error)
+	CopyChapter(ctx context.Context, id string, bookID string, position int32) (prisma.Chapter, error)
+	ChapterTags(ctx context.Context, id string) ([]string, error)
+	TagChapter(ctx context.Context, id string, tag string) ([]string, error)
+	UntagChapter(ctx context.Context, id string, tag string) ([]string, error)
+
+	AddNote(ctx context.Context, chapterID string, n NoteInput) (prisma.Note, error)
+	GetNote(ctx context.Context, id string) (prisma.Note, error)
+	UpdateNote(ctx context.Context, id string, n NoteInput) (prisma.Note, error)
+	DeleteNote(ctx context.Context, id string) (prisma.Note, error)
+	Notes(ctx context.Context, chapterID string) ([]prisma.Note, error)
+	NoteChapter(ctx context.Context, id string) (prisma.Chapter, error)
+
+	AddCard(ctx context.Context, chapterID string, c CardInput) (prisma.Card, error)
+	GetCard(ctx context.Context, id string) (prisma.Card, error)
+	DeleteCard(ctx context.Context, id string) (prisma.Card, error)
+	Cards(ctx context.Context, chapterID string) ([]prisma.Card, error)
+	CardChapter(ctx context.Context, id string) (prisma.Chapter, error)
+	DueCards(ctx context.Context, bookID string, limit int32) ([]prisma.Card, error)
+	GradeCard(ctx context.Context, id string, grade int32) (prisma.Card, error)
+	ReviewStats(ctx context.Context, bookID string) (ReviewStats, error)
+
+	SetChapterStatus(ctx context.Context, id string, status prisma.ReadingStatus) (ReadingChapter, error)
+	BookProgress(ctx context.Context, id string) (BookProgress, error)
+	CurrentlyReading(ctx context.Context) ([]BookProgress, error)
+	FinishedBooks(ctx context.Context, from time.Time, to time.Time) ([]BookProgress, error)
+
+	StartSession(ctx context.Context, bookID string, chapterID string) (Session, error)
+	StopSession(ctx context.Context, pages int32) (Session, error)
+	CurrentSession(ctx context.Context) (Session, error)
+	Sessions(ctx context.Context, bookID string) ([]Session, error)
+	ReadingStats(ctx context.Context, f SessionFilter) (ReadingStats, error)
+
+	Tags(ctx context.Context) ([]TagCount, error)
+	CreateCollection(ctx context.Context, name string, description string) (prisma.Collection, error)
+	Collections(ctx context.Context) ([]prisma.Collection, error)
+	DeleteCollection(ctx context.Context, id string) (prisma.Collection, error)
+	AddToCollection(ctx context.Context, id string, bookID string) error
+	RemoveFromCollection(ctx context.Context, id string, bookID string) error
+
+	Trash(ctx context.Context) (Trash, error)
+	RestoreBook(ctx context.Context, id string) (prisma.Book, error)
+	RestoreChapter(ctx context.Context, id string) (prisma.Chapter, error)
+
+	BookRevisions(ctx context.Context, id string) ([]prisma.Revision, error)
+	DiffBookRevisions(ctx context.Context, id string, from, to int32) (RevisionDiff, error)
+	BookAt(ctx context.Context, id string, at time.Time) (HistoricalBook, error)
+	RevertBook(ctx context.Context, id string, number int32, version int32) (prisma.Book, error)
+	ChapterRevisions(ctx context.Context, id string) ([]prisma.Revision, error)
+	DiffChapterRevisions(ctx context.Context, id string, from, to int32) (RevisionDiff, error)
+	RevertChapter(ctx context.Context, id string, number int32, version int32) (prisma.Chapter, error)
+
+	AuditLog(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
+}
+
+// NewChapter is a chapter to be created along with its book.
+type NewChapter struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
 }
 
 type service struct{}
@@ -32,21 +133,135 @@ func NewService() Service {
 
 var client = prisma.New(nil)
 
-func (s *service) AddBook(ctx context.Context, name, description string) (prisma.Book, error) {
+func (s *service) AddBook(ctx context.Context, name, description string, chapters []NewChapter) (prisma.Book, []prisma.Chapter, error) {
 	if name == "" || description == "" {
-		return prisma.Book{}, ErrInvalidArgument
+		return prisma.Book{}, nil, ErrInvalidArgument
+	}
+	for _, c := range chapters {
+		if c.Name == "" || c.Description == "" {
+			return prisma.Book{}, nil, ErrInvalidArgument
+		}
+	}
+
+	input, ids, err := bookCreateInput(prisma.Book{Name: name, Description: description}, chapters)
+	if err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	return createBook(ctx, input, ids)
+}
+
+// bookCreateInput returns the mutation creating a book like book, with
+// its chapters, and the IDs given to the chapters. The name, description
+// and metadata fields of book are used.
+func bookCreateInput(book prisma.Book, chapters []NewChapter) (prisma.BookCreateInput, []string, error) {
+	id := newID()
+	book.ID, book.Revision = id, 1
+	entry, err := newOutboxEntry(NewEvent(EventTypeBook, prisma.MutationTypeCreated, id, book, nil), id)
+	if err != nil {
+		return prisma.BookCreateInput{}, nil, err
+	}
+
+	// The chapters are created in the same mutation as the book, so either
+	// all of them exist afterwards or none does.
+	ids := make([]string, len(chapters))
+	positions := make([]float64, len(chapters))
+	creates := make([]prisma.ChapterCreateWithoutBookInput, len(chapters))
+	for i, c := range chapters {
+		ids[i], positions[i] = newID(), float64(i+1)
+		chapterEntry, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeCreated, id, prisma.Chapter{
+			ID:          ids[i],
+			Name:        c.Name,
+			Description: c.Description,
+			Position:    positions[i],
+			Revision:    1,
+		}, nil), ids[i])
+
+		if err != nil {
+			return prisma.BookCreateInput{}, nil, err
+		}
+
+		creates[i] = prisma.ChapterCreateWithoutBookInput{
+			ID:          &ids[i],
+			Name:        c.Name,
+			Description: c.Description,
+			Position:    &positions[i],
+			Revisions: &prisma.RevisionCreateManyWithoutChapterInput{
+				Create: []prisma.RevisionCreateWithoutChapterInput{{
+					Key:         revisionKey(ids[i], 1),
+					Number:      1,
+					Name:        c.Name,
+					Description: c.Description,
+				}},
+			},
+			Outbox: &prisma.OutboxEventCreateManyWithoutChapterInput{
+				Create: []prisma.OutboxEventCreateWithoutChapterInput{chapterEntry.withoutChapter()},
+			},
+		}
+	}
+
+	input := prisma.BookCreateInput{
+		ID:          &id,
+		Name:        book.Name,
+		Description: book.Description,
+		Isbn:        book.Isbn,
+		Publisher:   book.Publisher,
+		Year:        book.Year,
+		Language:    book.Language,
+		Revisions: &prisma.RevisionCreateManyWithoutBookInput{
+			Create: []prisma.RevisionCreateWithoutBookInput{{
+				Key:         revisionKey(id, 1),
+				Number:      1,
+				Name:        book.Name,
+				Description: book.Description,
+			}},
+		},
+		Outbox: &prisma.OutboxEventCreateManyWithoutBookInput{
+			Create: []prisma.OutboxEventCreateWithoutBookInput{entry.withoutBook()},
+		},
+	}
+	if len(creates) > 0 {
+		input.Chapters = &prisma.ChapterCreateManyWithoutBookInput{Create: creates}
+	}
+
+	return input, ids, nil
+}
+
+// createBook runs input, the mutation made by bookCreateInput, and returns
+// the book created and its chapters, whose IDs are ids.
+func createBook(ctx context.Context, input prisma.BookCreateInput, ids []string) (prisma.Book, []prisma.Chapter, error) {
+	if err := checkBookName(ctx, input.Name, ""); err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	book, err := client.CreateBook(input).Exec(ctx)
+	if err != nil {
+		return prisma.Book{}, nil, err
+	}
+
+	if len(ids) == 0 {
+		return *book, nil, nil
 	}
 
-	book, err := client.CreateBook(prisma.BookCreateInput{
-		Name:        name,
-		Description: description,
+	created, err := client.Chapters(&prisma.ChaptersParams{
+		Where: &prisma.ChapterWhereInput{IDIn: ids},
 	}).Exec(ctx)
 
 	if err != nil {
-		return prisma.Book{}, err
+		return prisma.Book{}, nil, err
 	}
 
-	return *book, nil
+	// Chapters are returned in the order they were given.
+	byID := make(map[string]prisma.Chapter, len(created))
+	for _, c := range created {
+		byID[c.ID] = c
+	}
+	result := make([]prisma.Chapter, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+
+	return *book, result, nil
 }
 
 func (s *service) GetBook(ctx context.Context, id string) (prisma.Book, error) {
@@ -54,10 +269,7 @@ func (s *service) GetBook(ctx context.Context, id string) (prisma.Book, error) {
 		return prisma.Book{}, ErrInvalidArgument
 	}
 
-	book, err := client.Book(prisma.BookWhereUniqueInput{
-		ID: &id,
-	}).Exec(ctx)
-
+	book, err := activeBook(ctx, id)
 	if err != nil {
 		return prisma.Book{}, err
 	}
@@ -65,24 +277,102 @@ func (s *service) GetBook(ctx context.Context, id string) (prisma.Book, error) {
 	return *book, nil
 }
 
-func (s *service) DeleteBook(ctx context.Context, id string) (prisma.Book, error) {
+func (s *service) UpdateBook(ctx context.Context, id, name, description string, version int32) (prisma.Book, error) {
+	if id == "" || name == "" || description == "" {
+		return prisma.Book{}, ErrInvalidArgument
+	}
+
+	book, err := activeBook(ctx, id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	if err := checkVersion(book.Revision, version); err != nil {
+		return prisma.Book{}, err
+	}
+
+	return updateBook(ctx, *book, name, description)
+}
+
+func (s *service) DeleteBook(ctx context.Context, id string, version int32) (prisma.Book, error) {
 	if id == "" {
 		return prisma.Book{}, ErrInvalidArgument
 	}
 
-	book, err := client.DeleteBook(prisma.BookWhereUniqueInput{
-		ID: &id,
-	}).Exec(ctx)
+	book, err := activeBook(ctx, id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
 
+	if err := checkVersion(book.Revision, version); err != nil {
+		return prisma.Book{}, err
+	}
+
+	entry, err := newOutboxEntry(NewEvent(EventTypeBook, prisma.MutationTypeDeleted, id, nil, *book), id)
 	if err != nil {
 		return prisma.Book{}, err
 	}
 
-	return *book, nil
+	// The book is moved to the trash, its chapters are hidden along with it.
+	// The move is written as the next revision, so that it fails if the book
+	// was changed since it was read.
+	number := book.Revision + 1
+	deleted, err := client.UpdateBook(prisma.BookUpdateParams{
+		Where: prisma.BookWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.BookUpdateInput{
+			Deleted:   prisma.Bool(true),
+			DeletedAt: prisma.Str(formatTime(time.Now())),
+			Revision:  &number,
+			Revisions: &prisma.RevisionUpdateManyWithoutBookInput{
+				Create: []prisma.RevisionCreateWithoutBookInput{{
+					Key:         revisionKey(id, number),
+					Number:      number,
+					Name:        book.Name,
+					Description: book.Description,
+				}},
+			},
+			Outbox: &prisma.OutboxEventUpdateManyWithoutBookInput{
+				Create: []prisma.OutboxEventCreateWithoutBookInput{entry.withoutBook()},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Book{}, bookWriteError(ctx, *book, err)
+	}
+
+	return *deleted, nil
 }
 
-func (s *service) Books(ctx context.Context) ([]prisma.Book, error) {
-	books, err := client.Books(nil).Exec(ctx)
+func (s *service) Books(ctx context.Context, filter BookFilter) ([]prisma.Book, error) {
+	where := prisma.BookWhereInput{
+		Deleted: prisma.Bool(false),
+	}
+	if filter.Author != "" {
+		where.AuthorsSome = &prisma.AuthorWhereInput{
+			Name: &filter.Author,
+		}
+	}
+	if filter.Tag != "" {
+		tag, err := normalizeTag(filter.Tag)
+		if err != nil {
+			return nil, err
+		}
+		where.TagsSome = &prisma.TagWhereInput{
+			Name: &tag,
+		}
+	}
+	if filter.Collection != "" {
+		where.CollectionsSome = &prisma.CollectionWhereInput{
+			ID: &filter.Collection,
+		}
+	}
+
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &where,
+	}).Exec(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -94,16 +384,15 @@ func (s *service) AddChapter(ctx context.Context, name string, description strin
 		return prisma.Chapter{}, ErrInvalidArgument
 	}
 
-	chapter, err := client.CreateChapter(prisma.ChapterCreateInput{
-		Name:        name,
-		Description: description,
-		Book: prisma.BookCreateOneWithoutChaptersInput{
-			Connect: &prisma.BookWhereUniqueInput{
-				ID: &bookID,
-			},
-		},
-	}).Exec(ctx)
+	return createChapter(ctx, name, description, bookID, LastPosition)
+}
 
+func (s *service) GetChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	if id == "" {
+		return prisma.Chapter{}, ErrInvalidArgument
+	}
+
+	chapter, err := activeChapter(ctx, id)
 	if err != nil {
 		return prisma.Chapter{}, err
 	}
@@ -111,50 +400,150 @@ func (s *service) AddChapter(ctx context.Context, name string, description strin
 	return *chapter, nil
 }
 
-func (s *service) GetChapter(ctx context.Context, id string) (prisma.Chapter, error) {
-	if id == "" {
+func (s *service) UpdateChapter(ctx context.Context, id, name, description string, version int32) (prisma.Chapter, error) {
+	if id == "" || name == "" || description == "" {
 		return prisma.Chapter{}, ErrInvalidArgument
 	}
 
-	chapter, err := client.Chapter(prisma.ChapterWhereUniqueInput{
-		ID: &id,
-	}).Exec(ctx)
-
+	chapter, err := activeChapter(ctx, id)
 	if err != nil {
 		return prisma.Chapter{}, err
 	}
 
-	return *chapter, nil
+	if err := checkVersion(chapter.Revision, version); err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	return updateChapter(ctx, *chapter, name, description)
 }
 
-func (s *service) DeleteChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+func (s *service) DeleteChapter(ctx context.Context, id string, version int32) (prisma.Chapter, error) {
 	if id == "" {
 		return prisma.Chapter{}, ErrInvalidArgument
 	}
 
-	chapter, err := client.DeleteChapter(prisma.ChapterWhereUniqueInput{
+	chapter, err := activeChapter(ctx, id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	if err := checkVersion(chapter.Revision, version); err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	book, err := client.Chapter(prisma.ChapterWhereUniqueInput{
 		ID: &id,
-	}).Exec(ctx)
+	}).Book().Exec(ctx)
 
 	if err != nil {
 		return prisma.Chapter{}, err
 	}
 
-	return *chapter, nil
+	entry, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeDeleted, book.ID, nil, *chapter), id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	number := chapter.Revision + 1
+	deleted, err := client.UpdateChapter(prisma.ChapterUpdateParams{
+		Where: prisma.ChapterWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.ChapterUpdateInput{
+			Deleted:   prisma.Bool(true),
+			DeletedAt: prisma.Str(formatTime(time.Now())),
+			Revision:  &number,
+			Revisions: &prisma.RevisionUpdateManyWithoutChapterInput{
+				Create: []prisma.RevisionCreateWithoutChapterInput{{
+					Key:         revisionKey(id, number),
+					Number:      number,
+					Name:        chapter.Name,
+					Description: chapter.Description,
+				}},
+			},
+			Outbox: &prisma.OutboxEventUpdateManyWithoutChapterInput{
+				Create: []prisma.OutboxEventCreateWithoutChapterInput{entry.withoutChapter()},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, chapterWriteError(ctx, *chapter, err)
+	}
+
+	return *deleted, nil
 }
 
-func (s *service) Chapters(ctx context.Context, bookID string) ([]prisma.Chapter, error) {
+// Chapters returns the chapters of the book with the given id in order,
+// each with its reading status for the calling user.
+func (s *service) Chapters(ctx context.Context, bookID string) ([]ReadingChapter, error) {
 	if bookID == "" {
 		return nil, ErrInvalidArgument
 	}
 
-	chapters, err := client.Book(prisma.BookWhereUniqueInput{
-		ID: &bookID,
-	}).Chapters(nil).Exec(ctx)
+	if _, err := activeBook(ctx, bookID); err != nil {
+		return nil, err
+	}
 
+	chapters, err := readingChapters(ctx, bookID, Actor(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	return chapters, nil
 }
+
+func (s *service) ChapterBook(ctx context.Context, id string) (prisma.Book, error) {
+	if id == "" {
+		return prisma.Book{}, ErrInvalidArgument
+	}
+
+	book, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &id,
+	}).Book().Exec(ctx)
+
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	return *book, nil
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// checkBookName returns an error if a book other than the one with the
+// given ID has the name, a *TrashedNameError if that book is in the trash.
+func checkBookName(ctx context.Context, name, id string) error {
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			Name: &name,
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for _, book := range books {
+		switch {
+		case book.ID == id:
+		case book.Deleted:
+			return &TrashedNameError{BookID: book.ID}
+		default:
+			return ErrDuplicateName
+		}
+	}
+
+	return nil
+}
+
+// checkVersion returns ErrVersionMismatch unless the current version of an
+// entity is the expected one or any version is expected.
+func checkVersion(current, expected int32) error {
+	if expected != AnyVersion && current != expected {
+		return ErrVersionMismatch
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package handling
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDeleteBook(t *testing.T) {
+	book := func(revision int, deleted bool) map[string]interface{} {
+		return map[string]interface{}{"id": "b1", "name": "Dune", "description": "Arrakis", "revision": revision, "deleted": deleted}
+	}
+
+	tests := []struct {
+		name    string
+		ops     map[string]prismaOp
+		version int32
+		err     error
+	}{
+		{"deleted", map[string]prismaOp{
+			"book":       returns(book(2, false)),
+			"updateBook": returns(book(3, true)),
+		}, 2, nil},
+		{"any version", map[string]prismaOp{
+			"book":       returns(book(2, false)),
+			"updateBook": returns(book(3, true)),
+		}, AnyVersion, nil},
+		{"stale version", map[string]prismaOp{
+			"book": returns(book(2, false)),
+		}, 1, ErrVersionMismatch},
+		{"updated meanwhile", map[string]prismaOp{
+			"book":       returns(book(2, false), book(3, false)),
+			"updateBook": fails(errUniqueKey),
+		}, 2, ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, restore := usePrisma(t, tt.ops)
+			defer restore()
+
+			got, err := NewService().DeleteBook(context.Background(), "b1", tt.version)
+			if err != tt.err {
+				t.Fatalf("DeleteBook error = %v, want %v", err, tt.err)
+			}
+
+			if err != nil {
+				return
+			}
+			if !got.Deleted || got.Revision != 3 {
+				t.Errorf("DeleteBook = %+v, want it deleted at revision 3", got)
+			}
+
+			updates := f.called("updateBook")
+			if len(updates) != 1 {
+				t.Fatalf("got %d updates, want 1", len(updates))
+			}
+			rev := lookup(updates[0].Vars["data"], "revisions", "create")
+			revs, _ := rev.([]interface{})
+			if len(revs) != 1 || lookup(revs[0], "key") != "b1:3" || lookup(revs[0], "name") != "Dune" {
+				t.Errorf("deletion written as revisions %v, want b1:3 named Dune", rev)
+			}
+		})
+	}
+}
+
+func TestDeleteChapter(t *testing.T) {
+	chapter := func(revision int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":          "c1",
+			"name":        "Prologue",
+			"description": "The beginning",
+			"revision":    revision,
+			"book":        map[string]interface{}{"id": "b1"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ops     map[string]prismaOp
+		version int32
+		err     error
+	}{
+		{"deleted", map[string]prismaOp{
+			"chapters":      returns([]interface{}{chapter(4)}),
+			"chapter":       returns(chapter(4)),
+			"updateChapter": returns(chapter(5)),
+		}, 4, nil},
+		{"stale version", map[string]prismaOp{
+			"chapters": returns([]interface{}{chapter(4)}),
+		}, 3, ErrVersionMismatch},
+		{"updated meanwhile", map[string]prismaOp{
+			"chapters":      returns([]interface{}{chapter(4)}),
+			"chapter":       returns(chapter(4), chapter(5)),
+			"updateChapter": fails(errUniqueKey),
+		}, 4, ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, restore := usePrisma(t, tt.ops)
+			defer restore()
+
+			_, err := NewService().DeleteChapter(context.Background(), "c1", tt.version)
+			if err != tt.err {
+				t.Fatalf("DeleteChapter error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+
+			updates := f.called("updateChapter")
+			if len(updates) != 1 {
+				t.Fatalf("got %d updates, want 1", len(updates))
+			}
+			rev := lookup(updates[0].Vars["data"], "revisions", "create")
+			revs, _ := rev.([]interface{})
+			if len(revs) != 1 || lookup(revs[0], "key") != "c1:5" {
+				t.Errorf("deletion written as revisions %v, want c1:5", rev)
+			}
+		})
+	}
+}
+
+func TestCheckBookName(t *testing.T) {
+	tests := []struct {
+		name  string
+		books []interface{}
+		id    string
+		err   error
+	}{
+		{"free", []interface{}{}, "", nil},
+		{"own name", []interface{}{map[string]interface{}{"id": "b1"}}, "b1", nil},
+		{"taken", []interface{}{map[string]interface{}{"id": "b2"}}, "b1", ErrDuplicateName},
+		{"taken in the trash", []interface{}{map[string]interface{}{"id": "b2", "deleted": true}}, "", &TrashedNameError{BookID: "b2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, restore := usePrisma(t, map[string]prismaOp{
+				"books": returns(tt.books),
+			})
+			defer restore()
+
+			err := checkBookName(context.Background(), "Dune", tt.id)
+			if !reflect.DeepEqual(err, tt.err) {
+				t.Errorf("checkBookName = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestAddBookTrashedName(t *testing.T) {
+	f, restore := usePrisma(t, map[string]prismaOp{
+		"books": returns([]interface{}{map[string]interface{}{"id": "b2", "deleted": true}}),
+	})
+	defer restore()
+
+	_, _, err := NewService().AddBook(context.Background(), "Dune", "Arrakis", nil)
+	if e, ok := err.(*TrashedNameError); !ok || e.BookID != "b2" {
+		t.Fatalf("AddBook error = %v, want the book in the trash", err)
+	}
+	if calls := f.called("createBook"); len(calls) != 0 {
+		t.Errorf("book created despite the name being taken")
+	}
+
+	w := httptest.NewRecorder()
+	encodeError(context.Background(), err, w)
+	if w.Code != http.StatusConflict || !strings.Contains(w.Body.String(), `"bookId":"b2"`) {
+		t.Errorf("encoded as %d %s, want a conflict naming b2", w.Code, w.Body)
+	}
+}
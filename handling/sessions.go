@@ -0,0 +1,453 @@
+package handling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Reading sessions log the time a user spends reading a book, optionally
+// a chapter of it. A user reads one book at a time: starting a session
+// stops the one still open, unless it is of the same book and chapter, in
+// which case it goes on. While a session is open its key is derived from
+// the user, so a second open session cannot be stored; once stopped the
+// key is the id of the session. A session left open is counted for
+// maxSessionDuration at most.
+
+// ErrSessionOpen is returned when a session is started while another one
+// of the same user is being started.
+var ErrSessionOpen = errors.New("another session is open")
+
+// maxSessionDuration is the longest a session lasts: one stopped later
+// was forgotten, and ends this long after it started.
+const maxSessionDuration = 4 * time.Hour
+
+// Session is a reading session with the book and chapter read.
+type Session struct {
+	prisma.ReadingSession
+	BookID    string `json:"bookId"`
+	ChapterID string `json:"chapterId,omitempty"`
+}
+
+// SessionFilter narrows down the sessions of the calling user summed up
+// by ReadingStats. Days and weeks are those of Location, UTC if nil.
+type SessionFilter struct {
+	BookID   string
+	Since    time.Time
+	Location *time.Location
+}
+
+// SessionTotals sums up a number of stopped sessions.
+type SessionTotals struct {
+	Sessions int   `json:"sessions"`
+	Seconds  int64 `json:"seconds"`
+	Pages    int64 `json:"pages"`
+}
+
+// PeriodTotals are the totals of the sessions started in a day, as in
+// 2006-01-02, or an ISO week, as in 2006-W01.
+type PeriodTotals struct {
+	Period string `json:"period"`
+	SessionTotals
+}
+
+// BookTotals are the totals of the sessions of a book.
+type BookTotals struct {
+	BookID string `json:"bookId"`
+	Name   string `json:"name"`
+	SessionTotals
+}
+
+// ReadingStats sums up the reading sessions of a user. A streak is a run
+// of consecutive days with a session; the current one is still going if it
+// ends today or yesterday. Pace is in pages per hour, counting sessions in
+// which pages were read only.
+type ReadingStats struct {
+	SessionTotals
+	AverageSeconds int64          `json:"averageSeconds"`
+	PagesPerHour   float64        `json:"pagesPerHour"`
+	CurrentStreak  int            `json:"currentStreak"`
+	LongestStreak  int            `json:"longestStreak"`
+	Days           []PeriodTotals `json:"days"`
+	Weeks          []PeriodTotals `json:"weeks"`
+	Books          []BookTotals   `json:"books"`
+}
+
+func (s *service) StartSession(ctx context.Context, bookID string, chapterID string) (Session, error) {
+	if bookID == "" {
+		return Session{}, ErrInvalidArgument
+	}
+
+	if _, err := activeBook(ctx, bookID); err != nil {
+		return Session{}, err
+	}
+
+	var chapter *prisma.ChapterCreateOneWithoutSessionsInput
+	if chapterID != "" {
+		book, err := s.ChapterBook(ctx, chapterID)
+		if err != nil {
+			return Session{}, err
+		}
+		if book.ID != bookID {
+			return Session{}, ErrInvalidArgument
+		}
+		if _, err := activeChapter(ctx, chapterID); err != nil {
+			return Session{}, err
+		}
+
+		chapter = &prisma.ChapterCreateOneWithoutSessionsInput{
+			Connect: &prisma.ChapterWhereUniqueInput{
+				ID: &chapterID,
+			},
+		}
+	}
+
+	user := Actor(ctx)
+	open, err := openSession(ctx, user)
+
+	switch err {
+	case nil:
+		if open.BookID == bookID && open.ChapterID == chapterID {
+			return open, nil
+		}
+		if _, err := stopSession(ctx, open, 0, time.Now()); err != nil {
+			return Session{}, err
+		}
+	case ErrNotFound:
+	default:
+		return Session{}, err
+	}
+
+	session, err := client.CreateReadingSession(prisma.ReadingSessionCreateInput{
+		Key:       openSessionKey(user),
+		User:      user,
+		StartedAt: formatTime(time.Now()),
+		Book: prisma.BookCreateOneWithoutSessionsInput{
+			Connect: &prisma.BookWhereUniqueInput{
+				ID: &bookID,
+			},
+		},
+		Chapter: chapter,
+	}).Exec(ctx)
+
+	if err != nil {
+		if _, oerr := openSession(ctx, user); oerr == nil {
+			return Session{}, ErrSessionOpen
+		}
+		return Session{}, err
+	}
+
+	return Session{ReadingSession: *session, BookID: bookID, ChapterID: chapterID}, nil
+}
+
+// StopSession stops the open session of the calling user, in which the
+// given number of pages was read.
+func (s *service) StopSession(ctx context.Context, pages int32) (Session, error) {
+	if pages < 0 {
+		return Session{}, ErrInvalidArgument
+	}
+
+	open, err := openSession(ctx, Actor(ctx))
+	if err != nil {
+		return Session{}, err
+	}
+
+	return stopSession(ctx, open, pages, time.Now())
+}
+
+func (s *service) CurrentSession(ctx context.Context) (Session, error) {
+	return openSession(ctx, Actor(ctx))
+}
+
+// Sessions returns the sessions of the calling user of the book with the
+// given id, the last one first.
+func (s *service) Sessions(ctx context.Context, bookID string) ([]Session, error) {
+	if bookID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeBook(ctx, bookID); err != nil {
+		return nil, err
+	}
+
+	orderBy := prisma.ReadingSessionOrderByInputStartedAtDesc
+	sessions, err := client.ReadingSessions(&prisma.ReadingSessionsParams{
+		Where: &prisma.ReadingSessionWhereInput{
+			User: prisma.Str(Actor(ctx)),
+			Book: &prisma.BookWhereInput{
+				ID: &bookID,
+			},
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Session, len(sessions))
+	for i, session := range sessions {
+		chapterID, err := sessionChapter(ctx, session.ID)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = Session{ReadingSession: session, BookID: bookID, ChapterID: chapterID}
+	}
+
+	return result, nil
+}
+
+func (s *service) ReadingStats(ctx context.Context, f SessionFilter) (ReadingStats, error) {
+	if f.Location == nil {
+		f.Location = time.UTC
+	}
+
+	user := Actor(ctx)
+
+	var books []prisma.Book
+	if f.BookID != "" {
+		book, err := activeBook(ctx, f.BookID)
+		if err != nil {
+			return ReadingStats{}, err
+		}
+		books = []prisma.Book{*book}
+	} else {
+		var err error
+		books, err = client.Books(&prisma.BooksParams{
+			Where: &prisma.BookWhereInput{
+				Deleted: prisma.Bool(false),
+				SessionsSome: &prisma.ReadingSessionWhereInput{
+					User: &user,
+					Open: prisma.Bool(false),
+				},
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return ReadingStats{}, err
+		}
+	}
+
+	sessions := make(map[string][]prisma.ReadingSession, len(books))
+	for _, book := range books {
+		id := book.ID
+		where := &prisma.ReadingSessionWhereInput{
+			User: &user,
+			Open: prisma.Bool(false),
+			Book: &prisma.BookWhereInput{
+				ID: &id,
+			},
+		}
+		if !f.Since.IsZero() {
+			where.StartedAtGte = prisma.Str(formatTime(f.Since))
+		}
+
+		list, err := client.ReadingSessions(&prisma.ReadingSessionsParams{
+			Where: where,
+		}).Exec(ctx)
+
+		if err != nil {
+			return ReadingStats{}, err
+		}
+		sessions[id] = list
+	}
+
+	return readingStats(books, sessions, time.Now(), f.Location)
+}
+
+// readingStats sums up the sessions of every book in books, days and weeks
+// being those of loc.
+func readingStats(books []prisma.Book, sessions map[string][]prisma.ReadingSession, now time.Time, loc *time.Location) (ReadingStats, error) {
+	var stats ReadingStats
+	days := make(map[string]*SessionTotals)
+	weeks := make(map[string]*SessionTotals)
+	var pacedSeconds int64
+
+	for _, book := range books {
+		totals := BookTotals{BookID: book.ID, Name: book.Name}
+
+		for _, session := range sessions[book.ID] {
+			started, err := time.Parse(time.RFC3339, session.StartedAt)
+			if err != nil {
+				return ReadingStats{}, err
+			}
+			started = started.In(loc)
+
+			day := started.Format("2006-01-02")
+			year, week := started.ISOWeek()
+			period := fmt.Sprintf("%04d-W%02d", year, week)
+			if days[day] == nil {
+				days[day] = &SessionTotals{}
+			}
+			if weeks[period] == nil {
+				weeks[period] = &SessionTotals{}
+			}
+
+			for _, t := range []*SessionTotals{&stats.SessionTotals, &totals.SessionTotals, days[day], weeks[period]} {
+				t.add(session)
+			}
+			if session.Pages > 0 {
+				pacedSeconds += int64(session.Duration)
+			}
+		}
+
+		if totals.Sessions > 0 {
+			stats.Books = append(stats.Books, totals)
+		}
+	}
+
+	if stats.Sessions > 0 {
+		stats.AverageSeconds = stats.Seconds / int64(stats.Sessions)
+	}
+	if pacedSeconds > 0 {
+		stats.PagesPerHour = round2(float64(stats.Pages) * 3600 / float64(pacedSeconds))
+	}
+
+	stats.Days = periodTotals(days)
+	stats.Weeks = periodTotals(weeks)
+	stats.CurrentStreak, stats.LongestStreak = streaks(stats.Days, now.In(loc))
+
+	sort.SliceStable(stats.Books, func(i, j int) bool {
+		return stats.Books[i].Seconds > stats.Books[j].Seconds
+	})
+
+	return stats, nil
+}
+
+func (t *SessionTotals) add(session prisma.ReadingSession) {
+	t.Sessions++
+	t.Seconds += int64(session.Duration)
+	t.Pages += int64(session.Pages)
+}
+
+// periodTotals returns the totals of periods in order.
+func periodTotals(periods map[string]*SessionTotals) []PeriodTotals {
+	result := make([]PeriodTotals, 0, len(periods))
+	for period, totals := range periods {
+		result = append(result, PeriodTotals{Period: period, SessionTotals: *totals})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Period < result[j].Period
+	})
+	return result
+}
+
+// streaks returns the current and the longest run of consecutive days in
+// days, which are in order.
+func streaks(days []PeriodTotals, today time.Time) (current, longest int) {
+	var run int
+	var previous time.Time
+	for i, d := range days {
+		day, _ := time.ParseInLocation("2006-01-02", d.Period, today.Location())
+		if i > 0 && previous.AddDate(0, 0, 1).Equal(day) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+		previous = day
+	}
+
+	if n := len(days); n > 0 {
+		last := days[n-1].Period
+		if last == today.Format("2006-01-02") || last == today.AddDate(0, 0, -1).Format("2006-01-02") {
+			current = run
+		}
+	}
+	return current, longest
+}
+
+// openSession returns the open session of user.
+func openSession(ctx context.Context, user string) (Session, error) {
+	key := openSessionKey(user)
+	session, err := client.ReadingSession(prisma.ReadingSessionWhereUniqueInput{
+		Key: &key,
+	}).Exec(ctx)
+
+	switch err {
+	case nil:
+	case prisma.ErrNoResult:
+		return Session{}, ErrNotFound
+	default:
+		return Session{}, err
+	}
+
+	book, err := client.ReadingSession(prisma.ReadingSessionWhereUniqueInput{
+		ID: &session.ID,
+	}).Book().Exec(ctx)
+
+	if err != nil {
+		return Session{}, err
+	}
+
+	chapterID, err := sessionChapter(ctx, session.ID)
+	if err != nil {
+		return Session{}, err
+	}
+
+	return Session{ReadingSession: *session, BookID: book.ID, ChapterID: chapterID}, nil
+}
+
+// stopSession stops session at end, or maxSessionDuration after it
+// started if that is earlier, giving it its own key.
+func stopSession(ctx context.Context, session Session, pages int32, end time.Time) (Session, error) {
+	started, err := time.Parse(time.RFC3339, session.StartedAt)
+	if err != nil {
+		return Session{}, err
+	}
+	if limit := started.Add(maxSessionDuration); end.After(limit) {
+		end = limit
+	}
+
+	duration := int32(end.Sub(started) / time.Second)
+	if duration < 0 {
+		duration = 0
+	}
+
+	id := session.ID
+	stopped, err := client.UpdateReadingSession(prisma.ReadingSessionUpdateParams{
+		Where: prisma.ReadingSessionWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.ReadingSessionUpdateInput{
+			Key:      &id,
+			Open:     prisma.Bool(false),
+			EndedAt:  prisma.Str(formatTime(end)),
+			Duration: &duration,
+			Pages:    &pages,
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return Session{}, err
+	}
+
+	session.ReadingSession = *stopped
+	return session, nil
+}
+
+// sessionChapter returns the id of the chapter of the session with the
+// given id, or an empty string if it has none.
+func sessionChapter(ctx context.Context, id string) (string, error) {
+	chapter, err := client.ReadingSession(prisma.ReadingSessionWhereUniqueInput{
+		ID: &id,
+	}).Chapter().Exec(ctx)
+
+	switch err {
+	case nil:
+		return chapter.ID, nil
+	case prisma.ErrNoResult:
+		return "", nil
+	}
+	return "", err
+}
+
+func openSessionKey(user string) string {
+	return "open:" + user
+}
@@ -0,0 +1,226 @@
+package handling
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+func TestStopSession(t *testing.T) {
+	started := time.Date(2026, 10, 18, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		end      time.Time
+		duration float64
+		endedAt  string
+	}{
+		{"stopped", started.Add(90 * time.Minute), 5400, "2026-10-18T21:30:00Z"},
+		{"at the limit", started.Add(maxSessionDuration), 14400, "2026-10-19T00:00:00Z"},
+		{"forgotten", started.Add(26 * time.Hour), 14400, "2026-10-19T00:00:00Z"},
+		{"stopped before it started", started.Add(-time.Minute), 0, "2026-10-18T19:59:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, restore := usePrisma(t, map[string]prismaOp{
+				"updateReadingSession": returns(map[string]interface{}{"id": "s1"}),
+			})
+			defer restore()
+
+			session := Session{ReadingSession: prisma.ReadingSession{ID: "s1", StartedAt: formatTime(started)}}
+			if _, err := stopSession(context.Background(), session, 12, tt.end); err != nil {
+				t.Fatal(err)
+			}
+
+			data := f.called("updateReadingSession")[0].Vars["data"]
+			if got := lookup(data, "duration"); got != tt.duration {
+				t.Errorf("duration = %v, want %v", got, tt.duration)
+			}
+			if got := lookup(data, "endedAt"); got != tt.endedAt {
+				t.Errorf("endedAt = %v, want %s", got, tt.endedAt)
+			}
+		})
+	}
+}
+
+func TestStartSessionConflict(t *testing.T) {
+	open := map[string]interface{}{
+		"id":        "s2",
+		"key":       openSessionKey(""),
+		"open":      true,
+		"startedAt": "2026-10-19T12:00:00Z",
+		"book":      map[string]interface{}{"id": "b1"},
+		"chapter":   nil,
+	}
+
+	_, restore := usePrisma(t, map[string]prismaOp{
+		"book": returns(map[string]interface{}{"id": "b1"}),
+		// No session is open until another request starts one.
+		"readingSession":       returns(nil, open),
+		"createReadingSession": fails(errUniqueKey),
+	})
+	defer restore()
+
+	_, err := NewService().StartSession(context.Background(), "b1", "")
+	if err != ErrSessionOpen {
+		t.Fatalf("StartSession error = %v, want %v", err, ErrSessionOpen)
+	}
+
+	w := httptest.NewRecorder()
+	encodeError(context.Background(), err, w)
+	if w.Code != http.StatusConflict {
+		t.Errorf("encoded as %d, want %d", w.Code, http.StatusConflict)
+	}
+}
+
+func TestReadingStats(t *testing.T) {
+	books := []prisma.Book{{ID: "b1", Name: "Dune"}, {ID: "b2", Name: "Emma"}}
+	sessions := map[string][]prisma.ReadingSession{
+		"b1": {
+			{StartedAt: "2026-10-17T10:00:00Z", Duration: 1800, Pages: 10},
+			{StartedAt: "2026-10-18T23:30:00Z", Duration: 3600},
+		},
+		"b2": {
+			{StartedAt: "2026-10-19T08:00:00Z", Duration: 600, Pages: 5},
+		},
+	}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		loc     *time.Location
+		days    []string
+		weeks   []string
+		current int
+		longest int
+	}{
+		{"utc", time.UTC,
+			[]string{"2026-10-17", "2026-10-18", "2026-10-19"},
+			[]string{"2026-W42", "2026-W43"}, 3, 3},
+		// The late session of the 18th is read on the 19th.
+		{"east of utc", time.FixedZone("UTC+3", 3*60*60),
+			[]string{"2026-10-17", "2026-10-19"},
+			[]string{"2026-W42", "2026-W43"}, 1, 1},
+		// The morning session of the 19th is read on the 18th, which is
+		// yesterday there.
+		{"west of utc", time.FixedZone("UTC-10", -10*60*60),
+			[]string{"2026-10-17", "2026-10-18"},
+			[]string{"2026-W42"}, 2, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := readingStats(books, sessions, now, tt.loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := SessionTotals{Sessions: 3, Seconds: 6000, Pages: 15}
+			if stats.SessionTotals != want {
+				t.Errorf("totals = %+v, want %+v", stats.SessionTotals, want)
+			}
+			if stats.AverageSeconds != 2000 {
+				t.Errorf("average = %d, want 2000", stats.AverageSeconds)
+			}
+			// The session in which no pages were read sets no pace.
+			if stats.PagesPerHour != 22.5 {
+				t.Errorf("pace = %v, want 22.5", stats.PagesPerHour)
+			}
+			if len(stats.Books) != 2 || stats.Books[0].BookID != "b1" || stats.Books[0].Seconds != 5400 {
+				t.Errorf("books = %+v, want b1 with 5400 seconds first", stats.Books)
+			}
+
+			if got := periods(stats.Days); !reflect.DeepEqual(got, tt.days) {
+				t.Errorf("days = %v, want %v", got, tt.days)
+			}
+			if got := periods(stats.Weeks); !reflect.DeepEqual(got, tt.weeks) {
+				t.Errorf("weeks = %v, want %v", got, tt.weeks)
+			}
+			if stats.CurrentStreak != tt.current || stats.LongestStreak != tt.longest {
+				t.Errorf("streaks = %d, %d, want %d, %d", stats.CurrentStreak, stats.LongestStreak, tt.current, tt.longest)
+			}
+		})
+	}
+}
+
+func TestStreaks(t *testing.T) {
+	tests := []struct {
+		name    string
+		days    []string
+		today   string
+		current int
+		longest int
+	}{
+		{"none", nil, "2026-10-19", 0, 0},
+		{"today", []string{"2026-10-19"}, "2026-10-19", 1, 1},
+		{"ending yesterday", []string{"2026-10-17", "2026-10-18"}, "2026-10-19", 2, 2},
+		{"ending the day before", []string{"2026-10-16", "2026-10-17"}, "2026-10-19", 0, 2},
+		{"broken", []string{"2026-10-01", "2026-10-02", "2026-10-03", "2026-10-05", "2026-10-06"}, "2026-10-06", 2, 3},
+		{"across months", []string{"2026-09-29", "2026-09-30", "2026-10-01"}, "2026-10-01", 3, 3},
+		{"across years", []string{"2025-12-31", "2026-01-01"}, "2026-01-02", 2, 2},
+		{"across a leap day", []string{"2028-02-28", "2028-02-29", "2028-03-01"}, "2028-03-01", 3, 3},
+		{"not a leap year", []string{"2026-02-28", "2026-03-01"}, "2026-03-01", 2, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			today, err := time.Parse("2006-01-02", tt.today)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			days := make([]PeriodTotals, len(tt.days))
+			for i, d := range tt.days {
+				days[i] = PeriodTotals{Period: d}
+			}
+
+			current, longest := streaks(days, today)
+			if current != tt.current || longest != tt.longest {
+				t.Errorf("streaks(%v, %s) = %d, %d, want %d, %d", tt.days, tt.today, current, longest, tt.current, tt.longest)
+			}
+		})
+	}
+}
+
+func TestStreaksDaylightSaving(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone Europe/Berlin: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		days []string
+	}{
+		{"clocks go back", []string{"2026-10-24", "2026-10-25", "2026-10-26"}},
+		{"clocks go forward", []string{"2026-03-28", "2026-03-29", "2026-03-30"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days := make([]PeriodTotals, len(tt.days))
+			for i, d := range tt.days {
+				days[i] = PeriodTotals{Period: d}
+			}
+			today, _ := time.ParseInLocation("2006-01-02", tt.days[2], loc)
+
+			if current, longest := streaks(days, today.Add(23*time.Hour)); current != 3 || longest != 3 {
+				t.Errorf("streaks(%v) = %d, %d, want 3, 3", tt.days, current, longest)
+			}
+		})
+	}
+}
+
+func periods(totals []PeriodTotals) []string {
+	result := make([]string, len(totals))
+	for i, t := range totals {
+		result[i] = t.Period
+	}
+	return result
+}
@@ -0,0 +1,294 @@
+package handling
+
+import (
+	"context"
+	"strings"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Tags label books and chapters. A tag is created the first time it is
+// used and is shared by everything labelled with it. Tag names are
+// compared in lower case with runs of white space collapsed, so "Go" and
+// " go " are the same tag. Tagging organises books and chapters without
+// changing them, so it makes no revisions.
+
+// maxTagLength is the maximum length of a tag name in bytes.
+const maxTagLength = 64
+
+// TagCount is a tag together with the number of books and chapters
+// labelled with it. Items in the trash are not counted.
+type TagCount struct {
+	Name     string `json:"name"`
+	Books    int    `json:"books"`
+	Chapters int    `json:"chapters"`
+}
+
+func (s *service) BookTags(ctx context.Context, id string) ([]string, error) {
+	if id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeBook(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return bookTags(ctx, id)
+}
+
+func (s *service) TagBook(ctx context.Context, id string, tag string) ([]string, error) {
+	tag, err := normalizeTag(tag)
+	if err != nil || id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeBook(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := upsertTag(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	_, err = client.UpdateBook(prisma.BookUpdateParams{
+		Where: prisma.BookWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.BookUpdateInput{
+			Tags: &prisma.TagUpdateManyWithoutBooksInput{
+				Connect: []prisma.TagWhereUniqueInput{{Name: &tag}},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bookTags(ctx, id)
+}
+
+func (s *service) UntagBook(ctx context.Context, id string, tag string) ([]string, error) {
+	tag, err := normalizeTag(tag)
+	if err != nil || id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeBook(ctx, id); err != nil {
+		return nil, err
+	}
+
+	tags, err := bookTags(ctx, id)
+	if err != nil || !contains(tags, tag) {
+		return tags, err
+	}
+
+	_, err = client.UpdateBook(prisma.BookUpdateParams{
+		Where: prisma.BookWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.BookUpdateInput{
+			Tags: &prisma.TagUpdateManyWithoutBooksInput{
+				Disconnect: []prisma.TagWhereUniqueInput{{Name: &tag}},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bookTags(ctx, id)
+}
+
+func (s *service) ChapterTags(ctx context.Context, id string) ([]string, error) {
+	if id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeChapter(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return chapterTags(ctx, id)
+}
+
+func (s *service) TagChapter(ctx context.Context, id string, tag string) ([]string, error) {
+	tag, err := normalizeTag(tag)
+	if err != nil || id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeChapter(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := upsertTag(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	_, err = client.UpdateChapter(prisma.ChapterUpdateParams{
+		Where: prisma.ChapterWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.ChapterUpdateInput{
+			Tags: &prisma.TagUpdateManyWithoutChaptersInput{
+				Connect: []prisma.TagWhereUniqueInput{{Name: &tag}},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return chapterTags(ctx, id)
+}
+
+func (s *service) UntagChapter(ctx context.Context, id string, tag string) ([]string, error) {
+	tag, err := normalizeTag(tag)
+	if err != nil || id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := activeChapter(ctx, id); err != nil {
+		return nil, err
+	}
+
+	tags, err := chapterTags(ctx, id)
+	if err != nil || !contains(tags, tag) {
+		return tags, err
+	}
+
+	_, err = client.UpdateChapter(prisma.ChapterUpdateParams{
+		Where: prisma.ChapterWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.ChapterUpdateInput{
+			Tags: &prisma.TagUpdateManyWithoutChaptersInput{
+				Disconnect: []prisma.TagWhereUniqueInput{{Name: &tag}},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return chapterTags(ctx, id)
+}
+
+// Tags returns the tags in use with their counts. The generated client
+// cannot aggregate, so the books and chapters of every tag are counted one
+// tag at a time.
+func (s *service) Tags(ctx context.Context) ([]TagCount, error) {
+	orderBy := prisma.TagOrderByInputNameAsc
+	tags, err := client.Tags(&prisma.TagsParams{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]TagCount, 0, len(tags))
+	for _, t := range tags {
+		name := t.Name
+		books, err := client.Tag(prisma.TagWhereUniqueInput{
+			Name: &name,
+		}).Books(&prisma.BooksParamsExec{
+			Where: &prisma.BookWhereInput{
+				Deleted: prisma.Bool(false),
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		chapters, err := client.Tag(prisma.TagWhereUniqueInput{
+			Name: &name,
+		}).Chapters(&prisma.ChaptersParamsExec{
+			Where: &prisma.ChapterWhereInput{
+				Deleted: prisma.Bool(false),
+				Book: &prisma.BookWhereInput{
+					Deleted: prisma.Bool(false),
+				},
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(books) > 0 || len(chapters) > 0 {
+			counts = append(counts, TagCount{Name: name, Books: len(books), Chapters: len(chapters)})
+		}
+	}
+
+	return counts, nil
+}
+
+func bookTags(ctx context.Context, id string) ([]string, error) {
+	orderBy := prisma.TagOrderByInputNameAsc
+	tags, err := client.Book(prisma.BookWhereUniqueInput{
+		ID: &id,
+	}).Tags(&prisma.TagsParamsExec{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tagNames(tags), nil
+}
+
+func chapterTags(ctx context.Context, id string) ([]string, error) {
+	orderBy := prisma.TagOrderByInputNameAsc
+	tags, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &id,
+	}).Tags(&prisma.TagsParamsExec{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tagNames(tags), nil
+}
+
+func upsertTag(ctx context.Context, name string) error {
+	_, err := client.UpsertTag(prisma.TagUpsertParams{
+		Where:  prisma.TagWhereUniqueInput{Name: &name},
+		Create: prisma.TagCreateInput{Name: name},
+	}).Exec(ctx)
+	return err
+}
+
+func tagNames(tags []prisma.Tag) []string {
+	names := make([]string, len(tags))
+	for i, t := range tags {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// normalizeTag returns the canonical form of a tag name.
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+	if tag == "" || len(tag) > maxTagLength {
+		return "", ErrInvalidArgument
+	}
+	return tag, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package handling
 
 import (
 	"context"
+	"time"
 
 	"github.com/maxp36/rembook/handling/generated/prisma"
 	"github.com/opentracing/opentracing-go"
@@ -19,10 +20,10 @@ func NewTracingService(tracer opentracing.Tracer, s Service) Service {
 	}
 }
 
-func (s *tracingService) AddBook(ctx context.Context, name, description string) (prisma.Book, error) {
+func (s *tracingService) AddBook(ctx context.Context, name, description string, chapters []NewChapter) (prisma.Book, []prisma.Chapter, error) {
 	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "AddBook")
 	defer span.Finish()
-	return s.Service.AddBook(ctx, name, description)
+	return s.Service.AddBook(ctx, name, description, chapters)
 }
 
 func (s *tracingService) GetBook(ctx context.Context, id string) (prisma.Book, error) {
@@ -31,16 +32,16 @@ func (s *tracingService) GetBook(ctx context.Context, id string) (prisma.Book, e
 	return s.Service.GetBook(ctx, id)
 }
 
-func (s *tracingService) DeleteBook(ctx context.Context, id string) (prisma.Book, error) {
+func (s *tracingService) DeleteBook(ctx context.Context, id string, version int32) (prisma.Book, error) {
 	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DeleteBook")
 	defer span.Finish()
-	return s.Service.DeleteBook(ctx, id)
+	return s.Service.DeleteBook(ctx, id, version)
 }
 
-func (s *tracingService) Books(ctx context.Context) ([]prisma.Book, error) {
+func (s *tracingService) Books(ctx context.Context, filter BookFilter) ([]prisma.Book, error) {
 	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Books")
 	defer span.Finish()
-	return s.Service.Books(ctx)
+	return s.Service.Books(ctx, filter)
 }
 
 func (s *tracingService) AddChapter(ctx context.Context, name string, description string, bookID string) (prisma.Chapter, error) {
@@ -55,14 +56,416 @@ func (s *tracingService) GetChapter(ctx context.Context, id string) (prisma.Chap
 	return s.Service.GetChapter(ctx, id)
 }
 
-func (s *tracingService) DeleteChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+func (s *tracingService) DeleteChapter(ctx context.Context, id string, version int32) (prisma.Chapter, error) {
 	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DeleteChapter")
 	defer span.Finish()
-	return s.Service.DeleteChapter(ctx, id)
+	return s.Service.DeleteChapter(ctx, id, version)
 }
 
-func (s *tracingService) Chapters(ctx context.Context, bookID string) ([]prisma.Chapter, error) {
+func (s *tracingService) Chapters(ctx context.Context, bookID string) ([]ReadingChapter, error) {
 	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Chapters")
 	defer span.Finish()
 	return s.Service.Chapters(ctx, bookID)
 }
+
+func (s *tracingService) ChapterBook(ctx context.Context, id string) (prisma.Book, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "ChapterBook")
+	defer span.Finish()
+	return s.Service.ChapterBook(ctx, id)
+}
+
+func (s *tracingService) AuditLog(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "AuditLog")
+	defer span.Finish()
+	return s.Service.AuditLog(ctx, filter)
+}
+
+func (s *tracingService) Trash(ctx context.Context) (Trash, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Trash")
+	defer span.Finish()
+	return s.Service.Trash(ctx)
+}
+
+func (s *tracingService) RestoreBook(ctx context.Context, id string) (prisma.Book, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "RestoreBook")
+	defer span.Finish()
+	return s.Service.RestoreBook(ctx, id)
+}
+
+func (s *tracingService) RestoreChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "RestoreChapter")
+	defer span.Finish()
+	return s.Service.RestoreChapter(ctx, id)
+}
+
+func (s *tracingService) UpdateBook(ctx context.Context, id string, name string, description string, version int32) (prisma.Book, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "UpdateBook")
+	defer span.Finish()
+	return s.Service.UpdateBook(ctx, id, name, description, version)
+}
+
+func (s *tracingService) UpdateChapter(ctx context.Context, id string, name string, description string, version int32) (prisma.Chapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "UpdateChapter")
+	defer span.Finish()
+	return s.Service.UpdateChapter(ctx, id, name, description, version)
+}
+
+func (s *tracingService) BookRevisions(ctx context.Context, id string) ([]prisma.Revision, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "BookRevisions")
+	defer span.Finish()
+	return s.Service.BookRevisions(ctx, id)
+}
+
+func (s *tracingService) DiffBookRevisions(ctx context.Context, id string, from, to int32) (RevisionDiff, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DiffBookRevisions")
+	defer span.Finish()
+	return s.Service.DiffBookRevisions(ctx, id, from, to)
+}
+
+func (s *tracingService) BookAt(ctx context.Context, id string, at time.Time) (HistoricalBook, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "BookAt")
+	defer span.Finish()
+	return s.Service.BookAt(ctx, id, at)
+}
+
+func (s *tracingService) RevertBook(ctx context.Context, id string, number int32, version int32) (prisma.Book, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "RevertBook")
+	defer span.Finish()
+	return s.Service.RevertBook(ctx, id, number, version)
+}
+
+func (s *tracingService) ChapterRevisions(ctx context.Context, id string) ([]prisma.Revision, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "ChapterRevisions")
+	defer span.Finish()
+	return s.Service.ChapterRevisions(ctx, id)
+}
+
+func (s *tracingService) DiffChapterRevisions(ctx context.Context, id string, from, to int32) (RevisionDiff, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DiffChapterRevisions")
+	defer span.Finish()
+	return s.Service.DiffChapterRevisions(ctx, id, from, to)
+}
+
+func (s *tracingService) RevertChapter(ctx context.Context, id string, number int32, version int32) (prisma.Chapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "RevertChapter")
+	defer span.Finish()
+	return s.Service.RevertChapter(ctx, id, number, version)
+}
+
+func (s *tracingService) MoveChapter(ctx context.Context, id string, bookID string, position int32, version int32) (prisma.Chapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "MoveChapter")
+	defer span.Finish()
+	return s.Service.MoveChapter(ctx, id, bookID, position, version)
+}
+
+func (s *tracingService) CopyChapter(ctx context.Context, id string, bookID string, position int32) (prisma.Chapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "CopyChapter")
+	defer span.Finish()
+	return s.Service.CopyChapter(ctx, id, bookID, position)
+}
+
+func (s *tracingService) MergeBooks(ctx context.Context, sourceID string, targetID string, strategy MergeStrategy, archive bool, version int32) (prisma.Book, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "MergeBooks")
+	defer span.Finish()
+	return s.Service.MergeBooks(ctx, sourceID, targetID, strategy, archive, version)
+}
+
+func (s *tracingService) DuplicateBook(ctx context.Context, id string, name string) (prisma.Book, []prisma.Chapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DuplicateBook")
+	defer span.Finish()
+	return s.Service.DuplicateBook(ctx, id, name)
+}
+
+func (s *tracingService) BookMetadata(ctx context.Context, id string) (Metadata, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "BookMetadata")
+	defer span.Finish()
+	return s.Service.BookMetadata(ctx, id)
+}
+
+func (s *tracingService) UpdateBookMetadata(ctx context.Context, id string, m Metadata, version int32) (prisma.Book, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "UpdateBookMetadata")
+	defer span.Finish()
+	return s.Service.UpdateBookMetadata(ctx, id, m, version)
+}
+
+func (s *tracingService) BookByISBN(ctx context.Context, isbn string) (prisma.Book, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "BookByISBN")
+	defer span.Finish()
+	return s.Service.BookByISBN(ctx, isbn)
+}
+
+func (s *tracingService) Authors(ctx context.Context) ([]prisma.Author, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Authors")
+	defer span.Finish()
+	return s.Service.Authors(ctx)
+}
+
+func (s *tracingService) BookTags(ctx context.Context, id string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "BookTags")
+	defer span.Finish()
+	return s.Service.BookTags(ctx, id)
+}
+
+func (s *tracingService) TagBook(ctx context.Context, id string, tag string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "TagBook")
+	defer span.Finish()
+	return s.Service.TagBook(ctx, id, tag)
+}
+
+func (s *tracingService) UntagBook(ctx context.Context, id string, tag string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "UntagBook")
+	defer span.Finish()
+	return s.Service.UntagBook(ctx, id, tag)
+}
+
+func (s *tracingService) ChapterTags(ctx context.Context, id string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "ChapterTags")
+	defer span.Finish()
+	return s.Service.ChapterTags(ctx, id)
+}
+
+func (s *tracingService) TagChapter(ctx context.Context, id string, tag string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "TagChapter")
+	defer span.Finish()
+	return s.Service.TagChapter(ctx, id, tag)
+}
+
+func (s *tracingService) UntagChapter(ctx context.Context, id string, tag string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "UntagChapter")
+	defer span.Finish()
+	return s.Service.UntagChapter(ctx, id, tag)
+}
+
+func (s *tracingService) Tags(ctx context.Context) ([]TagCount, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Tags")
+	defer span.Finish()
+	return s.Service.Tags(ctx)
+}
+
+func (s *tracingService) CreateCollection(ctx context.Context, name string, description string) (prisma.Collection, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "CreateCollection")
+	defer span.Finish()
+	return s.Service.CreateCollection(ctx, name, description)
+}
+
+func (s *tracingService) Collections(ctx context.Context) ([]prisma.Collection, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Collections")
+	defer span.Finish()
+	return s.Service.Collections(ctx)
+}
+
+func (s *tracingService) DeleteCollection(ctx context.Context, id string) (prisma.Collection, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DeleteCollection")
+	defer span.Finish()
+	return s.Service.DeleteCollection(ctx, id)
+}
+
+func (s *tracingService) AddToCollection(ctx context.Context, id string, bookID string) error {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "AddToCollection")
+	defer span.Finish()
+	return s.Service.AddToCollection(ctx, id, bookID)
+}
+
+func (s *tracingService) RemoveFromCollection(ctx context.Context, id string, bookID string) error {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "RemoveFromCollection")
+	defer span.Finish()
+	return s.Service.RemoveFromCollection(ctx, id, bookID)
+}
+
+func (s *tracingService) AddNote(ctx context.Context, chapterID string, n NoteInput) (prisma.Note, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "AddNote")
+	defer span.Finish()
+	return s.Service.AddNote(ctx, chapterID, n)
+}
+
+func (s *tracingService) GetNote(ctx context.Context, id string) (prisma.Note, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "GetNote")
+	defer span.Finish()
+	return s.Service.GetNote(ctx, id)
+}
+
+func (s *tracingService) UpdateNote(ctx context.Context, id string, n NoteInput) (prisma.Note, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "UpdateNote")
+	defer span.Finish()
+	return s.Service.UpdateNote(ctx, id, n)
+}
+
+func (s *tracingService) DeleteNote(ctx context.Context, id string) (prisma.Note, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DeleteNote")
+	defer span.Finish()
+	return s.Service.DeleteNote(ctx, id)
+}
+
+func (s *tracingService) Notes(ctx context.Context, chapterID string) ([]prisma.Note, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Notes")
+	defer span.Finish()
+	return s.Service.Notes(ctx, chapterID)
+}
+
+func (s *tracingService) NoteChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "NoteChapter")
+	defer span.Finish()
+	return s.Service.NoteChapter(ctx, id)
+}
+
+func (s *tracingService) AddCard(ctx context.Context, chapterID string, c CardInput) (prisma.Card, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "AddCard")
+	defer span.Finish()
+	return s.Service.AddCard(ctx, chapterID, c)
+}
+
+func (s *tracingService) GetCard(ctx context.Context, id string) (prisma.Card, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "GetCard")
+	defer span.Finish()
+	return s.Service.GetCard(ctx, id)
+}
+
+func (s *tracingService) DeleteCard(ctx context.Context, id string) (prisma.Card, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DeleteCard")
+	defer span.Finish()
+	return s.Service.DeleteCard(ctx, id)
+}
+
+func (s *tracingService) Cards(ctx context.Context, chapterID string) ([]prisma.Card, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Cards")
+	defer span.Finish()
+	return s.Service.Cards(ctx, chapterID)
+}
+
+func (s *tracingService) CardChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "CardChapter")
+	defer span.Finish()
+	return s.Service.CardChapter(ctx, id)
+}
+
+func (s *tracingService) DueCards(ctx context.Context, bookID string, limit int32) ([]prisma.Card, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DueCards")
+	defer span.Finish()
+	return s.Service.DueCards(ctx, bookID, limit)
+}
+
+func (s *tracingService) GradeCard(ctx context.Context, id string, grade int32) (prisma.Card, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "GradeCard")
+	defer span.Finish()
+	return s.Service.GradeCard(ctx, id, grade)
+}
+
+func (s *tracingService) ReviewStats(ctx context.Context, bookID string) (ReviewStats, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "ReviewStats")
+	defer span.Finish()
+	return s.Service.ReviewStats(ctx, bookID)
+}
+
+func (s *tracingService) SetChapterStatus(ctx context.Context, id string, status prisma.ReadingStatus) (ReadingChapter, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "SetChapterStatus")
+	defer span.Finish()
+	return s.Service.SetChapterStatus(ctx, id, status)
+}
+
+func (s *tracingService) BookProgress(ctx context.Context, id string) (BookProgress, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "BookProgress")
+	defer span.Finish()
+	return s.Service.BookProgress(ctx, id)
+}
+
+func (s *tracingService) CurrentlyReading(ctx context.Context) ([]BookProgress, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "CurrentlyReading")
+	defer span.Finish()
+	return s.Service.CurrentlyReading(ctx)
+}
+
+func (s *tracingService) FinishedBooks(ctx context.Context, from time.Time, to time.Time) ([]BookProgress, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "FinishedBooks")
+	defer span.Finish()
+	return s.Service.FinishedBooks(ctx, from, to)
+}
+
+func (s *tracingService) StartSession(ctx context.Context, bookID string, chapterID string) (Session, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "StartSession")
+	defer span.Finish()
+	return s.Service.StartSession(ctx, bookID, chapterID)
+}
+
+func (s *tracingService) StopSession(ctx context.Context, pages int32) (Session, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "StopSession")
+	defer span.Finish()
+	return s.Service.StopSession(ctx, pages)
+}
+
+func (s *tracingService) CurrentSession(ctx context.Context) (Session, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "CurrentSession")
+	defer span.Finish()
+	return s.Service.CurrentSession(ctx)
+}
+
+func (s *tracingService) Sessions(ctx context.Context, bookID string) ([]Session, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "Sessions")
+	defer span.Finish()
+	return s.Service.Sessions(ctx, bookID)
+}
+
+func (s *tracingService) ReadingStats(ctx context.Context, f SessionFilter) (ReadingStats, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "ReadingStats")
+	defer span.Finish()
+	return s.Service.ReadingStats(ctx, f)
+}
+
+func (s *tracingService) LookupBook(ctx context.Context, name string, limit int32) ([]BookMatch, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "LookupBook")
+	defer span.Finish()
+	return s.Service.LookupBook(ctx, name, limit)
+}
+
+func (s *tracingService) ExportBook(ctx context.Context, id string) (BookExport, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "ExportBook")
+	defer span.Finish()
+	return s.Service.ExportBook(ctx, id)
+}
+
+type tracingBulkService struct {
+	tracer opentracing.Tracer
+	BulkService
+}
+
+func NewTracingBulkService(tracer opentracing.Tracer, s BulkService) BulkService {
+	return &tracingBulkService{
+		tracer:      tracer,
+		BulkService: s,
+	}
+}
+
+func (s *tracingBulkService) DeleteBooks(ctx context.Context, sel Selection, dryRun bool) (BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DeleteBooks")
+	defer span.Finish()
+	return s.BulkService.DeleteBooks(ctx, sel, dryRun)
+}
+
+func (s *tracingBulkService) UpdateBooks(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "UpdateBooks")
+	defer span.Finish()
+	return s.BulkService.UpdateBooks(ctx, sel, set, dryRun)
+}
+
+func (s *tracingBulkService) DeleteChapters(ctx context.Context, sel Selection, dryRun bool) (BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "DeleteChapters")
+	defer span.Finish()
+	return s.BulkService.DeleteChapters(ctx, sel, dryRun)
+}
+
+func (s *tracingBulkService) UpdateChapters(ctx context.Context, sel Selection, set BulkUpdate, dryRun bool) (BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "UpdateChapters")
+	defer span.Finish()
+	return s.BulkService.UpdateChapters(ctx, sel, set, dryRun)
+}
+
+func (s *tracingBulkService) MoveChapters(ctx context.Context, sel Selection, bookID string, dryRun bool) (BulkResult, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "MoveChapters")
+	defer span.Finish()
+	return s.BulkService.MoveChapters(ctx, sel, bookID, dryRun)
+}
+
+func (s *tracingBulkService) ImportMarkdown(ctx context.Context, doc string, dryRun bool) (ImportResult, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, s.tracer, "ImportMarkdown")
+	defer span.Finish()
+	return s.BulkService.ImportMarkdown(ctx, doc, dryRun)
+}
@@ -4,20 +4,33 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"strconv"
+	"time"
 
 	kitlog "github.com/go-kit/kit/log"
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
+	"github.com/maxp36/rembook/handling/generated/prisma"
 )
 
-// MakeHandler returns a handler for the handling service.
-func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
+// MakeHandler returns a handler for the handling service and its bulk
+// operations.
+func MakeHandler(s Service, bs BulkService, logger kitlog.Logger) http.Handler {
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorLogger(logger),
 		kithttp.ServerErrorEncoder(encodeError),
+		kithttp.ServerBefore(populateRequestContext),
+		kithttp.ServerAfter(setRequestIDHeader),
 	}
 
+	// Reading status, progress and sessions belong to the calling user, so
+	// the responses carrying them vary with it.
+	actorOpts := append(opts[:len(opts):len(opts)], kithttp.ServerAfter(varyByActor))
+
 	addBookHandler := kithttp.NewServer(
 		makeAddBookEndpoint(s),
 		decodeAddBookRequest,
@@ -65,6 +78,388 @@ func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
 		makeListChaptersEndpoint(s),
 		decodeListChaptersRequest,
 		encodeResponse,
+		actorOpts...,
+	)
+
+	updateBookHandler := kithttp.NewServer(
+		makeUpdateBookEndpoint(s),
+		decodeUpdateBookRequest,
+		encodeResponse,
+		opts...,
+	)
+	listBookRevisionsHandler := kithttp.NewServer(
+		makeListBookRevisionsEndpoint(s),
+		decodeListRevisionsRequest,
+		encodeResponse,
+		opts...,
+	)
+	diffBookRevisionsHandler := kithttp.NewServer(
+		makeDiffBookRevisionsEndpoint(s),
+		decodeDiffRevisionsRequest,
+		encodeResponse,
+		opts...,
+	)
+	bookAtHandler := kithttp.NewServer(
+		makeBookAtEndpoint(s),
+		decodeBookAtRequest,
+		encodeResponse,
+		opts...,
+	)
+	revertBookHandler := kithttp.NewServer(
+		makeRevertBookEndpoint(s),
+		decodeRevertRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	updateChapterHandler := kithttp.NewServer(
+		makeUpdateChapterEndpoint(s),
+		decodeUpdateChapterRequest,
+		encodeResponse,
+		opts...,
+	)
+	moveChapterHandler := kithttp.NewServer(
+		makeMoveChapterEndpoint(s),
+		decodeMoveChapterRequest,
+		encodeResponse,
+		opts...,
+	)
+	copyChapterHandler := kithttp.NewServer(
+		makeCopyChapterEndpoint(s),
+		decodeMoveChapterRequest,
+		encodeResponse,
+		opts...,
+	)
+	getBookMetadataHandler := kithttp.NewServer(
+		makeGetBookMetadataEndpoint(s),
+		decodeGetBookRequest,
+		encodeResponse,
+		opts...,
+	)
+	updateBookMetadataHandler := kithttp.NewServer(
+		makeUpdateBookMetadataEndpoint(s),
+		decodeUpdateBookMetadataRequest,
+		encodeResponse,
+		opts...,
+	)
+	getBookByISBNHandler := kithttp.NewServer(
+		makeGetBookByISBNEndpoint(s),
+		decodeGetBookByISBNRequest,
+		encodeResponse,
+		opts...,
+	)
+	lookupBookHandler := kithttp.NewServer(
+		makeLookupBookEndpoint(s),
+		decodeLookupBookRequest,
+		encodeResponse,
+		opts...,
+	)
+	exportBookHandler := kithttp.NewServer(
+		makeExportBookEndpoint(s),
+		decodeExportBookRequest,
+		encodeExportResponse,
+		opts...,
+	)
+	listAuthorsHandler := kithttp.NewServer(
+		makeListAuthorsEndpoint(s),
+		decodeListAuthorsRequest,
+		encodeResponse,
+		opts...,
+	)
+	mergeBooksHandler := kithttp.NewServer(
+		makeMergeBooksEndpoint(s),
+		decodeMergeBooksRequest,
+		encodeResponse,
+		opts...,
+	)
+	duplicateBookHandler := kithttp.NewServer(
+		makeDuplicateBookEndpoint(s),
+		decodeDuplicateBookRequest,
+		encodeResponse,
+		opts...,
+	)
+	addNoteHandler := kithttp.NewServer(
+		makeAddNoteEndpoint(s),
+		decodeAddNoteRequest,
+		encodeResponse,
+		opts...,
+	)
+	listNotesHandler := kithttp.NewServer(
+		makeListNotesEndpoint(s),
+		decodeListNotesRequest,
+		encodeResponse,
+		opts...,
+	)
+	getNoteHandler := kithttp.NewServer(
+		makeGetNoteEndpoint(s),
+		decodeNoteRequest,
+		encodeResponse,
+		opts...,
+	)
+	updateNoteHandler := kithttp.NewServer(
+		makeUpdateNoteEndpoint(s),
+		decodeUpdateNoteRequest,
+		encodeResponse,
+		opts...,
+	)
+	deleteNoteHandler := kithttp.NewServer(
+		makeDeleteNoteEndpoint(s),
+		decodeNoteRequest,
+		encodeResponse,
+		opts...,
+	)
+	addCardHandler := kithttp.NewServer(
+		makeAddCardEndpoint(s),
+		decodeAddCardRequest,
+		encodeResponse,
+		opts...,
+	)
+	listCardsHandler := kithttp.NewServer(
+		makeListCardsEndpoint(s),
+		decodeListCardsRequest,
+		encodeResponse,
+		opts...,
+	)
+	getCardHandler := kithttp.NewServer(
+		makeGetCardEndpoint(s),
+		decodeCardRequest,
+		encodeResponse,
+		opts...,
+	)
+	deleteCardHandler := kithttp.NewServer(
+		makeDeleteCardEndpoint(s),
+		decodeCardRequest,
+		encodeResponse,
+		opts...,
+	)
+	dueCardsHandler := kithttp.NewServer(
+		makeDueCardsEndpoint(s),
+		decodeDueCardsRequest,
+		encodeResponse,
+		opts...,
+	)
+	gradeCardHandler := kithttp.NewServer(
+		makeGradeCardEndpoint(s),
+		decodeGradeCardRequest,
+		encodeResponse,
+		opts...,
+	)
+	reviewStatsHandler := kithttp.NewServer(
+		makeReviewStatsEndpoint(s),
+		decodeReviewStatsRequest,
+		encodeResponse,
+		opts...,
+	)
+	setChapterStatusHandler := kithttp.NewServer(
+		makeSetChapterStatusEndpoint(s),
+		decodeSetChapterStatusRequest,
+		encodeResponse,
+		opts...,
+	)
+	bookProgressHandler := kithttp.NewServer(
+		makeBookProgressEndpoint(s),
+		decodeBookProgressRequest,
+		encodeResponse,
+		actorOpts...,
+	)
+	currentlyReadingHandler := kithttp.NewServer(
+		makeCurrentlyReadingEndpoint(s),
+		decodeCurrentlyReadingRequest,
+		encodeResponse,
+		actorOpts...,
+	)
+	finishedBooksHandler := kithttp.NewServer(
+		makeFinishedBooksEndpoint(s),
+		decodeFinishedBooksRequest,
+		encodeResponse,
+		actorOpts...,
+	)
+	startSessionHandler := kithttp.NewServer(
+		makeStartSessionEndpoint(s),
+		decodeStartSessionRequest,
+		encodeResponse,
+		opts...,
+	)
+	stopSessionHandler := kithttp.NewServer(
+		makeStopSessionEndpoint(s),
+		decodeStopSessionRequest,
+		encodeResponse,
+		opts...,
+	)
+	currentSessionHandler := kithttp.NewServer(
+		makeCurrentSessionEndpoint(s),
+		decodeCurrentSessionRequest,
+		encodeResponse,
+		actorOpts...,
+	)
+	listSessionsHandler := kithttp.NewServer(
+		makeListSessionsEndpoint(s),
+		decodeListSessionsRequest,
+		encodeResponse,
+		actorOpts...,
+	)
+	readingStatsHandler := kithttp.NewServer(
+		makeReadingStatsEndpoint(s),
+		decodeReadingStatsRequest,
+		encodeResponse,
+		actorOpts...,
+	)
+	listChapterRevisionsHandler := kithttp.NewServer(
+		makeListChapterRevisionsEndpoint(s),
+		decodeListRevisionsRequest,
+		encodeResponse,
+		opts...,
+	)
+	diffChapterRevisionsHandler := kithttp.NewServer(
+		makeDiffChapterRevisionsEndpoint(s),
+		decodeDiffRevisionsRequest,
+		encodeResponse,
+		opts...,
+	)
+	revertChapterHandler := kithttp.NewServer(
+		makeRevertChapterEndpoint(s),
+		decodeRevertRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	auditLogHandler := kithttp.NewServer(
+		makeAuditLogEndpoint(s),
+		decodeAuditLogRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	trashHandler := kithttp.NewServer(
+		makeTrashEndpoint(s),
+		decodeTrashRequest,
+		encodeResponse,
+		opts...,
+	)
+	restoreBookHandler := kithttp.NewServer(
+		makeRestoreBookEndpoint(s),
+		decodeRestoreBookRequest,
+		encodeResponse,
+		opts...,
+	)
+	restoreChapterHandler := kithttp.NewServer(
+		makeRestoreChapterEndpoint(s),
+		decodeRestoreChapterRequest,
+		encodeResponse,
+		opts...,
+	)
+	bookTagsHandler := kithttp.NewServer(
+		makeBookTagsEndpoint(s),
+		decodeTagsRequest,
+		encodeResponse,
+		opts...,
+	)
+	tagBookHandler := kithttp.NewServer(
+		makeTagBookEndpoint(s),
+		decodeTagsRequest,
+		encodeResponse,
+		opts...,
+	)
+	untagBookHandler := kithttp.NewServer(
+		makeUntagBookEndpoint(s),
+		decodeTagsRequest,
+		encodeResponse,
+		opts...,
+	)
+	chapterTagsHandler := kithttp.NewServer(
+		makeChapterTagsEndpoint(s),
+		decodeTagsRequest,
+		encodeResponse,
+		opts...,
+	)
+	tagChapterHandler := kithttp.NewServer(
+		makeTagChapterEndpoint(s),
+		decodeTagsRequest,
+		encodeResponse,
+		opts...,
+	)
+	untagChapterHandler := kithttp.NewServer(
+		makeUntagChapterEndpoint(s),
+		decodeTagsRequest,
+		encodeResponse,
+		opts...,
+	)
+	listTagsHandler := kithttp.NewServer(
+		makeListTagsEndpoint(s),
+		decodeListTagsRequest,
+		encodeResponse,
+		opts...,
+	)
+	createCollectionHandler := kithttp.NewServer(
+		makeCreateCollectionEndpoint(s),
+		decodeCreateCollectionRequest,
+		encodeResponse,
+		opts...,
+	)
+	listCollectionsHandler := kithttp.NewServer(
+		makeListCollectionsEndpoint(s),
+		decodeListCollectionsRequest,
+		encodeResponse,
+		opts...,
+	)
+	deleteCollectionHandler := kithttp.NewServer(
+		makeDeleteCollectionEndpoint(s),
+		decodeDeleteCollectionRequest,
+		encodeResponse,
+		opts...,
+	)
+	addToCollectionHandler := kithttp.NewServer(
+		makeAddToCollectionEndpoint(s),
+		decodeCollectionBookRequest,
+		encodeResponse,
+		opts...,
+	)
+	removeFromCollectionHandler := kithttp.NewServer(
+		makeRemoveFromCollectionEndpoint(s),
+		decodeCollectionBookRequest,
+		encodeResponse,
+		opts...,
+	)
+	listCollectionBooksHandler := kithttp.NewServer(
+		makeListBooksEndpoint(s),
+		decodeListCollectionBooksRequest,
+		encodeResponse,
+		opts...,
+	)
+	deleteBooksHandler := kithttp.NewServer(
+		makeDeleteBooksEndpoint(bs),
+		decodeBulkRequest,
+		encodeResponse,
+		opts...,
+	)
+	updateBooksHandler := kithttp.NewServer(
+		makeUpdateBooksEndpoint(bs),
+		decodeBulkRequest,
+		encodeResponse,
+		opts...,
+	)
+	deleteChaptersHandler := kithttp.NewServer(
+		makeDeleteChaptersEndpoint(bs),
+		decodeBulkRequest,
+		encodeResponse,
+		opts...,
+	)
+	updateChaptersHandler := kithttp.NewServer(
+		makeUpdateChaptersEndpoint(bs),
+		decodeBulkRequest,
+		encodeResponse,
+		opts...,
+	)
+	moveChaptersHandler := kithttp.NewServer(
+		makeMoveChaptersEndpoint(bs),
+		decodeBulkRequest,
+		encodeResponse,
+		opts...,
+	)
+	importMarkdownHandler := kithttp.NewServer(
+		makeImportMarkdownEndpoint(bs),
+		decodeImportMarkdownRequest,
+		encodeResponse,
 		opts...,
 	)
 
@@ -72,26 +467,159 @@ func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
 
 	v1 := r.PathPrefix("/handling/v1").Subrouter()
 	{
-		v1.Handle("/books", addBookHandler).Methods("POST")
+		v1.Handle("/books", idempotent(addBookHandler, logger)).Methods("POST")
 		v1.Handle("/books", listBooksHandler).Methods("GET")
+		v1.Handle("/books/isbn/{isbn}", getBookByISBNHandler).Methods("GET")
+		v1.Handle("/books/lookup", lookupBookHandler).Methods("GET")
 		v1.Handle("/books/{id}", getBookHandler).Methods("GET")
+		v1.Handle("/books/{id}", updateBookHandler).Methods("PUT")
 		v1.Handle("/books/{id}", deleteBookHandler).Methods("DELETE")
+		v1.Handle("/books/{id}/metadata", getBookMetadataHandler).Methods("GET")
+		v1.Handle("/books/{id}/metadata", updateBookMetadataHandler).Methods("PUT")
+		v1.Handle("/books/{id}/tags", bookTagsHandler).Methods("GET")
+		v1.Handle("/books/{id}/tags/{tag}", tagBookHandler).Methods("PUT")
+		v1.Handle("/books/{id}/tags/{tag}", untagBookHandler).Methods("DELETE")
+		v1.Handle("/books/{id}/history", bookAtHandler).Methods("GET")
+		v1.Handle("/books/{id}/export", exportBookHandler).Methods("GET")
+		v1.Handle("/books/{id}/review/stats", reviewStatsHandler).Methods("GET")
+		v1.Handle("/books/{id}/progress", bookProgressHandler).Methods("GET")
+		v1.Handle("/books/{id}/sessions", listSessionsHandler).Methods("GET")
+		v1.Handle("/books/{id}/merge", mergeBooksHandler).Methods("POST")
+		v1.Handle("/books/{id}/duplicate", idempotent(duplicateBookHandler, logger)).Methods("POST")
+		v1.Handle("/books/{id}/revisions", listBookRevisionsHandler).Methods("GET")
+		v1.Handle("/books/{id}/revisions/diff", diffBookRevisionsHandler).Methods("GET")
+		v1.Handle("/books/{id}/revisions/{number}/revert", revertBookHandler).Methods("POST")
 
-		v1.Handle("/books/{book_id}/chapters", addChapterHandler).Methods("POST")
+		v1.Handle("/books/{book_id}/chapters", idempotent(addChapterHandler, logger)).Methods("POST")
 		v1.Handle("/books/{book_id}/chapters", listChaptersHandler).Methods("GET")
 		v1.Handle("/books/{book_id}/chapters/{id}", getChapterHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}", updateChapterHandler).Methods("PUT")
 		v1.Handle("/books/{book_id}/chapters/{id}", deleteChapterHandler).Methods("DELETE")
+		v1.Handle("/books/{book_id}/chapters/{id}/notes", idempotent(addNoteHandler, logger)).Methods("POST")
+		v1.Handle("/books/{book_id}/chapters/{id}/notes", listNotesHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}/notes/{note_id}", getNoteHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}/notes/{note_id}", updateNoteHandler).Methods("PUT")
+		v1.Handle("/books/{book_id}/chapters/{id}/notes/{note_id}", deleteNoteHandler).Methods("DELETE")
+		v1.Handle("/books/{book_id}/chapters/{id}/cards", idempotent(addCardHandler, logger)).Methods("POST")
+		v1.Handle("/books/{book_id}/chapters/{id}/cards", listCardsHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}/cards/{card_id}", getCardHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}/cards/{card_id}", deleteCardHandler).Methods("DELETE")
+		v1.Handle("/books/{book_id}/chapters/{id}/progress", setChapterStatusHandler).Methods("PUT")
+		v1.Handle("/books/{book_id}/chapters/{id}/tags", chapterTagsHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}/tags/{tag}", tagChapterHandler).Methods("PUT")
+		v1.Handle("/books/{book_id}/chapters/{id}/tags/{tag}", untagChapterHandler).Methods("DELETE")
+		v1.Handle("/books/{book_id}/chapters/{id}/move", moveChapterHandler).Methods("POST")
+		v1.Handle("/books/{book_id}/chapters/{id}/copy", copyChapterHandler).Methods("POST")
+		v1.Handle("/books/{book_id}/chapters/{id}/revisions", listChapterRevisionsHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}/revisions/diff", diffChapterRevisionsHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}/revisions/{number}/revert", revertChapterHandler).Methods("POST")
+
+		v1.Handle("/authors", listAuthorsHandler).Methods("GET")
+		v1.Handle("/tags", listTagsHandler).Methods("GET")
+
+		v1.Handle("/collections", listCollectionsHandler).Methods("GET")
+		v1.Handle("/collections", createCollectionHandler).Methods("POST")
+		v1.Handle("/collections/{id}", deleteCollectionHandler).Methods("DELETE")
+		v1.Handle("/collections/{id}/books", listCollectionBooksHandler).Methods("GET")
+		v1.Handle("/collections/{id}/books/{book_id}", addToCollectionHandler).Methods("PUT")
+		v1.Handle("/collections/{id}/books/{book_id}", removeFromCollectionHandler).Methods("DELETE")
+
+		v1.Handle("/progress/reading", currentlyReadingHandler).Methods("GET")
+		v1.Handle("/progress/finished", finishedBooksHandler).Methods("GET")
+
+		v1.Handle("/sessions/start", startSessionHandler).Methods("POST")
+		v1.Handle("/sessions/stop", stopSessionHandler).Methods("POST")
+		v1.Handle("/sessions/current", currentSessionHandler).Methods("GET")
+		v1.Handle("/sessions/stats", readingStatsHandler).Methods("GET")
+
+		v1.Handle("/review/due", dueCardsHandler).Methods("GET")
+		v1.Handle("/review/{id}/grade", gradeCardHandler).Methods("POST")
+
+		v1.Handle("/audit", auditLogHandler).Methods("GET")
+
+		v1.Handle("/trash", trashHandler).Methods("GET")
+		v1.Handle("/trash/books/{id}/restore", restoreBookHandler).Methods("POST")
+		v1.Handle("/trash/chapters/{id}/restore", restoreChapterHandler).Methods("POST")
+
+		v1.Handle("/bulk/books/delete", deleteBooksHandler).Methods("POST")
+		v1.Handle("/bulk/books/update", updateBooksHandler).Methods("POST")
+		v1.Handle("/bulk/chapters/delete", deleteChaptersHandler).Methods("POST")
+		v1.Handle("/bulk/chapters/update", updateChaptersHandler).Methods("POST")
+		v1.Handle("/bulk/chapters/move", moveChaptersHandler).Methods("POST")
+		v1.Handle("/import/markdown", idempotent(importMarkdownHandler, logger)).Methods("POST")
 	}
 
 	return r
 }
 
+// MakeEventsHandler returns a handler streaming change events from src
+// as Server-Sent Events. The stream can be narrowed to a single book with
+// the book_id query parameter.
+func MakeEventsHandler(src EventSource, logger kitlog.Logger) http.Handler {
+	r := mux.NewRouter()
+	r.Handle("/handling/v1/events", &eventsHandler{src: src, logger: logger}).Methods("GET")
+	return r
+}
+
+const eventsKeepAlive = 15 * time.Second
+
+type eventsHandler struct {
+	src    EventSource
+	logger kitlog.Logger
+}
+
+func (h *eventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		encodeError(r.Context(), errStreamingUnsupported, w)
+		return
+	}
+
+	events, err := h.src.Subscribe(r.Context(), r.URL.Query().Get("book_id"))
+	if err != nil {
+		encodeError(r.Context(), err, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(eventsKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				h.logger.Log("err", err)
+				continue
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+		case <-ticker.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
 var errBadRoute = errors.New("bad route")
 
+var errStreamingUnsupported = errors.New("streaming unsupported")
+
 func decodeAddBookRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	var body struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
+		Name        string       `json:"name"`
+		Description string       `json:"description"`
+		Chapters    []NewChapter `json:"chapters"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
@@ -101,6 +629,7 @@ func decodeAddBookRequest(_ context.Context, r *http.Request) (interface{}, erro
 	return addBookRequest{
 		Name:        body.Name,
 		Description: body.Description,
+		Chapters:    body.Chapters,
 	}, nil
 }
 
@@ -119,85 +648,843 @@ func decodeDeleteBookRequest(_ context.Context, r *http.Request) (interface{}, e
 	if !ok {
 		return nil, errBadRoute
 	}
-	return deleteBookRequest{ID: id}, nil
+	version, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+	return deleteBookRequest{ID: id, Version: version}, nil
 }
 
 func decodeListBooksRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return listBooksRequest{}, nil
+	q := r.URL.Query()
+	return listBooksRequest{
+		Filter: BookFilter{
+			Author:     q.Get("author"),
+			Tag:        q.Get("tag"),
+			Collection: q.Get("collection"),
+		},
+	}, nil
 }
 
-func decodeAddChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeListCollectionBooksRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
-	bookID, ok := vars["book_id"]
+	id, ok := vars["id"]
 	if !ok {
 		return nil, errBadRoute
 	}
+	return listBooksRequest{Filter: BookFilter{Collection: id}}, nil
+}
 
-	var body struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
+// decodeTagsRequest decodes requests on the tags of a book or a chapter.
+// The tag is only part of the route when one is attached or detached.
+func decodeTagsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
 	}
+	return tagsRequest{ID: id, Tag: vars["tag"]}, nil
+}
 
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+func decodeAddNoteRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	chapterID, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var n NoteInput
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
 		return nil, err
 	}
 
-	return addChapterRequest{
-		Name:        body.Name,
-		Description: body.Description,
-		BookID:      bookID,
-	}, nil
+	return addNoteRequest{ChapterID: chapterID, Note: n}, nil
 }
 
-func decodeGetChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeListNotesRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
-	id, ok := vars["id"]
+	chapterID, ok := vars["id"]
 	if !ok {
 		return nil, errBadRoute
 	}
-
-	return getChapterRequest{ID: id}, nil
+	return listNotesRequest{ChapterID: chapterID}, nil
 }
 
-func decodeDeleteChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeNoteRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
-	id, ok := vars["id"]
+	id, ok := vars["note_id"]
 	if !ok {
 		return nil, errBadRoute
 	}
-
-	return deleteChapterRequest{ID: id}, nil
+	return noteRequest{ID: id}, nil
 }
 
-func decodeListChaptersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeUpdateNoteRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	vars := mux.Vars(r)
-	bookID, ok := vars["book_id"]
+	id, ok := vars["note_id"]
 	if !ok {
 		return nil, errBadRoute
 	}
 
-	return listChaptersRequest{BookID: bookID}, nil
+	var n NoteInput
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		return nil, err
+	}
+
+	return noteRequest{ID: id, Note: n}, nil
 }
 
-func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+func decodeAddCardRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	chapterID, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var c CardInput
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return addCardRequest{ChapterID: chapterID, Card: c}, nil
+}
+
+func decodeListCardsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	chapterID, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return listCardsRequest{ChapterID: chapterID}, nil
+}
+
+func decodeCardRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["card_id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return cardRequest{ID: id}, nil
+}
+
+func decodeDueCardsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+
+	req := dueCardsRequest{BookID: q.Get("book_id")}
+	if limit := q.Get("limit"); limit != "" {
+		n, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		req.Limit = int32(n)
+	}
+
+	return req, nil
+}
+
+func decodeGradeCardRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		Grade *int32 `json:"grade"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Grade == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	return gradeCardRequest{ID: id, Grade: *body.Grade}, nil
+}
+
+func decodeReviewStatsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return reviewStatsRequest{BookID: id}, nil
+}
+
+func decodeSetChapterStatusRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		Status prisma.ReadingStatus `json:"status"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return setChapterStatusRequest{ID: id, Status: body.Status}, nil
+}
+
+func decodeBookProgressRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return bookProgressRequest{ID: id}, nil
+}
+
+func decodeCurrentlyReadingRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return currentlyReadingRequest{}, nil
+}
+
+// decodeFinishedBooksRequest reads the month to list the finished books
+// of, as in 2006-01, which defaults to the current month in UTC.
+func decodeFinishedBooksRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	if month := r.URL.Query().Get("month"); month != "" {
+		t, err := time.Parse("2006-01", month)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		from = t
+	}
+
+	return finishedBooksRequest{From: from, To: from.AddDate(0, 1, 0)}, nil
+}
+
+func decodeStartSessionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var body struct {
+		BookID    string `json:"bookId"`
+		ChapterID string `json:"chapterId"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return startSessionRequest{BookID: body.BookID, ChapterID: body.ChapterID}, nil
+}
+
+func decodeStopSessionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var body struct {
+		Pages int32 `json:"pages"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return stopSessionRequest{Pages: body.Pages}, nil
+}
+
+func decodeCurrentSessionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return currentSessionRequest{}, nil
+}
+
+func decodeListSessionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return listSessionsRequest{BookID: id}, nil
+}
+
+func decodeReadingStatsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+
+	filter := SessionFilter{
+		BookID: q.Get("book_id"),
+	}
+
+	if since := q.Get("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		filter.Since = t
+	}
+
+	if tz := q.Get("tz"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		filter.Location = loc
+	}
+
+	return readingStatsRequest{Filter: filter}, nil
+}
+
+func decodeListTagsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listTagsRequest{}, nil
+}
+
+func decodeCreateCollectionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return createCollectionRequest{
+		Name:        body.Name,
+		Description: body.Description,
+	}, nil
+}
+
+func decodeListCollectionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listCollectionsRequest{}, nil
+}
+
+func decodeDeleteCollectionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return deleteCollectionRequest{ID: id}, nil
+}
+
+func decodeCollectionBookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	bookID, ok := vars["book_id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return collectionBookRequest{ID: id, BookID: bookID}, nil
+}
+
+func decodeUpdateBookMetadataRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var m Metadata
+	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+		return nil, err
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateBookMetadataRequest{ID: id, Metadata: m, Version: version}, nil
+}
+
+func decodeGetBookByISBNRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	isbn, ok := vars["isbn"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return getBookByISBNRequest{ISBN: isbn}, nil
+}
+
+func decodeLookupBookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+
+	req := lookupBookRequest{Name: q.Get("name")}
+	if limit := q.Get("limit"); limit != "" {
+		n, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		req.Limit = int32(n)
+	}
+
+	return req, nil
+}
+
+// decodeExportBookRequest takes the format of the export from the format
+// query parameter or, without it, from the Accept header.
+func decodeExportBookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	if name := r.URL.Query().Get("format"); name != "" {
+		format, ok := exportFormatByName(name)
+		if !ok {
+			return nil, ErrInvalidArgument
+		}
+		return exportBookRequest{ID: id, Format: format}, nil
+	}
+
+	format, ok := negotiateExportFormat(r.Header.Get("Accept"))
+	if !ok {
+		return nil, errNotAcceptable
+	}
+	return exportBookRequest{ID: id, Format: format}, nil
+}
+
+func decodeListAuthorsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listAuthorsRequest{}, nil
+}
+
+func decodeAddChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	bookID, ok := vars["book_id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return addChapterRequest{
+		Name:        body.Name,
+		Description: body.Description,
+		BookID:      bookID,
+	}, nil
+}
+
+func decodeGetChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	return getChapterRequest{ID: id}, nil
+}
+
+func decodeDeleteChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return deleteChapterRequest{ID: id, Version: version}, nil
+}
+
+func decodeListChaptersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	bookID, ok := vars["book_id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	return listChaptersRequest{BookID: bookID}, nil
+}
+
+func decodeUpdateBookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateBookRequest{
+		ID:          id,
+		Name:        body.Name,
+		Description: body.Description,
+		Version:     version,
+	}, nil
+}
+
+func decodeUpdateChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateChapterRequest{
+		ID:          id,
+		Name:        body.Name,
+		Description: body.Description,
+		Version:     version,
+	}, nil
+}
+
+// decodeMergeBooksRequest decodes a merge of the book given in the body
+// into the book of the route. Unless deleteSource is set, the source is
+// kept in the trash.
+func decodeMergeBooksRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		SourceID     string        `json:"sourceId"`
+		Strategy     MergeStrategy `json:"strategy"`
+		DeleteSource bool          `json:"deleteSource"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if body.Strategy == "" {
+		body.Strategy = MergeKeepTarget
+	}
+
+	return mergeBooksRequest{
+		SourceID: body.SourceID,
+		TargetID: id,
+		Strategy: body.Strategy,
+		Archive:  !body.DeleteSource,
+		Version:  version,
+	}, nil
+}
+
+// decodeDuplicateBookRequest decodes a request to duplicate a book. The
+// body with the name of the copy is optional.
+func decodeDuplicateBookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		Name string `json:"name"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return duplicateBookRequest{ID: id, Name: body.Name}, nil
+}
+
+// decodeMoveChapterRequest decodes the target of a chapter move or copy.
+// The target book defaults to the book of the chapter and the position to
+// the end of the target book.
+func decodeMoveChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	bookID, ok := vars["book_id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		BookID   string `json:"bookId"`
+		Position *int32 `json:"position"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := moveChapterRequest{
+		ID:       id,
+		BookID:   bookID,
+		Position: LastPosition,
+		Version:  version,
+	}
+	if body.BookID != "" {
+		req.BookID = body.BookID
+	}
+	if body.Position != nil {
+		req.Position = *body.Position
+	}
+	return req, nil
+}
+
+func decodeListRevisionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return listRevisionsRequest{ID: id}, nil
+}
+
+func decodeDiffRevisionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	q := r.URL.Query()
+	from, err := parseRevisionNumber(q.Get("from"))
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseRevisionNumber(q.Get("to"))
+	if err != nil {
+		return nil, err
+	}
+
+	return diffRevisionsRequest{ID: id, From: from, To: to}, nil
+}
+
+func decodeBookAtRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	at, err := time.Parse(time.RFC3339, r.URL.Query().Get("at"))
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	return bookAtRequest{ID: id, At: at}, nil
+}
+
+func decodeRevertRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	number, err := parseRevisionNumber(vars["number"])
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return revertRequest{ID: id, Number: number, Version: version}, nil
+}
+
+func parseRevisionNumber(s string) (int32, error) {
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || n < 1 {
+		return 0, ErrInvalidArgument
+	}
+	return int32(n), nil
+}
+
+func decodeAuditLogRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+
+	filter := AuditFilter{
+		BookID: q.Get("book_id"),
+		Actor:  q.Get("actor"),
+	}
+
+	if since := q.Get("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return nil, ErrInvalidArgument
+		}
+		filter.Since = t
+	}
+
+	return auditLogRequest{Filter: filter}, nil
+}
+
+func decodeTrashRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return trashRequest{}, nil
+}
+
+func decodeRestoreBookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return restoreBookRequest{ID: id}, nil
+}
+
+func decodeRestoreChapterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return restoreChapterRequest{ID: id}, nil
+}
+
+func decodeBulkRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var body struct {
+		IDs    []string    `json:"ids"`
+		Filter *BulkFilter `json:"filter"`
+		Set    BulkUpdate  `json:"set"`
+		BookID string      `json:"bookId"`
+		DryRun bool        `json:"dryRun"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return bulkRequest{
+		Selection: Selection{IDs: body.IDs, Filter: body.Filter},
+		Set:       body.Set,
+		BookID:    body.BookID,
+		DryRun:    body.DryRun,
+	}, nil
+}
+
+// decodeImportMarkdownRequest decodes the Markdown document in the body of
+// a request, which is imported in a dry run if dry_run is true.
+func decodeImportMarkdownRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var dryRun bool
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			return nil, ErrInvalidArgument
+		}
+	}
+
+	doc, err := ioutil.ReadAll(io.LimitReader(r.Body, MaxImportSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(doc) > MaxImportSize {
+		return nil, ErrInvalidArgument
+	}
+
+	return importMarkdownRequest{Doc: string(doc), DryRun: dryRun}, nil
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
 	if e, ok := response.(errorer); ok && e.error() != nil {
 		encodeError(ctx, e.error(), w)
 		return nil
 	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	body = append(body, '\n')
+
+	if etag := responseETag(ctx, response, body); etag != "" {
+		w.Header().Set("ETag", etag)
+		if isGet(ctx) && etagMatches(ifNoneMatch(ctx), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	return json.NewEncoder(w).Encode(response)
+	_, err = w.Write(body)
+	return err
+}
+
+// encodeExportResponse streams the exported book as the document it was
+// asked for, to be saved as a file.
+func encodeExportResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(exportBookResponse)
+	if resp.Err != nil {
+		encodeError(ctx, resp.Err, w)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", resp.Format.contentType+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", exportDisposition(resp.Export.Book, resp.Format))
+	w.Header().Set("Vary", "Accept")
+	return writeExport(ctx, w, resp.Export, resp.Format)
 }
 
 type errorer interface {
 	error() error
 }
 
+// versioner is implemented by responses carrying a single entity, whose
+// version and the time it last changed make a strong ETag.
+type versioner interface {
+	version() (int32, string)
+}
+
 // EncodeError encodes errors from business-logic.
 func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if e, ok := err.(*TrashedNameError); ok {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error":  err.Error(),
+			"bookId": e.BookID,
+		})
+		return
+	}
 	switch err {
 	case ErrInvalidArgument:
 		w.WriteHeader(http.StatusBadRequest)
+	case ErrNotFound, prisma.ErrNoResult:
+		w.WriteHeader(http.StatusNotFound)
+	case ErrVersionMismatch:
+		w.WriteHeader(http.StatusPreconditionFailed)
+	case errNotAcceptable:
+		w.WriteHeader(http.StatusNotAcceptable)
+	case errIdempotencyKeyReused:
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	case errIdempotencyKeyInUse, ErrDuplicateISBN, ErrDuplicateName, ErrSessionOpen:
+		w.WriteHeader(http.StatusConflict)
+	case errRequestTooLarge:
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
@@ -0,0 +1,298 @@
+package handling
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Deleting a book or a chapter moves it to the trash. Chapters of a book in
+// the trash are hidden along with it and come back when it is restored.
+// Restoring a chapter of a book in the trash restores the book as well.
+// Restoring leaves deletedAt as it was, the deleted flag is authoritative.
+
+// Trash lists the books and chapters in the trash. Chapters of books in the
+// trash are not listed separately.
+type Trash struct {
+	Books    []prisma.Book    `json:"books"`
+	Chapters []prisma.Chapter `json:"chapters"`
+}
+
+func (s *service) Trash(ctx context.Context) (Trash, error) {
+	orderBy := prisma.BookOrderByInputDeletedAtDesc
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			Deleted: prisma.Bool(true),
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return Trash{}, err
+	}
+
+	chapterOrderBy := prisma.ChapterOrderByInputDeletedAtDesc
+	chapters, err := client.Chapters(&prisma.ChaptersParams{
+		Where: &prisma.ChapterWhereInput{
+			Deleted: prisma.Bool(true),
+			Book: &prisma.BookWhereInput{
+				Deleted: prisma.Bool(false),
+			},
+		},
+		OrderBy: &chapterOrderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return Trash{}, err
+	}
+
+	return Trash{Books: books, Chapters: chapters}, nil
+}
+
+func (s *service) RestoreBook(ctx context.Context, id string) (prisma.Book, error) {
+	if id == "" {
+		return prisma.Book{}, ErrInvalidArgument
+	}
+
+	book, err := client.Book(prisma.BookWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	if !book.Deleted {
+		return prisma.Book{}, ErrNotFound
+	}
+
+	book.Deleted = false
+	entry, err := newOutboxEntry(NewEvent(EventTypeBook, prisma.MutationTypeCreated, id, *book, nil), id)
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	book, err = client.UpdateBook(prisma.BookUpdateParams{
+		Where: prisma.BookWhereUniqueInput{
+			ID: &id,
+		},
+		Data: prisma.BookUpdateInput{
+			Deleted: prisma.Bool(false),
+			Outbox: &prisma.OutboxEventUpdateManyWithoutBookInput{
+				Create: []prisma.OutboxEventCreateWithoutBookInput{entry.withoutBook()},
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Book{}, err
+	}
+
+	return *book, nil
+}
+
+func (s *service) RestoreChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	if id == "" {
+		return prisma.Chapter{}, ErrInvalidArgument
+	}
+
+	chapter, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	book, err := client.Chapter(prisma.ChapterWhereUniqueInput{
+		ID: &id,
+	}).Book().Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	if !chapter.Deleted && !book.Deleted {
+		return prisma.Chapter{}, ErrNotFound
+	}
+
+	data := prisma.ChapterUpdateInput{
+		Deleted: prisma.Bool(false),
+	}
+
+	// The book comes back first, so its event is made before the chapter's
+	// and relayed before it.
+	if book.Deleted {
+		book.Deleted = false
+		bookEntry, err := newOutboxEntry(NewEvent(EventTypeBook, prisma.MutationTypeCreated, book.ID, *book, nil), book.ID)
+		if err != nil {
+			return prisma.Chapter{}, err
+		}
+
+		data.Book = &prisma.BookUpdateOneRequiredWithoutChaptersInput{
+			Update: &prisma.BookUpdateWithoutChaptersDataInput{
+				Deleted: prisma.Bool(false),
+				Outbox: &prisma.OutboxEventUpdateManyWithoutBookInput{
+					Create: []prisma.OutboxEventCreateWithoutBookInput{bookEntry.withoutBook()},
+				},
+			},
+		}
+	}
+
+	chapter.Deleted = false
+	entry, err := newOutboxEntry(NewEvent(EventTypeChapter, prisma.MutationTypeCreated, book.ID, *chapter, nil), id)
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	data.Outbox = &prisma.OutboxEventUpdateManyWithoutChapterInput{
+		Create: []prisma.OutboxEventCreateWithoutChapterInput{entry.withoutChapter()},
+	}
+
+	chapter, err = client.UpdateChapter(prisma.ChapterUpdateParams{
+		Where: prisma.ChapterWhereUniqueInput{
+			ID: &id,
+		},
+		Data: data,
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.Chapter{}, err
+	}
+
+	return *chapter, nil
+}
+
+// activeBook returns the book with the given ID unless it is in the trash.
+func activeBook(ctx context.Context, id string) (*prisma.Book, error) {
+	book, err := client.Book(prisma.BookWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err == prisma.ErrNoResult {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if book.Deleted {
+		return nil, ErrNotFound
+	}
+
+	return book, nil
+}
+
+// activeChapter returns the chapter with the given ID unless it or its book
+// is in the trash.
+func activeChapter(ctx context.Context, id string) (*prisma.Chapter, error) {
+	chapters, err := client.Chapters(&prisma.ChaptersParams{
+		Where: &prisma.ChapterWhereInput{
+			ID:      &id,
+			Deleted: prisma.Bool(false),
+			Book: &prisma.BookWhereInput{
+				Deleted: prisma.Bool(false),
+			},
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chapters) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return &chapters[0], nil
+}
+
+// PurgeActor is the actor the Purger records in the audit trail.
+const PurgeActor = "system"
+
+const purgeInterval = time.Hour
+
+// Purger permanently deletes books and chapters which have been in the
+// trash for longer than the retention period.
+type Purger struct {
+	retention time.Duration
+	logger    log.Logger
+}
+
+// NewPurger returns a new instance of a Purger.
+func NewPurger(logger log.Logger, retention time.Duration) *Purger {
+	return &Purger{
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Run purges the trash every hour until ctx is done.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(WithActor(ctx, PurgeActor))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purge(ctx context.Context) {
+	before := formatTime(time.Now().Add(-p.retention))
+
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			Deleted:     prisma.Bool(true),
+			DeletedAtLt: &before,
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		p.logger.Log("err", err)
+		return
+	}
+
+	// Books are deleted one by one, as deleting many does not cascade.
+	for _, book := range books {
+		id := book.ID
+		_, err := client.DeleteBook(prisma.BookWhereUniqueInput{ID: &id}).Exec(ctx)
+		if err == nil {
+			err = recordAudit(ctx, "purge_book", book.ID, "", book, nil)
+		}
+		p.logger.Log("method", "purge_book", "id", book.ID, "err", err)
+	}
+
+	chapters, err := client.Chapters(&prisma.ChaptersParams{
+		Where: &prisma.ChapterWhereInput{
+			Deleted:     prisma.Bool(true),
+			DeletedAtLt: &before,
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		p.logger.Log("err", err)
+		return
+	}
+
+	for _, chapter := range chapters {
+		id := chapter.ID
+		book, err := client.Chapter(prisma.ChapterWhereUniqueInput{ID: &id}).Book().Exec(ctx)
+		if err != nil {
+			p.logger.Log("method", "purge_chapter", "id", chapter.ID, "err", err)
+			continue
+		}
+		_, err = client.DeleteChapter(prisma.ChapterWhereUniqueInput{ID: &id}).Exec(ctx)
+		if err == nil {
+			err = recordAudit(ctx, "purge_chapter", book.ID, chapter.ID, chapter, nil)
+		}
+		p.logger.Log("method", "purge_chapter", "id", chapter.ID, "err", err)
+	}
+}
@@ -1,12 +1,18 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
@@ -17,13 +23,34 @@ import (
 
 	"github.com/go-kit/kit/log"
 	"github.com/maxp36/rembook/handling"
+	"github.com/maxp36/rembook/outbox"
+	"github.com/maxp36/rembook/reminder"
+	"github.com/maxp36/rembook/search"
+	"github.com/maxp36/rembook/webhook"
 )
 
 const defaultPort = "8080"
 
 func main() {
 
-	var httpAddr = flag.String("http.addr", ":"+defaultPort, "HTTP listen address")
+	var (
+		httpAddr      = flag.String("http.addr", ":"+defaultPort, "HTTP listen address")
+		outboxSinks   = flag.String("outbox.sinks", "log", "Comma-separated outbox sinks: log, file, webhook, nats")
+		outboxFile    = flag.String("outbox.file", "outbox.jsonl", "File the file outbox sink appends to")
+		outboxWebhook = flag.String("outbox.webhook", "", "URL the webhook outbox sink posts to")
+		outboxNATS    = flag.String("outbox.nats", "localhost:4222", "NATS server address of the nats outbox sink")
+		outboxSubject = flag.String("outbox.subject", "rembook.events", "NATS subject prefix of the nats outbox sink")
+		trashDays     = flag.Int("trash.days", 30, "Days after which trashed books and chapters are purged, 0 keeps them")
+		smtpAddr      = flag.String("reminder.smtp.addr", "", "SMTP server address of the smtp reminder notifier, none disables it")
+		smtpFrom      = flag.String("reminder.smtp.from", "rembook@localhost", "Sender address of the smtp reminder notifier")
+		smtpUser      = flag.String("reminder.smtp.user", "", "SMTP username of the smtp reminder notifier, none sends unauthenticated")
+		smtpPassword  = flag.String("reminder.smtp.password", "", "SMTP password of the smtp reminder notifier")
+		searchIndex   = flag.String("search.index", "search.idx", "File the search index is kept in, none keeps it in memory")
+		searchRebuild = flag.Bool("search.rebuild", false, "Rebuild the search index from the existing books and exit")
+		importFile    = flag.String("import.markdown", "", "Import the books of a Markdown file, - for stdin, and exit")
+		importDryRun  = flag.Bool("import.dry-run", false, "Report what -import.markdown would create without creating it")
+		importUser    = flag.String("import.user", "", "User -import.markdown acts as")
+	)
 	flag.Parse()
 
 	var logger log.Logger
@@ -49,10 +76,41 @@ func main() {
 	}
 	defer closer.Close()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	labelNames := []string{"method"}
 
+	events := handling.NewEventHub()
+	dispatcher := webhook.NewDispatcher(log.With(logger, "component", "webhook_dispatcher"))
+
+	index := search.NewIndex(log.With(logger, "component", "search_index"), *searchIndex)
+
 	var hs handling.Service
 	hs = handling.NewService()
+
+	indexer := search.NewIndexer(index, hs)
+	if *searchRebuild {
+		n, err := indexer.Rebuild(ctx)
+		if err != nil {
+			logger.Log("err", err)
+			os.Exit(1)
+		}
+		logger.Log("msg", "search index rebuilt", "documents", n)
+		return
+	}
+	if err := index.Load(); err != nil {
+		logger.Log("msg", "rebuilding search index", "err", err)
+		go func() {
+			if _, err := indexer.Rebuild(ctx); err != nil {
+				logger.Log("err", err)
+			}
+		}()
+	}
+
+	hs = search.NewIndexingService(log.With(logger, "component", "search_indexer"), indexer, hs)
+	hs = handling.NewAuditingService(log.With(logger, "component", "audit"), hs)
+	hs = handling.NewEventingService(handling.EventPublishers{events, dispatcher}, hs)
 	hs = handling.NewLoggingService(log.With(logger, "component", "handling"), hs)
 	hs = handling.NewInstrumentingService(
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -71,10 +129,154 @@ func main() {
 	)
 	hs = handling.NewTracingService(tracer, hs)
 
+	var bs handling.BulkService
+	bs = handling.NewBulkService(hs)
+	bs = handling.NewLoggingBulkService(log.With(logger, "component", "handling"), bs)
+	bs = handling.NewInstrumentingBulkService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "api",
+			Subsystem: "handling_bulk_service",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, labelNames),
+		kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "api",
+			Subsystem: "handling_bulk_service",
+			Name:      "request_latency_seconds",
+			Help:      "Total duration of requests in seconds.",
+		}, labelNames),
+		bs,
+	)
+	bs = handling.NewTracingBulkService(tracer, bs)
+
+	if *importFile != "" {
+		if err := importMarkdown(handling.WithActor(ctx, *importUser), bs, *importFile, *importDryRun); err != nil {
+			logger.Log("err", err)
+			os.Exit(1)
+		}
+		if err := index.Flush(); err != nil {
+			logger.Log("err", err)
+		}
+		return
+	}
+
+	var ss search.Service
+	ss = search.NewService(index)
+	ss = search.NewLoggingService(log.With(logger, "component", "search"), ss)
+	ss = search.NewInstrumentingService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "api",
+			Subsystem: "search_service",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, labelNames),
+		kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "api",
+			Subsystem: "search_service",
+			Name:      "request_latency_seconds",
+			Help:      "Total duration of requests in seconds.",
+		}, labelNames),
+		ss,
+	)
+
+	var ws webhook.Service
+	ws = webhook.NewService()
+	ws = webhook.NewLoggingService(log.With(logger, "component", "webhook"), ws)
+	ws = webhook.NewInstrumentingService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "api",
+			Subsystem: "webhook_service",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, labelNames),
+		kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "api",
+			Subsystem: "webhook_service",
+			Name:      "request_latency_seconds",
+			Help:      "Total duration of requests in seconds.",
+		}, labelNames),
+		ws,
+	)
+
+	var rs reminder.Service
+	rs = reminder.NewService()
+	rs = reminder.NewLoggingService(log.With(logger, "component", "reminder"), rs)
+	rs = reminder.NewInstrumentingService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "api",
+			Subsystem: "reminder_service",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, labelNames),
+		kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "api",
+			Subsystem: "reminder_service",
+			Name:      "request_latency_seconds",
+			Help:      "Total duration of requests in seconds.",
+		}, labelNames),
+		rs,
+	)
+
+	notifiers := map[string]reminder.Notifier{
+		reminder.NotifierLog:     reminder.NewLogNotifier(log.With(logger, "component", "reminder_notifier")),
+		reminder.NotifierWebhook: reminder.NewWebhookNotifier(),
+	}
+	if *smtpAddr != "" {
+		notifiers[reminder.NotifierSMTP] = reminder.NewSMTPNotifier(*smtpAddr, *smtpFrom, *smtpUser, *smtpPassword)
+	}
+	scheduler := reminder.NewScheduler(log.With(logger, "component", "reminder_scheduler"), notifiers)
+
+	var sinks []outbox.Sink
+	for _, name := range strings.Split(*outboxSinks, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case "log":
+			sinks = append(sinks, outbox.NewLogSink(log.With(logger, "component", "outbox_sink")))
+		case "file":
+			sink, err := outbox.NewFileSink(*outboxFile)
+			if err != nil {
+				logger.Log("err", err)
+				os.Exit(1)
+			}
+			sinks = append(sinks, sink)
+		case "webhook":
+			sinks = append(sinks, outbox.NewWebhookSink(*outboxWebhook))
+		case "nats":
+			sinks = append(sinks, outbox.NewNATSSink(*outboxNATS, *outboxSubject))
+		default:
+			logger.Log("err", fmt.Sprintf("unknown outbox sink %q", name))
+			os.Exit(1)
+		}
+	}
+	relay := outbox.NewRelay(log.With(logger, "component", "outbox"), sinks...)
+
+	// Books and chapters created before revisions were kept get their
+	// revision 1 before anything can change them.
+	n, err := handling.BackfillRevisions(ctx)
+	if err != nil {
+		logger.Log("err", err)
+		os.Exit(1)
+	}
+	if n > 0 {
+		logger.Log("msg", "revisions backfilled", "entities", n)
+	}
+
+	go dispatcher.Run(ctx)
+	go relay.Run(ctx)
+	go scheduler.Run(ctx)
+	go index.Run(ctx)
+	if *trashDays > 0 {
+		purger := handling.NewPurger(log.With(logger, "component", "purger"), time.Duration(*trashDays)*24*time.Hour)
+		go purger.Run(ctx)
+	}
+
 	httpLogger := log.With(logger, "component", "http")
 
 	mux := http.NewServeMux()
-	mux.Handle("/handling/v1/", handling.MakeHandler(hs, httpLogger))
+	mux.Handle("/handling/v1/", search.MakeHandler(ss, handling.MakeHandler(hs, bs, httpLogger), httpLogger))
+	mux.Handle("/handling/v1/events", handling.MakeEventsHandler(events, httpLogger))
+	mux.Handle("/webhook/v1/", webhook.MakeHandler(ws, httpLogger))
+	mux.Handle("/reminder/v1/", reminder.MakeHandler(rs, httpLogger))
 
 	http.Handle("/", accessControl(mux))
 	http.Handle("/metrics", promhttp.Handler())
@@ -85,19 +287,52 @@ func main() {
 		errs <- http.ListenAndServe(*httpAddr, nil)
 	}()
 	go func() {
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT)
 		errs <- fmt.Errorf("%s", <-c)
 	}()
 
 	logger.Log("terminated", <-errs)
+
+	if err := index.Flush(); err != nil {
+		logger.Log("err", err)
+	}
+}
+
+// importMarkdown imports the books of the Markdown file at path, or of
+// stdin if path is "-", and writes the result to stdout.
+func importMarkdown(ctx context.Context, bs handling.BulkService, path string, dryRun bool) error {
+	in := os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	doc, err := ioutil.ReadAll(io.LimitReader(in, handling.MaxImportSize+1))
+	if err != nil {
+		return err
+	}
+
+	result, err := bs.ImportMarkdown(ctx, string(doc), dryRun)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
 }
 
 func accessControl(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Idempotency-Key, If-Match, If-None-Match, X-User-ID, X-Request-ID")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag, X-Request-ID")
 
 		if r.Method == "OPTIONS" {
 			return
@@ -0,0 +1,153 @@
+// Package outbox relays the domain events recorded in the outbox by the
+// handling service to pluggable sinks.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Relay tuning.
+const (
+	pollInterval = time.Second
+	batchSize    = 100
+
+	// retention is how long published events are kept.
+	retention    = 7 * 24 * time.Hour
+	pruneEvery   = time.Hour
+	storeTimeout = 10 * time.Second
+)
+
+// Message is an outbox event handed to a sink. ID is stable across
+// redeliveries and should be used by consumers to deduplicate.
+type Message struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Aggregate   string          `json:"aggregate"`
+	AggregateID string          `json:"aggregate_id"`
+	CreatedAt   string          `json:"created_at"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// Sink publishes outbox messages to an external system.
+type Sink interface {
+	Publish(ctx context.Context, m Message) error
+}
+
+// Relay publishes READY outbox events to its sinks in the order they were
+// recorded. An event is marked PUBLISHED only once every sink accepted it,
+// so delivery is at-least-once: a sink may see the same message again after
+// a failure of another sink or a restart.
+type Relay struct {
+	sinks  []Sink
+	logger log.Logger
+}
+
+// NewRelay returns a new instance of a Relay.
+func NewRelay(logger log.Logger, sinks ...Sink) *Relay {
+	return &Relay{
+		sinks:  sinks,
+		logger: logger,
+	}
+}
+
+var client = prisma.New(nil)
+
+// Run relays events until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var lastPrune time.Time
+	for {
+		if time.Since(lastPrune) >= pruneEvery {
+			r.prune(ctx)
+			lastPrune = time.Now()
+		}
+		r.relay(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) relay(ctx context.Context) {
+	status := prisma.OutboxStatusReady
+	orderBy := prisma.OutboxEventOrderByInputSequenceAsc
+
+	events, err := client.OutboxEvents(&prisma.OutboxEventsParams{
+		Where: &prisma.OutboxEventWhereInput{
+			Status: &status,
+		},
+		OrderBy: &orderBy,
+		First:   prisma.Int32(batchSize),
+	}).Exec(ctx)
+
+	if err != nil {
+		r.logger.Log("err", err)
+		return
+	}
+
+	for _, e := range events {
+		m := Message{
+			ID:          e.ID,
+			Type:        e.Type,
+			Aggregate:   e.Aggregate,
+			AggregateID: e.AggregateID,
+			CreatedAt:   e.CreatedAt,
+			Payload:     json.RawMessage(e.Payload),
+		}
+
+		for _, sink := range r.sinks {
+			if err := sink.Publish(ctx, m); err != nil {
+				// Keep the order: stop here and retry on the next tick.
+				r.logger.Log("event", e.ID, "type", e.Type, "err", err)
+				return
+			}
+		}
+
+		if err := r.markPublished(ctx, e.ID); err != nil {
+			r.logger.Log("event", e.ID, "type", e.Type, "err", err)
+			return
+		}
+	}
+}
+
+func (r *Relay) markPublished(ctx context.Context, id string) error {
+	status := prisma.OutboxStatusPublished
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	_, err := client.UpdateOutboxEvent(prisma.OutboxEventUpdateParams{
+		Where: prisma.OutboxEventWhereUniqueInput{ID: &id},
+		Data: prisma.OutboxEventUpdateInput{
+			Status:      &status,
+			PublishedAt: &now,
+		},
+	}).Exec(ctx)
+
+	return err
+}
+
+func (r *Relay) prune(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, storeTimeout)
+	defer cancel()
+
+	status := prisma.OutboxStatusPublished
+	before := time.Now().Add(-retention).UTC().Format(time.RFC3339)
+
+	_, err := client.DeleteManyOutboxEvents(&prisma.OutboxEventWhereInput{
+		Status:        &status,
+		PublishedAtLt: &before,
+	}).Exec(ctx)
+
+	if err != nil {
+		r.logger.Log("err", err)
+	}
+}
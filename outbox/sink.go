@@ -0,0 +1,226 @@
+package outbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+type logSink struct {
+	logger log.Logger
+}
+
+// NewLogSink returns a Sink which logs every message.
+func NewLogSink(logger log.Logger) Sink {
+	return &logSink{logger}
+}
+
+func (s *logSink) Publish(_ context.Context, m Message) error {
+	return s.logger.Log(
+		"id", m.ID,
+		"type", m.Type,
+		"aggregate", m.Aggregate,
+		"aggregate_id", m.AggregateID,
+	)
+}
+
+type fileSink struct {
+	mtx  sync.Mutex
+	file *os.File
+}
+
+// NewFileSink returns a Sink which appends every message as a line of JSON
+// to the file at path.
+func NewFileSink(path string) (Sink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{file: f}, nil
+}
+
+func (s *fileSink) Publish(_ context.Context, m Message) error {
+	line, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// HeaderIdempotencyKey carries the message ID on webhook sink requests.
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a Sink which POSTs every message as JSON to url.
+// Any response other than 2xx fails the delivery.
+func NewWebhookSink(url string) Sink {
+	return &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *webhookSink) Publish(ctx context.Context, m Message) error {
+	body, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set(HeaderIdempotencyKey, m.ID)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// natsSink publishes to a NATS server using its plain text protocol. Every
+// publish is followed by a PING so that the message is known to have
+// reached the server before the event is marked as published.
+type natsSink struct {
+	addr    string
+	subject string
+
+	mtx  sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// NewNATSSink returns a Sink which publishes every message as JSON to
+// subject on the NATS server at addr. The message type is appended to the
+// subject, e.g. "rembook.events.book.created".
+func NewNATSSink(addr string, subject string) Sink {
+	return &natsSink{
+		addr:    addr,
+		subject: subject,
+	}
+}
+
+const natsTimeout = 10 * time.Second
+
+var errNATSProtocol = errors.New("nats: unexpected reply")
+
+func (s *natsSink) Publish(ctx context.Context, m Message) error {
+	body, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := s.publish(m.Type, body); err != nil {
+		s.conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (s *natsSink) connect(ctx context.Context) error {
+	var d net.Dialer
+	ctx, cancel := context.WithTimeout(ctx, natsTimeout)
+	defer cancel()
+
+	conn, err := d.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(natsTimeout))
+
+	r := bufio.NewReader(conn)
+	info, err := r.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if !strings.HasPrefix(info, "INFO ") {
+		conn.Close()
+		return errNATSProtocol
+	}
+
+	if _, err := io.WriteString(conn, "CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"rembook-outbox\"}\r\n"); err != nil {
+		conn.Close()
+		return err
+	}
+
+	s.conn = conn
+	s.r = r
+	return nil
+}
+
+func (s *natsSink) publish(typ string, body []byte) error {
+	s.conn.SetDeadline(time.Now().Add(natsTimeout))
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "PUB %s.%s %d\r\n", s.subject, typ, len(body))
+	b.Write(body)
+	b.WriteString("\r\nPING\r\n")
+
+	if _, err := s.conn.Write(b.Bytes()); err != nil {
+		return err
+	}
+
+	for {
+		line, err := s.r.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		switch {
+		case strings.HasPrefix(line, "PONG"):
+			return nil
+		case strings.HasPrefix(line, "PING"):
+			if _, err := io.WriteString(s.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "INFO "), strings.HasPrefix(line, "+OK"):
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		default:
+			return errNATSProtocol
+		}
+	}
+}
@@ -0,0 +1,424 @@
+package reminder
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/maxp36/rembook/handling"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Calendar feed tuning. The feed covers the events of the next
+// feedHorizon, and at most maxOccurrences times of a reminder.
+const (
+	feedHorizon      = 60 * 24 * time.Hour
+	maxOccurrences   = 100
+	reminderDuration = 15 * time.Minute
+)
+
+// Feed is the calendar feed of a user. Token is only ever returned when it
+// is issued, as just a hash of it is stored; Path is where the feed is
+// served, to be subscribed to from a calendar.
+type Feed struct {
+	Token string `json:"token"`
+	Path  string `json:"path"`
+}
+
+// Event is an event of a calendar feed. All-day events start at the
+// beginning of the date of Start. UID is stable, so that a calendar
+// replaces an event as it changes rather than adding another one.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	Duration    time.Duration
+	AllDay      bool
+	Stamp       time.Time
+}
+
+// IssueFeed returns a new calendar feed of the calling user, revoking the
+// previous one, if any.
+func (s *service) IssueFeed(ctx context.Context) (Feed, error) {
+	token, err := newFeedToken()
+	if err != nil {
+		return Feed{}, err
+	}
+
+	user := handling.Actor(ctx)
+	hash := feedTokenHash(token)
+
+	if _, err := client.UpsertCalendarFeed(prisma.CalendarFeedUpsertParams{
+		Where: prisma.CalendarFeedWhereUniqueInput{
+			User: &user,
+		},
+		Create: prisma.CalendarFeedCreateInput{
+			User:      user,
+			TokenHash: hash,
+		},
+		Update: prisma.CalendarFeedUpdateInput{
+			TokenHash: &hash,
+		},
+	}).Exec(ctx); err != nil {
+		return Feed{}, err
+	}
+
+	return Feed{
+		Token: token,
+		Path:  "/reminder/v1/calendar/" + token + ".ics",
+	}, nil
+}
+
+func (s *service) RevokeFeed(ctx context.Context) error {
+	user := handling.Actor(ctx)
+	_, err := client.DeleteCalendarFeed(prisma.CalendarFeedWhereUniqueInput{
+		User: &user,
+	}).Exec(ctx)
+
+	if err == prisma.ErrNoResult {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Calendar returns the events of the calendar feed with the given token:
+// the upcoming times of the active reminders of its user, and for every
+// book a day with cards due for review, in the time zone timezone, UTC by
+// default. Cards overdue are due today.
+func (s *service) Calendar(ctx context.Context, token, timezone string) ([]Event, error) {
+	if token == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	hash := feedTokenHash(token)
+	feed, err := client.CalendarFeed(prisma.CalendarFeedWhereUniqueInput{
+		TokenHash: &hash,
+	}).Exec(ctx)
+
+	switch {
+	case err == prisma.ErrNoResult:
+		return nil, ErrNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	now := time.Now()
+	until := now.Add(feedHorizon)
+
+	events, err := reminderEvents(ctx, feed.User, until)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := reviewEvents(ctx, now.In(loc), until)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, reviews...)
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Start.Equal(events[j].Start) {
+			return events[i].Start.Before(events[j].Start)
+		}
+		return events[i].UID < events[j].UID
+	})
+	return events, nil
+}
+
+// reminderEvents returns an event for every time the active reminders of
+// user fire at before until. The times of a recurring reminder are events
+// of their own, each with the time in its UID.
+func reminderEvents(ctx context.Context, user string, until time.Time) ([]Event, error) {
+	orderBy := prisma.ReminderOrderByInputCreatedAtAsc
+	reminders, err := client.Reminders(&prisma.RemindersParams{
+		Where: &prisma.ReminderWhereInput{
+			User:          &user,
+			Active:        prisma.Bool(true),
+			NextFireAtLte: prisma.Str(formatTime(until)),
+			Book: &prisma.BookWhereInput{
+				Deleted: prisma.Bool(false),
+			},
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	for _, r := range reminders {
+		id := r.ID
+		book, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+			ID: &id,
+		}).Book().Exec(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var chapterName string
+		chapter, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+			ID: &id,
+		}).Chapter().Exec(ctx)
+
+		switch {
+		case err == nil && chapter.Deleted:
+			continue
+		case err == nil:
+			chapterName = chapter.Name
+		case err != prisma.ErrNoResult:
+			return nil, err
+		}
+
+		summary := r.Message
+		if summary == "" {
+			summary = defaultMessage(book.Name, chapterName)
+		}
+
+		times, err := occurrences(r, until)
+		if err != nil {
+			return nil, err
+		}
+
+		stamp, _ := time.Parse(time.RFC3339, r.UpdatedAt)
+		for _, t := range times {
+			uid := "reminder-" + r.ID
+			if r.Rule != "" {
+				uid += "-" + t.UTC().Format("20060102T150405Z")
+			}
+			events = append(events, Event{
+				UID:         uid + "@rembook",
+				Summary:     summary,
+				Description: book.Name,
+				Start:       t,
+				Duration:    reminderDuration,
+				Stamp:       stamp,
+			})
+		}
+	}
+	return events, nil
+}
+
+// occurrences returns the times r fires at before until.
+func occurrences(r prisma.Reminder, until time.Time) ([]time.Time, error) {
+	next, err := time.Parse(time.RFC3339, r.NextFireAt)
+	if err != nil {
+		return nil, err
+	}
+	if r.Rule == "" {
+		return []time.Time{next}, nil
+	}
+
+	rule, err := parseRule(r.Rule)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	var times []time.Time
+	for t, ok := next.In(loc), true; ok && t.Before(until) && len(times) < maxOccurrences; t, ok = rule.next(t) {
+		times = append(times, t)
+	}
+	return times, nil
+}
+
+// reviewEvents returns an all-day event for every book and day before
+// until with cards due for review, days being those of the location of
+// now.
+func reviewEvents(ctx context.Context, now, until time.Time) ([]Event, error) {
+	due := prisma.Str(formatTime(until))
+	orderBy := prisma.BookOrderByInputCreatedAtAsc
+
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			Deleted: prisma.Bool(false),
+			ChaptersSome: &prisma.ChapterWhereInput{
+				Deleted: prisma.Bool(false),
+				CardsSome: &prisma.CardWhereInput{
+					DueLt: due,
+				},
+			},
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	today := startOfDay(now)
+
+	var events []Event
+	for _, book := range books {
+		bookID := book.ID
+		cards, err := client.Cards(&prisma.CardsParams{
+			Where: &prisma.CardWhereInput{
+				DueLt: due,
+				Chapter: &prisma.ChapterWhereInput{
+					Deleted: prisma.Bool(false),
+					Book: &prisma.BookWhereInput{
+						ID: &bookID,
+					},
+				},
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		type day struct {
+			cards int
+			stamp time.Time
+		}
+		days := make(map[time.Time]*day)
+		for _, c := range cards {
+			at, err := time.Parse(time.RFC3339, c.Due)
+			if err != nil {
+				return nil, err
+			}
+			date := startOfDay(at.In(now.Location()))
+			if date.Before(today) {
+				date = today
+			}
+
+			d, ok := days[date]
+			if !ok {
+				d = &day{}
+				days[date] = d
+			}
+			d.cards++
+			if stamp, _ := time.Parse(time.RFC3339, c.UpdatedAt); stamp.After(d.stamp) {
+				d.stamp = stamp
+			}
+		}
+
+		for date, d := range days {
+			summary := fmt.Sprintf("Review %d cards of %s", d.cards, book.Name)
+			if d.cards == 1 {
+				summary = "Review 1 card of " + book.Name
+			}
+			events = append(events, Event{
+				UID:     "review-" + book.ID + "-" + date.Format("20060102") + "@rembook",
+				Summary: summary,
+				Start:   date,
+				AllDay:  true,
+				Stamp:   d.stamp,
+			})
+		}
+	}
+	return events, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func newFeedToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func feedTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeCalendar writes events to w as an iCalendar (RFC 5545) object.
+func writeCalendar(w io.Writer, events []Event) error {
+	c := &calendarWriter{w: w}
+	c.line("BEGIN:VCALENDAR")
+	c.line("VERSION:2.0")
+	c.line("PRODID:-//rembook//reminder//EN")
+	c.line("CALSCALE:GREGORIAN")
+	c.line("METHOD:PUBLISH")
+	c.line("X-WR-CALNAME:rembook")
+	c.line("REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	c.line("X-PUBLISHED-TTL:PT1H")
+
+	for _, e := range events {
+		c.line("BEGIN:VEVENT")
+		c.line("UID:" + e.UID)
+		c.line("DTSTAMP:" + e.Stamp.UTC().Format("20060102T150405Z"))
+		if e.AllDay {
+			c.line("DTSTART;VALUE=DATE:" + e.Start.Format("20060102"))
+			c.line("DTEND;VALUE=DATE:" + e.Start.AddDate(0, 0, 1).Format("20060102"))
+		} else {
+			c.line("DTSTART:" + e.Start.UTC().Format("20060102T150405Z"))
+			c.line("DTEND:" + e.Start.Add(e.Duration).UTC().Format("20060102T150405Z"))
+		}
+		c.line("SUMMARY:" + escapeText(e.Summary))
+		if e.Description != "" {
+			c.line("DESCRIPTION:" + escapeText(e.Description))
+		}
+		c.line("TRANSP:TRANSPARENT")
+		c.line("END:VEVENT")
+	}
+
+	c.line("END:VCALENDAR")
+	return c.err
+}
+
+type calendarWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes a content line, folded into lines of at most 75 octets
+// without splitting a character.
+func (c *calendarWriter) line(s string) {
+	if c.err != nil {
+		return
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range s {
+		size := len(string(r))
+		if n+size > 75 {
+			b.WriteString("\r\n ")
+			n = 1
+		}
+		b.WriteRune(r)
+		n += size
+	}
+	b.WriteString("\r\n")
+
+	_, c.err = io.WriteString(c.w, b.String())
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
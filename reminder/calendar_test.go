@@ -0,0 +1,109 @@
+package reminder
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Read Dune", "Read Dune"},
+		{"Dune, Part One", `Dune\, Part One`},
+		{"Dune; Messiah", `Dune\; Messiah`},
+		{`C:\books`, `C:\\books`},
+		{"one\ntwo", `one\ntwo`},
+		{"one\r\ntwo", `one\ntwo`},
+		{`a\,b`, `a\\\,b`},
+		{"a;b,c\\d\ne", `a\;b\,c\\d\ne`},
+		{"Война и мир, том 1", `Война и мир\, том 1`},
+	}
+
+	for _, tt := range tests {
+		if got := escapeText(tt.text); got != tt.want {
+			t.Errorf("escapeText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCalendarLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want []string
+	}{
+		{"short", "SUMMARY:Read Dune", []string{"SUMMARY:Read Dune"}},
+		{"75 octets", strings.Repeat("a", 75), []string{strings.Repeat("a", 75)}},
+		{"76 octets", strings.Repeat("a", 76), []string{strings.Repeat("a", 75), " a"}},
+		{"folded twice", strings.Repeat("a", 150), []string{strings.Repeat("a", 75), " " + strings.Repeat("a", 74), " a"}},
+		// The two octets of я would end at octet 76.
+		{"character at the fold", strings.Repeat("a", 74) + "яb", []string{strings.Repeat("a", 74), " яb"}},
+		{"character before the fold", strings.Repeat("a", 73) + "яb", []string{strings.Repeat("a", 73) + "я", " b"}},
+		// The four octets of 📚 would end at octet 77.
+		{"emoji at the fold", strings.Repeat("a", 73) + "📚", []string{strings.Repeat("a", 73), " 📚"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			c := &calendarWriter{w: &buf}
+			c.line(tt.line)
+			if c.err != nil {
+				t.Fatal(c.err)
+			}
+
+			want := strings.Join(tt.want, "\r\n") + "\r\n"
+			if got := buf.String(); got != want {
+				t.Errorf("line(%q) wrote %q, want %q", tt.line, got, want)
+			}
+		})
+	}
+}
+
+func TestWriteCalendarFolding(t *testing.T) {
+	summary := strings.Repeat("Война и мир, том 1; «Анна Каренина»\n", 5)
+	events := []Event{{
+		UID:     "reminder-r1@rembook",
+		Summary: summary,
+		Start:   time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+		Stamp:   time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC),
+	}}
+
+	var buf bytes.Buffer
+	if err := writeCalendar(&buf, events); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	if !strings.HasSuffix(out, "\r\n") {
+		t.Fatalf("calendar does not end with CRLF: %q", out)
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+
+	var folded int
+	for _, l := range lines {
+		if len(l) > 75 {
+			t.Errorf("line of %d octets: %q", len(l), l)
+		}
+		if !utf8.ValidString(l) {
+			t.Errorf("line splits a character: %q", l)
+		}
+		if strings.HasPrefix(l, " ") {
+			folded++
+		}
+	}
+	if folded == 0 {
+		t.Fatal("summary not folded")
+	}
+
+	// Unfolding, as a calendar does, gives back the escaped summary.
+	unfolded := strings.Replace(out, "\r\n ", "", -1)
+	if want := "\r\nSUMMARY:" + escapeText(summary) + "\r\n"; !strings.Contains(unfolded, want) {
+		t.Errorf("unfolded calendar %q does not contain %q", unfolded, want)
+	}
+}
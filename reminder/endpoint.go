@@ -0,0 +1,140 @@
+package reminder
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+type addReminderRequest struct {
+	Spec Spec
+}
+
+type reminderResponse struct {
+	Reminder Reminder `json:"reminder,omitempty"`
+	Err      error    `json:"err,omitempty"`
+}
+
+func (r reminderResponse) error() error { return r.Err }
+
+func makeAddReminderEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(addReminderRequest)
+		reminder, err := s.AddReminder(ctx, req.Spec)
+		return reminderResponse{Reminder: reminder, Err: err}, nil
+	}
+}
+
+type getReminderRequest struct {
+	ID string `json:"id"`
+}
+
+func makeGetReminderEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getReminderRequest)
+		reminder, err := s.GetReminder(ctx, req.ID)
+		return reminderResponse{Reminder: reminder, Err: err}, nil
+	}
+}
+
+type deleteReminderRequest struct {
+	ID string `json:"id"`
+}
+
+func makeDeleteReminderEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteReminderRequest)
+		reminder, err := s.DeleteReminder(ctx, req.ID)
+		return reminderResponse{Reminder: reminder, Err: err}, nil
+	}
+}
+
+type listRemindersRequest struct{}
+
+type listRemindersResponse struct {
+	Reminders []Reminder `json:"reminders,omitempty"`
+	Err       error      `json:"err,omitempty"`
+}
+
+func (r listRemindersResponse) error() error { return r.Err }
+
+func makeListRemindersEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listRemindersRequest)
+		reminders, err := s.Reminders(ctx)
+		return listRemindersResponse{Reminders: reminders, Err: err}, nil
+	}
+}
+
+type listFiringsRequest struct {
+	ReminderID string `json:"reminder_id"`
+}
+
+type listFiringsResponse struct {
+	Firings []prisma.ReminderFiring `json:"firings,omitempty"`
+	Err     error                   `json:"err,omitempty"`
+}
+
+func (r listFiringsResponse) error() error { return r.Err }
+
+func makeListFiringsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listFiringsRequest)
+		firings, err := s.Firings(ctx, req.ReminderID)
+		return listFiringsResponse{Firings: firings, Err: err}, nil
+	}
+}
+
+type issueFeedRequest struct{}
+
+type feedResponse struct {
+	Feed Feed  `json:"feed,omitempty"`
+	Err  error `json:"err,omitempty"`
+}
+
+func (r feedResponse) error() error { return r.Err }
+
+func makeIssueFeedEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(issueFeedRequest)
+		feed, err := s.IssueFeed(ctx)
+		return feedResponse{Feed: feed, Err: err}, nil
+	}
+}
+
+type revokeFeedRequest struct{}
+
+type revokeFeedResponse struct {
+	Err error `json:"err,omitempty"`
+}
+
+func (r revokeFeedResponse) error() error { return r.Err }
+
+func makeRevokeFeedEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(revokeFeedRequest)
+		err := s.RevokeFeed(ctx)
+		return revokeFeedResponse{Err: err}, nil
+	}
+}
+
+type calendarRequest struct {
+	Token    string
+	Timezone string
+}
+
+type calendarResponse struct {
+	Events []Event
+	Err    error
+}
+
+func (r calendarResponse) error() error { return r.Err }
+
+func makeCalendarEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(calendarRequest)
+		events, err := s.Calendar(ctx, req.Token, req.Timezone)
+		return calendarResponse{Events: events, Err: err}, nil
+	}
+}
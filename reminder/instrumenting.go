@@ -0,0 +1,96 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+type instrumentingService struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	Service
+}
+
+// NewInstrumentingService returns an instance of an instrumenting Service.
+func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram, s Service) Service {
+	return &instrumentingService{
+		requestCount:   counter,
+		requestLatency: latency,
+		Service:        s,
+	}
+}
+
+func (s *instrumentingService) AddReminder(ctx context.Context, spec Spec) (Reminder, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "add_reminder").Add(1)
+		s.requestLatency.With("method", "add_reminder").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.AddReminder(ctx, spec)
+}
+
+func (s *instrumentingService) GetReminder(ctx context.Context, id string) (Reminder, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "get_reminder").Add(1)
+		s.requestLatency.With("method", "get_reminder").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.GetReminder(ctx, id)
+}
+
+func (s *instrumentingService) DeleteReminder(ctx context.Context, id string) (Reminder, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "delete_reminder").Add(1)
+		s.requestLatency.With("method", "delete_reminder").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DeleteReminder(ctx, id)
+}
+
+func (s *instrumentingService) Reminders(ctx context.Context) ([]Reminder, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_reminders").Add(1)
+		s.requestLatency.With("method", "list_reminders").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Reminders(ctx)
+}
+
+func (s *instrumentingService) Firings(ctx context.Context, id string) ([]prisma.ReminderFiring, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_firings").Add(1)
+		s.requestLatency.With("method", "list_firings").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Firings(ctx, id)
+}
+
+func (s *instrumentingService) IssueFeed(ctx context.Context) (Feed, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "issue_feed").Add(1)
+		s.requestLatency.With("method", "issue_feed").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.IssueFeed(ctx)
+}
+
+func (s *instrumentingService) RevokeFeed(ctx context.Context) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "revoke_feed").Add(1)
+		s.requestLatency.With("method", "revoke_feed").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RevokeFeed(ctx)
+}
+
+func (s *instrumentingService) Calendar(ctx context.Context, token, timezone string) ([]Event, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "calendar").Add(1)
+		s.requestLatency.With("method", "calendar").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Calendar(ctx, token, timezone)
+}
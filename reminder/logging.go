@@ -0,0 +1,123 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+type loggingService struct {
+	logger log.Logger
+	Service
+}
+
+// NewLoggingService returns a new instance of a logging Service.
+func NewLoggingService(logger log.Logger, s Service) Service {
+	return &loggingService{logger, s}
+}
+
+func (s *loggingService) AddReminder(ctx context.Context, spec Spec) (reminder Reminder, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "add_reminder",
+			"user", handling.Actor(ctx),
+			"book_id", spec.BookID,
+			"chapter_id", spec.ChapterID,
+			"rule", spec.Rule,
+			"notifier", spec.Notifier,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AddReminder(ctx, spec)
+}
+
+func (s *loggingService) GetReminder(ctx context.Context, id string) (reminder Reminder, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "get_reminder",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.GetReminder(ctx, id)
+}
+
+func (s *loggingService) DeleteReminder(ctx context.Context, id string) (reminder Reminder, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "delete_reminder",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeleteReminder(ctx, id)
+}
+
+func (s *loggingService) Reminders(ctx context.Context) (reminders []Reminder, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_reminders",
+			"user", handling.Actor(ctx),
+			"reminders", len(reminders),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Reminders(ctx)
+}
+
+func (s *loggingService) Firings(ctx context.Context, id string) (firings []prisma.ReminderFiring, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_firings",
+			"id", id,
+			"firings", len(firings),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Firings(ctx, id)
+}
+
+func (s *loggingService) IssueFeed(ctx context.Context) (feed Feed, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "issue_feed",
+			"user", handling.Actor(ctx),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.IssueFeed(ctx)
+}
+
+func (s *loggingService) RevokeFeed(ctx context.Context) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "revoke_feed",
+			"user", handling.Actor(ctx),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RevokeFeed(ctx)
+}
+
+func (s *loggingService) Calendar(ctx context.Context, token, timezone string) (events []Event, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "calendar",
+			"timezone", timezone,
+			"events", len(events),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Calendar(ctx, token, timezone)
+}
@@ -0,0 +1,154 @@
+package reminder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+// Notification is a reminder firing handed to a notifier. ID is stable
+// across retries of the same firing and should be used by receivers to
+// deduplicate.
+type Notification struct {
+	ID          string `json:"id"`
+	ReminderID  string `json:"reminderId"`
+	User        string `json:"user"`
+	Message     string `json:"message"`
+	BookID      string `json:"bookId"`
+	BookName    string `json:"bookName"`
+	ChapterID   string `json:"chapterId,omitempty"`
+	ChapterName string `json:"chapterName,omitempty"`
+	ScheduledAt string `json:"scheduledAt"`
+
+	// Target is the target of the reminder.
+	Target string `json:"-"`
+}
+
+// Notifier sends notifications to the user of a reminder.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+type logNotifier struct {
+	logger log.Logger
+}
+
+// NewLogNotifier returns a Notifier which logs every notification.
+func NewLogNotifier(logger log.Logger) Notifier {
+	return &logNotifier{logger}
+}
+
+func (n *logNotifier) Notify(_ context.Context, m Notification) error {
+	return n.logger.Log(
+		"id", m.ID,
+		"reminder", m.ReminderID,
+		"user", m.User,
+		"book_id", m.BookID,
+		"chapter_id", m.ChapterID,
+		"message", m.Message,
+	)
+}
+
+// HeaderIdempotencyKey carries the notification ID on webhook requests.
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+type webhookNotifier struct {
+	client *http.Client
+}
+
+// NewWebhookNotifier returns a Notifier which POSTs every notification as
+// JSON to the target of its reminder. Any response other than 2xx fails
+// the notification.
+func NewWebhookNotifier() Notifier {
+	return &webhookNotifier{
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, m Notification) error {
+	body, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", m.Target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", "rembook-reminder")
+	req.Header.Set(HeaderIdempotencyKey, m.ID)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type smtpNotifier struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPNotifier returns a Notifier which mails every notification to the
+// target of its reminder through the SMTP server at addr. Without a
+// username, mail is sent without authentication.
+func NewSMTPNotifier(addr, from, username, password string) Notifier {
+	n := &smtpNotifier{
+		addr: addr,
+		from: from,
+	}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		n.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return n
+}
+
+func (n *smtpNotifier) Notify(_ context.Context, m Notification) error {
+	subject := "Reminder: " + m.BookName
+	if m.ChapterName != "" {
+		subject += ", " + m.ChapterName
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.Target)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&b, "Message-ID: <%s@rembook>\r\n", m.ID)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.Replace(m.Message, "\n", "\r\n", -1))
+	b.WriteString("\r\n")
+
+	// Reminders stored before their targets were normalized may still have
+	// a display name, which does not belong in the envelope.
+	to := m.Target
+	if addr, err := mail.ParseAddress(to); err == nil {
+		to = addr.Address
+	}
+
+	return smtp.SendMail(n.addr, n.auth, n.from, []string{to}, []byte(b.String()))
+}
@@ -0,0 +1,199 @@
+package reminder
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A rule is a cron expression of five fields: minute, hour, day of month,
+// month and day of week, e.g. "30 8 * * 1-5" for 8:30 on weekdays. A field
+// is a *, a value, a range like 1-5 or a comma-separated list of these, any
+// of which may be followed by a step like */15. Days of the week are 0 to
+// 7, both 0 and 7 being Sunday. As in cron, a day matches if either the day
+// of month or the day of week does, when both are restricted.
+
+// maxClockShift is the most clocks are set back by at once, e.g. when
+// daylight saving time ends.
+const maxClockShift = 3 * time.Hour
+
+// maxRuleYears bounds the search for the next time of a rule, so that a
+// rule which never matches, like "0 0 31 2 *", fails instead of looping.
+const maxRuleYears = 5
+
+type rule struct {
+	minutes, hours, days, months, weekdays uint64
+
+	// anyDay and anyWeekday are set if the field was a plain *.
+	anyDay, anyWeekday bool
+}
+
+type field struct {
+	min, max int
+}
+
+var fields = [5]field{
+	{0, 59}, // minute
+	{0, 23}, // hour
+	{1, 31}, // day of month
+	{1, 12}, // month
+	{0, 7},  // day of week
+}
+
+// parseRule parses a rule, returning ErrInvalidArgument if it is not valid.
+func parseRule(s string) (rule, error) {
+	parts := strings.Fields(s)
+	if len(parts) != len(fields) {
+		return rule{}, ErrInvalidArgument
+	}
+
+	var sets [5]uint64
+	for i, part := range parts {
+		set, err := parseField(part, fields[i])
+		if err != nil {
+			return rule{}, err
+		}
+		sets[i] = set
+	}
+
+	// Sunday is both 0 and 7.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	return rule{
+		minutes:    sets[0],
+		hours:      sets[1],
+		days:       sets[2],
+		months:     sets[3],
+		weekdays:   sets[4],
+		anyDay:     parts[2] == "*",
+		anyWeekday: parts[4] == "*",
+	}, nil
+}
+
+func parseField(s string, f field) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(s, ",") {
+		step := 1
+		if i := strings.IndexByte(item, '/'); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n <= 0 {
+				return 0, ErrInvalidArgument
+			}
+			step, item = n, item[:i]
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case item == "*":
+		case strings.IndexByte(item, '-') > 0:
+			i := strings.IndexByte(item, '-')
+			var err error
+			if lo, err = strconv.Atoi(item[:i]); err != nil {
+				return 0, ErrInvalidArgument
+			}
+			if hi, err = strconv.Atoi(item[i+1:]); err != nil {
+				return 0, ErrInvalidArgument
+			}
+		default:
+			n, err := strconv.Atoi(item)
+			if err != nil {
+				return 0, ErrInvalidArgument
+			}
+			lo, hi = n, n
+			if step > 1 {
+				hi = f.max
+			}
+		}
+
+		if lo < f.min || hi > f.max || lo > hi {
+			return 0, ErrInvalidArgument
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+// next returns the first time of the rule after t, in the location of t.
+// Times which do not exist in the location, as when clocks go forward, are
+// skipped, and times which occur twice, as when clocks go back, are only
+// returned the first time.
+func (r rule) next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxRuleYears, 0, 0)
+
+	for t.Before(limit) {
+		if !has(r.months, int(t.Month())) {
+			t = forward(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !r.matchDay(t) {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !has(r.hours, t.Hour()) {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc))
+			continue
+		}
+		if !has(r.minutes, t.Minute()) || !firstOccurrence(t).Equal(t) {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// forward returns next, unless it is not after t. That happens when next
+// does not exist in its location, as time.Date then may move it back; the
+// start of the hour after t is returned instead. Of a time which occurs
+// twice, time.Date may return either, so the first is taken if it is after
+// t.
+func forward(t, next time.Time) time.Time {
+	if first := firstOccurrence(next); first.After(t) {
+		return first
+	}
+	if next.After(t) {
+		return next
+	}
+	return t.Truncate(time.Hour).Add(time.Hour)
+}
+
+// firstOccurrence returns the first time the wall clock showed the time
+// of t, which is earlier than t if clocks have been set back since.
+func firstOccurrence(t time.Time) time.Time {
+	_, offset := t.Zone()
+	_, before := t.Add(-maxClockShift).Zone()
+	if before <= offset {
+		return t
+	}
+
+	u := t.Add(-time.Duration(before-offset) * time.Second)
+	if u.Day() == t.Day() && u.Hour() == t.Hour() && u.Minute() == t.Minute() {
+		return u
+	}
+	return t
+}
+
+func (r rule) matchDay(t time.Time) bool {
+	day := has(r.days, t.Day())
+	weekday := has(r.weekdays, int(t.Weekday()))
+
+	switch {
+	case r.anyDay && r.anyWeekday:
+		return true
+	case r.anyDay:
+		return weekday
+	case r.anyWeekday:
+		return day
+	}
+	return day || weekday
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<uint(v)) != 0
+}
@@ -0,0 +1,154 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		rule string
+		want rule
+		err  error
+	}{
+		{"* * * * *", rule{
+			minutes:    1<<60 - 1,
+			hours:      1<<24 - 1,
+			days:       1<<32 - 2,
+			months:     1<<13 - 2,
+			weekdays:   1<<8 - 1,
+			anyDay:     true,
+			anyWeekday: true,
+		}, nil},
+		{"30 8 * * 1-5", rule{
+			minutes:    1 << 30,
+			hours:      1 << 8,
+			days:       1<<32 - 2,
+			months:     1<<13 - 2,
+			weekdays:   1<<6 - 2,
+			anyDay:     true,
+			anyWeekday: false,
+		}, nil},
+		{"0,30 */6 1,15 1-12/3 *", rule{
+			minutes:    1 | 1<<30,
+			hours:      1 | 1<<6 | 1<<12 | 1<<18,
+			days:       1<<1 | 1<<15,
+			months:     1<<1 | 1<<4 | 1<<7 | 1<<10,
+			weekdays:   1<<8 - 1,
+			anyWeekday: true,
+		}, nil},
+		{"5/20 0 * * 7", rule{
+			minutes:  1<<5 | 1<<25 | 1<<45,
+			hours:    1,
+			days:     1<<32 - 2,
+			months:   1<<13 - 2,
+			weekdays: 1 | 1<<7,
+			anyDay:   true,
+		}, nil},
+		{"", rule{}, ErrInvalidArgument},
+		{"* * * *", rule{}, ErrInvalidArgument},
+		{"* * * * * *", rule{}, ErrInvalidArgument},
+		{"60 * * * *", rule{}, ErrInvalidArgument},
+		{"* 24 * * *", rule{}, ErrInvalidArgument},
+		{"* * 0 * *", rule{}, ErrInvalidArgument},
+		{"* * * 13 *", rule{}, ErrInvalidArgument},
+		{"* * * * 8", rule{}, ErrInvalidArgument},
+		{"5-1 * * * *", rule{}, ErrInvalidArgument},
+		{"*/0 * * * *", rule{}, ErrInvalidArgument},
+		{"a * * * *", rule{}, ErrInvalidArgument},
+		{"1,,2 * * * *", rule{}, ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			got, err := parseRule(tt.rule)
+			if err != tt.err {
+				t.Fatalf("parseRule(%q) error = %v, want %v", tt.rule, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("parseRule(%q) = %+v, want %+v", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleNext(t *testing.T) {
+	berlin := loadLocation(t, "Europe/Berlin")
+	kolkata := loadLocation(t, "Asia/Kolkata")
+	utc := time.UTC
+
+	tests := []struct {
+		name string
+		rule string
+		from time.Time
+		want time.Time
+	}{
+		{"weekdays", "30 8 * * 1-5",
+			time.Date(2026, 10, 16, 9, 0, 0, 0, utc),
+			time.Date(2026, 10, 19, 8, 30, 0, 0, utc)},
+		{"step", "*/15 * * * *",
+			time.Date(2026, 10, 16, 10, 7, 30, 0, utc),
+			time.Date(2026, 10, 16, 10, 15, 0, 0, utc)},
+		{"strictly after", "0 12 * * *",
+			time.Date(2026, 10, 16, 12, 0, 0, 0, utc),
+			time.Date(2026, 10, 17, 12, 0, 0, 0, utc)},
+		{"day of month or of week", "0 0 1,15 * 1",
+			time.Date(2026, 10, 16, 0, 0, 0, 0, utc),
+			time.Date(2026, 10, 19, 0, 0, 0, 0, utc)},
+		{"leap day", "0 0 29 2 *",
+			time.Date(2026, 3, 1, 0, 0, 0, 0, utc),
+			time.Date(2028, 2, 29, 0, 0, 0, 0, utc)},
+		{"half hour offset", "30 * * * *",
+			time.Date(2026, 10, 16, 9, 40, 0, 0, kolkata),
+			time.Date(2026, 10, 16, 10, 30, 0, 0, kolkata)},
+		{"skipped when clocks go forward", "30 2 * * *",
+			time.Date(2026, 3, 29, 0, 0, 0, 0, berlin),
+			time.Date(2026, 3, 30, 2, 30, 0, 0, berlin)},
+		{"hour after clocks go forward", "0 * * * *",
+			time.Date(2026, 3, 29, 1, 30, 0, 0, berlin),
+			time.Date(2026, 3, 29, 1, 0, 0, 0, utc)},
+		{"first when clocks go back", "30 2 * * *",
+			time.Date(2026, 10, 25, 0, 0, 0, 0, berlin),
+			time.Date(2026, 10, 25, 0, 30, 0, 0, utc)},
+		{"once when clocks go back", "30 2 * * *",
+			time.Date(2026, 10, 25, 0, 30, 0, 0, utc).In(berlin),
+			time.Date(2026, 10, 26, 2, 30, 0, 0, berlin)},
+		{"repeated hour skipped", "0 * * * *",
+			time.Date(2026, 10, 25, 0, 0, 0, 0, utc).In(berlin),
+			time.Date(2026, 10, 25, 2, 0, 0, 0, utc)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseRule(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := r.next(tt.from)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("next(%v) of %q = %v, %v, want %v", tt.from, tt.rule, got, ok, tt.want)
+			}
+			if ok && got.Location() != tt.from.Location() {
+				t.Errorf("next(%v) of %q is in %v, want %v", tt.from, tt.rule, got.Location(), tt.from.Location())
+			}
+		})
+	}
+}
+
+func TestRuleNextNever(t *testing.T) {
+	r, err := parseRule("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := r.next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("next of %q = %v, want none", "0 0 31 2 *", got)
+	}
+}
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s: %v", name, err)
+	}
+	return loc
+}
@@ -0,0 +1,311 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Scheduler tuning. A failed notification is retried on every poll and
+// given up after maxAttempts.
+const (
+	pollInterval = 30 * time.Second
+	batchSize    = 50
+	maxAttempts  = 5
+)
+
+// Scheduler fires due reminders in the background. Firing a reminder
+// records a firing keyed by the reminder and the time it was due at, and
+// moves the reminder on to its next time, in a single mutation. The key
+// is unique, so a reminder fires once for every time it is due, even with
+// a restart in between or more than one scheduler running. The firing is
+// then sent through the notifier of the reminder; firings still pending
+// are resumed after a restart, so notification is at-least-once.
+//
+// A reminder which was due while the scheduler was down fires once, and
+// then goes on from the present. Reminders of books or chapters in the
+// trash do not fire until they are restored.
+type Scheduler struct {
+	notifiers map[string]Notifier
+	logger    log.Logger
+}
+
+// NewScheduler returns a new instance of a Scheduler sending notifications
+// through notifiers, by notifier name.
+func NewScheduler(logger log.Logger, notifiers map[string]Notifier) *Scheduler {
+	return &Scheduler{
+		notifiers: notifiers,
+		logger:    logger,
+	}
+}
+
+// Run fires reminders until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.fireDue(ctx)
+		s.notifyPending(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) fireDue(ctx context.Context) {
+	now := time.Now()
+	orderBy := prisma.ReminderOrderByInputNextFireAtAsc
+
+	// Reminders of books and chapters in the trash are left out, or they
+	// would stay due, and at the head of every batch, until restored. NOT
+	// rather than a filter on the chapter keeps the reminders without one.
+	due, err := client.Reminders(&prisma.RemindersParams{
+		Where: &prisma.ReminderWhereInput{
+			Active:        prisma.Bool(true),
+			NextFireAtLte: prisma.Str(formatTime(now)),
+			Book: &prisma.BookWhereInput{
+				Deleted: prisma.Bool(false),
+			},
+			Not: []prisma.ReminderWhereInput{{
+				Chapter: &prisma.ChapterWhereInput{
+					Deleted: prisma.Bool(true),
+				},
+			}},
+		},
+		OrderBy: &orderBy,
+		First:   prisma.Int32(batchSize),
+	}).Exec(ctx)
+
+	if err != nil {
+		s.logger.Log("err", err)
+		return
+	}
+
+	for _, r := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.fire(ctx, r, now); err != nil {
+			s.logger.Log("reminder", r.ID, "err", err)
+		}
+	}
+}
+
+// fire records a firing of r for the time it is due at and moves it on to
+// its next time.
+func (s *Scheduler) fire(ctx context.Context, r prisma.Reminder, now time.Time) error {
+	id := r.ID
+
+	chapter, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+		ID: &id,
+	}).Chapter().Exec(ctx)
+
+	switch {
+	case err == nil && chapter.Deleted:
+		return nil
+	case err != nil && err != prisma.ErrNoResult:
+		return err
+	}
+
+	data := prisma.ReminderUpdateInput{
+		LastFiredAt: prisma.Str(formatTime(now)),
+		Firings: &prisma.ReminderFiringUpdateManyWithoutReminderInput{
+			Create: []prisma.ReminderFiringCreateWithoutReminderInput{{
+				Key:         firingKey(id, r.NextFireAt),
+				ScheduledAt: r.NextFireAt,
+			}},
+		},
+	}
+
+	next, ok, err := nextFireAt(r, now)
+	if err != nil {
+		return err
+	}
+	if ok {
+		data.NextFireAt = prisma.Str(formatTime(next))
+	} else {
+		data.Active = prisma.Bool(false)
+	}
+
+	_, err = client.UpdateReminder(prisma.ReminderUpdateParams{
+		Where: prisma.ReminderWhereUniqueInput{
+			ID: &id,
+		},
+		Data: data,
+	}).Exec(ctx)
+
+	if err != nil {
+		// Another scheduler may have fired it first.
+		key := firingKey(id, r.NextFireAt)
+		if _, ferr := client.ReminderFiring(prisma.ReminderFiringWhereUniqueInput{Key: &key}).Exec(ctx); ferr == nil {
+			return nil
+		}
+		return err
+	}
+
+	s.logger.Log("reminder", id, "scheduled_at", r.NextFireAt, "next", data.NextFireAt)
+	return nil
+}
+
+func (s *Scheduler) notifyPending(ctx context.Context) {
+	status := prisma.FiringStatusPending
+	orderBy := prisma.ReminderFiringOrderByInputScheduledAtAsc
+
+	pending, err := client.ReminderFirings(&prisma.ReminderFiringsParams{
+		Where: &prisma.ReminderFiringWhereInput{
+			Status: &status,
+		},
+		OrderBy: &orderBy,
+		First:   prisma.Int32(batchSize),
+	}).Exec(ctx)
+
+	if err != nil {
+		s.logger.Log("err", err)
+		return
+	}
+
+	for _, f := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+		s.notify(ctx, f)
+	}
+}
+
+func (s *Scheduler) notify(ctx context.Context, f prisma.ReminderFiring) {
+	id := f.ID
+	attempts := f.Attempts + 1
+	data := prisma.ReminderFiringUpdateInput{
+		Attempts: &attempts,
+	}
+
+	n, notifier, err := s.notification(ctx, f)
+	if err == nil {
+		err = notifier.Notify(ctx, n)
+	}
+
+	switch {
+	case err == nil:
+		status := prisma.FiringStatusSent
+		data.Status = &status
+	case attempts >= maxAttempts:
+		status := prisma.FiringStatusFailed
+		data.Status = &status
+		data.LastError = prisma.Str(err.Error())
+	default:
+		data.LastError = prisma.Str(err.Error())
+	}
+
+	s.logger.Log(
+		"firing", id,
+		"reminder", n.ReminderID,
+		"attempt", attempts,
+		"err", err,
+	)
+
+	_, err = client.UpdateReminderFiring(prisma.ReminderFiringUpdateParams{
+		Where: prisma.ReminderFiringWhereUniqueInput{ID: &id},
+		Data:  data,
+	}).Exec(ctx)
+
+	if err != nil {
+		s.logger.Log("firing", id, "err", err)
+	}
+}
+
+// notification returns the notification of firing f and the notifier to
+// send it through.
+func (s *Scheduler) notification(ctx context.Context, f prisma.ReminderFiring) (Notification, Notifier, error) {
+	id := f.ID
+	r, err := client.ReminderFiring(prisma.ReminderFiringWhereUniqueInput{
+		ID: &id,
+	}).Reminder().Exec(ctx)
+
+	if err != nil {
+		return Notification{}, nil, err
+	}
+
+	n := Notification{
+		ID:          f.ID,
+		ReminderID:  r.ID,
+		User:        r.User,
+		Message:     r.Message,
+		ScheduledAt: f.ScheduledAt,
+		Target:      r.Target,
+	}
+
+	notifier, ok := s.notifiers[r.Notifier]
+	if !ok {
+		return n, nil, fmt.Errorf("notifier %q not configured", r.Notifier)
+	}
+
+	book, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+		ID: &r.ID,
+	}).Book().Exec(ctx)
+
+	if err != nil {
+		return n, nil, err
+	}
+	n.BookID, n.BookName = book.ID, book.Name
+
+	chapter, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+		ID: &r.ID,
+	}).Chapter().Exec(ctx)
+
+	switch err {
+	case nil:
+		n.ChapterID, n.ChapterName = chapter.ID, chapter.Name
+	case prisma.ErrNoResult:
+	default:
+		return n, nil, err
+	}
+
+	if n.Message == "" {
+		n.Message = defaultMessage(book.Name, n.ChapterName)
+	}
+
+	return n, notifier, nil
+}
+
+// defaultMessage returns the message of a reminder without one, naming the
+// book or chapter to read.
+func defaultMessage(book, chapter string) string {
+	if chapter == "" {
+		return "Time to read " + book
+	}
+	return fmt.Sprintf("Time to read %s of %s", chapter, book)
+}
+
+// nextFireAt returns the time r fires at after firing at now, if any. The
+// times missed are skipped.
+func nextFireAt(r prisma.Reminder, now time.Time) (time.Time, bool, error) {
+	if r.Rule == "" {
+		return time.Time{}, false, nil
+	}
+
+	rule, err := parseRule(r.Rule)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	next, ok := rule.next(now.In(loc))
+	return next, ok, nil
+}
+
+// firingKey returns the key of the firing of the reminder with the given
+// id for the time it was due at.
+func firingKey(id string, at string) string {
+	return id + "@" + at
+}
@@ -0,0 +1,394 @@
+// Package reminder provides reminders to read a book or a chapter, and the
+// scheduler which fires them through pluggable notifiers.
+package reminder
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/maxp36/rembook/handling"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// ErrInvalidArgument is returned when one or more arguments are invalid.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrNotFound is returned when a reminder does not exist or belongs to
+// another user.
+var ErrNotFound = errors.New("not found")
+
+// Notifiers a reminder can be sent through. The target of a reminder is
+// the URL posted to by the webhook notifier and the address mailed to by
+// the SMTP notifier. The log notifier takes no target.
+const (
+	NotifierLog     = "log"
+	NotifierWebhook = "webhook"
+	NotifierSMTP    = "smtp"
+)
+
+// Spec describes a new reminder. A reminder fires once at At, or whenever
+// Rule matches. At is either RFC 3339 or a local time like
+// 2006-01-02T15:04, and both it and Rule are in the time zone Timezone,
+// UTC by default. Without a message, the reminder names the book or
+// chapter to read.
+type Spec struct {
+	BookID    string `json:"bookId"`
+	ChapterID string `json:"chapterId"`
+	Message   string `json:"message"`
+	At        string `json:"at"`
+	Rule      string `json:"rule"`
+	Timezone  string `json:"timezone"`
+	Notifier  string `json:"notifier"`
+	Target    string `json:"target"`
+}
+
+// Reminder is a reminder of a user. NextFireAt is unset once a reminder has
+// fired for the last time.
+type Reminder struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	BookID      string  `json:"bookId"`
+	ChapterID   string  `json:"chapterId,omitempty"`
+	Message     string  `json:"message,omitempty"`
+	Rule        string  `json:"rule,omitempty"`
+	Timezone    string  `json:"timezone"`
+	Notifier    string  `json:"notifier"`
+	Target      string  `json:"target,omitempty"`
+	Active      bool    `json:"active"`
+	NextFireAt  string  `json:"nextFireAt,omitempty"`
+	LastFiredAt *string `json:"lastFiredAt,omitempty"`
+}
+
+// Service is the interface that provides reminder methods. Reminders
+// belong to the user who created them.
+type Service interface {
+	AddReminder(ctx context.Context, spec Spec) (Reminder, error)
+	GetReminder(ctx context.Context, id string) (Reminder, error)
+	DeleteReminder(ctx context.Context, id string) (Reminder, error)
+	Reminders(ctx context.Context) ([]Reminder, error)
+	Firings(ctx context.Context, id string) ([]prisma.ReminderFiring, error)
+	IssueFeed(ctx context.Context) (Feed, error)
+	RevokeFeed(ctx context.Context) error
+	Calendar(ctx context.Context, token, timezone string) ([]Event, error)
+}
+
+type service struct{}
+
+// NewService returns a new instance of a reminder Service.
+func NewService() Service {
+	return &service{}
+}
+
+var client = prisma.New(nil)
+
+func (s *service) AddReminder(ctx context.Context, spec Spec) (Reminder, error) {
+	spec, loc, err := normalizeSpec(spec)
+	if err != nil {
+		return Reminder{}, err
+	}
+
+	next, err := firstFireAt(spec, loc, time.Now())
+	if err != nil {
+		return Reminder{}, err
+	}
+
+	books, err := client.Books(&prisma.BooksParams{
+		Where: &prisma.BookWhereInput{
+			ID:      &spec.BookID,
+			Deleted: prisma.Bool(false),
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return Reminder{}, err
+	}
+	if len(books) == 0 {
+		return Reminder{}, ErrNotFound
+	}
+
+	var chapter *prisma.ChapterCreateOneWithoutRemindersInput
+	if spec.ChapterID != "" {
+		chapters, err := client.Chapters(&prisma.ChaptersParams{
+			Where: &prisma.ChapterWhereInput{
+				ID:      &spec.ChapterID,
+				Deleted: prisma.Bool(false),
+				Book: &prisma.BookWhereInput{
+					ID: &spec.BookID,
+				},
+			},
+		}).Exec(ctx)
+
+		if err != nil {
+			return Reminder{}, err
+		}
+		if len(chapters) == 0 {
+			return Reminder{}, ErrNotFound
+		}
+
+		chapter = &prisma.ChapterCreateOneWithoutRemindersInput{
+			Connect: &prisma.ChapterWhereUniqueInput{
+				ID: &spec.ChapterID,
+			},
+		}
+	}
+
+	r, err := client.CreateReminder(prisma.ReminderCreateInput{
+		User:       handling.Actor(ctx),
+		Message:    spec.Message,
+		Rule:       spec.Rule,
+		Timezone:   spec.Timezone,
+		Notifier:   spec.Notifier,
+		Target:     spec.Target,
+		NextFireAt: formatTime(next),
+		Book: prisma.BookCreateOneWithoutRemindersInput{
+			Connect: &prisma.BookWhereUniqueInput{
+				ID: &spec.BookID,
+			},
+		},
+		Chapter: chapter,
+	}).Exec(ctx)
+
+	if err != nil {
+		return Reminder{}, err
+	}
+
+	return toReminder(*r, spec.BookID, spec.ChapterID), nil
+}
+
+func (s *service) GetReminder(ctx context.Context, id string) (Reminder, error) {
+	if id == "" {
+		return Reminder{}, ErrInvalidArgument
+	}
+
+	r, err := ownReminder(ctx, id)
+	if err != nil {
+		return Reminder{}, err
+	}
+
+	return withParents(ctx, *r)
+}
+
+func (s *service) DeleteReminder(ctx context.Context, id string) (Reminder, error) {
+	if id == "" {
+		return Reminder{}, ErrInvalidArgument
+	}
+
+	r, err := ownReminder(ctx, id)
+	if err != nil {
+		return Reminder{}, err
+	}
+
+	reminder, err := withParents(ctx, *r)
+	if err != nil {
+		return Reminder{}, err
+	}
+
+	if _, err := client.DeleteReminder(prisma.ReminderWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx); err != nil {
+		return Reminder{}, err
+	}
+
+	return reminder, nil
+}
+
+func (s *service) Reminders(ctx context.Context) ([]Reminder, error) {
+	orderBy := prisma.ReminderOrderByInputCreatedAtAsc
+	reminders, err := client.Reminders(&prisma.RemindersParams{
+		Where: &prisma.ReminderWhereInput{
+			User: prisma.Str(handling.Actor(ctx)),
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Reminder, 0, len(reminders))
+	for _, r := range reminders {
+		reminder, err := withParents(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, reminder)
+	}
+	return result, nil
+}
+
+func (s *service) Firings(ctx context.Context, id string) ([]prisma.ReminderFiring, error) {
+	if id == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := ownReminder(ctx, id); err != nil {
+		return nil, err
+	}
+
+	orderBy := prisma.ReminderFiringOrderByInputScheduledAtDesc
+	firings, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+		ID: &id,
+	}).Firings(&prisma.FiringsParamsExec{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return firings, nil
+}
+
+// ownReminder returns the reminder with the given id if it belongs to the
+// calling user.
+func ownReminder(ctx context.Context, id string) (*prisma.Reminder, error) {
+	r, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	switch {
+	case err == prisma.ErrNoResult:
+		return nil, ErrNotFound
+	case err != nil:
+		return nil, err
+	case r.User != handling.Actor(ctx):
+		return nil, ErrNotFound
+	}
+	return r, nil
+}
+
+// withParents returns r with the ids of its book and chapter.
+func withParents(ctx context.Context, r prisma.Reminder) (Reminder, error) {
+	id := r.ID
+	book, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+		ID: &id,
+	}).Book().Exec(ctx)
+
+	if err != nil {
+		return Reminder{}, err
+	}
+
+	var chapterID string
+	chapter, err := client.Reminder(prisma.ReminderWhereUniqueInput{
+		ID: &id,
+	}).Chapter().Exec(ctx)
+
+	switch err {
+	case nil:
+		chapterID = chapter.ID
+	case prisma.ErrNoResult:
+	default:
+		return Reminder{}, err
+	}
+
+	return toReminder(r, book.ID, chapterID), nil
+}
+
+func toReminder(r prisma.Reminder, bookID, chapterID string) Reminder {
+	reminder := Reminder{
+		ID:          r.ID,
+		CreatedAt:   r.CreatedAt,
+		BookID:      bookID,
+		ChapterID:   chapterID,
+		Message:     r.Message,
+		Rule:        r.Rule,
+		Timezone:    r.Timezone,
+		Notifier:    r.Notifier,
+		Target:      r.Target,
+		Active:      r.Active,
+		LastFiredAt: r.LastFiredAt,
+	}
+	if r.Active {
+		reminder.NextFireAt = r.NextFireAt
+	}
+	return reminder
+}
+
+// normalizeSpec validates spec and returns it as it is stored, along with
+// its location.
+func normalizeSpec(spec Spec) (Spec, *time.Location, error) {
+	spec.Message = strings.TrimSpace(spec.Message)
+	spec.At = strings.TrimSpace(spec.At)
+	spec.Rule = strings.Join(strings.Fields(spec.Rule), " ")
+	spec.Timezone = strings.TrimSpace(spec.Timezone)
+	spec.Notifier = strings.ToLower(strings.TrimSpace(spec.Notifier))
+	spec.Target = strings.TrimSpace(spec.Target)
+
+	if spec.BookID == "" || (spec.At == "") == (spec.Rule == "") {
+		return Spec{}, nil, ErrInvalidArgument
+	}
+
+	if spec.Timezone == "" {
+		spec.Timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(spec.Timezone)
+	if err != nil {
+		return Spec{}, nil, ErrInvalidArgument
+	}
+
+	if spec.Notifier == "" {
+		spec.Notifier = NotifierLog
+	}
+	target, ok := normalizeTarget(spec.Notifier, spec.Target)
+	if !ok {
+		return Spec{}, nil, ErrInvalidArgument
+	}
+	spec.Target = target
+
+	return spec, loc, nil
+}
+
+// firstFireAt returns the time a reminder first fires at, which has to be
+// after now.
+func firstFireAt(spec Spec, loc *time.Location, now time.Time) (time.Time, error) {
+	if spec.Rule != "" {
+		r, err := parseRule(spec.Rule)
+		if err != nil {
+			return time.Time{}, err
+		}
+		next, ok := r.next(now.In(loc))
+		if !ok {
+			return time.Time{}, ErrInvalidArgument
+		}
+		return next, nil
+	}
+
+	at, err := time.Parse(time.RFC3339, spec.At)
+	if err != nil {
+		if at, err = time.ParseInLocation("2006-01-02T15:04", spec.At, loc); err != nil {
+			return time.Time{}, ErrInvalidArgument
+		}
+	}
+	if !at.After(now) {
+		return time.Time{}, ErrInvalidArgument
+	}
+	return at, nil
+}
+
+// normalizeTarget returns target as it is stored for notifier, and whether
+// it is valid. Mail addresses are stored bare, without a display name, as
+// they are used in the SMTP envelope.
+func normalizeTarget(notifier, target string) (string, bool) {
+	switch notifier {
+	case NotifierLog:
+		return target, target == ""
+	case NotifierWebhook:
+		u, err := url.Parse(target)
+		return target, err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	case NotifierSMTP:
+		addr, err := mail.ParseAddress(target)
+		if err != nil {
+			return "", false
+		}
+		return addr.Address, true
+	}
+	return "", false
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,198 @@
+package reminder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	kitlog "github.com/go-kit/kit/log"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maxp36/rembook/handling"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// MakeHandler returns a handler for the reminder service.
+func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerBefore(populateActor),
+		kithttp.ServerErrorLogger(logger),
+		kithttp.ServerErrorEncoder(encodeError),
+	}
+
+	addReminderHandler := kithttp.NewServer(
+		makeAddReminderEndpoint(s),
+		decodeAddReminderRequest,
+		encodeResponse,
+		opts...,
+	)
+	getReminderHandler := kithttp.NewServer(
+		makeGetReminderEndpoint(s),
+		decodeGetReminderRequest,
+		encodeResponse,
+		opts...,
+	)
+	deleteReminderHandler := kithttp.NewServer(
+		makeDeleteReminderEndpoint(s),
+		decodeDeleteReminderRequest,
+		encodeResponse,
+		opts...,
+	)
+	listRemindersHandler := kithttp.NewServer(
+		makeListRemindersEndpoint(s),
+		decodeListRemindersRequest,
+		encodeResponse,
+		opts...,
+	)
+	listFiringsHandler := kithttp.NewServer(
+		makeListFiringsEndpoint(s),
+		decodeListFiringsRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	issueFeedHandler := kithttp.NewServer(
+		makeIssueFeedEndpoint(s),
+		decodeIssueFeedRequest,
+		encodeResponse,
+		opts...,
+	)
+	revokeFeedHandler := kithttp.NewServer(
+		makeRevokeFeedEndpoint(s),
+		decodeRevokeFeedRequest,
+		encodeResponse,
+		opts...,
+	)
+	calendarHandler := kithttp.NewServer(
+		makeCalendarEndpoint(s),
+		decodeCalendarRequest,
+		encodeCalendarResponse,
+		opts...,
+	)
+
+	r := mux.NewRouter()
+
+	v1 := r.PathPrefix("/reminder/v1").Subrouter()
+	{
+		v1.Handle("/reminders", addReminderHandler).Methods("POST")
+		v1.Handle("/reminders", listRemindersHandler).Methods("GET")
+		v1.Handle("/reminders/{id}", getReminderHandler).Methods("GET")
+		v1.Handle("/reminders/{id}", deleteReminderHandler).Methods("DELETE")
+		v1.Handle("/reminders/{id}/firings", listFiringsHandler).Methods("GET")
+		v1.Handle("/calendar/feed", issueFeedHandler).Methods("POST")
+		v1.Handle("/calendar/feed", revokeFeedHandler).Methods("DELETE")
+		v1.Handle("/calendar/{token}.ics", calendarHandler).Methods("GET")
+	}
+
+	return r
+}
+
+var errBadRoute = errors.New("bad route")
+
+// populateActor moves the user identified by the request into the
+// context, as reminders belong to the user who created them.
+func populateActor(ctx context.Context, r *http.Request) context.Context {
+	return handling.WithActor(ctx, r.Header.Get(handling.HeaderActor))
+}
+
+func decodeAddReminderRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var spec Spec
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+		return nil, err
+	}
+	return addReminderRequest{Spec: spec}, nil
+}
+
+func decodeGetReminderRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return getReminderRequest{ID: id}, nil
+}
+
+func decodeDeleteReminderRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return deleteReminderRequest{ID: id}, nil
+}
+
+func decodeListRemindersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listRemindersRequest{}, nil
+}
+
+func decodeListFiringsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return listFiringsRequest{ReminderID: id}, nil
+}
+
+func decodeIssueFeedRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return issueFeedRequest{}, nil
+}
+
+func decodeRevokeFeedRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return revokeFeedRequest{}, nil
+}
+
+// decodeCalendarRequest decodes a request for a calendar feed, which is
+// authorized by its token alone, as calendars cannot send headers.
+func decodeCalendarRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	token, ok := vars["token"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return calendarRequest{
+		Token:    token,
+		Timezone: r.URL.Query().Get("tz"),
+	}, nil
+}
+
+func encodeCalendarResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(calendarResponse)
+	if resp.Err != nil {
+		encodeError(ctx, resp.Err, w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	return writeCalendar(w, resp.Events)
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encodeError encodes errors from business-logic.
+func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch err {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case ErrNotFound, prisma.ErrNoResult:
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,132 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// token is a word of a text, at the byte offsets start to end of it, with
+// the term it is indexed by.
+type token struct {
+	term       string
+	start, end int
+}
+
+// tokenize splits text into words, which are runs of letters and digits,
+// and turns each into a term: lower-cased and stemmed as English or Russian
+// by its script. Stop words yield no term and are left out.
+func tokenize(text string) []token {
+	var tokens []token
+
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = appendToken(tokens, text, start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = appendToken(tokens, text, start, len(text))
+	}
+	return tokens
+}
+
+func appendToken(tokens []token, text string, start, end int) []token {
+	if term := analyze(text[start:end]); term != "" {
+		tokens = append(tokens, token{term: term, start: start, end: end})
+	}
+	return tokens
+}
+
+// terms returns the distinct terms of text, in order.
+func terms(text string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, t := range tokenize(text) {
+		if !seen[t.term] {
+			seen[t.term] = true
+			result = append(result, t.term)
+		}
+	}
+	return result
+}
+
+// maxWordLength bounds the words which are stemmed; longer ones are kept
+// as they are.
+const maxWordLength = 64
+
+func analyze(word string) string {
+	word = strings.Replace(strings.ToLower(word), "ё", "е", -1)
+	if _, ok := stopWords[word]; ok {
+		return ""
+	}
+	if utf8.RuneCountInString(word) > maxWordLength {
+		return word
+	}
+
+	switch script(word) {
+	case scriptLatin:
+		return stemEnglish(word)
+	case scriptCyrillic:
+		return stemRussian(word)
+	}
+	return word
+}
+
+const (
+	scriptOther = iota
+	scriptLatin
+	scriptCyrillic
+)
+
+// script returns the script of word if it is written in Latin or Cyrillic
+// letters only.
+func script(word string) int {
+	s := scriptOther
+	for _, r := range word {
+		var rs int
+		switch {
+		case r >= 'a' && r <= 'z':
+			rs = scriptLatin
+		case r >= 'а' && r <= 'я':
+			rs = scriptCyrillic
+		default:
+			return scriptOther
+		}
+		if s != scriptOther && s != rs {
+			return scriptOther
+		}
+		s = rs
+	}
+	return s
+}
+
+var stopWords = makeSet(
+	// English.
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if",
+	"in", "into", "is", "it", "no", "not", "of", "on", "or", "such", "that",
+	"the", "their", "then", "there", "these", "they", "this", "to", "was",
+	"will", "with",
+
+	// Russian.
+	"и", "в", "во", "не", "что", "он", "на", "я", "с", "со", "как", "а",
+	"то", "все", "она", "так", "его", "но", "да", "ты", "к", "у", "же", "вы",
+	"за", "бы", "по", "только", "ее", "мне", "было", "вот", "от", "меня",
+	"еще", "нет", "о", "из", "ему", "ли", "если", "или", "ни", "быть", "был",
+	"до", "вас", "уже", "для", "мы", "их", "при", "это", "этот", "эти",
+)
+
+func makeSet(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		set[w] = struct{}{}
+	}
+	return set
+}
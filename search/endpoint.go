@@ -0,0 +1,60 @@
+package search
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+)
+
+type searchRequest struct {
+	Query Query
+}
+
+type searchResponse struct {
+	Results
+	Err error `json:"err,omitempty"`
+}
+
+func (r searchResponse) error() error { return r.Err }
+
+func makeSearchEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(searchRequest)
+		results, err := s.Search(ctx, req.Query)
+		return searchResponse{Results: results, Err: err}, nil
+	}
+}
+
+type relatedBooksRequest struct {
+	ID    string
+	Limit int32
+}
+
+type relatedResponse struct {
+	Related []Related `json:"related"`
+	Err     error     `json:"err,omitempty"`
+}
+
+func (r relatedResponse) error() error { return r.Err }
+
+func makeRelatedBooksEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(relatedBooksRequest)
+		related, err := s.RelatedBooks(ctx, req.ID, req.Limit)
+		return relatedResponse{Related: related, Err: err}, nil
+	}
+}
+
+type relatedChaptersRequest struct {
+	BookID string
+	ID     string
+	Limit  int32
+}
+
+func makeRelatedChaptersEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(relatedChaptersRequest)
+		related, err := s.RelatedChapters(ctx, req.BookID, req.ID, req.Limit)
+		return relatedResponse{Related: related, Err: err}, nil
+	}
+}
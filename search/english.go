@@ -0,0 +1,343 @@
+package search
+
+import "strings"
+
+// stemEnglish returns the stem of an English word by the Porter2 (Snowball
+// English) algorithm. The word is expected in lower case.
+func stemEnglish(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	if stem, ok := englishExceptions[word]; ok {
+		return stem
+	}
+
+	w := []byte(strings.TrimPrefix(word, "'"))
+
+	// Mark consonant ys, which are then no vowels.
+	for i := range w {
+		if w[i] == 'y' && (i == 0 || isEnglishVowel(w[i-1])) {
+			w[i] = 'Y'
+		}
+	}
+
+	r1, r2 := englishRegions(w)
+	e := &englishWord{w: w, r1: r1, r2: r2}
+
+	e.step0()
+	e.step1a()
+	if _, ok := englishInvariants[string(e.w)]; ok {
+		return string(e.w)
+	}
+	e.step1b()
+	e.step1c()
+	e.step2()
+	e.step3()
+	e.step4()
+	e.step5()
+
+	return strings.Replace(string(e.w), "Y", "y", -1)
+}
+
+var englishExceptions = map[string]string{
+	"skis":   "ski",
+	"skies":  "sky",
+	"dying":  "die",
+	"lying":  "lie",
+	"tying":  "tie",
+	"idly":   "idl",
+	"gently": "gentl",
+	"ugly":   "ugli",
+	"early":  "earli",
+	"only":   "onli",
+	"singly": "singl",
+	"sky":    "sky",
+	"news":   "news",
+	"howe":   "howe",
+	"atlas":  "atlas",
+	"cosmos": "cosmos",
+	"bias":   "bias",
+	"andes":  "andes",
+}
+
+// englishInvariants are left as they are after step 1a.
+var englishInvariants = map[string]struct{}{
+	"inning":  {},
+	"outing":  {},
+	"canning": {},
+	"herring": {},
+	"earring": {},
+	"proceed": {},
+	"exceed":  {},
+	"succeed": {},
+}
+
+type englishWord struct {
+	w      []byte
+	r1, r2 int
+}
+
+func isEnglishVowel(c byte) bool {
+	switch c {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+	return false
+}
+
+// englishRegions returns the starts of the regions R1 and R2 of w.
+func englishRegions(w []byte) (int, int) {
+	r1 := regionAfter(w, 0)
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(string(w), prefix) {
+			r1 = len(prefix)
+			break
+		}
+	}
+	return r1, regionAfter(w, r1)
+}
+
+// regionAfter returns the position after the first non-vowel following a
+// vowel in w from start.
+func regionAfter(w []byte, start int) int {
+	for i := start + 1; i < len(w); i++ {
+		if !isEnglishVowel(w[i]) && isEnglishVowel(w[i-1]) {
+			return i + 1
+		}
+	}
+	return len(w)
+}
+
+func (e *englishWord) hasSuffix(s string) bool {
+	return strings.HasSuffix(string(e.w), s)
+}
+
+// longest returns the longest of suffixes which w ends with.
+func (e *englishWord) longest(suffixes ...string) string {
+	found := ""
+	for _, s := range suffixes {
+		if len(s) > len(found) && e.hasSuffix(s) {
+			found = s
+		}
+	}
+	return found
+}
+
+func (e *englishWord) in(region int, suffix string) bool {
+	return len(e.w)-len(suffix) >= region
+}
+
+func (e *englishWord) replace(suffix, with string) {
+	e.w = append(e.w[:len(e.w)-len(suffix)], with...)
+}
+
+// hasVowel reports whether w has a vowel before the position end.
+func (e *englishWord) hasVowel(end int) bool {
+	for i := 0; i < end; i++ {
+		if isEnglishVowel(e.w[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// shortSyllable reports whether w ends in a short syllable.
+func (e *englishWord) shortSyllable() bool {
+	n := len(e.w)
+	switch {
+	case n == 2:
+		return isEnglishVowel(e.w[0]) && !isEnglishVowel(e.w[1])
+	case n >= 3:
+		c := e.w[n-1]
+		return !isEnglishVowel(e.w[n-3]) && isEnglishVowel(e.w[n-2]) &&
+			!isEnglishVowel(c) && c != 'w' && c != 'x' && c != 'Y'
+	}
+	return false
+}
+
+func (e *englishWord) short() bool {
+	return e.r1 >= len(e.w) && e.shortSyllable()
+}
+
+func (e *englishWord) step0() {
+	if s := e.longest("'", "'s", "'s'"); s != "" {
+		e.replace(s, "")
+	}
+}
+
+func (e *englishWord) step1a() {
+	switch s := e.longest("sses", "ied", "ies", "s", "us", "ss"); s {
+	case "sses":
+		e.replace(s, "ss")
+	case "ied", "ies":
+		if len(e.w) > 4 {
+			e.replace(s, "i")
+		} else {
+			e.replace(s, "ie")
+		}
+	case "s":
+		if e.hasVowel(len(e.w) - 2) {
+			e.replace(s, "")
+		}
+	}
+}
+
+func (e *englishWord) step1b() {
+	switch s := e.longest("eed", "eedly", "ed", "edly", "ing", "ingly"); s {
+	case "":
+	case "eed", "eedly":
+		if e.in(e.r1, s) {
+			e.replace(s, "ee")
+		}
+	default:
+		if !e.hasVowel(len(e.w) - len(s)) {
+			return
+		}
+		e.replace(s, "")
+
+		switch {
+		case e.hasSuffix("at"), e.hasSuffix("bl"), e.hasSuffix("iz"):
+			e.w = append(e.w, 'e')
+		case e.endsDouble():
+			e.w = e.w[:len(e.w)-1]
+		case e.short():
+			e.w = append(e.w, 'e')
+		}
+	}
+}
+
+func (e *englishWord) endsDouble() bool {
+	for _, d := range []string{"bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"} {
+		if e.hasSuffix(d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *englishWord) step1c() {
+	n := len(e.w)
+	if n > 2 && (e.w[n-1] == 'y' || e.w[n-1] == 'Y') && !isEnglishVowel(e.w[n-2]) {
+		e.w[n-1] = 'i'
+	}
+}
+
+var englishStep2 = map[string]string{
+	"tional":  "tion",
+	"enci":    "ence",
+	"anci":    "ance",
+	"abli":    "able",
+	"entli":   "ent",
+	"izer":    "ize",
+	"ization": "ize",
+	"ational": "ate",
+	"ation":   "ate",
+	"ator":    "ate",
+	"alism":   "al",
+	"aliti":   "al",
+	"alli":    "al",
+	"fulness": "ful",
+	"ousli":   "ous",
+	"ousness": "ous",
+	"iveness": "ive",
+	"iviti":   "ive",
+	"biliti":  "ble",
+	"bli":     "ble",
+	"ogi":     "og",
+	"fulli":   "ful",
+	"lessli":  "less",
+	"li":      "",
+}
+
+func (e *englishWord) step2() {
+	s := e.longest(keys(englishStep2)...)
+	if s == "" || !e.in(e.r1, s) {
+		return
+	}
+
+	before := byte(0)
+	if n := len(e.w) - len(s); n > 0 {
+		before = e.w[n-1]
+	}
+	switch s {
+	case "ogi":
+		if before != 'l' {
+			return
+		}
+	case "li":
+		if before == 0 || !strings.ContainsRune("cdeghkmnrt", rune(before)) {
+			return
+		}
+	}
+	e.replace(s, englishStep2[s])
+}
+
+var englishStep3 = map[string]string{
+	"tional":  "tion",
+	"ational": "ate",
+	"alize":   "al",
+	"icate":   "ic",
+	"iciti":   "ic",
+	"ical":    "ic",
+	"ful":     "",
+	"ness":    "",
+	"ative":   "",
+}
+
+func (e *englishWord) step3() {
+	s := e.longest(keys(englishStep3)...)
+	if s == "" || !e.in(e.r1, s) {
+		return
+	}
+	if s == "ative" && !e.in(e.r2, s) {
+		return
+	}
+	e.replace(s, englishStep3[s])
+}
+
+var englishStep4 = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement",
+	"ment", "ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+}
+
+func (e *englishWord) step4() {
+	s := e.longest(englishStep4...)
+	if s == "" || !e.in(e.r2, s) {
+		return
+	}
+	if s == "ion" {
+		n := len(e.w) - len(s)
+		if n == 0 || (e.w[n-1] != 's' && e.w[n-1] != 't') {
+			return
+		}
+	}
+	e.replace(s, "")
+}
+
+func (e *englishWord) step5() {
+	switch {
+	case e.hasSuffix("e"):
+		if e.in(e.r2, "e") {
+			e.replace("e", "")
+			return
+		}
+		if e.in(e.r1, "e") {
+			rest := &englishWord{w: e.w[:len(e.w)-1]}
+			if !rest.shortSyllable() {
+				e.replace("e", "")
+			}
+		}
+	case e.hasSuffix("ll"):
+		if e.in(e.r2, "l") {
+			e.replace("l", "")
+		}
+	}
+}
+
+func keys(m map[string]string) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	return ks
+}
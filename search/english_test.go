@@ -0,0 +1,58 @@
+package search
+
+import "testing"
+
+func TestStemEnglish(t *testing.T) {
+	tests := []struct {
+		word, want string
+	}{
+		{"by", "by"},
+		{"cats", "cat"},
+		{"caresses", "caress"},
+		{"ponies", "poni"},
+		{"ties", "tie"},
+		{"dog's", "dog"},
+		{"'tis", "tis"},
+		{"agreed", "agre"},
+		{"feed", "feed"},
+		{"cried", "cri"},
+		{"running", "run"},
+		{"hopping", "hop"},
+		{"hoping", "hope"},
+		{"yelling", "yell"},
+		{"sayings", "say"},
+		{"happy", "happi"},
+		{"consigned", "consign"},
+		{"consignment", "consign"},
+		{"consistently", "consist"},
+		{"consolation", "consol"},
+		{"knackeries", "knackeri"},
+		{"knightly", "knight"},
+		{"fluently", "fluentli"},
+		{"national", "nation"},
+		{"relativity", "relat"},
+		{"abilities", "abil"},
+		{"hopeful", "hope"},
+		{"goodness", "good"},
+		{"electrical", "electr"},
+		{"adjustment", "adjust"},
+		{"controlling", "control"},
+		{"cease", "ceas"},
+		{"rate", "rate"},
+		{"bell", "bell"},
+		{"generously", "generous"},
+		{"generation", "generat"},
+		{"communication", "communic"},
+		{"skies", "sky"},
+		{"dying", "die"},
+		{"news", "news"},
+		{"innings", "inning"},
+		{"proceed", "proceed"},
+	}
+
+	for _, tt := range tests {
+		if got := stemEnglish(tt.word); got != tt.want {
+			t.Errorf("stemEnglish(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,440 @@
+package search
+
+import (
+	"context"
+	"encoding/gob"
+	"html"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+// Kinds of documents in the index.
+const (
+	KindBook    = "book"
+	KindChapter = "chapter"
+	KindNote    = "note"
+)
+
+// Document is a book, chapter or note as it is indexed. Title is weighted
+// above Text in ranking.
+type Document struct {
+	Kind      string
+	ID        string
+	BookID    string
+	ChapterID string
+	Title     string
+	Text      string
+}
+
+func (d Document) key() string {
+	return d.Kind + ":" + d.ID
+}
+
+// Ranking parameters: the BM25 k1 and b, and the weight of a term in the
+// title against one in the text.
+const (
+	bm25K1      = 1.2
+	bm25B       = 0.75
+	titleWeight = 2
+)
+
+// Snippets are cut to a window of snippetWords words around the matches.
+const snippetWords = 24
+
+// flushInterval is how often a changed index is written to its file.
+const flushInterval = 10 * time.Second
+
+// Index is an embedded full-text index of documents, held in memory and
+// written to a file as it changes. Documents are grouped by book, the unit
+// they are replaced in.
+type Index struct {
+	mtx      sync.RWMutex
+	docs     map[string]*entry
+	postings map[string]map[string]float64
+	books    map[string][]string
+	length   float64
+	dirty    bool
+
+	// groups are the chapters and books, with the terms of all their
+	// documents, and df the number of groups of a kind with a term.
+	groups map[string]*group
+	df     map[string]map[string]int
+
+	path   string
+	logger log.Logger
+}
+
+type entry struct {
+	Document
+	length float64
+	terms  map[string]float64
+}
+
+// NewIndex returns a new, empty Index kept in the file at path. An empty
+// path keeps the index in memory only.
+func NewIndex(logger log.Logger, path string) *Index {
+	return &Index{
+		docs:     make(map[string]*entry),
+		postings: make(map[string]map[string]float64),
+		books:    make(map[string][]string),
+		groups:   make(map[string]*group),
+		df:       make(map[string]map[string]int),
+		path:     path,
+		logger:   logger,
+	}
+}
+
+// Load reads the index from its file. It returns an error satisfying
+// os.IsNotExist if there is no file yet.
+func (idx *Index) Load() error {
+	if idx.path == "" {
+		return nil
+	}
+
+	f, err := os.Open(idx.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var docs []Document
+	if err := gob.NewDecoder(f).Decode(&docs); err != nil {
+		return err
+	}
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.reset()
+	for _, d := range docs {
+		idx.add(d)
+	}
+	return nil
+}
+
+// Flush writes the index to its file if it changed since it was last
+// written.
+func (idx *Index) Flush() error {
+	idx.mtx.Lock()
+	if idx.path == "" || !idx.dirty {
+		idx.mtx.Unlock()
+		return nil
+	}
+	docs := make([]Document, 0, len(idx.docs))
+	for _, e := range idx.docs {
+		docs = append(docs, e.Document)
+	}
+	idx.dirty = false
+	idx.mtx.Unlock()
+
+	sort.Slice(docs, func(i, j int) bool { return docs[i].key() < docs[j].key() })
+
+	if err := writeFile(idx.path, docs); err != nil {
+		idx.mtx.Lock()
+		idx.dirty = true
+		idx.mtx.Unlock()
+		return err
+	}
+	return nil
+}
+
+// writeFile writes docs to a temporary file which is then renamed to path,
+// so that the file is never left half written.
+func writeFile(path string, docs []Document) error {
+	f, err := os.Create(filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp"))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := gob.NewEncoder(f).Encode(docs); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// Run writes the index to its file as it changes until ctx is done.
+func (idx *Index) Run(ctx context.Context) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := idx.Flush(); err != nil {
+				idx.logger.Log("err", err)
+			}
+			return
+		case <-ticker.C:
+		}
+
+		if err := idx.Flush(); err != nil {
+			idx.logger.Log("err", err)
+		}
+	}
+}
+
+// ReplaceBook replaces the documents of the book with the given ID by docs.
+// No docs removes the book from the index.
+func (idx *Index) ReplaceBook(bookID string, docs []Document) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.removeBook(bookID)
+	for _, d := range docs {
+		idx.add(d)
+	}
+	idx.dirty = true
+}
+
+// ReplaceAll replaces all documents in the index by docs.
+func (idx *Index) ReplaceAll(docs []Document) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.reset()
+	for _, d := range docs {
+		idx.add(d)
+	}
+	idx.dirty = true
+}
+
+// Len returns the number of documents in the index.
+func (idx *Index) Len() int {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+	return len(idx.docs)
+}
+
+// reset must be called with idx.mtx held.
+func (idx *Index) reset() {
+	idx.docs = make(map[string]*entry)
+	idx.postings = make(map[string]map[string]float64)
+	idx.books = make(map[string][]string)
+	idx.length = 0
+	idx.groups = make(map[string]*group)
+	idx.df = make(map[string]map[string]int)
+}
+
+// add must be called with idx.mtx held.
+func (idx *Index) add(d Document) {
+	key := d.key()
+	if _, ok := idx.docs[key]; ok {
+		idx.remove(key)
+	}
+
+	e := &entry{
+		Document: d,
+		terms:    make(map[string]float64),
+	}
+	for _, t := range tokenize(d.Title) {
+		e.terms[t.term] += titleWeight
+		e.length += titleWeight
+	}
+	for _, t := range tokenize(d.Text) {
+		e.terms[t.term]++
+		e.length++
+	}
+
+	for term, tf := range e.terms {
+		p, ok := idx.postings[term]
+		if !ok {
+			p = make(map[string]float64)
+			idx.postings[term] = p
+		}
+		p[key] = tf
+	}
+
+	idx.docs[key] = e
+	idx.books[d.BookID] = append(idx.books[d.BookID], key)
+	idx.length += e.length
+	idx.group(e, 1)
+}
+
+// remove must be called with idx.mtx held. It leaves the document in the
+// list of its book.
+func (idx *Index) remove(key string) {
+	e, ok := idx.docs[key]
+	if !ok {
+		return
+	}
+	for term := range e.terms {
+		p := idx.postings[term]
+		delete(p, key)
+		if len(p) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.docs, key)
+	idx.length -= e.length
+	idx.group(e, -1)
+}
+
+// removeBook must be called with idx.mtx held.
+func (idx *Index) removeBook(bookID string) {
+	for _, key := range idx.books[bookID] {
+		if e, ok := idx.docs[key]; ok && e.BookID == bookID {
+			idx.remove(key)
+		}
+	}
+	delete(idx.books, bookID)
+}
+
+// Hit is a document matching a query, with its score and a snippet of it
+// in HTML, the words matching the query marked.
+type Hit struct {
+	Document
+	Score   float64
+	Snippet string
+}
+
+// Search returns the documents matching all terms of q, ranked by BM25,
+// best first, and the number of them in total. An empty bookID searches
+// all books.
+func (idx *Index) Search(q string, bookID string, offset, limit int) ([]Hit, int) {
+	qterms := terms(q)
+	if len(qterms) == 0 {
+		return nil, 0
+	}
+
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	// Start from the rarest term, which has the fewest documents to
+	// intersect the others with.
+	sort.Slice(qterms, func(i, j int) bool {
+		return len(idx.postings[qterms[i]]) < len(idx.postings[qterms[j]])
+	})
+
+	n := float64(len(idx.docs))
+	avg := idx.length / math.Max(n, 1)
+
+	var hits []Hit
+	for key := range idx.postings[qterms[0]] {
+		e := idx.docs[key]
+		if bookID != "" && e.BookID != bookID {
+			continue
+		}
+
+		score := 0.0
+		for _, term := range qterms {
+			tf, ok := idx.postings[term][key]
+			if !ok {
+				score = -1
+				break
+			}
+			df := float64(len(idx.postings[term]))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*e.length/avg))
+		}
+		if score < 0 {
+			continue
+		}
+		hits = append(hits, Hit{Document: e.Document, Score: score})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].key() < hits[j].key()
+	})
+
+	total := len(hits)
+	if offset > total {
+		offset = total
+	}
+	hits = hits[offset:]
+	if limit < len(hits) {
+		hits = hits[:limit]
+	}
+
+	match := make(map[string]bool, len(qterms))
+	for _, term := range qterms {
+		match[term] = true
+	}
+	for i := range hits {
+		hits[i].Snippet = snippet(hits[i].Text, match)
+		if hits[i].Snippet == "" {
+			hits[i].Snippet = snippet(hits[i].Title, match)
+		}
+	}
+
+	return hits, total
+}
+
+// snippet returns the window of text with the most words matching, in
+// HTML, the matching words marked. It returns "" if no word matches.
+func snippet(text string, match map[string]bool) string {
+	tokens := tokenize(text)
+
+	best, bestCount := -1, 0
+	for i := range tokens {
+		if !match[tokens[i].term] {
+			continue
+		}
+		count := 0
+		for j := i; j < len(tokens) && j < i+snippetWords; j++ {
+			if match[tokens[j].term] {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = i, count
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+
+	// Start a few words before the first match, for context.
+	first := best - snippetWords/4
+	if first < 0 {
+		first = 0
+	}
+	last := first + snippetWords - 1
+	if last >= len(tokens) {
+		last = len(tokens) - 1
+	}
+
+	start, end := tokens[first].start, tokens[last].end
+	if first == 0 {
+		start = 0
+	}
+	if last == len(tokens)-1 {
+		end = len(text)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, t := range tokens[first : last+1] {
+		if !match[t.term] {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:t.start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[t.start:t.end]))
+		b.WriteString("</mark>")
+		pos = t.end
+	}
+	b.WriteString(html.EscapeString(text[pos:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
@@ -0,0 +1,44 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSnippet(t *testing.T) {
+	words := make([]string, 60)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%02d", i)
+	}
+	long := strings.Join(words, " ")
+
+	tests := []struct {
+		name  string
+		text  string
+		match []string
+		want  string
+	}{
+		{"no match", "The quick brown dog", []string{"fox"}, ""},
+		{"stemmed and escaped", "Two Foxes,\n\n  and  a <fox> & co.", []string{"fox"},
+			"Two <mark>Foxes</mark>, and a &lt;<mark>fox</mark>&gt; &amp; co."},
+		{"middle", long, []string{"w40"},
+			"…w34 w35 w36 w37 w38 w39 <mark>w40</mark> w41 w42 w43 w44 w45 w46 w47 w48 w49 w50 w51 w52 w53 w54 w55 w56 w57…"},
+		{"start", long, []string{"w02"},
+			"w00 w01 <mark>w02</mark> w03 w04 w05 w06 w07 w08 w09 w10 w11 w12 w13 w14 w15 w16 w17 w18 w19 w20 w21 w22 w23…"},
+		{"most matches", long, []string{"w05", "w50", "w52"},
+			"…w44 w45 w46 w47 w48 w49 <mark>w50</mark> w51 <mark>w52</mark> w53 w54 w55 w56 w57 w58 w59"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := make(map[string]bool, len(tt.match))
+			for _, term := range tt.match {
+				match[term] = true
+			}
+			if got := snippet(tt.text, match); got != tt.want {
+				t.Errorf("snippet(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Indexer keeps an Index in line with the books, chapters and notes of a
+// handling.Service. Books are indexed as a whole, one at a time, so that
+// an index of a book is never replaced by one read before it.
+type Indexer struct {
+	mtx   sync.Mutex
+	index *Index
+	s     handling.Service
+}
+
+// NewIndexer returns a new instance of an Indexer reading from s.
+func NewIndexer(index *Index, s handling.Service) *Indexer {
+	return &Indexer{
+		index: index,
+		s:     s,
+	}
+}
+
+// IndexBook indexes the book with the given ID as it is now, removing it
+// from the index if it no longer exists or is in the trash.
+func (x *Indexer) IndexBook(ctx context.Context, id string) error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	book, err := x.s.GetBook(ctx, id)
+	switch {
+	case err == handling.ErrNotFound:
+		x.index.ReplaceBook(id, nil)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	docs, err := x.documents(ctx, book)
+	if err != nil {
+		return err
+	}
+	x.index.ReplaceBook(id, docs)
+	return nil
+}
+
+// Rebuild indexes all books anew and writes the index to its file. It
+// returns the number of documents indexed.
+func (x *Indexer) Rebuild(ctx context.Context) (int, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	books, err := x.s.Books(ctx, handling.BookFilter{})
+	if err != nil {
+		return 0, err
+	}
+
+	var docs []Document
+	for _, book := range books {
+		d, err := x.documents(ctx, book)
+		if err != nil {
+			return 0, err
+		}
+		docs = append(docs, d...)
+	}
+
+	x.index.ReplaceAll(docs)
+	if err := x.index.Flush(); err != nil {
+		return 0, err
+	}
+	return len(docs), nil
+}
+
+// documents returns the documents of book, its chapters and their notes.
+func (x *Indexer) documents(ctx context.Context, book prisma.Book) ([]Document, error) {
+	docs := []Document{{
+		Kind:   KindBook,
+		ID:     book.ID,
+		BookID: book.ID,
+		Title:  book.Name,
+		Text:   book.Description,
+	}}
+
+	chapters, err := x.s.Chapters(ctx, book.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, chapter := range chapters {
+		docs = append(docs, Document{
+			Kind:      KindChapter,
+			ID:        chapter.ID,
+			BookID:    book.ID,
+			ChapterID: chapter.ID,
+			Title:     chapter.Name,
+			Text:      chapter.Description,
+		})
+
+		notes, err := x.s.Notes(ctx, chapter.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, note := range notes {
+			docs = append(docs, Document{
+				Kind:      KindNote,
+				ID:        note.ID,
+				BookID:    book.ID,
+				ChapterID: chapter.ID,
+				Text:      strings.TrimSpace(note.Quote + "\n\n" + note.Text),
+			})
+		}
+	}
+	return docs, nil
+}
+
+type indexingService struct {
+	indexer *Indexer
+	logger  log.Logger
+	handling.Service
+}
+
+// NewIndexingService returns a new instance of a handling.Service which
+// indexes the books changed by every successful mutation. A failure to
+// index is logged and does not fail the mutation; the index is put right
+// by the next change of the book, or by a rebuild.
+func NewIndexingService(logger log.Logger, indexer *Indexer, s handling.Service) handling.Service {
+	return &indexingService{
+		indexer: indexer,
+		logger:  logger,
+		Service: s,
+	}
+}
+
+// index indexes the books with the given IDs.
+func (s *indexingService) index(ctx context.Context, ids ...string) {
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if err := s.indexer.IndexBook(ctx, id); err != nil {
+			s.logger.Log("book_id", id, "err", err)
+		}
+	}
+}
+
+// chapterBook returns the ID of the book of the chapter with the given ID,
+// or "" if there is none.
+func (s *indexingService) chapterBook(ctx context.Context, id string) string {
+	book, err := s.Service.ChapterBook(ctx, id)
+	if err != nil {
+		return ""
+	}
+	return book.ID
+}
+
+// noteBook returns the ID of the book of the note with the given ID, or ""
+// if there is none.
+func (s *indexingService) noteBook(ctx context.Context, id string) string {
+	chapter, err := s.Service.NoteChapter(ctx, id)
+	if err != nil {
+		return ""
+	}
+	return s.chapterBook(ctx, chapter.ID)
+}
+
+func (s *indexingService) AddBook(ctx context.Context, name string, description string, chapters []handling.NewChapter) (prisma.Book, []prisma.Chapter, error) {
+	book, created, err := s.Service.AddBook(ctx, name, description, chapters)
+	if err == nil {
+		s.index(ctx, book.ID)
+	}
+	return book, created, err
+}
+
+func (s *indexingService) UpdateBook(ctx context.Context, id string, name string, description string, version int32) (prisma.Book, error) {
+	book, err := s.Service.UpdateBook(ctx, id, name, description, version)
+	if err == nil {
+		s.index(ctx, id)
+	}
+	return book, err
+}
+
+func (s *indexingService) DeleteBook(ctx context.Context, id string, version int32) (prisma.Book, error) {
+	book, err := s.Service.DeleteBook(ctx, id, version)
+	if err == nil {
+		s.index(ctx, id)
+	}
+	return book, err
+}
+
+func (s *indexingService) MergeBooks(ctx context.Context, sourceID string, targetID string, strategy handling.MergeStrategy, archive bool, version int32) (prisma.Book, error) {
+	book, err := s.Service.MergeBooks(ctx, sourceID, targetID, strategy, archive, version)
+	if err == nil {
+		s.index(ctx, sourceID, targetID)
+	}
+	return book, err
+}
+
+func (s *indexingService) DuplicateBook(ctx context.Context, id string, name string) (prisma.Book, []prisma.Chapter, error) {
+	book, chapters, err := s.Service.DuplicateBook(ctx, id, name)
+	if err == nil {
+		s.index(ctx, book.ID)
+	}
+	return book, chapters, err
+}
+
+func (s *indexingService) RestoreBook(ctx context.Context, id string) (prisma.Book, error) {
+	book, err := s.Service.RestoreBook(ctx, id)
+	if err == nil {
+		s.index(ctx, id)
+	}
+	return book, err
+}
+
+func (s *indexingService) RevertBook(ctx context.Context, id string, number int32, version int32) (prisma.Book, error) {
+	book, err := s.Service.RevertBook(ctx, id, number, version)
+	if err == nil {
+		s.index(ctx, id)
+	}
+	return book, err
+}
+
+func (s *indexingService) AddChapter(ctx context.Context, name string, description string, bookID string) (prisma.Chapter, error) {
+	chapter, err := s.Service.AddChapter(ctx, name, description, bookID)
+	if err == nil {
+		s.index(ctx, bookID)
+	}
+	return chapter, err
+}
+
+func (s *indexingService) UpdateChapter(ctx context.Context, id string, name string, description string, version int32) (prisma.Chapter, error) {
+	chapter, err := s.Service.UpdateChapter(ctx, id, name, description, version)
+	if err == nil {
+		s.index(ctx, s.chapterBook(ctx, id))
+	}
+	return chapter, err
+}
+
+func (s *indexingService) DeleteChapter(ctx context.Context, id string, version int32) (prisma.Chapter, error) {
+	bookID := s.chapterBook(ctx, id)
+	chapter, err := s.Service.DeleteChapter(ctx, id, version)
+	if err == nil {
+		s.index(ctx, bookID)
+	}
+	return chapter, err
+}
+
+func (s *indexingService) MoveChapter(ctx context.Context, id string, bookID string, position int32, version int32) (prisma.Chapter, error) {
+	sourceID := s.chapterBook(ctx, id)
+	chapter, err := s.Service.MoveChapter(ctx, id, bookID, position, version)
+	if err == nil {
+		s.index(ctx, sourceID, bookID)
+	}
+	return chapter, err
+}
+
+func (s *indexingService) CopyChapter(ctx context.Context, id string, bookID string, position int32) (prisma.Chapter, error) {
+	chapter, err := s.Service.CopyChapter(ctx, id, bookID, position)
+	if err == nil {
+		s.index(ctx, bookID)
+	}
+	return chapter, err
+}
+
+func (s *indexingService) RestoreChapter(ctx context.Context, id string) (prisma.Chapter, error) {
+	chapter, err := s.Service.RestoreChapter(ctx, id)
+	if err == nil {
+		s.index(ctx, s.chapterBook(ctx, id))
+	}
+	return chapter, err
+}
+
+func (s *indexingService) RevertChapter(ctx context.Context, id string, number int32, version int32) (prisma.Chapter, error) {
+	chapter, err := s.Service.RevertChapter(ctx, id, number, version)
+	if err == nil {
+		s.index(ctx, s.chapterBook(ctx, id))
+	}
+	return chapter, err
+}
+
+func (s *indexingService) AddNote(ctx context.Context, chapterID string, n handling.NoteInput) (prisma.Note, error) {
+	note, err := s.Service.AddNote(ctx, chapterID, n)
+	if err == nil {
+		s.index(ctx, s.chapterBook(ctx, chapterID))
+	}
+	return note, err
+}
+
+func (s *indexingService) UpdateNote(ctx context.Context, id string, n handling.NoteInput) (prisma.Note, error) {
+	note, err := s.Service.UpdateNote(ctx, id, n)
+	if err == nil {
+		s.index(ctx, s.noteBook(ctx, id))
+	}
+	return note, err
+}
+
+func (s *indexingService) DeleteNote(ctx context.Context, id string) (prisma.Note, error) {
+	bookID := s.noteBook(ctx, id)
+	note, err := s.Service.DeleteNote(ctx, id)
+	if err == nil {
+		s.index(ctx, bookID)
+	}
+	return note, err
+}
@@ -0,0 +1,50 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+)
+
+type instrumentingService struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	Service
+}
+
+// NewInstrumentingService returns an instance of an instrumenting Service.
+func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram, s Service) Service {
+	return &instrumentingService{
+		requestCount:   counter,
+		requestLatency: latency,
+		Service:        s,
+	}
+}
+
+func (s *instrumentingService) Search(ctx context.Context, q Query) (Results, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "search").Add(1)
+		s.requestLatency.With("method", "search").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Search(ctx, q)
+}
+
+func (s *instrumentingService) RelatedBooks(ctx context.Context, id string, limit int32) ([]Related, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "related_books").Add(1)
+		s.requestLatency.With("method", "related_books").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RelatedBooks(ctx, id, limit)
+}
+
+func (s *instrumentingService) RelatedChapters(ctx context.Context, bookID string, id string, limit int32) ([]Related, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "related_chapters").Add(1)
+		s.requestLatency.With("method", "related_chapters").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RelatedChapters(ctx, bookID, id, limit)
+}
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+type loggingService struct {
+	logger log.Logger
+	Service
+}
+
+// NewLoggingService returns a new instance of a logging Service.
+func NewLoggingService(logger log.Logger, s Service) Service {
+	return &loggingService{logger, s}
+}
+
+func (s *loggingService) Search(ctx context.Context, q Query) (results Results, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "search",
+			"q", q.Text,
+			"book_id", q.BookID,
+			"offset", q.Offset,
+			"limit", q.Limit,
+			"total", results.Total,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Search(ctx, q)
+}
+
+func (s *loggingService) RelatedBooks(ctx context.Context, id string, limit int32) (related []Related, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "related_books",
+			"id", id,
+			"limit", limit,
+			"related", len(related),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RelatedBooks(ctx, id, limit)
+}
+
+func (s *loggingService) RelatedChapters(ctx context.Context, bookID string, id string, limit int32) (related []Related, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "related_chapters",
+			"book_id", bookID,
+			"id", id,
+			"limit", limit,
+			"related", len(related),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RelatedChapters(ctx, bookID, id, limit)
+}
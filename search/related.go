@@ -0,0 +1,175 @@
+package search
+
+import (
+	"math"
+	"sort"
+)
+
+// group is a chapter or a book as a whole: a chapter with its notes, a
+// book with its chapters and their notes. Its terms are the sum of the
+// terms of its documents, kept up to date as they are added and removed.
+type group struct {
+	kind   string
+	id     string
+	bookID string
+	title  string
+	docs   int
+	terms  map[string]float64
+}
+
+// Similar is a chapter or book similar in content to another, with the
+// cosine similarity of their TF-IDF vectors, from 0 to 1.
+type Similar struct {
+	Kind   string
+	ID     string
+	BookID string
+	Title  string
+	Score  float64
+}
+
+// group adds the terms of e to the groups it belongs to, or removes them
+// with a sign of -1. It must be called with idx.mtx held.
+func (idx *Index) group(e *entry, sign float64) {
+	idx.groupTerms(KindBook, e.BookID, e, sign)
+	if e.Kind != KindBook {
+		idx.groupTerms(KindChapter, e.ChapterID, e, sign)
+	}
+}
+
+func (idx *Index) groupTerms(kind, id string, e *entry, sign float64) {
+	key := kind + ":" + id
+	g, ok := idx.groups[key]
+	if !ok {
+		g = &group{
+			kind:   kind,
+			id:     id,
+			bookID: e.BookID,
+			terms:  make(map[string]float64),
+		}
+		idx.groups[key] = g
+	}
+	if sign > 0 {
+		g.bookID = e.BookID
+		if e.Kind == kind {
+			g.title = e.Title
+		}
+	}
+
+	df, ok := idx.df[kind]
+	if !ok {
+		df = make(map[string]int)
+		idx.df[kind] = df
+	}
+
+	for term, tf := range e.terms {
+		before := g.terms[term]
+		after := before + sign*tf
+		switch {
+		case after <= 0:
+			delete(g.terms, term)
+			if before > 0 {
+				df[term]--
+				if df[term] <= 0 {
+					delete(df, term)
+				}
+			}
+		default:
+			g.terms[term] = after
+			if before <= 0 {
+				df[term]++
+			}
+		}
+	}
+
+	g.docs += int(sign)
+	if g.docs <= 0 {
+		delete(idx.groups, key)
+	}
+}
+
+// Similar returns the chapters or books, by kind, most similar in content
+// to the one with the given ID, best first. It returns false if there is
+// no such chapter or book in the index.
+func (idx *Index) Similar(kind, id string, limit int) ([]Similar, bool) {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	g, ok := idx.groups[kind+":"+id]
+	if !ok {
+		return nil, false
+	}
+
+	n := 0
+	for _, other := range idx.groups {
+		if other.kind == kind {
+			n++
+		}
+	}
+	df := idx.df[kind]
+	weight := func(term string, tf float64) float64 {
+		return (1 + math.Log(tf)) * math.Log(1+float64(n)/float64(df[term]))
+	}
+
+	vector := make(map[string]float64, len(g.terms))
+	norm := 0.0
+	for term, tf := range g.terms {
+		w := weight(term, tf)
+		vector[term] = w
+		norm += w * w
+	}
+	norm = math.Sqrt(norm)
+
+	var similar []Similar
+	if norm == 0 {
+		return similar, true
+	}
+
+	for _, other := range idx.groups {
+		if other.kind != kind || other == g {
+			continue
+		}
+
+		dot, otherNorm := 0.0, 0.0
+		for term, tf := range other.terms {
+			w := weight(term, tf)
+			otherNorm += w * w
+			dot += w * vector[term]
+		}
+		if dot <= 0 {
+			continue
+		}
+
+		similar = append(similar, Similar{
+			Kind:   other.kind,
+			ID:     other.id,
+			BookID: other.bookID,
+			Title:  other.title,
+			Score:  dot / (norm * math.Sqrt(otherNorm)),
+		})
+	}
+
+	sort.Slice(similar, func(i, j int) bool {
+		if similar[i].Score != similar[j].Score {
+			return similar[i].Score > similar[j].Score
+		}
+		return similar[i].ID < similar[j].ID
+	})
+
+	if len(similar) > limit {
+		similar = similar[:limit]
+	}
+	return similar, true
+}
+
+// BookOf returns the ID of the book of the chapter with the given ID in
+// the index, if it is there.
+func (idx *Index) BookOf(chapterID string) (string, bool) {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	g, ok := idx.groups[KindChapter+":"+chapterID]
+	if !ok {
+		return "", false
+	}
+	return g.bookID, true
+}
@@ -0,0 +1,179 @@
+package search
+
+// stemRussian returns the stem of a Russian word by the Snowball Russian
+// algorithm. The word is expected in lower case, with ё replaced by е.
+func stemRussian(word string) string {
+	w := []rune(word)
+	rv, r2 := russianRegions(w)
+	if rv >= len(w) {
+		return word
+	}
+
+	r := &russianWord{w: w, rv: rv}
+
+	// Step 1.
+	if !r.removeGroups(russianPerfectiveGerund1, russianPerfectiveGerund2) {
+		r.removeGroups(nil, russianReflexive)
+		if !r.removeAdjectival() && !r.removeGroups(russianVerb1, russianVerb2) {
+			r.removeGroups(nil, russianNoun)
+		}
+	}
+
+	// Step 2.
+	if r.ends("и") && len(r.w)-1 >= r.rv {
+		r.cut(1)
+	}
+
+	// Step 3.
+	if s := r.longest(r2, russianDerivational); s > 0 {
+		r.cut(s)
+	}
+
+	// Step 4.
+	switch {
+	case r.longest(r.rv, russianSuperlative) > 0:
+		r.cut(r.longest(r.rv, russianSuperlative))
+		if r.ends("нн") && len(r.w)-2 >= r.rv {
+			r.cut(1)
+		}
+	case r.ends("нн") && len(r.w)-2 >= r.rv:
+		r.cut(1)
+	case r.ends("ь") && len(r.w)-1 >= r.rv:
+		r.cut(1)
+	}
+
+	return string(r.w)
+}
+
+var (
+	russianPerfectiveGerund1 = []string{"в", "вши", "вшись"}
+	russianPerfectiveGerund2 = []string{"ив", "ивши", "ившись", "ыв", "ывши", "ывшись"}
+
+	russianAdjective = []string{
+		"ее", "ие", "ые", "ое", "ими", "ыми", "ей", "ий", "ый", "ой", "ем",
+		"им", "ым", "ом", "его", "ого", "ему", "ому", "их", "ых", "ую", "юю",
+		"ая", "яя", "ою", "ею",
+	}
+	russianParticiple1 = []string{"ем", "нн", "вш", "ющ", "щ"}
+	russianParticiple2 = []string{"ивш", "ывш", "ующ"}
+
+	russianReflexive = []string{"ся", "сь"}
+
+	russianVerb1 = []string{
+		"ла", "на", "ете", "йте", "ли", "й", "л", "ем", "н", "ло", "но", "ет",
+		"ют", "ны", "ть", "ешь", "нно",
+	}
+	russianVerb2 = []string{
+		"ила", "ыла", "ена", "ейте", "уйте", "ите", "или", "ыли", "ей", "уй",
+		"ил", "ыл", "им", "ым", "ен", "ило", "ыло", "ено", "ят", "ует", "уют",
+		"ит", "ыт", "ены", "ить", "ыть", "ишь", "ую", "ю",
+	}
+
+	russianNoun = []string{
+		"а", "ев", "ов", "ие", "ье", "е", "иями", "ями", "ами", "еи", "ии",
+		"и", "ией", "ей", "ой", "ий", "й", "иям", "ям", "ием", "ем", "ам",
+		"ом", "о", "у", "ах", "иях", "ях", "ы", "ь", "ию", "ью", "ю", "ия",
+		"ья", "я",
+	}
+
+	russianSuperlative  = []string{"ейш", "ейше"}
+	russianDerivational = []string{"ост", "ость"}
+)
+
+func isRussianVowel(c rune) bool {
+	switch c {
+	case 'а', 'е', 'и', 'о', 'у', 'ы', 'э', 'ю', 'я':
+		return true
+	}
+	return false
+}
+
+// russianRegions returns the starts of the regions RV and R2 of w.
+func russianRegions(w []rune) (int, int) {
+	rv := len(w)
+	for i, c := range w {
+		if isRussianVowel(c) {
+			rv = i + 1
+			break
+		}
+	}
+
+	after := func(start int) int {
+		for i := start + 1; i < len(w); i++ {
+			if !isRussianVowel(w[i]) && isRussianVowel(w[i-1]) {
+				return i + 1
+			}
+		}
+		return len(w)
+	}
+	return rv, after(after(0))
+}
+
+type russianWord struct {
+	w  []rune
+	rv int
+}
+
+func (r *russianWord) ends(s string) bool {
+	suffix := []rune(s)
+	if len(suffix) > len(r.w) {
+		return false
+	}
+	for i, c := range suffix {
+		if r.w[len(r.w)-len(suffix)+i] != c {
+			return false
+		}
+	}
+	return true
+}
+
+// longest returns the length of the longest of suffixes which w ends with
+// within the region starting at region, or 0.
+func (r *russianWord) longest(region int, suffixes []string) int {
+	found := 0
+	for _, s := range suffixes {
+		n := len([]rune(s))
+		if n > found && len(r.w)-n >= region && r.ends(s) {
+			found = n
+		}
+	}
+	return found
+}
+
+func (r *russianWord) cut(n int) {
+	r.w = r.w[:len(r.w)-n]
+}
+
+// removeGroups removes the longest ending of group1 or group2 within RV.
+// The endings of group1 only count when they follow an а or a я, which is
+// kept.
+func (r *russianWord) removeGroups(group1, group2 []string) bool {
+	n1 := r.longest(r.rv, group1)
+	n2 := r.longest(r.rv, group2)
+
+	switch {
+	case n2 >= n1 && n2 > 0:
+		r.cut(n2)
+		return true
+	case n1 > 0:
+		before := len(r.w) - n1 - 1
+		if before < r.rv || (r.w[before] != 'а' && r.w[before] != 'я') {
+			return false
+		}
+		r.cut(n1)
+		return true
+	}
+	return false
+}
+
+// removeAdjectival removes an adjective ending, along with a participle
+// ending before it.
+func (r *russianWord) removeAdjectival() bool {
+	n := r.longest(r.rv, russianAdjective)
+	if n == 0 {
+		return false
+	}
+	r.cut(n)
+	r.removeGroups(russianParticiple1, russianParticiple2)
+	return true
+}
@@ -0,0 +1,37 @@
+package search
+
+import "testing"
+
+func TestStemRussian(t *testing.T) {
+	tests := []struct {
+		word, want string
+	}{
+		{"и", "и"},
+		{"мой", "мо"},
+		{"книги", "книг"},
+		{"столами", "стол"},
+		{"пятницу", "пятниц"},
+		{"жизнь", "жизн"},
+		{"вавиловка", "вавиловк"},
+		{"красивые", "красив"},
+		{"важная", "важн"},
+		{"важного", "важн"},
+		{"важнейшие", "важн"},
+		{"прекраснейшая", "прекрасн"},
+		{"каменный", "камен"},
+		{"бегущий", "бегущ"},
+		{"бывшие", "бывш"},
+		{"читали", "чита"},
+		{"гуляя", "гул"},
+		{"прочитавши", "прочита"},
+		{"умывшись", "ум"},
+		{"вечность", "вечност"},
+		{"подробности", "подробн"},
+	}
+
+	for _, tt := range tests {
+		if got := stemRussian(tt.word); got != tt.want {
+			t.Errorf("stemRussian(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
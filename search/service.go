@@ -0,0 +1,163 @@
+// Package search provides full-text search of books, chapters and notes,
+// through an embedded index kept in line with the handling service.
+package search
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidArgument is returned when one or more arguments are invalid.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrNotFound is returned when a book or chapter is not in the index.
+var ErrNotFound = errors.New("not found")
+
+// Limits of a page of results.
+const (
+	defaultLimit        = 20
+	defaultRelatedLimit = 5
+	maxLimit            = 100
+)
+
+// minRelatedScore is the similarity below which books and chapters are
+// not considered related.
+const minRelatedScore = 0.05
+
+// Query is a search of the index. Every word of Text has to match, in any
+// of its forms. BookID limits the search to one book.
+type Query struct {
+	Text   string
+	BookID string
+	Offset int32
+	Limit  int32
+}
+
+// Result is a book, chapter or note matching a query. Snippet is a part of
+// it in HTML, the words matching the query marked with <mark>.
+type Result struct {
+	Kind      string  `json:"kind"`
+	ID        string  `json:"id"`
+	BookID    string  `json:"bookId"`
+	ChapterID string  `json:"chapterId,omitempty"`
+	Title     string  `json:"title,omitempty"`
+	Snippet   string  `json:"snippet"`
+	Score     float64 `json:"score"`
+}
+
+// Results is a page of the results of a query, best first, and the number
+// of them in total.
+type Results struct {
+	Results []Result `json:"results"`
+	Total   int      `json:"total"`
+}
+
+// Related is a book or chapter similar in content to another. Score is
+// their similarity, from 0 to 1.
+type Related struct {
+	Kind   string  `json:"kind"`
+	ID     string  `json:"id"`
+	BookID string  `json:"bookId"`
+	Title  string  `json:"title"`
+	Score  float64 `json:"score"`
+}
+
+// Service is the interface that provides search methods.
+type Service interface {
+	Search(ctx context.Context, q Query) (Results, error)
+	RelatedBooks(ctx context.Context, id string, limit int32) ([]Related, error)
+	RelatedChapters(ctx context.Context, bookID string, id string, limit int32) ([]Related, error)
+}
+
+type service struct {
+	index *Index
+}
+
+// NewService returns a new instance of a search Service over index.
+func NewService(index *Index) Service {
+	return &service{
+		index: index,
+	}
+}
+
+func (s *service) Search(ctx context.Context, q Query) (Results, error) {
+	q.Text = strings.TrimSpace(q.Text)
+	if q.Text == "" || q.Offset < 0 || q.Limit < 0 || q.Limit > maxLimit {
+		return Results{}, ErrInvalidArgument
+	}
+	if q.Limit == 0 {
+		q.Limit = defaultLimit
+	}
+
+	hits, total := s.index.Search(q.Text, q.BookID, int(q.Offset), int(q.Limit))
+
+	results := make([]Result, 0, len(hits))
+	for _, h := range hits {
+		results = append(results, Result{
+			Kind:      h.Kind,
+			ID:        h.ID,
+			BookID:    h.BookID,
+			ChapterID: h.ChapterID,
+			Title:     h.Title,
+			Snippet:   h.Snippet,
+			Score:     round(h.Score),
+		})
+	}
+
+	return Results{Results: results, Total: total}, nil
+}
+
+// RelatedBooks returns the books most similar to the book with the given
+// ID, by the words of the book, its chapters and their notes.
+func (s *service) RelatedBooks(ctx context.Context, id string, limit int32) ([]Related, error) {
+	if id == "" {
+		return nil, ErrInvalidArgument
+	}
+	return s.related(KindBook, id, limit)
+}
+
+// RelatedChapters returns the chapters most similar to the chapter with
+// the given ID, by the words of the chapters and their notes.
+func (s *service) RelatedChapters(ctx context.Context, bookID string, id string, limit int32) ([]Related, error) {
+	if bookID == "" || id == "" {
+		return nil, ErrInvalidArgument
+	}
+	if b, ok := s.index.BookOf(id); !ok || b != bookID {
+		return nil, ErrNotFound
+	}
+	return s.related(KindChapter, id, limit)
+}
+
+func (s *service) related(kind, id string, limit int32) ([]Related, error) {
+	if limit < 0 || limit > maxLimit {
+		return nil, ErrInvalidArgument
+	}
+	if limit == 0 {
+		limit = defaultRelatedLimit
+	}
+
+	similar, ok := s.index.Similar(kind, id, int(limit))
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	related := make([]Related, 0, len(similar))
+	for _, sim := range similar {
+		if sim.Score < minRelatedScore {
+			break
+		}
+		related = append(related, Related{
+			Kind:   sim.Kind,
+			ID:     sim.ID,
+			BookID: sim.BookID,
+			Title:  sim.Title,
+			Score:  round(sim.Score),
+		})
+	}
+	return related, nil
+}
+
+func round(f float64) float64 {
+	return float64(int64(f*1000+0.5)) / 1000
+}
@@ -0,0 +1,150 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	kitlog "github.com/go-kit/kit/log"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+)
+
+// MakeHandler returns a handler for the search service. Its routes are
+// among those of the handling service, so requests to any other route are
+// passed on to next.
+func MakeHandler(s Service, next http.Handler, logger kitlog.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorLogger(logger),
+		kithttp.ServerErrorEncoder(encodeError),
+	}
+
+	searchHandler := kithttp.NewServer(
+		makeSearchEndpoint(s),
+		decodeSearchRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	relatedBooksHandler := kithttp.NewServer(
+		makeRelatedBooksEndpoint(s),
+		decodeRelatedBooksRequest,
+		encodeResponse,
+		opts...,
+	)
+	relatedChaptersHandler := kithttp.NewServer(
+		makeRelatedChaptersEndpoint(s),
+		decodeRelatedChaptersRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	r := mux.NewRouter()
+	r.NotFoundHandler = next
+
+	v1 := r.PathPrefix("/handling/v1").Subrouter()
+	{
+		v1.Handle("/search", searchHandler).Methods("GET")
+		v1.Handle("/books/{id}/related", relatedBooksHandler).Methods("GET")
+		v1.Handle("/books/{book_id}/chapters/{id}/related", relatedChaptersHandler).Methods("GET")
+	}
+
+	return r
+}
+
+var errBadRoute = errors.New("bad route")
+
+func decodeSearchRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+
+	query := Query{
+		Text:   q.Get("q"),
+		BookID: q.Get("book_id"),
+	}
+
+	var err error
+	if query.Offset, err = parseInt32(q.Get("offset")); err != nil {
+		return nil, err
+	}
+	if query.Limit, err = parseInt32(q.Get("limit")); err != nil {
+		return nil, err
+	}
+
+	return searchRequest{Query: query}, nil
+}
+
+func decodeRelatedBooksRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	limit, err := parseInt32(r.URL.Query().Get("limit"))
+	if err != nil {
+		return nil, err
+	}
+
+	return relatedBooksRequest{ID: id, Limit: limit}, nil
+}
+
+func decodeRelatedChaptersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	bookID, ok := vars["book_id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	limit, err := parseInt32(r.URL.Query().Get("limit"))
+	if err != nil {
+		return nil, err
+	}
+
+	return relatedChaptersRequest{BookID: bookID, ID: id, Limit: limit}, nil
+}
+
+func parseInt32(s string) (int32, error) {
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, ErrInvalidArgument
+	}
+	return int32(n), nil
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encodeError encodes errors from business-logic.
+func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch err {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case ErrNotFound:
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,313 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// Delivery tuning. A failed delivery is retried with exponential backoff,
+// starting at retryBaseDelay and capped at retryMaxDelay, and moved to the
+// dead-letter list after maxAttempts.
+const (
+	maxAttempts    = 8
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = time.Hour
+
+	pollInterval = 5 * time.Second
+	batchSize    = 50
+	storeTimeout = 5 * time.Second
+	sendTimeout  = 10 * time.Second
+)
+
+// Headers set on every delivery.
+const (
+	HeaderEvent     = "X-Rembook-Event"
+	HeaderDelivery  = "X-Rembook-Delivery"
+	HeaderSignature = "X-Rembook-Signature"
+)
+
+// Dispatcher records a delivery for every webhook subscribed to a published
+// event and sends pending deliveries in the background. Deliveries are
+// persisted, so the ones still pending are resumed after a restart.
+type Dispatcher struct {
+	client *http.Client
+	logger log.Logger
+	wake   chan struct{}
+}
+
+// NewDispatcher returns a new instance of a Dispatcher.
+func NewDispatcher(logger log.Logger) *Dispatcher {
+	return &Dispatcher{
+		client: &http.Client{Timeout: sendTimeout},
+		logger: logger,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+type payload struct {
+	Name string `json:"event"`
+	handling.Event
+}
+
+// Publish implements handling.EventPublisher.
+func (d *Dispatcher) Publish(_ context.Context, e handling.Event) {
+	// The mutation has already happened, so the deliveries are recorded
+	// even if the request that caused it is cancelled meanwhile.
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	name := e.Name()
+	body, err := json.Marshal(payload{Name: name, Event: e})
+	if err != nil {
+		d.logger.Log("event", name, "err", err)
+		return
+	}
+
+	hooks, err := client.Webhooks(nil).Exec(ctx)
+	if err != nil {
+		d.logger.Log("event", name, "err", err)
+		return
+	}
+
+	now := formatTime(time.Now())
+	for _, hook := range hooks {
+		if !subscribed(hook, name) {
+			continue
+		}
+		id := hook.ID
+		_, err := client.CreateWebhookDelivery(prisma.WebhookDeliveryCreateInput{
+			Event:         name,
+			Payload:       string(body),
+			NextAttemptAt: &now,
+			Webhook: prisma.WebhookCreateOneWithoutDeliveriesInput{
+				Connect: &prisma.WebhookWhereUniqueInput{ID: &id},
+			},
+		}).Exec(ctx)
+		if err != nil {
+			d.logger.Log("event", name, "webhook", id, "err", err)
+		}
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run sends due deliveries until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+func (d *Dispatcher) deliverDue(ctx context.Context) {
+	status := prisma.DeliveryStatusPending
+	now := time.Now()
+	ts := formatTime(now)
+	orderBy := prisma.WebhookDeliveryOrderByInputNextAttemptAtAsc
+
+	due, err := client.WebhookDeliveries(&prisma.WebhookDeliveriesParams{
+		Where: &prisma.WebhookDeliveryWhereInput{
+			Status:           &status,
+			NextAttemptAtLte: &ts,
+		},
+		OrderBy: &orderBy,
+		First:   prisma.Int32(batchSize),
+	}).Exec(ctx)
+
+	if err != nil {
+		d.logger.Log("err", err)
+		return
+	}
+
+	// Deliveries to different webhooks are sent concurrently, so that an
+	// endpoint that times out does not hold up the others.
+	var (
+		order []string
+		hooks = make(map[string]prisma.Webhook)
+	)
+	for _, delivery := range due {
+		id := delivery.ID
+		hook, err := client.WebhookDelivery(prisma.WebhookDeliveryWhereUniqueInput{
+			ID: &id,
+		}).Webhook().Exec(ctx)
+
+		if err != nil {
+			d.logger.Log("delivery", id, "err", err)
+			continue
+		}
+		if _, ok := hooks[hook.ID]; !ok {
+			order = append(order, hook.ID)
+			hooks[hook.ID] = *hook
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, id := range order {
+		wg.Add(1)
+		go func(hook prisma.Webhook) {
+			defer wg.Done()
+			d.deliverInOrder(ctx, hook, now)
+		}(hooks[id])
+	}
+	wg.Wait()
+}
+
+// deliverInOrder sends the pending deliveries to hook in the order they were
+// made. A delivery waiting for its retry holds back the ones after it, so
+// they stop at the first one which is not due or fails. Dead deliveries are
+// not pending and hold back nothing.
+func (d *Dispatcher) deliverInOrder(ctx context.Context, hook prisma.Webhook, now time.Time) {
+	status := prisma.DeliveryStatusPending
+	orderBy := prisma.WebhookDeliveryOrderByInputCreatedAtAsc
+	id := hook.ID
+
+	pending, err := client.WebhookDeliveries(&prisma.WebhookDeliveriesParams{
+		Where: &prisma.WebhookDeliveryWhereInput{
+			Status:  &status,
+			Webhook: &prisma.WebhookWhereInput{ID: &id},
+		},
+		OrderBy: &orderBy,
+		First:   prisma.Int32(batchSize),
+	}).Exec(ctx)
+
+	if err != nil {
+		d.logger.Log("webhook", id, "err", err)
+		return
+	}
+
+	for _, delivery := range pending {
+		if !isDue(delivery, now) || ctx.Err() != nil || !d.attempt(ctx, hook, delivery) {
+			return
+		}
+	}
+}
+
+// attempt sends delivery to hook, records the outcome and reports whether
+// the delivery succeeded.
+func (d *Dispatcher) attempt(ctx context.Context, hook prisma.Webhook, delivery prisma.WebhookDelivery) bool {
+	id := delivery.ID
+	attempts := delivery.Attempts + 1
+	data := prisma.WebhookDeliveryUpdateInput{
+		Attempts: &attempts,
+	}
+
+	code, err := d.send(ctx, hook, delivery)
+	sent := err == nil
+	if code != 0 {
+		c := int32(code)
+		data.LastStatusCode = &c
+	}
+
+	switch {
+	case err == nil:
+		status := prisma.DeliveryStatusSucceeded
+		data.Status = &status
+	case attempts >= maxAttempts:
+		status := prisma.DeliveryStatusDead
+		data.Status = &status
+		data.LastError = prisma.Str(err.Error())
+	default:
+		data.LastError = prisma.Str(err.Error())
+		data.NextAttemptAt = prisma.Str(formatTime(time.Now().Add(backoff(attempts))))
+	}
+
+	d.logger.Log(
+		"delivery", id,
+		"webhook", hook.ID,
+		"event", delivery.Event,
+		"attempt", attempts,
+		"code", code,
+		"err", err,
+	)
+
+	_, err = client.UpdateWebhookDelivery(prisma.WebhookDeliveryUpdateParams{
+		Where: prisma.WebhookDeliveryWhereUniqueInput{ID: &id},
+		Data:  data,
+	}).Exec(ctx)
+
+	if err != nil {
+		d.logger.Log("delivery", id, "err", err)
+	}
+	return sent
+}
+
+func (d *Dispatcher) send(ctx context.Context, hook prisma.Webhook, delivery prisma.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+
+	req, err := http.NewRequest("POST", hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", "rembook-webhook")
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderSignature, Sign(hook.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature of body sent in the X-Rembook-Signature header:
+// the hex encoded HMAC-SHA256 of the body keyed with the webhook secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// isDue reports whether delivery is to be attempted at now.
+func isDue(delivery prisma.WebhookDelivery, now time.Time) bool {
+	if delivery.NextAttemptAt == nil {
+		return true
+	}
+	next, err := time.Parse(time.RFC3339, *delivery.NextAttemptAt)
+	return err != nil || !next.After(now)
+}
+
+func backoff(attempt int32) time.Duration {
+	delay := retryBaseDelay
+	for i := int32(1); i < attempt; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
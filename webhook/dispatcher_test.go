@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		secret string
+		body   string
+		want   string
+	}{
+		{"Jefe", "what do ya want for nothing?", "sha256=5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"},
+		{"secret", `{"event":"book.created"}`, "sha256=2be006d1380b9f266ffbc7c0d8b09fa732b52e9b621f2797d878263b7cd93d67"},
+		{"other", `{"event":"book.created"}`, "sha256=479294a306c549a4d393cb4a300535cdcf9b7c8229b3df3d5400a6e9f518f13c"},
+		{"secret", "", "sha256=f9e66e179b6747ae54108f82f8ade8b3c25d76fd30afde6c395822c530196169"},
+	}
+
+	for _, tt := range tests {
+		if got := Sign(tt.secret, []byte(tt.body)); got != tt.want {
+			t.Errorf("Sign(%q, %q) = %s, want %s", tt.secret, tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int32
+		want    time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{4, 80 * time.Second},
+		{7, 640 * time.Second},
+		{8, 1280 * time.Second},
+		{9, 2560 * time.Second},
+		{10, time.Hour},
+		{30, time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := backoff(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestAttempt(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     int
+		attempts int32
+		sent     bool
+		status   interface{}
+		retried  bool
+	}{
+		{"succeeded", http.StatusOK, 0, true, "SUCCEEDED", false},
+		{"succeeded on a retry", http.StatusNoContent, 5, true, "SUCCEEDED", false},
+		{"failed", http.StatusInternalServerError, 0, false, nil, true},
+		{"failed for the seventh time", http.StatusBadGateway, 6, false, nil, true},
+		{"failed for the eighth time", http.StatusInternalServerError, 7, false, "DEAD", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.code)
+			}))
+			defer endpoint.Close()
+
+			f, restore := usePrisma(t, map[string]prismaOp{
+				"updateWebhookDelivery": returns(map[string]interface{}{"id": "d1"}),
+			})
+			defer restore()
+
+			d := NewDispatcher(log.NewNopLogger())
+			hook := prisma.Webhook{ID: "w1", URL: endpoint.URL, Secret: "secret"}
+			delivery := prisma.WebhookDelivery{ID: "d1", Event: "book.created", Payload: "{}", Attempts: tt.attempts}
+
+			if sent := d.attempt(context.Background(), hook, delivery); sent != tt.sent {
+				t.Errorf("attempt = %v, want %v", sent, tt.sent)
+			}
+
+			updates := f.called("updateWebhookDelivery")
+			if len(updates) != 1 {
+				t.Fatalf("got %d updates, want 1", len(updates))
+			}
+			data := updates[0].Vars["data"]
+			if got := lookup(data, "attempts"); got != float64(tt.attempts+1) {
+				t.Errorf("attempts = %v, want %d", got, tt.attempts+1)
+			}
+			if got := lookup(data, "status"); got != tt.status {
+				t.Errorf("status = %v, want %v", got, tt.status)
+			}
+			if got := lookup(data, "lastStatusCode"); got != float64(tt.code) {
+				t.Errorf("lastStatusCode = %v, want %d", got, tt.code)
+			}
+			if retried := lookup(data, "nextAttemptAt") != nil; retried != tt.retried {
+				t.Errorf("retry scheduled: %v, want %v", retried, tt.retried)
+			}
+		})
+	}
+}
+
+func TestDeliverInOrder(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	past, future := "2026-10-19T11:59:00.000Z", "2026-10-19T12:05:00.000Z"
+
+	tests := []struct {
+		name    string
+		pending []map[string]interface{}
+		failing string
+		want    []string
+	}{
+		{"all due", []map[string]interface{}{
+			{"id": "d1", "nextAttemptAt": past},
+			{"id": "d2", "nextAttemptAt": past},
+		}, "", []string{"d1", "d2"}},
+		{"stopped by a failure", []map[string]interface{}{
+			{"id": "d1", "nextAttemptAt": past},
+			{"id": "d2", "nextAttemptAt": past},
+			{"id": "d3", "nextAttemptAt": past},
+		}, "d2", []string{"d1", "d2"}},
+		{"held back by a retry", []map[string]interface{}{
+			{"id": "d1", "nextAttemptAt": future},
+			{"id": "d2", "nextAttemptAt": past},
+		}, "", nil},
+		{"after a due retry", []map[string]interface{}{
+			{"id": "d1", "nextAttemptAt": past},
+			{"id": "d2", "nextAttemptAt": future},
+			{"id": "d3", "nextAttemptAt": past},
+		}, "", []string{"d1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu   sync.Mutex
+				sent []string
+			)
+			endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				id := r.Header.Get(HeaderDelivery)
+				mu.Lock()
+				sent = append(sent, id)
+				mu.Unlock()
+				if id == tt.failing {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+			}))
+			defer endpoint.Close()
+
+			pending := make([]interface{}, len(tt.pending))
+			for i, p := range tt.pending {
+				pending[i] = p
+			}
+			f, restore := usePrisma(t, map[string]prismaOp{
+				"webhookDeliveries":     returns(pending),
+				"updateWebhookDelivery": returns(map[string]interface{}{"id": "d"}),
+			})
+			defer restore()
+
+			hook := prisma.Webhook{ID: "w1", URL: endpoint.URL, Secret: "secret"}
+			NewDispatcher(log.NewNopLogger()).deliverInOrder(context.Background(), hook, now)
+
+			if !reflect.DeepEqual(sent, tt.want) {
+				t.Errorf("sent %v, want %v", sent, tt.want)
+			}
+
+			where := f.called("webhookDeliveries")[0].Vars
+			if lookup(where, "where", "webhook", "id") != "w1" || lookup(where, "where", "status") != "PENDING" || lookup(where, "orderBy") != "createdAt_ASC" {
+				t.Errorf("pending deliveries queried with %v, want those of w1 in the order they were made", where)
+			}
+		})
+	}
+}
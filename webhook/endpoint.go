@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+type addSubscriptionRequest struct {
+	URL    string
+	Secret string
+	Events []string
+}
+
+type addSubscriptionResponse struct {
+	Subscription Subscription `json:"subscription,omitempty"`
+	Err          error        `json:"err,omitempty"`
+}
+
+func (r addSubscriptionResponse) error() error { return r.Err }
+
+func makeAddSubscriptionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(addSubscriptionRequest)
+		sub, err := s.AddSubscription(ctx, req.URL, req.Secret, req.Events)
+		return addSubscriptionResponse{Subscription: sub, Err: err}, nil
+	}
+}
+
+type getSubscriptionRequest struct {
+	ID string `json:"id"`
+}
+
+type getSubscriptionResponse struct {
+	Subscription Subscription `json:"subscription,omitempty"`
+	Err          error        `json:"err,omitempty"`
+}
+
+func (r getSubscriptionResponse) error() error { return r.Err }
+
+func makeGetSubscriptionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getSubscriptionRequest)
+		sub, err := s.GetSubscription(ctx, req.ID)
+		return getSubscriptionResponse{Subscription: sub, Err: err}, nil
+	}
+}
+
+type deleteSubscriptionRequest struct {
+	ID string `json:"id"`
+}
+
+type deleteSubscriptionResponse struct {
+	Subscription Subscription `json:"subscription,omitempty"`
+	Err          error        `json:"err,omitempty"`
+}
+
+func (r deleteSubscriptionResponse) error() error { return r.Err }
+
+func makeDeleteSubscriptionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteSubscriptionRequest)
+		sub, err := s.DeleteSubscription(ctx, req.ID)
+		return deleteSubscriptionResponse{Subscription: sub, Err: err}, nil
+	}
+}
+
+type listSubscriptionsRequest struct{}
+
+type listSubscriptionsResponse struct {
+	Subscriptions []Subscription `json:"subscriptions,omitempty"`
+	Err           error          `json:"err,omitempty"`
+}
+
+func (r listSubscriptionsResponse) error() error { return r.Err }
+
+func makeListSubscriptionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listSubscriptionsRequest)
+		subs, err := s.Subscriptions(ctx)
+		return listSubscriptionsResponse{Subscriptions: subs, Err: err}, nil
+	}
+}
+
+type listDeliveriesRequest struct {
+	SubscriptionID string `json:"subscription_id"`
+}
+
+type listDeliveriesResponse struct {
+	Deliveries []prisma.WebhookDelivery `json:"deliveries,omitempty"`
+	Err        error                    `json:"err,omitempty"`
+}
+
+func (r listDeliveriesResponse) error() error { return r.Err }
+
+func makeListDeliveriesEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listDeliveriesRequest)
+		deliveries, err := s.Deliveries(ctx, req.SubscriptionID)
+		return listDeliveriesResponse{Deliveries: deliveries, Err: err}, nil
+	}
+}
+
+type listDeadLettersRequest struct{}
+
+type listDeadLettersResponse struct {
+	Deliveries []prisma.WebhookDelivery `json:"deliveries,omitempty"`
+	Err        error                    `json:"err,omitempty"`
+}
+
+func (r listDeadLettersResponse) error() error { return r.Err }
+
+func makeListDeadLettersEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listDeadLettersRequest)
+		deliveries, err := s.DeadLetters(ctx)
+		return listDeadLettersResponse{Deliveries: deliveries, Err: err}, nil
+	}
+}
+
+type redeliverRequest struct {
+	ID string `json:"id"`
+}
+
+type redeliverResponse struct {
+	Delivery prisma.WebhookDelivery `json:"delivery,omitempty"`
+	Err      error                  `json:"err,omitempty"`
+}
+
+func (r redeliverResponse) error() error { return r.Err }
+
+func makeRedeliverEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(redeliverRequest)
+		delivery, err := s.Redeliver(ctx, req.ID)
+		return redeliverResponse{Delivery: delivery, Err: err}, nil
+	}
+}
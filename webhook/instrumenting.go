@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+type instrumentingService struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	Service
+}
+
+// NewInstrumentingService returns an instance of an instrumenting Service.
+func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram, s Service) Service {
+	return &instrumentingService{
+		requestCount:   counter,
+		requestLatency: latency,
+		Service:        s,
+	}
+}
+
+func (s *instrumentingService) AddSubscription(ctx context.Context, url string, secret string, events []string) (Subscription, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "add_subscription").Add(1)
+		s.requestLatency.With("method", "add_subscription").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.AddSubscription(ctx, url, secret, events)
+}
+
+func (s *instrumentingService) GetSubscription(ctx context.Context, id string) (Subscription, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "get_subscription").Add(1)
+		s.requestLatency.With("method", "get_subscription").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.GetSubscription(ctx, id)
+}
+
+func (s *instrumentingService) DeleteSubscription(ctx context.Context, id string) (Subscription, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "delete_subscription").Add(1)
+		s.requestLatency.With("method", "delete_subscription").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DeleteSubscription(ctx, id)
+}
+
+func (s *instrumentingService) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_subscriptions").Add(1)
+		s.requestLatency.With("method", "list_subscriptions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Subscriptions(ctx)
+}
+
+func (s *instrumentingService) Deliveries(ctx context.Context, subscriptionID string) ([]prisma.WebhookDelivery, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_deliveries").Add(1)
+		s.requestLatency.With("method", "list_deliveries").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Deliveries(ctx, subscriptionID)
+}
+
+func (s *instrumentingService) DeadLetters(ctx context.Context) ([]prisma.WebhookDelivery, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_dead_letters").Add(1)
+		s.requestLatency.With("method", "list_dead_letters").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DeadLetters(ctx)
+}
+
+func (s *instrumentingService) Redeliver(ctx context.Context, deliveryID string) (prisma.WebhookDelivery, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "redeliver").Add(1)
+		s.requestLatency.With("method", "redeliver").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Redeliver(ctx, deliveryID)
+}
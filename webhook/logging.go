@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+type loggingService struct {
+	logger log.Logger
+	Service
+}
+
+// NewLoggingService returns a new instance of a logging Service.
+func NewLoggingService(logger log.Logger, s Service) Service {
+	return &loggingService{logger, s}
+}
+
+func (s *loggingService) AddSubscription(ctx context.Context, url string, secret string, events []string) (sub Subscription, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "add_subscription",
+			"url", url,
+			"events", len(events),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AddSubscription(ctx, url, secret, events)
+}
+
+func (s *loggingService) GetSubscription(ctx context.Context, id string) (sub Subscription, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "get_subscription",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.GetSubscription(ctx, id)
+}
+
+func (s *loggingService) DeleteSubscription(ctx context.Context, id string) (sub Subscription, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "delete_subscription",
+			"id", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeleteSubscription(ctx, id)
+}
+
+func (s *loggingService) Subscriptions(ctx context.Context) (subs []Subscription, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_subscriptions",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Subscriptions(ctx)
+}
+
+func (s *loggingService) Deliveries(ctx context.Context, subscriptionID string) (deliveries []prisma.WebhookDelivery, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_deliveries",
+			"subscriptionID", subscriptionID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Deliveries(ctx, subscriptionID)
+}
+
+func (s *loggingService) DeadLetters(ctx context.Context) (deliveries []prisma.WebhookDelivery, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_dead_letters",
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DeadLetters(ctx)
+}
+
+func (s *loggingService) Redeliver(ctx context.Context, deliveryID string) (delivery prisma.WebhookDelivery, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "redeliver",
+			"deliveryID", deliveryID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Redeliver(ctx, deliveryID)
+}
@@ -0,0 +1,269 @@
+// Package webhook provides management of webhook subscriptions and the
+// delivery of book and chapter change events to them.
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// ErrInvalidArgument is returned when one or more arguments are invalid.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrNotFound is returned when a subscription or a delivery does not exist.
+var ErrNotFound = errors.New("not found")
+
+// AllEvents subscribes a webhook to every event.
+const AllEvents = "*"
+
+// Events lists the event names a webhook can subscribe to.
+var Events = []string{
+	"book.created",
+	"book.updated",
+	"book.deleted",
+	"chapter.created",
+	"chapter.updated",
+	"chapter.deleted",
+}
+
+// Subscription is a webhook subscription. The secret is write-only and is
+// never returned by the API.
+type Subscription struct {
+	ID        string   `json:"id"`
+	CreatedAt string   `json:"createdAt"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+}
+
+// Service is the interface that provides webhook methods.
+type Service interface {
+	AddSubscription(ctx context.Context, url string, secret string, events []string) (Subscription, error)
+	GetSubscription(ctx context.Context, id string) (Subscription, error)
+	DeleteSubscription(ctx context.Context, id string) (Subscription, error)
+	Subscriptions(ctx context.Context) ([]Subscription, error)
+
+	Deliveries(ctx context.Context, subscriptionID string) ([]prisma.WebhookDelivery, error)
+	DeadLetters(ctx context.Context) ([]prisma.WebhookDelivery, error)
+	Redeliver(ctx context.Context, deliveryID string) (prisma.WebhookDelivery, error)
+}
+
+type service struct{}
+
+// NewService returns a new instance of a webhook Service.
+func NewService() Service {
+	return &service{}
+}
+
+var client = prisma.New(nil)
+
+func (s *service) AddSubscription(ctx context.Context, rawurl string, secret string, events []string) (Subscription, error) {
+	if !validURL(rawurl) || secret == "" || !validEvents(events) {
+		return Subscription{}, ErrInvalidArgument
+	}
+
+	hook, err := client.CreateWebhook(prisma.WebhookCreateInput{
+		URL:    rawurl,
+		Secret: secret,
+		Events: strings.Join(events, ","),
+	}).Exec(ctx)
+
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	return toSubscription(*hook), nil
+}
+
+func (s *service) GetSubscription(ctx context.Context, id string) (Subscription, error) {
+	if id == "" {
+		return Subscription{}, ErrInvalidArgument
+	}
+
+	hook, err := findWebhook(ctx, id)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	return toSubscription(*hook), nil
+}
+
+func (s *service) DeleteSubscription(ctx context.Context, id string) (Subscription, error) {
+	if id == "" {
+		return Subscription{}, ErrInvalidArgument
+	}
+
+	if _, err := findWebhook(ctx, id); err != nil {
+		return Subscription{}, err
+	}
+
+	hook, err := client.DeleteWebhook(prisma.WebhookWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	return toSubscription(*hook), nil
+}
+
+func (s *service) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	hooks, err := client.Webhooks(nil).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]Subscription, 0, len(hooks))
+	for _, hook := range hooks {
+		subs = append(subs, toSubscription(hook))
+	}
+	return subs, nil
+}
+
+func (s *service) Deliveries(ctx context.Context, subscriptionID string) ([]prisma.WebhookDelivery, error) {
+	if subscriptionID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := findWebhook(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	orderBy := prisma.WebhookDeliveryOrderByInputCreatedAtDesc
+	deliveries, err := client.Webhook(prisma.WebhookWhereUniqueInput{
+		ID: &subscriptionID,
+	}).Deliveries(&prisma.DeliveriesParamsExec{
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (s *service) DeadLetters(ctx context.Context) ([]prisma.WebhookDelivery, error) {
+	status := prisma.DeliveryStatusDead
+	orderBy := prisma.WebhookDeliveryOrderByInputUpdatedAtDesc
+	deliveries, err := client.WebhookDeliveries(&prisma.WebhookDeliveriesParams{
+		Where: &prisma.WebhookDeliveryWhereInput{
+			Status: &status,
+		},
+		OrderBy: &orderBy,
+	}).Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (s *service) Redeliver(ctx context.Context, deliveryID string) (prisma.WebhookDelivery, error) {
+	if deliveryID == "" {
+		return prisma.WebhookDelivery{}, ErrInvalidArgument
+	}
+
+	_, err := client.WebhookDelivery(prisma.WebhookDeliveryWhereUniqueInput{
+		ID: &deliveryID,
+	}).Exec(ctx)
+
+	if err == prisma.ErrNoResult {
+		return prisma.WebhookDelivery{}, ErrNotFound
+	}
+	if err != nil {
+		return prisma.WebhookDelivery{}, err
+	}
+
+	status := prisma.DeliveryStatusPending
+	delivery, err := client.UpdateWebhookDelivery(prisma.WebhookDeliveryUpdateParams{
+		Where: prisma.WebhookDeliveryWhereUniqueInput{
+			ID: &deliveryID,
+		},
+		Data: prisma.WebhookDeliveryUpdateInput{
+			Status:        &status,
+			Attempts:      prisma.Int32(0),
+			NextAttemptAt: prisma.Str(formatTime(time.Now())),
+		},
+	}).Exec(ctx)
+
+	if err != nil {
+		return prisma.WebhookDelivery{}, err
+	}
+
+	return *delivery, nil
+}
+
+// findWebhook returns the webhook with the given ID.
+func findWebhook(ctx context.Context, id string) (*prisma.Webhook, error) {
+	hook, err := client.Webhook(prisma.WebhookWhereUniqueInput{
+		ID: &id,
+	}).Exec(ctx)
+
+	if err == prisma.ErrNoResult {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return hook, nil
+}
+
+func toSubscription(hook prisma.Webhook) Subscription {
+	return Subscription{
+		ID:        hook.ID,
+		CreatedAt: hook.CreatedAt,
+		URL:       hook.URL,
+		Events:    strings.Split(hook.Events, ","),
+	}
+}
+
+func subscribed(hook prisma.Webhook, event string) bool {
+	for _, e := range strings.Split(hook.Events, ",") {
+		if e == event || e == AllEvents {
+			return true
+		}
+	}
+	return false
+}
+
+func validURL(rawurl string) bool {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func validEvents(events []string) bool {
+	if len(events) == 0 {
+		return false
+	}
+	for _, e := range events {
+		if e == AllEvents {
+			continue
+		}
+		known := false
+		for _, name := range Events {
+			if e == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return false
+		}
+	}
+	return true
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUnknown(t *testing.T) {
+	s := NewService()
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context) error
+	}{
+		{"get subscription", func(ctx context.Context) error {
+			_, err := s.GetSubscription(ctx, "w1")
+			return err
+		}},
+		{"delete subscription", func(ctx context.Context) error {
+			_, err := s.DeleteSubscription(ctx, "w1")
+			return err
+		}},
+		{"list deliveries", func(ctx context.Context) error {
+			_, err := s.Deliveries(ctx, "w1")
+			return err
+		}},
+		{"redeliver", func(ctx context.Context) error {
+			_, err := s.Redeliver(ctx, "d1")
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, restore := usePrisma(t, map[string]prismaOp{
+				"webhook":         returns(nil),
+				"webhookDelivery": returns(nil),
+			})
+			defer restore()
+
+			err := tt.call(context.Background())
+			if err != ErrNotFound {
+				t.Fatalf("error = %v, want %v", err, ErrNotFound)
+			}
+
+			w := httptest.NewRecorder()
+			encodeError(context.Background(), err, w)
+			if w.Code != http.StatusNotFound {
+				t.Errorf("encoded as %d, want %d", w.Code, http.StatusNotFound)
+			}
+		})
+	}
+}
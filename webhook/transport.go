@@ -0,0 +1,174 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	kitlog "github.com/go-kit/kit/log"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maxp36/rembook/handling/generated/prisma"
+)
+
+// MakeHandler returns a handler for the webhook service.
+func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorLogger(logger),
+		kithttp.ServerErrorEncoder(encodeError),
+	}
+
+	addSubscriptionHandler := kithttp.NewServer(
+		makeAddSubscriptionEndpoint(s),
+		decodeAddSubscriptionRequest,
+		encodeResponse,
+		opts...,
+	)
+	getSubscriptionHandler := kithttp.NewServer(
+		makeGetSubscriptionEndpoint(s),
+		decodeGetSubscriptionRequest,
+		encodeResponse,
+		opts...,
+	)
+	deleteSubscriptionHandler := kithttp.NewServer(
+		makeDeleteSubscriptionEndpoint(s),
+		decodeDeleteSubscriptionRequest,
+		encodeResponse,
+		opts...,
+	)
+	listSubscriptionsHandler := kithttp.NewServer(
+		makeListSubscriptionsEndpoint(s),
+		decodeListSubscriptionsRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	listDeliveriesHandler := kithttp.NewServer(
+		makeListDeliveriesEndpoint(s),
+		decodeListDeliveriesRequest,
+		encodeResponse,
+		opts...,
+	)
+	listDeadLettersHandler := kithttp.NewServer(
+		makeListDeadLettersEndpoint(s),
+		decodeListDeadLettersRequest,
+		encodeResponse,
+		opts...,
+	)
+	redeliverHandler := kithttp.NewServer(
+		makeRedeliverEndpoint(s),
+		decodeRedeliverRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	r := mux.NewRouter()
+
+	v1 := r.PathPrefix("/webhook/v1").Subrouter()
+	{
+		v1.Handle("/subscriptions", addSubscriptionHandler).Methods("POST")
+		v1.Handle("/subscriptions", listSubscriptionsHandler).Methods("GET")
+		v1.Handle("/subscriptions/{id}", getSubscriptionHandler).Methods("GET")
+		v1.Handle("/subscriptions/{id}", deleteSubscriptionHandler).Methods("DELETE")
+		v1.Handle("/subscriptions/{id}/deliveries", listDeliveriesHandler).Methods("GET")
+
+		v1.Handle("/dead-letters", listDeadLettersHandler).Methods("GET")
+		v1.Handle("/deliveries/{id}/redeliver", redeliverHandler).Methods("POST")
+	}
+
+	return r
+}
+
+var errBadRoute = errors.New("bad route")
+
+func decodeAddSubscriptionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var body struct {
+		URL    string   `json:"url"`
+		Secret string   `json:"secret"`
+		Events []string `json:"events"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return addSubscriptionRequest{
+		URL:    body.URL,
+		Secret: body.Secret,
+		Events: body.Events,
+	}, nil
+}
+
+func decodeGetSubscriptionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return getSubscriptionRequest{ID: id}, nil
+}
+
+func decodeDeleteSubscriptionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return deleteSubscriptionRequest{ID: id}, nil
+}
+
+func decodeListSubscriptionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listSubscriptionsRequest{}, nil
+}
+
+func decodeListDeliveriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return listDeliveriesRequest{SubscriptionID: id}, nil
+}
+
+func decodeListDeadLettersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listDeadLettersRequest{}, nil
+}
+
+func decodeRedeliverRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return redeliverRequest{ID: id}, nil
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encodeError encodes errors from business-logic.
+func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch err {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case ErrNotFound, prisma.ErrNoResult:
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}